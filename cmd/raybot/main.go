@@ -3,11 +3,20 @@ package main
 import (
 	"flag"
 	"os"
+	// Embed the time zone database, the schedules are evaluated in their time zone
+	// and the robot image may not ship one.
+	_ "time/tzdata"
 
+	"github.com/tbe-team/raybot/cmd/raybot/replay"
 	"github.com/tbe-team/raybot/cmd/raybot/standalone"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		replay.Run(os.Args[2:])
+		return
+	}
+
 	var (
 		configFilePath string
 		dbPath         string
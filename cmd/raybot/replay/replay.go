@@ -0,0 +1,108 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/tbe-team/raybot/internal/hardware/serialcapture"
+	"github.com/tbe-team/raybot/internal/replay"
+)
+
+// Run replays the serial captures given as arguments, in order, and prints
+// the failed messages and the final robot state.
+func Run(args []string) {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	verbose := fs.Bool("v", false, "print every record, not only the failed ones")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: raybot replay [-v] <capture file>...\n\n")
+		fmt.Fprintf(fs.Output(), "Rotated captures are replayed in the given order, oldest first.\n\n")
+		fs.PrintDefaults()
+	}
+	// ExitOnError exits on a parse error
+	_ = fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	// The migrations of the in-memory db would clutter the replay output
+	goose.SetLogger(goose.NopLogger())
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	if err := run(context.Background(), logger, os.Stdout, fs.Args(), *verbose); err != nil {
+		log.Fatalf("error replaying capture: %v", err)
+	}
+}
+
+func run(ctx context.Context, logger *slog.Logger, out io.Writer, paths []string, verbose bool) error {
+	r, cleanup, err := replay.New(logger)
+	if err != nil {
+		return fmt.Errorf("create replayer: %w", err)
+	}
+	defer func() {
+		if err := cleanup(); err != nil {
+			logger.Error("failed to clean up replayer", slog.Any("error", err))
+		}
+	}()
+
+	onResult := func(result replay.Result) {
+		if !verbose && result.Err == nil {
+			return
+		}
+		record := result.Record
+		data := record.Data
+		if record.IsRaw() {
+			data = "raw " + record.Raw
+		}
+		fmt.Fprintf(out, "%s %s %s %s\n", record.Time.Format(time.RFC3339Nano), record.Board, record.Direction, data)
+		if result.Err != nil {
+			fmt.Fprintf(out, "    error: %v\n", result.Err)
+		}
+	}
+
+	var total replay.Summary
+	for _, path := range paths {
+		summary, err := replayFile(ctx, r, path, onResult)
+		total.Records += summary.Records
+		total.Handled += summary.Handled
+		total.Failed += summary.Failed
+		if err != nil {
+			return fmt.Errorf("replay %s: %w", path, err)
+		}
+	}
+
+	fmt.Fprintf(out, "\nreplayed %d records: %d received messages, %d failed\n", total.Records, total.Handled, total.Failed)
+
+	state, err := r.RobotState(ctx)
+	if err != nil {
+		return fmt.Errorf("get robot state: %w", err)
+	}
+
+	stateJSON, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal robot state: %w", err)
+	}
+	fmt.Fprintf(out, "\nfinal robot state:\n%s\n", stateJSON)
+
+	return nil
+}
+
+func replayFile(ctx context.Context, r *replay.Replayer, path string, onResult func(replay.Result)) (replay.Summary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return replay.Summary{}, fmt.Errorf("open capture: %w", err)
+	}
+	defer f.Close()
+
+	return r.Replay(ctx, serialcapture.NewReader(f), onResult)
+}
@@ -18,6 +18,7 @@ func startCloud(app *application.Application, interruptChan <-chan any) error {
 		app.EventBus,
 		app.EventBus,
 		app.CommandService,
+		app.RailMapService,
 		app.SystemService,
 		app.BatteryService,
 		app.CargoService,
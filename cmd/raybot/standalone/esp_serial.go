@@ -15,6 +15,9 @@ func startESPSerial(app *application.Application, interruptChan <-chan any, read
 		app.EventBus,
 		app.ESPSerialClient,
 		app.CargoService,
+		app.AppStateService,
+		app.PeripheralService,
+		app.Boards,
 	)
 
 	cleanup, err := service.Run(app.Context)
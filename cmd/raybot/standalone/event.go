@@ -14,6 +14,7 @@ func startEventService(app *application.Application, interruptChan <-chan any) e
 		app.AppStateService,
 		app.CommandService,
 		app.SystemService,
+		app.LedService,
 	)
 
 	cleanup, err := service.Run(app.Context)
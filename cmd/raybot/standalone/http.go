@@ -12,6 +12,7 @@ func startHTTPService(app *application.Application, interruptChan <-chan any) er
 	service := http.New(
 		app.Cfg.HTTP,
 		app.Log,
+		app.EventBus,
 		app.ConfigService,
 		app.SystemService,
 		app.DashboardDataService,
@@ -20,6 +21,8 @@ func startHTTPService(app *application.Application, interruptChan <-chan any) er
 		app.ApperrorcodeService,
 		app.LimitSwitchService,
 		app.AlarmService,
+		app.RailMapService,
+		app.ScheduleService,
 	)
 
 	cleanup, err := service.Run()
@@ -21,6 +21,8 @@ func startPICSerial(app *application.Application, interruptChan <-chan any, read
 		app.LimitSwitchService,
 		app.CargoService,
 		app.AppStateService,
+		app.PeripheralService,
+		app.Boards,
 	)
 
 	cleanup, err := service.Run(app.Context)
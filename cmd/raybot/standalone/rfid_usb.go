@@ -9,10 +9,16 @@ import (
 )
 
 func startRFIDUSB(app *application.Application, interruptChan <-chan any, readyWg *sync.WaitGroup) error {
+	var opts []rfidusb.OptionFunc
+	if app.SimRobot != nil {
+		opts = append(opts, rfidusb.WithClient(app.SimRobot.RFIDReader()))
+	}
+
 	service := rfidusb.New(
 		app.Log,
 		app.EventBus,
 		app.LocationService,
+		opts...,
 	)
 
 	cleanup, err := service.Run(app.Context)
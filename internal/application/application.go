@@ -10,7 +10,10 @@ import (
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/hardware/controller"
 	"github.com/tbe-team/raybot/internal/hardware/espserial"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
 	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/hardware/serialcapture"
+	"github.com/tbe-team/raybot/internal/hardware/sim"
 	"github.com/tbe-team/raybot/internal/logging"
 	"github.com/tbe-team/raybot/internal/services/alarm"
 	"github.com/tbe-team/raybot/internal/services/alarm/alarmimpl"
@@ -46,6 +49,10 @@ import (
 	"github.com/tbe-team/raybot/internal/services/monitoring/monitoringimpl"
 	"github.com/tbe-team/raybot/internal/services/peripheral"
 	"github.com/tbe-team/raybot/internal/services/peripheral/peripheralimpl"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/internal/services/railmap/railmapimpl"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/internal/services/schedule/scheduleimpl"
 	"github.com/tbe-team/raybot/internal/services/system"
 	"github.com/tbe-team/raybot/internal/services/system/systemimpl"
 	"github.com/tbe-team/raybot/internal/services/system/systeminfocollector"
@@ -67,6 +74,10 @@ type Application struct {
 
 	ESPSerialClient espserial.Client
 	PICSerialClient picserial.Client
+	// SimRobot is the hardware simulator, nil unless the hardware mode is sim.
+	SimRobot *sim.Robot
+	// Boards holds what the PIC and ESP reported in the protocol handshake.
+	Boards *handshake.Registry
 
 	BatteryService        battery.Service
 	DistanceSensorService distancesensor.Service
@@ -84,12 +95,16 @@ type Application struct {
 	ApperrorcodeService   apperrorcode.Service
 	LedService            led.Service
 	AlarmService          alarm.Service
+	RailMapService        railmap.Service
 	MonitoringService     monitoring.Service
+	ScheduleService       schedule.Service
 }
 
 type CleanupFunc func() error
 
-func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
+// New creates the application. The executor options register the executors
+// of custom command types, e.g. site-specific steps of a deployment.
+func New(configFilePath, dbPath string, executorOpts ...executor.Option) (*Application, CleanupFunc, error) {
 	ctx := context.Background()
 
 	// Set UTC timezone
@@ -136,13 +151,52 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 	distanceSensorStateRepository := distancesensorimpl.NewDistanceSensorStateRepository()
 	appStateRepository := appstateimpl.NewAppStateRepository()
 	commandRepository := commandimpl.NewCommandRepository(db, queries)
+	missionRepository := commandimpl.NewMissionRepository(db, queries)
+	queueStateRepository := commandimpl.NewQueueStateRepository(db, queries)
 	systemInfoRepository := systemimpl.NewRepository()
 	ledRepository := ledimpl.NewRepository()
 	alarmRepository := alarmimpl.NewRepository(db, queries)
+	railMapRepository := railmapimpl.NewRepository(db, queries)
+	scheduleRepository := scheduleimpl.NewRepository(db, queries)
+
+	// Record the serial traffic: the raw bytes of the ports here, the messages
+	// through the wrapped clients below
+	var (
+		captureRecorder *serialcapture.Recorder
+		espOpts         []espserial.OptionFunc
+		picOpts         []picserial.OptionFunc
+	)
+	if cfg.Hardware.Capture.Enable {
+		captureRecorder, err = serialcapture.NewRecorder(cfg.Hardware.Capture, log)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create serial capture recorder: %w", err)
+		}
+		espOpts = append(espOpts, espserial.WithPortWrapper(serialcapture.WrapPort(serialcapture.BoardESP, captureRecorder)))
+		picOpts = append(picOpts, picserial.WithPortWrapper(serialcapture.WrapPort(serialcapture.BoardPIC, captureRecorder)))
+	}
 
 	// Initialize hardware components
-	espSerialClient := espserial.NewClient(cfg.Hardware.ESP.Serial)
-	if err := espSerialClient.Open(); err != nil {
+	espSerialClient := espserial.NewClient(cfg.Hardware.ESP.Serial, espOpts...)
+	picSerialClient := picserial.NewClient(cfg.Hardware.PIC.Serial, picOpts...)
+	openESPSerialClient := espSerialClient.Open
+	openPICSerialClient := picSerialClient.Open
+
+	// In sim mode the serial clients talk to the simulated boards, the ports are already open
+	var simRobot *sim.Robot
+	if cfg.Hardware.Mode == config.HardwareModeSim {
+		simRobot = sim.New(cfg.Hardware.Sim, log,
+			sim.WithESPProtocol(cfg.Hardware.ESP.Serial.Protocol),
+			sim.WithPICProtocol(cfg.Hardware.PIC.Serial.Protocol),
+		)
+		espSerialClient = espserial.NewClientWithPort(simRobot.ESPPort(),
+			append(espOpts, espserial.WithProtocol(cfg.Hardware.ESP.Serial.Protocol))...)
+		picSerialClient = picserial.NewClientWithPort(simRobot.PICPort(),
+			append(picOpts, picserial.WithProtocol(cfg.Hardware.PIC.Serial.Protocol))...)
+		openESPSerialClient = func() error { return nil }
+		openPICSerialClient = func() error { return nil }
+	}
+
+	if err := openESPSerialClient(); err != nil {
 		log.Error("failed to open ESP serial client",
 			slog.Any("serial_cfg", cfg.Hardware.ESP.Serial),
 			slog.Any("error", err),
@@ -167,8 +221,7 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 		}
 	}
 
-	picSerialClient := picserial.NewClient(cfg.Hardware.PIC.Serial)
-	if err := picSerialClient.Open(); err != nil {
+	if err := openPICSerialClient(); err != nil {
 		log.Error("failed to open PIC serial client",
 			slog.Any("serial_cfg", cfg.Hardware.PIC.Serial),
 			slog.Any("error", err),
@@ -192,7 +245,19 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 			log.Error("failed to update PIC serial connection", slog.Any("error", err))
 		}
 	}
-	hardwareController := controller.New(cfg.Hardware, log, eventBus, picSerialClient, espSerialClient)
+
+	// Record the serial messages, the controller and the serial handlers use the wrapped clients
+	var (
+		espClient espserial.Client = espSerialClient
+		picClient picserial.Client = picSerialClient
+	)
+	if captureRecorder != nil {
+		espClient = serialcapture.NewClient(espSerialClient, serialcapture.BoardESP, captureRecorder)
+		picClient = serialcapture.NewClient(picSerialClient, serialcapture.BoardPIC, captureRecorder)
+	}
+
+	boards := handshake.NewRegistry()
+	hardwareController := controller.New(cfg.Hardware, log, eventBus, picClient, espClient, controller.WithBoards(boards))
 
 	// Initialize services
 	batteryService := batteryimpl.NewService(validator, eventBus, batteryStateRepository, batterySettingRepository, hardwareController)
@@ -201,6 +266,7 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 	liftMotorService := liftmotorimpl.NewService(validator, liftMotorStateRepository, hardwareController)
 	cargoService := cargoimpl.NewService(validator, eventBus, cargoRepository, hardwareController)
 	locationService := locationimpl.NewService(validator, eventBus, locationRepository)
+	railMapService := railmapimpl.NewService(validator, railMapRepository)
 	limitSwitchService := limitswitchimpl.NewService(log, validator, eventBus, limitSwitchStateRepository)
 	configService := configimpl.NewService(cfg, fileClient)
 	dashboardDataService := dashboarddataimpl.NewService(
@@ -213,31 +279,46 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 		cargoRepository,
 		appStateRepository,
 		ledRepository,
+		queueStateRepository,
 	)
 	appStateService := appstateimpl.NewService(appStateRepository)
 	peripheralService := peripheralimpl.NewService()
 
 	runningCmdRepository := commandimpl.NewRunningCmdRepository()
+	executorService, err := executor.NewService(
+		log,
+		eventBus,
+		eventBus,
+		configService,
+		driveMotorService,
+		liftMotorService,
+		cargoService,
+		batteryService,
+		distanceSensorService,
+		locationService,
+		railMapService,
+		dashboardDataService,
+		runningCmdRepository,
+		commandRepository,
+		executorOpts...,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create executor service: %w", err)
+	}
 	commandService := commandimpl.NewService(
 		cfg.Cron.DeleteOldCommand,
+		cfg.Command.Recovery,
 		log,
 		validator,
 		eventBus,
 		runningCmdRepository,
 		commandRepository,
+		missionRepository,
+		queueStateRepository,
 		processinglockimpl.New(),
-		executor.NewService(
-			log,
-			eventBus,
-			configService,
-			driveMotorService,
-			liftMotorService,
-			cargoService,
-			distanceSensorService,
-			runningCmdRepository,
-			commandRepository,
-		),
+		executorService,
 	)
+	scheduleService := scheduleimpl.NewService(log, validator, scheduleRepository, commandService)
 	wifiService := wifiimpl.NewService(cfg.Wifi, log)
 	if err := wifiService.Run(ctx); err != nil {
 		return nil, nil, fmt.Errorf("failed to run wifi service: %w", err)
@@ -268,9 +349,16 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 	)
 	monitoringService.Start(ctx)
 
+	if simRobot != nil {
+		simRobot.Start(ctx)
+	}
+
 	cleanup := func() error {
 		var errs []error
 
+		if simRobot != nil {
+			simRobot.Stop()
+		}
 		monitoringService.Stop()
 		systemInfoCollectorService.Stop()
 		appStateRepository.Cleanup()
@@ -290,6 +378,12 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 			}
 		}
 
+		if captureRecorder != nil {
+			if captureErr := captureRecorder.Close(); captureErr != nil {
+				errs = append(errs, fmt.Errorf("failed to close serial capture recorder: %w", captureErr))
+			}
+		}
+
 		if dbErr := db.Close(); dbErr != nil {
 			errs = append(errs, fmt.Errorf("failed to close db: %w", dbErr))
 		}
@@ -306,8 +400,10 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 		Log:                   log,
 		Context:               ctx,
 		EventBus:              eventBus,
-		ESPSerialClient:       espSerialClient,
-		PICSerialClient:       picSerialClient,
+		ESPSerialClient:       espClient,
+		PICSerialClient:       picClient,
+		SimRobot:              simRobot,
+		Boards:                boards,
 		BatteryService:        batteryService,
 		DistanceSensorService: distanceSensorService,
 		DriveMotorService:     driveMotorService,
@@ -323,5 +419,7 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 		CommandService:        commandService,
 		ApperrorcodeService:   apperrorcodeService,
 		AlarmService:          alarmService,
+		RailMapService:        railMapService,
+		ScheduleService:       scheduleService,
 	}, cleanup, nil
 }
@@ -0,0 +1,43 @@
+package config
+
+import "fmt"
+
+const (
+	defaultCapturePath          = "logs/serial.capture"
+	defaultCaptureMaxSize       = 10 // MB
+	defaultCaptureRotationCount = 5
+)
+
+// Capture is the recording of the PIC and ESP serial traffic, used to
+// debug the firmware and to replay field issues offline.
+type Capture struct {
+	Enable bool `yaml:"enable"`
+	// Path is the capture file. Rotated files are kept next to it.
+	Path string `yaml:"path"`
+	// MaxSize is the size in MB of the capture file before it is rotated.
+	MaxSize int `yaml:"max_size"`
+	// RotationCount is the number of rotated files to keep.
+	RotationCount int `yaml:"rotation_count"`
+}
+
+func (c *Capture) Validate() error {
+	if c.Path == "" {
+		c.Path = defaultCapturePath
+	}
+
+	if c.MaxSize < 0 {
+		return fmt.Errorf("max size must be positive: %d", c.MaxSize)
+	}
+	if c.MaxSize == 0 {
+		c.MaxSize = defaultCaptureMaxSize
+	}
+
+	if c.RotationCount < 0 {
+		return fmt.Errorf("rotation count must be positive: %d", c.RotationCount)
+	}
+	if c.RotationCount == 0 {
+		c.RotationCount = defaultCaptureRotationCount
+	}
+
+	return nil
+}
@@ -1,13 +1,23 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type Command struct {
-	CargoLift  CargoLift  `yaml:"cargo_lift"`
-	CargoLower CargoLower `yaml:"cargo_lower"`
+	Move       Move           `yaml:"move"`
+	CargoLift  CargoLift      `yaml:"cargo_lift"`
+	CargoLower CargoLower     `yaml:"cargo_lower"`
+	Timeout    CommandTimeout `yaml:"timeout"`
+	Recovery   Recovery       `yaml:"recovery"`
 }
 
 func (c *Command) Validate() error {
+	if err := c.Move.Validate(); err != nil {
+		return fmt.Errorf("move: %w", err)
+	}
+
 	if err := c.CargoLift.Validate(); err != nil {
 		return fmt.Errorf("cargo_lift: %w", err)
 	}
@@ -16,6 +26,64 @@ func (c *Command) Validate() error {
 		return fmt.Errorf("cargo_lower: %w", err)
 	}
 
+	if err := c.Timeout.Validate(); err != nil {
+		return fmt.Errorf("timeout: %w", err)
+	}
+
+	if err := c.Recovery.Validate(); err != nil {
+		return fmt.Errorf("recovery: %w", err)
+	}
+
+	return nil
+}
+
+// Move is the configuration for MOVE_TO, MOVE_FORWARD and MOVE_BACKWARD commands.
+type Move struct {
+	// EnableObstacleTracking stops the drive motor while an obstacle is detected in the direction of travel
+	EnableObstacleTracking bool `yaml:"enable_obstacle_tracking"`
+
+	// FrontObstacleTracking is the configuration for the front obstacle tracking, used when moving forward
+	FrontObstacleTracking ObstacleTracking `yaml:"front_obstacle_tracking"`
+
+	// BackObstacleTracking is the configuration for the back obstacle tracking, used when moving backward
+	BackObstacleTracking ObstacleTracking `yaml:"back_obstacle_tracking"`
+
+	// AccelerationRamp is the duration to ramp the drive motor up to the target speed, zero starts at the target speed
+	AccelerationRamp time.Duration `yaml:"acceleration_ramp"`
+
+	// DecelerationRamp is the duration to ramp the drive motor down to the approach speed
+	DecelerationRamp time.Duration `yaml:"deceleration_ramp"`
+
+	// ApproachSpeed is the drive motor speed (0-100) after the location just before the MOVE_TO target,
+	// zero disables the slow-down zone
+	ApproachSpeed uint8 `yaml:"approach_speed"`
+}
+
+func (c Move) Validate() error {
+	if c.AccelerationRamp < 0 {
+		return fmt.Errorf("acceleration_ramp must not be negative")
+	}
+
+	if c.DecelerationRamp < 0 {
+		return fmt.Errorf("deceleration_ramp must not be negative")
+	}
+
+	if c.ApproachSpeed > 100 {
+		return fmt.Errorf("approach_speed must be between 0 and 100")
+	}
+
+	if !c.EnableObstacleTracking {
+		return nil
+	}
+
+	if err := c.FrontObstacleTracking.Validate(); err != nil {
+		return fmt.Errorf("front_obstacle_tracking: %w", err)
+	}
+
+	if err := c.BackObstacleTracking.Validate(); err != nil {
+		return fmt.Errorf("back_obstacle_tracking: %w", err)
+	}
+
 	return nil
 }
 
@@ -59,3 +127,83 @@ func (c ObstacleTracking) Validate() error {
 	}
 	return nil
 }
+
+// CommandTimeout is the default execution timeout for each command type.
+// A zero value means the command never times out unless the timeout is set in its inputs.
+type CommandTimeout struct {
+	StopMovement time.Duration `yaml:"stop_movement"`
+	MoveForward  time.Duration `yaml:"move_forward"`
+	MoveBackward time.Duration `yaml:"move_backward"`
+	MoveTo       time.Duration `yaml:"move_to"`
+	CargoOpen    time.Duration `yaml:"cargo_open"`
+	CargoClose   time.Duration `yaml:"cargo_close"`
+	CargoLift    time.Duration `yaml:"cargo_lift"`
+	CargoLower   time.Duration `yaml:"cargo_lower"`
+	CargoCheckQR time.Duration `yaml:"cargo_check_qr"`
+	ScanLocation time.Duration `yaml:"scan_location"`
+	Wait         time.Duration `yaml:"wait"`
+}
+
+func (c CommandTimeout) Validate() error {
+	timeouts := map[string]time.Duration{
+		"stop_movement":  c.StopMovement,
+		"move_forward":   c.MoveForward,
+		"move_backward":  c.MoveBackward,
+		"move_to":        c.MoveTo,
+		"cargo_open":     c.CargoOpen,
+		"cargo_close":    c.CargoClose,
+		"cargo_lift":     c.CargoLift,
+		"cargo_lower":    c.CargoLower,
+		"cargo_check_qr": c.CargoCheckQR,
+		"scan_location":  c.ScanLocation,
+		"wait":           c.Wait,
+	}
+	for name, timeout := range timeouts {
+		if timeout < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	return nil
+}
+
+// RecoveryPolicy decides what happens on startup to the commands left pending by the previous run.
+type RecoveryPolicy string
+
+func (p RecoveryPolicy) Validate() error {
+	switch p {
+	case RecoveryPolicyCancelAll, RecoveryPolicyFailInterrupted, RecoveryPolicyRerunIdempotent:
+		return nil
+	}
+	return fmt.Errorf("invalid recovery policy: %s", p)
+}
+
+func (p RecoveryPolicy) String() string {
+	return string(p)
+}
+
+const (
+	// RecoveryPolicyCancelAll cancels the queued and the interrupted commands.
+	RecoveryPolicyCancelAll RecoveryPolicy = "CANCEL_ALL"
+	// RecoveryPolicyFailInterrupted fails the interrupted command and resumes the queue.
+	RecoveryPolicyFailInterrupted RecoveryPolicy = "FAIL_INTERRUPTED"
+	// RecoveryPolicyRerunIdempotent queues the interrupted command again if it is idempotent,
+	// fails it otherwise, and resumes the queue.
+	RecoveryPolicyRerunIdempotent RecoveryPolicy = "RERUN_IDEMPOTENT"
+)
+
+// Recovery is the configuration for recovering the command queue on startup.
+type Recovery struct {
+	// Policy is the recovery policy, CANCEL_ALL if empty
+	Policy RecoveryPolicy `yaml:"policy"`
+
+	// SafeLiftPosition is the lift position (bottom distance, cm) that must be reached, as for CARGO_LIFT,
+	// before the queue resumes after a command was interrupted, zero skips the lift position check
+	SafeLiftPosition uint16 `yaml:"safe_lift_position"`
+}
+
+func (c *Recovery) Validate() error {
+	if c.Policy == "" {
+		c.Policy = RecoveryPolicyCancelAll
+	}
+	return c.Policy.Validate()
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -10,7 +11,7 @@ type Cron struct {
 	DeleteOldCommand DeleteOldCommand `yaml:"delete_old_command"`
 }
 
-func (c Cron) Validate() error {
+func (c *Cron) Validate() error {
 	if err := c.DeleteOldCommand.Validate(); err != nil {
 		return fmt.Errorf("delete_old_command: %w", err)
 	}
@@ -18,22 +19,24 @@ func (c Cron) Validate() error {
 }
 
 type DeleteOldCommand struct {
-	scheduleDuration time.Duration
-	Schedule         string        `yaml:"schedule"`
-	Threshold        time.Duration `yaml:"threshold"`
+	schedule CronSchedule
+	// Schedule is the cron expression of the deletion, evaluated in UTC.
+	Schedule  string        `yaml:"schedule"`
+	Threshold time.Duration `yaml:"threshold"`
 }
 
-func (c DeleteOldCommand) ScheduleDuration() time.Duration {
-	return c.scheduleDuration
+// NextRun returns the next time the old commands are deleted after t.
+func (c DeleteOldCommand) NextRun(t time.Time) time.Time {
+	return c.schedule.Next(t.UTC())
 }
 
 func (c *DeleteOldCommand) Validate() error {
-	d, err := parseDuration(c.Schedule)
+	s, err := ParseCronSchedule(c.Schedule)
 	if err != nil {
 		return fmt.Errorf("schedule: %w", err)
 	}
 
-	c.scheduleDuration = d
+	c.schedule = s
 
 	if c.Threshold.Hours() < 1 {
 		return fmt.Errorf("threshold must be greater than 1 hour")
@@ -42,6 +45,75 @@ func (c *DeleteOldCommand) Validate() error {
 	return nil
 }
 
+// CronSchedule is a parsed cron expression.
+type CronSchedule interface {
+	// Next returns the first activation time after t.
+	// It returns the zero time if there is no activation in the next years.
+	Next(t time.Time) time.Time
+}
+
+// ParseCronSchedule parses a cron expression. The supported formats are:
+//   - "@every <duration>", for example "@every 1h".
+//   - The descriptors @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly.
+//   - The standard five fields "minute hour day-of-month month day-of-week",
+//     for example "30 8 * * MON-FRI". Each field accepts *, values, ranges, lists and steps.
+//     Months and days of week accept their three letter names, Sunday is 0 or 7.
+//
+// The fields are evaluated in the location of the time given to Next.
+func ParseCronSchedule(expr string) (CronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	if strings.HasPrefix(expr, "@every ") {
+		d, err := parseDuration(expr)
+		if err != nil {
+			return nil, err
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf("@every duration must be at least 1 second")
+		}
+		return everySchedule{interval: d}, nil
+	}
+
+	if descriptor, ok := cronDescriptors[expr]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+
+	var bits [5]uint64
+	for i, field := range fields {
+		b, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", cronFields[i].name, err)
+		}
+		bits[i] = b
+	}
+
+	// Sunday can be written as 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	s := fieldsSchedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}
+
+	if s.Next(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("expression never matches")
+	}
+
+	return s, nil
+}
+
 // parseDuration parses a duration string with the format "@every <duration>".
 // For example, "@every 1h" will be parsed as 1 hour.
 func parseDuration(expr string) (time.Duration, error) {
@@ -51,3 +123,171 @@ func parseDuration(expr string) (time.Duration, error) {
 	}
 	return time.ParseDuration(strings.TrimPrefix(expr, prefix))
 }
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronField struct {
+	name  string
+	min   uint
+	max   uint
+	names map[string]uint
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+// parseCronField parses a comma separated list of *, values, ranges and steps
+// into a bit set of the matching values.
+func parseCronField(expr string, field cronField) (uint64, error) {
+	var bits uint64
+	for part := range strings.SplitSeq(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+
+		step := uint(1)
+		if hasStep {
+			s, err := strconv.ParseUint(stepExpr, 10, 8)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step %q", stepExpr)
+			}
+			step = uint(s)
+		}
+
+		var lo, hi uint
+		if rangeExpr == "*" {
+			lo, hi = field.min, field.max
+		} else {
+			loExpr, hiExpr, isRange := strings.Cut(rangeExpr, "-")
+
+			var err error
+			lo, err = parseCronValue(loExpr, field)
+			if err != nil {
+				return 0, err
+			}
+
+			switch {
+			case isRange:
+				hi, err = parseCronValue(hiExpr, field)
+				if err != nil {
+					return 0, err
+				}
+			case hasStep:
+				// "5/15" is the same as "5-max/15".
+				hi = field.max
+			default:
+				hi = lo
+			}
+		}
+
+		if lo > hi {
+			return 0, fmt.Errorf("invalid range %q", rangeExpr)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(expr string, field cronField) (uint, error) {
+	if v, ok := field.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.ParseUint(expr, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", expr)
+	}
+	if uint(v) < field.min || uint(v) > field.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, field.min, field.max)
+	}
+
+	return uint(v), nil
+}
+
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// fieldsSchedule is a five-field cron expression, each field is a bit set of the matching values.
+type fieldsSchedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// As in the standard cron, if both day of month and day of week are restricted,
+	// a day matches if either of them matches.
+	domStar bool
+	dowStar bool
+}
+
+// cronSearchYears bounds the search of the next activation.
+const cronSearchYears = 5
+
+func (s fieldsSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+	yearLimit := t.Year() + cronSearchYears
+
+	// Each loop moves t to the start of the next matching unit,
+	// it restarts from the month when a unit wraps around.
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s fieldsSchedule) matchDay(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
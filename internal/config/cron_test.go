@@ -0,0 +1,117 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	// 2025-08-06 is a Wednesday.
+	from := time.Date(2025, time.August, 6, 10, 15, 30, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		next time.Time
+	}{
+		{
+			name: "every duration",
+			expr: "@every 1h",
+			next: from.Add(time.Hour),
+		},
+		{
+			name: "descriptor",
+			expr: "@daily",
+			next: time.Date(2025, time.August, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			next: time.Date(2025, time.August, 6, 10, 16, 0, 0, time.UTC),
+		},
+		{
+			name: "fixed time later today",
+			expr: "30 14 * * *",
+			next: time.Date(2025, time.August, 6, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "fixed time tomorrow",
+			expr: "0 8 * * *",
+			next: time.Date(2025, time.August, 7, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "steps",
+			expr: "*/20 * * * *",
+			next: time.Date(2025, time.August, 6, 10, 20, 0, 0, time.UTC),
+		},
+		{
+			name: "list and range",
+			expr: "0 9,17 * * 1-5",
+			next: time.Date(2025, time.August, 6, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of week names",
+			expr: "0 8 * * SAT,SUN",
+			next: time.Date(2025, time.August, 9, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 8 * * 7",
+			next: time.Date(2025, time.August, 10, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month names",
+			expr: "0 0 1 jan *",
+			next: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 1 * FRI",
+			next: time.Date(2025, time.August, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			next: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := ParseCronSchedule(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.next, s.Next(from))
+		})
+	}
+
+	t.Run("Should use the location of the given time", func(t *testing.T) {
+		loc := time.FixedZone("UTC+7", 7*60*60)
+		s, err := ParseCronSchedule("0 8 * * *")
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, time.August, 7, 8, 0, 0, 0, loc), s.Next(from.In(loc)))
+	})
+
+	invalid := []string{
+		"",
+		"@every",
+		"@every 1ms",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * foo *",
+		"0 0 30 2 *",
+	}
+	for _, expr := range invalid {
+		t.Run("Should fail to parse "+expr, func(t *testing.T) {
+			_, err := ParseCronSchedule(expr)
+			require.Error(t, err)
+		})
+	}
+}
@@ -6,15 +6,36 @@ import (
 	"time"
 )
 
-const defaultCommandACKTimeout = 1 * time.Second
+const (
+	defaultCommandACKTimeout = 1 * time.Second
+	maxCommandACKAttempts    = 10
+
+	defaultReconnectInitialBackoff = 500 * time.Millisecond
+	defaultReconnectMaxBackoff     = 30 * time.Second
+)
 
 type Hardware struct {
-	ESP  ESP  `yaml:"esp"`
-	PIC  PIC  `yaml:"pic"`
-	Leds Leds `yaml:"leds"`
+	// Mode selects the hardware backend, the real serial boards or the simulator.
+	Mode HardwareMode `yaml:"mode"`
+	ESP  ESP          `yaml:"esp"`
+	PIC  PIC          `yaml:"pic"`
+	Leds Leds         `yaml:"leds"`
+	Sim  Sim          `yaml:"sim"`
+	// Capture records the serial traffic of the PIC and ESP.
+	Capture Capture `yaml:"capture"`
 }
 
 func (h *Hardware) Validate() error {
+	mode := HardwareMode(strings.ToLower(string(h.Mode)))
+	switch mode {
+	case "":
+		mode = HardwareModeSerial
+	case HardwareModeSerial, HardwareModeSim:
+	default:
+		return fmt.Errorf("invalid mode: %s", h.Mode)
+	}
+	h.Mode = mode
+
 	if err := h.ESP.Validate(); err != nil {
 		return fmt.Errorf("validate esp: %w", err)
 	}
@@ -31,13 +52,34 @@ func (h *Hardware) Validate() error {
 		return fmt.Errorf("validate leds: %w", err)
 	}
 
+	if err := h.Sim.Validate(); err != nil {
+		return fmt.Errorf("validate sim: %w", err)
+	}
+
+	if err := h.Capture.Validate(); err != nil {
+		return fmt.Errorf("validate capture: %w", err)
+	}
+
 	return nil
 }
 
+// HardwareMode is the hardware backend of the robot.
+type HardwareMode string
+
+const (
+	// HardwareModeSerial talks to the PIC and ESP boards over the serial ports.
+	HardwareModeSerial HardwareMode = "serial"
+	// HardwareModeSim runs an in-process simulator instead of the boards,
+	// so the whole stack can run without the robot.
+	HardwareModeSim HardwareMode = "sim"
+)
+
 type ESP struct {
-	Serial            Serial        `yaml:"serial"`
-	EnableACK         bool          `yaml:"enable_ack"`
-	CommandACKTimeout time.Duration `yaml:"command_ack_timeout"`
+	Serial            Serial          `yaml:"serial"`
+	EnableACK         bool            `yaml:"enable_ack"`
+	CommandACKTimeout time.Duration   `yaml:"command_ack_timeout"`
+	CommandACKRetry   CommandACKRetry `yaml:"command_ack_retry"`
+	Reconnect         SerialReconnect `yaml:"reconnect"`
 }
 
 func (e *ESP) Validate() error {
@@ -49,13 +91,23 @@ func (e *ESP) Validate() error {
 		e.CommandACKTimeout = defaultCommandACKTimeout
 	}
 
+	if err := e.CommandACKRetry.Validate(); err != nil {
+		return fmt.Errorf("validate esp command ack retry: %w", err)
+	}
+
+	if err := e.Reconnect.Validate(); err != nil {
+		return fmt.Errorf("validate esp reconnect: %w", err)
+	}
+
 	return nil
 }
 
 type PIC struct {
-	Serial            Serial        `yaml:"serial"`
-	EnableACK         bool          `yaml:"enable_ack"`
-	CommandACKTimeout time.Duration `yaml:"command_ack_timeout"`
+	Serial            Serial          `yaml:"serial"`
+	EnableACK         bool            `yaml:"enable_ack"`
+	CommandACKTimeout time.Duration   `yaml:"command_ack_timeout"`
+	CommandACKRetry   CommandACKRetry `yaml:"command_ack_retry"`
+	Reconnect         SerialReconnect `yaml:"reconnect"`
 }
 
 func (p *PIC) Validate() error {
@@ -67,6 +119,69 @@ func (p *PIC) Validate() error {
 		p.CommandACKTimeout = defaultCommandACKTimeout
 	}
 
+	if err := p.CommandACKRetry.Validate(); err != nil {
+		return fmt.Errorf("validate pic command ack retry: %w", err)
+	}
+
+	if err := p.Reconnect.Validate(); err != nil {
+		return fmt.Errorf("validate pic reconnect: %w", err)
+	}
+
+	return nil
+}
+
+// CommandACKRetry is the retry of a command whose ACK timed out.
+// The delay between the attempts starts at InitialBackoff and doubles up to MaxBackoff.
+type CommandACKRetry struct {
+	// MaxAttempts is the number of attempts including the first one, 1 disables the retry.
+	MaxAttempts    uint          `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+func (r *CommandACKRetry) Validate() error {
+	if r.MaxAttempts == 0 {
+		r.MaxAttempts = 1
+	}
+
+	if r.MaxAttempts > maxCommandACKAttempts {
+		return fmt.Errorf("max attempts must be less than or equal to %d", maxCommandACKAttempts)
+	}
+
+	if r.InitialBackoff < 0 {
+		return fmt.Errorf("initial backoff must be greater than or equal to 0")
+	}
+
+	if r.MaxBackoff != 0 && r.MaxBackoff < r.InitialBackoff {
+		return fmt.Errorf("max backoff must be greater than or equal to initial backoff")
+	}
+
+	return nil
+}
+
+// SerialReconnect is the re-opening of a serial port after the link is lost.
+// The delay between the attempts starts at InitialBackoff and doubles up to MaxBackoff.
+type SerialReconnect struct {
+	Enable         bool          `yaml:"enable"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+func (r *SerialReconnect) Validate() error {
+	if r.InitialBackoff < 0 {
+		return fmt.Errorf("initial backoff must be greater than or equal to 0")
+	}
+	if r.InitialBackoff == 0 {
+		r.InitialBackoff = defaultReconnectInitialBackoff
+	}
+
+	if r.MaxBackoff == 0 {
+		r.MaxBackoff = max(defaultReconnectMaxBackoff, r.InitialBackoff)
+	}
+	if r.MaxBackoff < r.InitialBackoff {
+		return fmt.Errorf("max backoff must be greater than or equal to initial backoff")
+	}
+
 	return nil
 }
 
@@ -77,6 +192,27 @@ type Serial struct {
 	StopBits    float32       `yaml:"stop_bits"`
 	Parity      string        `yaml:"parity"`
 	ReadTimeout time.Duration `yaml:"read_timeout"`
+	// Protocol is the framing of the messages on the link. It is selected here, not negotiated
+	// with the board, so it must match the board firmware. A link where no message is found
+	// is reported as disconnected with serialframe.ErrNotSynced.
+	Protocol SerialProtocol `yaml:"protocol"`
+	// USB identifies the USB serial adapter of the board. When set, the port is
+	// looked up by these IDs on reconnect, so the board is found again when it
+	// re-enumerates under another /dev/ttyUSB* name.
+	USB SerialUSB `yaml:"usb"`
+}
+
+// SerialUSB are the USB IDs of a serial adapter, as hex strings like "1A86".
+// Empty fields match any value.
+type SerialUSB struct {
+	VID          string `yaml:"vid"`
+	PID          string `yaml:"pid"`
+	SerialNumber string `yaml:"serial_number"`
+}
+
+// IsSet reports whether the adapter is identified by at least one ID.
+func (u SerialUSB) IsSet() bool {
+	return u.VID != "" || u.PID != "" || u.SerialNumber != ""
 }
 
 func (s *Serial) Validate() error {
@@ -98,9 +234,30 @@ func (s *Serial) Validate() error {
 	}
 	s.Parity = p
 
+	protocol := SerialProtocol(strings.ToUpper(string(s.Protocol)))
+	switch protocol {
+	case "":
+		protocol = SerialProtocolJSON
+	case SerialProtocolJSON, SerialProtocolFramed:
+	default:
+		return fmt.Errorf("invalid protocol: %s", s.Protocol)
+	}
+	s.Protocol = protocol
+
 	return nil
 }
 
+// SerialProtocol is the framing of the messages on a serial link.
+type SerialProtocol string
+
+const (
+	// SerialProtocolJSON frames each JSON message as '>' + message + CR LF.
+	SerialProtocolJSON SerialProtocol = "JSON"
+	// SerialProtocolFramed frames each JSON message with a length prefix,
+	// a sequence number and a CRC-16.
+	SerialProtocolFramed SerialProtocol = "FRAMED"
+)
+
 type Leds struct {
 	System Led `yaml:"system"`
 	Alert  Led `yaml:"alert"`
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHardwareValidate(t *testing.T) {
+	newHardware := func() Hardware {
+		serial := Serial{Port: "/dev/ttyUSB0", BaudRate: 9600, DataBits: 8, StopBits: 1, Parity: "NONE"}
+		h := Hardware{ESP: ESP{Serial: serial}, PIC: PIC{Serial: serial}}
+		h.PIC.Serial.Port = "/dev/ttyUSB1"
+		return h
+	}
+
+	t.Run("Should default to serial mode", func(t *testing.T) {
+		h := newHardware()
+		require.NoError(t, h.Validate())
+		require.Equal(t, HardwareModeSerial, h.Mode)
+	})
+
+	t.Run("Should accept sim mode and fill the simulator defaults", func(t *testing.T) {
+		h := newHardware()
+		h.Mode = "SIM"
+		require.NoError(t, h.Validate())
+		require.Equal(t, HardwareModeSim, h.Mode)
+		require.Equal(t, uint16(defaultSimDriveSpeed), h.Sim.DriveSpeed)
+		require.Equal(t, defaultSimTickInterval, h.Sim.TickInterval)
+		require.Equal(t, uint8(100), h.Sim.BatteryPercent)
+	})
+
+	t.Run("Should default the serial protocol to JSON", func(t *testing.T) {
+		h := newHardware()
+		h.PIC.Serial.Protocol = "framed"
+		require.NoError(t, h.Validate())
+		require.Equal(t, SerialProtocolJSON, h.ESP.Serial.Protocol)
+		require.Equal(t, SerialProtocolFramed, h.PIC.Serial.Protocol)
+	})
+
+	t.Run("Should reject an unknown serial protocol", func(t *testing.T) {
+		h := newHardware()
+		h.ESP.Serial.Protocol = "binary"
+		require.Error(t, h.Validate())
+	})
+
+	t.Run("Should fill the reconnect backoff defaults", func(t *testing.T) {
+		h := newHardware()
+		h.PIC.Reconnect = SerialReconnect{Enable: true, InitialBackoff: time.Minute}
+		require.NoError(t, h.Validate())
+		require.Equal(t, defaultReconnectInitialBackoff, h.ESP.Reconnect.InitialBackoff)
+		require.Equal(t, defaultReconnectMaxBackoff, h.ESP.Reconnect.MaxBackoff)
+		require.Equal(t, time.Minute, h.PIC.Reconnect.MaxBackoff)
+	})
+
+	t.Run("Should reject a reconnect max backoff below the initial backoff", func(t *testing.T) {
+		h := newHardware()
+		h.ESP.Reconnect = SerialReconnect{InitialBackoff: time.Second, MaxBackoff: time.Millisecond}
+		require.Error(t, h.Validate())
+	})
+
+	t.Run("Should reject an unknown mode", func(t *testing.T) {
+		h := newHardware()
+		h.Mode = "usb"
+		require.Error(t, h.Validate())
+	})
+
+	t.Run("Should reject duplicate simulator tags", func(t *testing.T) {
+		h := newHardware()
+		h.Sim.Tags = []SimTag{{ID: "0001", Position: 0}, {ID: "0001", Position: 100}}
+		require.Error(t, h.Validate())
+	})
+}
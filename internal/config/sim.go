@@ -0,0 +1,119 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultSimDriveSpeed     = 200 // mm/s
+	defaultSimLiftSpeed      = 20  // cm/s
+	defaultSimDoorDuration   = 2 * time.Second
+	defaultSimBatteryDrain   = 0.5 // percent per minute
+	defaultSimTickInterval   = 50 * time.Millisecond
+	defaultSimSyncInterval   = 200 * time.Millisecond
+	defaultSimLiftPosition   = 10  // cm
+	defaultSimGroundHeight   = 100 // cm
+	defaultSimFreeDistance   = 500 // cm
+	defaultSimBatteryPercent = 100
+)
+
+// Sim is the configuration of the hardware simulator, used when the
+// hardware mode is sim.
+type Sim struct {
+	// Tags are the RFID tags placed along the virtual rail.
+	Tags []SimTag `yaml:"tags"`
+	// StartPosition is the initial position of the robot on the rail in mm.
+	StartPosition int32 `yaml:"start_position"`
+	// DriveSpeed is the speed of the robot in mm/s when the drive motor runs at speed 100.
+	DriveSpeed uint16 `yaml:"drive_speed"`
+	// LiftSpeed is the speed of the lift in cm/s when the lift motor runs at output 100.
+	LiftSpeed uint16 `yaml:"lift_speed"`
+	// LiftPosition is the initial down distance of the lift in cm.
+	LiftPosition uint16 `yaml:"lift_position"`
+	// DoorDuration is the time the cargo door takes to open or close at speed 100.
+	DoorDuration time.Duration `yaml:"door_duration"`
+	// BatteryPercent is the initial battery charge.
+	BatteryPercent uint8 `yaml:"battery_percent"`
+	// BatteryDrain is the battery drain in percent per minute while a motor runs.
+	BatteryDrain float64 `yaml:"battery_drain"`
+	// FrontDistance, BackDistance and BottomDistance are the readings of the
+	// front, back and cargo bottom distance sensors in cm.
+	FrontDistance  uint16 `yaml:"front_distance"`
+	BackDistance   uint16 `yaml:"back_distance"`
+	BottomDistance uint16 `yaml:"bottom_distance"`
+	// QRCode is the code reported by the cargo QR scanner, empty for no code.
+	QRCode string `yaml:"qr_code"`
+	// TickInterval is the step of the physics model.
+	TickInterval time.Duration `yaml:"tick_interval"`
+	// SyncInterval is the interval of the sync state messages.
+	SyncInterval time.Duration `yaml:"sync_interval"`
+}
+
+func (s *Sim) Validate() error {
+	seen := make(map[string]struct{}, len(s.Tags))
+	for i, tag := range s.Tags {
+		if tag.ID == "" {
+			return fmt.Errorf("tag %d: id is required", i)
+		}
+		if _, ok := seen[tag.ID]; ok {
+			return fmt.Errorf("tag %d: duplicate id: %s", i, tag.ID)
+		}
+		seen[tag.ID] = struct{}{}
+	}
+
+	if s.BatteryPercent > 100 {
+		return fmt.Errorf("battery percent must be less than or equal to 100")
+	}
+
+	if s.BatteryDrain < 0 {
+		return fmt.Errorf("battery drain must be greater than or equal to 0")
+	}
+
+	if s.TickInterval < 0 || s.SyncInterval < 0 || s.DoorDuration < 0 {
+		return fmt.Errorf("intervals must be greater than or equal to 0")
+	}
+
+	if s.DriveSpeed == 0 {
+		s.DriveSpeed = defaultSimDriveSpeed
+	}
+	if s.LiftSpeed == 0 {
+		s.LiftSpeed = defaultSimLiftSpeed
+	}
+	if s.LiftPosition == 0 {
+		s.LiftPosition = defaultSimLiftPosition
+	}
+	if s.DoorDuration == 0 {
+		s.DoorDuration = defaultSimDoorDuration
+	}
+	if s.BatteryPercent == 0 {
+		s.BatteryPercent = defaultSimBatteryPercent
+	}
+	if s.BatteryDrain == 0 {
+		s.BatteryDrain = defaultSimBatteryDrain
+	}
+	if s.FrontDistance == 0 {
+		s.FrontDistance = defaultSimFreeDistance
+	}
+	if s.BackDistance == 0 {
+		s.BackDistance = defaultSimFreeDistance
+	}
+	if s.BottomDistance == 0 {
+		s.BottomDistance = defaultSimFreeDistance
+	}
+	if s.TickInterval == 0 {
+		s.TickInterval = defaultSimTickInterval
+	}
+	if s.SyncInterval == 0 {
+		s.SyncInterval = defaultSimSyncInterval
+	}
+
+	return nil
+}
+
+// SimTag is an RFID tag on the virtual rail.
+type SimTag struct {
+	ID string `yaml:"id"`
+	// Position is the position of the tag on the rail in mm.
+	Position int32 `yaml:"position"`
+}
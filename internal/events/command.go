@@ -1,9 +1,38 @@
 package events
 
+import "github.com/tbe-team/raybot/internal/services/command"
+
 const (
-	CommandCreatedTopic = "command:created"
+	CommandCreatedTopic  = "command:created"
+	CommandProgressTopic = "command:progress"
+
+	CommandStartedTopic   = "command:started"
+	CommandSucceededTopic = "command:succeeded"
+	// CommandFailedTopic is published when a command fails or times out.
+	CommandFailedTopic   = "command:failed"
+	CommandCanceledTopic = "command:canceled"
 )
 
 type CommandCreatedEvent struct {
 	CommandID int64
 }
+
+type CommandProgressEvent struct {
+	Progress command.Progress
+}
+
+type CommandStartedEvent struct {
+	Command command.Command
+}
+
+type CommandSucceededEvent struct {
+	Command command.Command
+}
+
+type CommandFailedEvent struct {
+	Command command.Command
+}
+
+type CommandCanceledEvent struct {
+	Command command.Command
+}
@@ -0,0 +1,106 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	commandv1 "github.com/tbe-team/raybot-api/command/v1"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+const commandBatchServiceName = localPackage + "command.v1.CommandBatchService"
+
+// CreateCommandsMethod is the full method name of the batch command creation.
+//
+// The command API has no batch creation, so the service is described by hand.
+// The request is a google.protobuf.Struct of the form
+//
+//	{"commands": [{"command": <CreateCommandRequest>, "request_id": "...", "priority": 0, "not_before": "<RFC 3339>"}]}
+//
+// where the command is the JSON encoding of a command.v1.CreateCommandRequest and the other fields are optional.
+// The response is a google.protobuf.Struct of the form {"command_ids": [...]} with the IDs in the order of the request.
+const CreateCommandsMethod = "/" + commandBatchServiceName + "/CreateCommands"
+
+type commandBatchServer interface {
+	CreateCommands(context.Context, *structpb.Struct) (*structpb.Struct, error)
+}
+
+var commandBatchServiceDesc = grpc.ServiceDesc{
+	ServiceName: commandBatchServiceName,
+	HandlerType: (*commandBatchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		structMethod(commandBatchServiceName, "CreateCommands", commandBatchServer.CreateCommands),
+	},
+}
+
+type createCommandsRequest struct {
+	Commands []createCommandsItem `json:"commands"`
+}
+
+type createCommandsItem struct {
+	Command   json.RawMessage `json:"command"`
+	RequestID *string         `json:"request_id"`
+	Priority  uint8           `json:"priority"`
+	NotBefore *time.Time      `json:"not_before"`
+}
+
+type commandBatchHandler struct {
+	commandService command.Service
+	commandHandler commandHandler
+}
+
+func newCommandBatchHandler(commandService command.Service) commandBatchServer {
+	return &commandBatchHandler{
+		commandService: commandService,
+	}
+}
+
+func (h commandBatchHandler) CreateCommands(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r createCommandsRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	items := make([]command.CreateCommandsItem, len(r.Commands))
+	for i, item := range r.Commands {
+		var cmdReq commandv1.CreateCommandRequest
+		if err := protojson.Unmarshal(item.Command, &cmdReq); err != nil {
+			return nil, fmt.Errorf("invalid command %d: %v", i, err)
+		}
+
+		inputs, err := h.commandHandler.convertReqInputsToCommandInputs(&cmdReq)
+		if err != nil {
+			return nil, fmt.Errorf("convert inputs of command %d: %v", i, err)
+		}
+
+		items[i] = command.CreateCommandsItem{
+			Inputs:    inputs,
+			RequestID: item.RequestID,
+			Priority:  item.Priority,
+			NotBefore: item.NotBefore,
+		}
+	}
+
+	cmds, err := h.commandService.CreateCommands(ctx, command.CreateCommandsParams{
+		Source:   command.SourceCloud,
+		Commands: items,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create commands: %v", err)
+	}
+
+	ids := make([]any, len(cmds))
+	for i, cmd := range cmds {
+		ids[i] = cmd.ID
+	}
+
+	return structpb.NewStruct(map[string]any{
+		"command_ids": ids,
+	})
+}
@@ -0,0 +1,85 @@
+package cloud_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	commandv1 "github.com/tbe-team/raybot-api/command/v1"
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
+	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+func TestIntegrationCommandBatchHandler_CreateCommands(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+
+	newItem := func(t *testing.T, req *commandv1.CreateCommandRequest, extra map[string]any) map[string]any {
+		b, err := protojson.Marshal(req)
+		require.NoError(t, err)
+		var cmd map[string]any
+		require.NoError(t, json.Unmarshal(b, &cmd))
+
+		item := map[string]any{"command": cmd}
+		for k, v := range extra {
+			item[k] = v
+		}
+		return item
+	}
+
+	stop := &commandv1.CreateCommandRequest{
+		Type: commandv1.CommandType_COMMAND_TYPE_STOP_MOVEMENT,
+		Inputs: &commandv1.CommandInputs{
+			Inputs: &commandv1.CommandInputs_Stop{Stop: &commandv1.StopInputs{}},
+		},
+	}
+	moveTo := &commandv1.CreateCommandRequest{
+		Type: commandv1.CommandType_COMMAND_TYPE_MOVE_TO,
+		Inputs: &commandv1.CommandInputs{
+			Inputs: &commandv1.CommandInputs_MoveTo{MoveTo: &commandv1.MoveToInputs{
+				Location:   "test-location",
+				MotorSpeed: 100,
+			}},
+		},
+	}
+
+	req, err := structpb.NewStruct(map[string]any{
+		"commands": []any{
+			newItem(t, stop, map[string]any{"request_id": "batch-1"}),
+			newItem(t, moveTo, map[string]any{"request_id": "batch-2", "priority": 10}),
+		},
+	})
+	require.NoError(t, err)
+
+	createCommands := func() []int64 {
+		res := new(structpb.Struct)
+		require.NoError(t, testEnv.TunnelChannel.Invoke(context.Background(), cloud.CreateCommandsMethod, req, res))
+
+		values := res.Fields["command_ids"].GetListValue().GetValues()
+		ids := make([]int64, len(values))
+		for i, v := range values {
+			ids[i] = int64(v.GetNumberValue())
+		}
+		return ids
+	}
+
+	ids := createCommands()
+	require.Len(t, ids, 2)
+
+	cmd, err := testEnv.CommandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{CommandID: ids[1]})
+	require.NoError(t, err)
+	require.Equal(t, command.CommandTypeMoveTo, cmd.Type)
+	require.Equal(t, command.SourceCloud, cmd.Source)
+	require.Equal(t, uint8(10), cmd.Priority)
+
+	// A retry of the same batch returns the same commands
+	require.Equal(t, ids, createCommands())
+}
@@ -0,0 +1,84 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+const commandCancelServiceName = localPackage + "command.v1.CommandCancelService"
+
+// CancelCommandByIDMethod and CancelCommandByRequestIDMethod are the full method names of the command cancellation.
+//
+// The command API only cancels the current processing command, so the service is described by hand.
+// The requests are google.protobuf.Struct of the form {"command_id": 1} and {"request_id": "..."},
+// the response is an empty google.protobuf.Struct.
+// A QUEUED command is CANCELED right away, a PROCESSING command is CANCELING until the robot stops it.
+const (
+	CancelCommandByIDMethod        = "/" + commandCancelServiceName + "/CancelCommandByID"
+	CancelCommandByRequestIDMethod = "/" + commandCancelServiceName + "/CancelCommandByRequestID"
+)
+
+type commandCancelServer interface {
+	CancelCommandByID(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	CancelCommandByRequestID(context.Context, *structpb.Struct) (*structpb.Struct, error)
+}
+
+var commandCancelServiceDesc = grpc.ServiceDesc{
+	ServiceName: commandCancelServiceName,
+	HandlerType: (*commandCancelServer)(nil),
+	Methods: []grpc.MethodDesc{
+		structMethod(commandCancelServiceName, "CancelCommandByID", commandCancelServer.CancelCommandByID),
+		structMethod(commandCancelServiceName, "CancelCommandByRequestID", commandCancelServer.CancelCommandByRequestID),
+	},
+}
+
+type cancelCommandRequest struct {
+	CommandID int64  `json:"command_id"`
+	RequestID string `json:"request_id"`
+}
+
+type commandCancelHandler struct {
+	commandService command.Service
+}
+
+func newCommandCancelHandler(commandService command.Service) commandCancelServer {
+	return &commandCancelHandler{
+		commandService: commandService,
+	}
+}
+
+func (h commandCancelHandler) CancelCommandByID(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r cancelCommandRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	// The errors are wrapped so that the cloud gets the not found and bad request codes.
+	if err := h.commandService.CancelCommandByID(ctx, command.CancelCommandByIDParams{
+		CommandID: r.CommandID,
+	}); err != nil {
+		return nil, fmt.Errorf("cancel command by id: %w", err)
+	}
+
+	return &structpb.Struct{}, nil
+}
+
+func (h commandCancelHandler) CancelCommandByRequestID(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r cancelCommandRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	if err := h.commandService.CancelCommandByRequestID(ctx, command.CancelCommandByRequestIDParams{
+		RequestID: r.RequestID,
+	}); err != nil {
+		return nil, fmt.Errorf("cancel command by request id: %w", err)
+	}
+
+	return &structpb.Struct{}, nil
+}
@@ -0,0 +1,67 @@
+package cloud_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
+	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestIntegrationCommandCancelHandler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+
+	cancel := func(method string, fields map[string]any) error {
+		req, err := structpb.NewStruct(fields)
+		require.NoError(t, err)
+		return testEnv.TunnelChannel.Invoke(context.Background(), method, req, new(structpb.Struct))
+	}
+
+	t.Run("Should cancel a queued command by ID", func(t *testing.T) {
+		cmd, err := testEnv.CommandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceCloud,
+			Inputs: &command.StopMovementInputs{},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, cancel(cloud.CancelCommandByIDMethod, map[string]any{"command_id": cmd.ID}))
+
+		cmd, err = testEnv.CommandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{CommandID: cmd.ID})
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, cmd.Status)
+
+		err = cancel(cloud.CancelCommandByIDMethod, map[string]any{"command_id": cmd.ID})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should cancel a queued command by request ID", func(t *testing.T) {
+		cmd, err := testEnv.CommandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:    command.SourceCloud,
+			Inputs:    &command.StopMovementInputs{},
+			RequestID: ptr.New("cloud-cancel-1"),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, cancel(cloud.CancelCommandByRequestIDMethod, map[string]any{"request_id": "cloud-cancel-1"}))
+
+		cmd, err = testEnv.CommandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{CommandID: cmd.ID})
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, cmd.Status)
+	})
+
+	t.Run("Should not cancel an unknown command", func(t *testing.T) {
+		err := cancel(cloud.CancelCommandByRequestIDMethod, map[string]any{"request_id": "unknown"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,109 @@
+package cloud
+
+import (
+	"log/slog"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	commandv1 "github.com/tbe-team/raybot-api/command/v1"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+const commandEventServiceName = localPackage + "command.v1.CommandEventService"
+
+// StreamCommandEventsMethod is the full method name of the command lifecycle event stream.
+//
+// The command API has no event stream, so the service is described by hand.
+// The request is a google.protobuf.Empty and each response is the command.v1.Command
+// that started, succeeded, failed, timed out or was canceled, the event is given by its status.
+const StreamCommandEventsMethod = "/" + commandEventServiceName + "/StreamCommandEvents"
+
+type commandEventServer interface {
+	StreamCommandEvents(*emptypb.Empty, grpc.ServerStreamingServer[commandv1.Command]) error
+}
+
+var commandEventServiceDesc = grpc.ServiceDesc{
+	ServiceName: commandEventServiceName,
+	HandlerType: (*commandEventServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCommandEvents",
+			Handler:       streamCommandEventsHandler,
+			ServerStreams: true,
+		},
+	},
+}
+
+func streamCommandEventsHandler(srv any, stream grpc.ServerStream) error {
+	in := new(emptypb.Empty)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+	return srv.(commandEventServer).StreamCommandEvents(
+		in,
+		&grpc.GenericServerStream[emptypb.Empty, commandv1.Command]{ServerStream: stream},
+	)
+}
+
+type commandEventHandler struct {
+	log            *slog.Logger
+	subscriber     eventbus.Subscriber
+	commandHandler commandHandler
+}
+
+func newCommandEventHandler(log *slog.Logger, subscriber eventbus.Subscriber) commandEventServer {
+	return &commandEventHandler{
+		log:        log,
+		subscriber: subscriber,
+	}
+}
+
+func (h commandEventHandler) StreamCommandEvents(
+	_ *emptypb.Empty,
+	stream grpc.ServerStreamingServer[commandv1.Command],
+) error {
+	h.log.Info("streaming command events")
+
+	ctx := stream.Context()
+	// The event handlers run concurrently, but a stream does not support concurrent sends
+	var mu sync.Mutex
+	send := func(msg *eventbus.Message) {
+		var cmd command.Command
+		switch ev := msg.Payload.(type) {
+		case events.CommandStartedEvent:
+			cmd = ev.Command
+		case events.CommandSucceededEvent:
+			cmd = ev.Command
+		case events.CommandFailedEvent:
+			cmd = ev.Command
+		case events.CommandCanceledEvent:
+			cmd = ev.Command
+		default:
+			h.log.Error("received invalid event", slog.Any("event", msg.Payload))
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err := stream.Send(h.commandHandler.convertCommandToResponse(cmd)); err != nil {
+			h.log.Error("failed to send command event", slog.Any("error", err))
+		}
+	}
+
+	for _, topic := range []string{
+		events.CommandStartedTopic,
+		events.CommandSucceededTopic,
+		events.CommandFailedTopic,
+		events.CommandCanceledTopic,
+	} {
+		h.subscriber.Subscribe(ctx, topic, send)
+	}
+
+	<-ctx.Done()
+	h.log.Info("stopped streaming command events")
+	return nil
+}
@@ -0,0 +1,73 @@
+package cloud_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	commandv1 "github.com/tbe-team/raybot-api/command/v1"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
+	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestIntegrationCommandEventHandler_StreamCommandEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := testEnv.TunnelChannel.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, cloud.StreamCommandEventsMethod)
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+	require.NoError(t, stream.CloseSend())
+
+	// Publish until the handler has subscribed and the event is received
+	received := make(chan *commandv1.Command, 1)
+	go func() {
+		res := new(commandv1.Command)
+		if err := stream.RecvMsg(res); err == nil {
+			received <- res
+		}
+	}()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case res := <-received:
+			require.Equal(t, int64(7), res.Id)
+			require.Equal(t, commandv1.CommandStatus_COMMAND_STATUS_FAILED, res.Status)
+			require.Equal(t, "exec error", res.GetError())
+			return
+
+		case <-ticker.C:
+			testEnv.EventBus.Publish(events.CommandFailedTopic, eventbus.NewMessage(events.CommandFailedEvent{
+				Command: command.Command{
+					ID:        7,
+					Type:      command.CommandTypeStopMovement,
+					Status:    command.StatusFailed,
+					Source:    command.SourceCloud,
+					Inputs:    &command.StopMovementInputs{},
+					Error:     ptr.New("exec error"),
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				},
+			}))
+
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for the command event")
+		}
+	}
+}
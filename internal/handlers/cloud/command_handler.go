@@ -26,10 +26,42 @@ func (h commandHandler) CreateCommand(ctx context.Context, req *commandv1.Create
 	if err != nil {
 		return nil, fmt.Errorf("convert req inputs to command inputs: %v", err)
 	}
+	priority, err := GetPriorityFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get priority: %v", err)
+	}
+	preempt, err := GetPreemptFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get preempt: %v", err)
+	}
+	notBefore, err := GetNotBeforeFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get not before: %v", err)
+	}
+	timeoutMs, err := GetTimeoutMsFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get timeout ms: %v", err)
+	}
+	preconditions, err := GetPreconditionsFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get preconditions: %v", err)
+	}
+	if timeoutMs != nil || len(preconditions) > 0 {
+		inputs, err = command.WithCommonInputs(inputs, command.CommonInputs{
+			TimeoutMs:     timeoutMs,
+			Preconditions: preconditions,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("set common inputs: %v", err)
+		}
+	}
 	cmd, err := h.commandService.CreateCommand(ctx, command.CreateCommandParams{
 		Source:    command.SourceCloud,
 		Inputs:    inputs,
 		RequestID: GetRequestIDFromContext(ctx),
+		Priority:  priority,
+		Preempt:   preempt,
+		NotBefore: notBefore,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create command: %v", err)
@@ -72,6 +104,8 @@ func (commandHandler) convertReqInputsToCommandInputs(req *commandv1.CreateComma
 
 		var direction command.MoveDirection
 		switch i.Direction {
+		case commandv1.MoveToInputs_DIRECTION_UNSPECIFIED:
+			// The direction is inferred from the rail map.
 		case commandv1.MoveToInputs_DIRECTION_FORWARD:
 			direction = command.MoveDirectionForward
 		case commandv1.MoveToInputs_DIRECTION_BACKWARD:
@@ -187,7 +221,7 @@ func (h commandHandler) convertCommandToResponse(cmd command.Command) *commandv1
 		Status:      h.convertCommandStatusToResponse(cmd.Status),
 		Source:      h.convertCommandSourceToResponse(cmd.Source),
 		Outputs:     h.convertCommandOutputsToResponse(cmd.Outputs),
-		Error:       cmd.Error,
+		Error:       h.convertCommandErrorToResponse(cmd),
 		StartedAt:   startedAt,
 		CompletedAt: completedAt,
 		CreatedAt:   timestamppb.New(cmd.CreatedAt),
@@ -368,11 +402,30 @@ func (commandHandler) convertCommandStatusToResponse(status command.Status) comm
 	case command.StatusFailed:
 		return commandv1.CommandStatus_COMMAND_STATUS_FAILED
 
+	// commandv1 has no timed out status, the cloud sees it as a failure
+	// and the timeout is described in the command error.
+	case command.StatusTimedOut:
+		return commandv1.CommandStatus_COMMAND_STATUS_FAILED
+
 	default:
 		return commandv1.CommandStatus_COMMAND_STATUS_UNSPECIFIED
 	}
 }
 
+func (commandHandler) convertCommandErrorToResponse(cmd command.Command) *string {
+	if cmd.Status != command.StatusTimedOut || cmd.Error != nil {
+		return cmd.Error
+	}
+
+	msg := "command timed out"
+	if cmd.Outputs != nil {
+		if elapsedMs := cmd.Outputs.Common().ElapsedMs; elapsedMs != nil {
+			msg = fmt.Sprintf("command timed out after %dms", *elapsedMs)
+		}
+	}
+	return &msg
+}
+
 func (commandHandler) convertCommandSourceToResponse(source command.Source) commandv1.CommandSource {
 	switch source {
 	case command.SourceCloud:
@@ -3,11 +3,14 @@ package cloud_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
 	commandv1 "github.com/tbe-team/raybot-api/command/v1"
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
 	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
 	"github.com/tbe-team/raybot/internal/services/command"
 )
@@ -221,6 +224,94 @@ func TestIntegrationCommandHandler_CreateCommand(t *testing.T) {
 	}
 }
 
+func TestIntegrationCommandHandler_CreateCommandWithPriority(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+	client := commandv1.NewCommandServiceClient(testEnv.TunnelChannel)
+
+	req := &commandv1.CreateCommandRequest{
+		Type: commandv1.CommandType_COMMAND_TYPE_STOP_MOVEMENT,
+		Inputs: &commandv1.CommandInputs{
+			Inputs: &commandv1.CommandInputs_Stop{
+				Stop: &commandv1.StopInputs{},
+			},
+		},
+	}
+
+	t.Run("Should create command with the priority from the metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(),
+			cloud.PriorityKey, "42",
+			cloud.PreemptKey, "true",
+		)
+		createResp, err := client.CreateCommand(ctx, req)
+		require.NoError(t, err)
+
+		cmd, err := testEnv.CommandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
+			CommandID: createResp.Command.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint8(42), cmd.Priority)
+	})
+
+	t.Run("Should return error for invalid priority in the metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), cloud.PriorityKey, "high")
+		_, err := client.CreateCommand(ctx, req)
+		require.Error(t, err)
+	})
+
+	t.Run("Should create command with the not before from the metadata", func(t *testing.T) {
+		notBefore := time.Now().Add(time.Hour).Truncate(time.Second)
+		ctx := metadata.AppendToOutgoingContext(context.Background(), cloud.NotBeforeKey, notBefore.Format(time.RFC3339))
+		createResp, err := client.CreateCommand(ctx, req)
+		require.NoError(t, err)
+
+		cmd, err := testEnv.CommandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
+			CommandID: createResp.Command.Id,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cmd.NotBefore)
+		require.True(t, notBefore.Equal(*cmd.NotBefore))
+	})
+
+	t.Run("Should create command with the timeout from the metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), cloud.TimeoutMsKey, "1500")
+		createResp, err := client.CreateCommand(ctx, req)
+		require.NoError(t, err)
+
+		cmd, err := testEnv.CommandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
+			CommandID: createResp.Command.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1500*time.Millisecond, cmd.Inputs.Common().Timeout())
+	})
+
+	t.Run("Should return error for invalid timeout in the metadata", func(t *testing.T) {
+		for _, timeoutMs := range []string{"soon", "0", "-5"} {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), cloud.TimeoutMsKey, timeoutMs)
+			_, err := client.CreateCommand(ctx, req)
+			require.Error(t, err, timeoutMs)
+		}
+	})
+
+	t.Run("Should create command with the preconditions from the metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(),
+			cloud.PreconditionsKey, `[{"type":"BATTERY_AT_LEAST","battery_percent":30}]`)
+		createResp, err := client.CreateCommand(ctx, req)
+		require.NoError(t, err)
+
+		cmd, err := testEnv.CommandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
+			CommandID: createResp.Command.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []command.Condition{
+			{Type: command.ConditionTypeBatteryAtLeast, BatteryPercent: 30},
+		}, cmd.Inputs.Common().Preconditions)
+	})
+}
+
 func TestIntegrationCommandHandler_GetCommand(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -0,0 +1,116 @@
+package cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+const commandProgressServiceName = localPackage + "command.v1.CommandProgressService"
+
+// StreamCommandProgressMethod is the full method name of the command progress stream.
+//
+// The command API has no progress stream, so the service is described by hand.
+// The request is a google.protobuf.Empty and each response is a google.protobuf.Struct
+// holding the progress with the same fields as its JSON encoding.
+const StreamCommandProgressMethod = "/" + commandProgressServiceName + "/StreamCommandProgress"
+
+type commandProgressServer interface {
+	StreamCommandProgress(*emptypb.Empty, grpc.ServerStreamingServer[structpb.Struct]) error
+}
+
+var commandProgressServiceDesc = grpc.ServiceDesc{
+	ServiceName: commandProgressServiceName,
+	HandlerType: (*commandProgressServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCommandProgress",
+			Handler:       streamCommandProgressHandler,
+			ServerStreams: true,
+		},
+	},
+}
+
+func streamCommandProgressHandler(srv any, stream grpc.ServerStream) error {
+	in := new(emptypb.Empty)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+	return srv.(commandProgressServer).StreamCommandProgress(
+		in,
+		&grpc.GenericServerStream[emptypb.Empty, structpb.Struct]{ServerStream: stream},
+	)
+}
+
+type commandProgressHandler struct {
+	log        *slog.Logger
+	subscriber eventbus.Subscriber
+}
+
+func newCommandProgressHandler(log *slog.Logger, subscriber eventbus.Subscriber) commandProgressServer {
+	return &commandProgressHandler{
+		log:        log,
+		subscriber: subscriber,
+	}
+}
+
+func (h commandProgressHandler) StreamCommandProgress(
+	_ *emptypb.Empty,
+	stream grpc.ServerStreamingServer[structpb.Struct],
+) error {
+	h.log.Info("streaming command progress")
+
+	ctx := stream.Context()
+	// The event handlers run concurrently, but a stream does not support concurrent sends
+	var mu sync.Mutex
+	h.subscriber.Subscribe(
+		ctx,
+		events.CommandProgressTopic,
+		func(msg *eventbus.Message) {
+			ev, ok := msg.Payload.(events.CommandProgressEvent)
+			if !ok {
+				h.log.Error("received invalid event", slog.Any("event", msg.Payload))
+				return
+			}
+
+			res, err := convertProgressToStruct(ev.Progress)
+			if err != nil {
+				h.log.Error("failed to convert command progress", slog.Any("error", err))
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err := stream.Send(res); err != nil {
+				h.log.Error("failed to send command progress", slog.Any("error", err))
+			}
+		},
+	)
+
+	<-ctx.Done()
+	h.log.Info("stopped streaming command progress")
+	return nil
+}
+
+func convertProgressToStruct(progress command.Progress) (*structpb.Struct, error) {
+	b, err := json.Marshal(progress)
+	if err != nil {
+		return nil, fmt.Errorf("marshal progress: %w", err)
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal progress: %w", err)
+	}
+
+	return structpb.NewStruct(m)
+}
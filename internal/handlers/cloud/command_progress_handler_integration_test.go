@@ -0,0 +1,70 @@
+package cloud_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
+	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestIntegrationCommandProgressHandler_StreamCommandProgress(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := testEnv.TunnelChannel.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, cloud.StreamCommandProgressMethod)
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+	require.NoError(t, stream.CloseSend())
+
+	// Publish until the handler has subscribed and the progress is received
+	received := make(chan *structpb.Struct, 1)
+	go func() {
+		res := new(structpb.Struct)
+		if err := stream.RecvMsg(res); err == nil {
+			received <- res
+		}
+	}()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case res := <-received:
+			m := res.AsMap()
+			require.InDelta(t, 1, m["command_id"], 0)
+			require.Equal(t, "WAIT", m["command_type"])
+			require.InDelta(t, 1500, m["waited_ms"], 0)
+			return
+
+		case <-ticker.C:
+			testEnv.EventBus.Publish(events.CommandProgressTopic, eventbus.NewMessage(events.CommandProgressEvent{
+				Progress: command.Progress{
+					CommandID:   1,
+					CommandType: command.CommandTypeWait,
+					WaitedMs:    ptr.New(int64(1500)),
+					UpdatedAt:   time.Now(),
+				},
+			}))
+
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for the command progress")
+		}
+	}
+}
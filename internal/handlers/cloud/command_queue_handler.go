@@ -0,0 +1,88 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+const commandQueueServiceName = localPackage + "command.v1.CommandQueueService"
+
+// PauseQueueMethod, ResumeQueueMethod and GetQueueStateMethod are the full method names of the command queue state.
+//
+// The command API has no queue state, so the service is described by hand.
+// The requests are empty google.protobuf.Struct, the responses are a google.protobuf.Struct of the form
+//
+//	{"paused": true, "updated_at": "<RFC 3339>"}
+//
+// A paused queue finishes the current processing command but pulls no new command until it is resumed.
+const (
+	PauseQueueMethod    = "/" + commandQueueServiceName + "/PauseQueue"
+	ResumeQueueMethod   = "/" + commandQueueServiceName + "/ResumeQueue"
+	GetQueueStateMethod = "/" + commandQueueServiceName + "/GetQueueState"
+)
+
+type commandQueueServer interface {
+	PauseQueue(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	ResumeQueue(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	GetQueueState(context.Context, *structpb.Struct) (*structpb.Struct, error)
+}
+
+var commandQueueServiceDesc = grpc.ServiceDesc{
+	ServiceName: commandQueueServiceName,
+	HandlerType: (*commandQueueServer)(nil),
+	Methods: []grpc.MethodDesc{
+		structMethod(commandQueueServiceName, "PauseQueue", commandQueueServer.PauseQueue),
+		structMethod(commandQueueServiceName, "ResumeQueue", commandQueueServer.ResumeQueue),
+		structMethod(commandQueueServiceName, "GetQueueState", commandQueueServer.GetQueueState),
+	},
+}
+
+type commandQueueHandler struct {
+	commandService command.Service
+}
+
+func newCommandQueueHandler(commandService command.Service) commandQueueServer {
+	return &commandQueueHandler{
+		commandService: commandService,
+	}
+}
+
+func (h commandQueueHandler) PauseQueue(ctx context.Context, _ *structpb.Struct) (*structpb.Struct, error) {
+	state, err := h.commandService.PauseQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pause queue: %w", err)
+	}
+
+	return h.convertQueueStateToResponse(state)
+}
+
+func (h commandQueueHandler) ResumeQueue(ctx context.Context, _ *structpb.Struct) (*structpb.Struct, error) {
+	state, err := h.commandService.ResumeQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resume queue: %w", err)
+	}
+
+	return h.convertQueueStateToResponse(state)
+}
+
+func (h commandQueueHandler) GetQueueState(ctx context.Context, _ *structpb.Struct) (*structpb.Struct, error) {
+	state, err := h.commandService.GetQueueState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get queue state: %w", err)
+	}
+
+	return h.convertQueueStateToResponse(state)
+}
+
+func (commandQueueHandler) convertQueueStateToResponse(state command.QueueState) (*structpb.Struct, error) {
+	return structpb.NewStruct(map[string]any{
+		"paused":     state.Paused,
+		"updated_at": state.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
@@ -0,0 +1,40 @@
+package cloud_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
+	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
+)
+
+func TestIntegrationCommandQueueHandler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+
+	invoke := func(method string) map[string]any {
+		res := new(structpb.Struct)
+		require.NoError(t, testEnv.TunnelChannel.Invoke(context.Background(), method, &structpb.Struct{}, res))
+		return res.AsMap()
+	}
+
+	t.Run("Should pause and resume the queue", func(t *testing.T) {
+		require.Equal(t, false, invoke(cloud.GetQueueStateMethod)["paused"])
+
+		require.Equal(t, true, invoke(cloud.PauseQueueMethod)["paused"])
+		require.Equal(t, true, invoke(cloud.GetQueueStateMethod)["paused"])
+
+		state, err := testEnv.CommandService.GetQueueState(context.Background())
+		require.NoError(t, err)
+		require.True(t, state.Paused)
+
+		require.Equal(t, false, invoke(cloud.ResumeQueueMethod)["paused"])
+		require.Equal(t, false, invoke(cloud.GetQueueStateMethod)["paused"])
+	})
+}
@@ -0,0 +1,193 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	commandv1 "github.com/tbe-team/raybot-api/command/v1"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+const missionServiceName = localPackage + "command.v1.MissionService"
+
+// CreateMissionMethod, GetMissionMethod and CancelMissionMethod are the full method names of the missions.
+//
+// The command API has no missions, so the service is described by hand.
+// The create request is a google.protobuf.Struct of the form
+//
+//	{"steps": [<CreateCommandRequest>], "on_failure": "ABORT", "max_retries": 0}
+//
+// where each step is the JSON encoding of a command.v1.CreateCommandRequest, on_failure is one of
+// ABORT, SKIP and RETRY and defaults to ABORT, and max_retries is only used by RETRY.
+// The get and cancel requests are of the form {"mission_id": 1}.
+// The create and get responses are a google.protobuf.Struct of the form
+//
+//	{
+//	  "id": 1, "status": "QUEUED", "source": "CLOUD", "on_failure": "ABORT", "max_retries": 0,
+//	  "steps": [<Command>], "error": null, "started_at": null, "completed_at": null,
+//	  "created_at": "<RFC 3339>", "updated_at": "<RFC 3339>"
+//	}
+//
+// where each step is the JSON encoding of a command.v1.Command. The cancel response is an empty google.protobuf.Struct.
+const (
+	CreateMissionMethod = "/" + missionServiceName + "/CreateMission"
+	GetMissionMethod    = "/" + missionServiceName + "/GetMission"
+	CancelMissionMethod = "/" + missionServiceName + "/CancelMission"
+)
+
+type missionServer interface {
+	CreateMission(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	GetMission(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	CancelMission(context.Context, *structpb.Struct) (*structpb.Struct, error)
+}
+
+var missionServiceDesc = grpc.ServiceDesc{
+	ServiceName: missionServiceName,
+	HandlerType: (*missionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		structMethod(missionServiceName, "CreateMission", missionServer.CreateMission),
+		structMethod(missionServiceName, "GetMission", missionServer.GetMission),
+		structMethod(missionServiceName, "CancelMission", missionServer.CancelMission),
+	},
+}
+
+type createMissionRequest struct {
+	Steps      []json.RawMessage `json:"steps"`
+	OnFailure  string            `json:"on_failure"`
+	MaxRetries uint8             `json:"max_retries"`
+}
+
+type missionIDRequest struct {
+	MissionID int64 `json:"mission_id"`
+}
+
+type missionHandler struct {
+	commandService command.Service
+	commandHandler commandHandler
+}
+
+func newMissionHandler(commandService command.Service) missionServer {
+	return &missionHandler{
+		commandService: commandService,
+	}
+}
+
+func (h missionHandler) CreateMission(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r createMissionRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	steps := make([]command.Inputs, len(r.Steps))
+	for i, step := range r.Steps {
+		var cmdReq commandv1.CreateCommandRequest
+		if err := protojson.Unmarshal(step, &cmdReq); err != nil {
+			return nil, fmt.Errorf("invalid step %d: %v", i, err)
+		}
+
+		inputs, err := h.commandHandler.convertReqInputsToCommandInputs(&cmdReq)
+		if err != nil {
+			return nil, fmt.Errorf("convert inputs of step %d: %v", i, err)
+		}
+		steps[i] = inputs
+	}
+
+	onFailure := command.OnFailureAbort
+	if r.OnFailure != "" {
+		onFailure = command.OnFailurePolicy(r.OnFailure)
+	}
+
+	mission, err := h.commandService.CreateMission(ctx, command.CreateMissionParams{
+		Source:     command.SourceCloud,
+		Steps:      steps,
+		OnFailure:  onFailure,
+		MaxRetries: r.MaxRetries,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create mission: %w", err)
+	}
+
+	return h.convertMissionToResponse(mission)
+}
+
+func (h missionHandler) GetMission(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r missionIDRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	// The errors are wrapped so that the cloud gets the not found and bad request codes.
+	mission, err := h.commandService.GetMissionByID(ctx, command.GetMissionByIDParams{
+		MissionID: r.MissionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get mission: %w", err)
+	}
+
+	return h.convertMissionToResponse(mission)
+}
+
+func (h missionHandler) CancelMission(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r missionIDRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	if err := h.commandService.CancelMissionByID(ctx, command.CancelMissionByIDParams{
+		MissionID: r.MissionID,
+	}); err != nil {
+		return nil, fmt.Errorf("cancel mission: %w", err)
+	}
+
+	return &structpb.Struct{}, nil
+}
+
+func (h missionHandler) convertMissionToResponse(mission command.Mission) (*structpb.Struct, error) {
+	steps := make([]any, 0, len(mission.Steps))
+	for _, step := range mission.Steps {
+		b, err := protojson.Marshal(h.commandHandler.convertCommandToResponse(step))
+		if err != nil {
+			return nil, fmt.Errorf("marshal step %d: %v", step.ID, err)
+		}
+
+		var s map[string]any
+		if err := json.Unmarshal(b, &s); err != nil {
+			return nil, fmt.Errorf("unmarshal step %d: %v", step.ID, err)
+		}
+		steps = append(steps, s)
+	}
+
+	return structpb.NewStruct(map[string]any{
+		"id":           mission.ID,
+		"status":       mission.Status.String(),
+		"source":       mission.Source.String(),
+		"on_failure":   mission.OnFailure.String(),
+		"max_retries":  int64(mission.MaxRetries),
+		"steps":        steps,
+		"error":        optionalString(mission.Error),
+		"started_at":   optionalTime(mission.StartedAt),
+		"completed_at": optionalTime(mission.CompletedAt),
+		"created_at":   mission.CreatedAt.Format(time.RFC3339Nano),
+		"updated_at":   mission.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
+
+func optionalString(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func optionalTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.Format(time.RFC3339Nano)
+}
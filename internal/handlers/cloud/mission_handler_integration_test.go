@@ -0,0 +1,86 @@
+package cloud_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
+	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+func TestIntegrationMissionHandler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+
+	invoke := func(method string, fields map[string]any) (*structpb.Struct, error) {
+		req, err := structpb.NewStruct(fields)
+		require.NoError(t, err)
+		res := new(structpb.Struct)
+		return res, testEnv.TunnelChannel.Invoke(context.Background(), method, req, res)
+	}
+
+	stopStep := map[string]any{
+		"type":   "COMMAND_TYPE_STOP_MOVEMENT",
+		"inputs": map[string]any{"stop": map[string]any{}},
+	}
+
+	t.Run("Should create, get and cancel a mission", func(t *testing.T) {
+		res, err := invoke(cloud.CreateMissionMethod, map[string]any{
+			"steps":       []any{stopStep, stopStep},
+			"on_failure":  "RETRY",
+			"max_retries": 2,
+		})
+		require.NoError(t, err)
+
+		created := res.AsMap()
+		require.Equal(t, "QUEUED", created["status"])
+		require.Equal(t, "CLOUD", created["source"])
+		require.Equal(t, "RETRY", created["on_failure"])
+		require.Equal(t, float64(2), created["max_retries"])
+		require.Len(t, created["steps"], 2)
+
+		missionID := created["id"]
+		res, err = invoke(cloud.GetMissionMethod, map[string]any{"mission_id": missionID})
+		require.NoError(t, err)
+		require.Equal(t, missionID, res.AsMap()["id"])
+
+		_, err = invoke(cloud.CancelMissionMethod, map[string]any{"mission_id": missionID})
+		require.NoError(t, err)
+
+		mission, err := testEnv.CommandService.GetMissionByID(context.Background(), command.GetMissionByIDParams{
+			MissionID: int64(missionID.(float64)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, command.MissionStatusCanceled, mission.Status)
+
+		_, err = invoke(cloud.CancelMissionMethod, map[string]any{"mission_id": missionID})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should default the on failure policy to ABORT", func(t *testing.T) {
+		res, err := invoke(cloud.CreateMissionMethod, map[string]any{
+			"steps": []any{stopStep},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ABORT", res.AsMap()["on_failure"])
+	})
+
+	t.Run("Should not create a mission without steps", func(t *testing.T) {
+		_, err := invoke(cloud.CreateMissionMethod, map[string]any{"steps": []any{}})
+		require.Error(t, err)
+	})
+
+	t.Run("Should not get an unknown mission", func(t *testing.T) {
+		_, err := invoke(cloud.GetMissionMethod, map[string]any{"mission_id": 99999})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
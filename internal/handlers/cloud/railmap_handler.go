@@ -0,0 +1,218 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/services/railmap"
+)
+
+const railMapServiceName = localPackage + "railmap.v1.RailMapService"
+
+// GetRailMapMethod, UpdateRailMapMethod, CreateRailMapTagMethod, UpdateRailMapTagMethod and DeleteRailMapTagMethod
+// are the full method names of the rail map.
+//
+// The robot API has no rail map, so the service is described by hand.
+// The requests are google.protobuf.Struct of the form
+//
+//	GetRailMap:       {}
+//	UpdateRailMap:    {"topology": "LOOP", "tags": [{"location": "A", "distance_to_next": 120, "alias": "dock-A"}]}
+//	CreateRailMapTag: {"location": "A", "position": 0, "distance_to_next": 120, "alias": "dock-A"}
+//	UpdateRailMapTag: {"tag_id": 1, "distance_to_next": 120, "alias": "dock-A"}
+//	DeleteRailMapTag: {"tag_id": 1}
+//
+// where position, distance_to_next and alias are optional. UpdateRailMapTag replaces both the distance and the alias,
+// a missing one is cleared. The rail map responses are a google.protobuf.Struct of the form
+//
+//	{"topology": "LOOP", "tags": [<Tag>], "updated_at": "<RFC 3339>"}
+//
+// and the tag responses of the form
+//
+//	{"id": 1, "location": "A", "position": 0, "distance_to_next": 120, "alias": "dock-A", "created_at": "<RFC 3339>", "updated_at": "<RFC 3339>"}
+//
+// The delete response is an empty google.protobuf.Struct.
+const (
+	GetRailMapMethod       = "/" + railMapServiceName + "/GetRailMap"
+	UpdateRailMapMethod    = "/" + railMapServiceName + "/UpdateRailMap"
+	CreateRailMapTagMethod = "/" + railMapServiceName + "/CreateRailMapTag"
+	UpdateRailMapTagMethod = "/" + railMapServiceName + "/UpdateRailMapTag"
+	DeleteRailMapTagMethod = "/" + railMapServiceName + "/DeleteRailMapTag"
+)
+
+type railMapServer interface {
+	GetRailMap(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	UpdateRailMap(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	CreateRailMapTag(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	UpdateRailMapTag(context.Context, *structpb.Struct) (*structpb.Struct, error)
+	DeleteRailMapTag(context.Context, *structpb.Struct) (*structpb.Struct, error)
+}
+
+var railMapServiceDesc = grpc.ServiceDesc{
+	ServiceName: railMapServiceName,
+	HandlerType: (*railMapServer)(nil),
+	Methods: []grpc.MethodDesc{
+		structMethod(railMapServiceName, "GetRailMap", railMapServer.GetRailMap),
+		structMethod(railMapServiceName, "UpdateRailMap", railMapServer.UpdateRailMap),
+		structMethod(railMapServiceName, "CreateRailMapTag", railMapServer.CreateRailMapTag),
+		structMethod(railMapServiceName, "UpdateRailMapTag", railMapServer.UpdateRailMapTag),
+		structMethod(railMapServiceName, "DeleteRailMapTag", railMapServer.DeleteRailMapTag),
+	},
+}
+
+type updateRailMapRequest struct {
+	Topology string                    `json:"topology"`
+	Tags     []updateRailMapTagRequest `json:"tags"`
+}
+
+type updateRailMapTagRequest struct {
+	Location       string  `json:"location"`
+	DistanceToNext *uint32 `json:"distance_to_next"`
+	Alias          *string `json:"alias"`
+}
+
+type createRailMapTagRequest struct {
+	Location       string  `json:"location"`
+	Position       *int    `json:"position"`
+	DistanceToNext *uint32 `json:"distance_to_next"`
+	Alias          *string `json:"alias"`
+}
+
+type railMapTagRequest struct {
+	TagID          int64   `json:"tag_id"`
+	DistanceToNext *uint32 `json:"distance_to_next"`
+	Alias          *string `json:"alias"`
+}
+
+type railMapHandler struct {
+	railMapService railmap.Service
+}
+
+func newRailMapHandler(railMapService railmap.Service) railMapServer {
+	return &railMapHandler{
+		railMapService: railMapService,
+	}
+}
+
+func (h railMapHandler) GetRailMap(ctx context.Context, _ *structpb.Struct) (*structpb.Struct, error) {
+	m, err := h.railMapService.GetRailMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get rail map: %w", err)
+	}
+
+	return structpb.NewStruct(h.convertRailMapToResponse(m))
+}
+
+func (h railMapHandler) UpdateRailMap(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r updateRailMapRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	tags := make([]railmap.UpdateRailMapTag, len(r.Tags))
+	for i, tag := range r.Tags {
+		tags[i] = railmap.UpdateRailMapTag{
+			Location:       tag.Location,
+			DistanceToNext: tag.DistanceToNext,
+			Alias:          tag.Alias,
+		}
+	}
+
+	// The errors are wrapped so that the cloud gets the not found, bad request and conflict codes.
+	m, err := h.railMapService.UpdateRailMap(ctx, railmap.UpdateRailMapParams{
+		Topology: railmap.Topology(r.Topology),
+		Tags:     tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update rail map: %w", err)
+	}
+
+	return structpb.NewStruct(h.convertRailMapToResponse(m))
+}
+
+func (h railMapHandler) CreateRailMapTag(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r createRailMapTagRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	tag, err := h.railMapService.CreateTag(ctx, railmap.CreateTagParams{
+		Location:       r.Location,
+		Position:       r.Position,
+		DistanceToNext: r.DistanceToNext,
+		Alias:          r.Alias,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create rail map tag: %w", err)
+	}
+
+	return structpb.NewStruct(h.convertTagToResponse(tag))
+}
+
+func (h railMapHandler) UpdateRailMapTag(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r railMapTagRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	tag, err := h.railMapService.UpdateTag(ctx, railmap.UpdateTagParams{
+		ID:                r.TagID,
+		DistanceToNext:    r.DistanceToNext,
+		SetDistanceToNext: true,
+		Alias:             r.Alias,
+		SetAlias:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update rail map tag: %w", err)
+	}
+
+	return structpb.NewStruct(h.convertTagToResponse(tag))
+}
+
+func (h railMapHandler) DeleteRailMapTag(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	var r railMapTagRequest
+	if err := decodeStructRequest(req, &r); err != nil {
+		return nil, err
+	}
+
+	if err := h.railMapService.DeleteTag(ctx, railmap.DeleteTagParams{
+		ID: r.TagID,
+	}); err != nil {
+		return nil, fmt.Errorf("delete rail map tag: %w", err)
+	}
+
+	return &structpb.Struct{}, nil
+}
+
+func (h railMapHandler) convertRailMapToResponse(m railmap.RailMap) map[string]any {
+	tags := make([]any, len(m.Tags))
+	for i, tag := range m.Tags {
+		tags[i] = h.convertTagToResponse(tag)
+	}
+
+	return map[string]any{
+		"topology":   m.Topology.String(),
+		"tags":       tags,
+		"updated_at": m.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+func (railMapHandler) convertTagToResponse(tag railmap.Tag) map[string]any {
+	var distanceToNext any
+	if tag.DistanceToNext != nil {
+		distanceToNext = int64(*tag.DistanceToNext)
+	}
+
+	return map[string]any{
+		"id":               tag.ID,
+		"location":         tag.Location,
+		"position":         int64(tag.Position),
+		"distance_to_next": distanceToNext,
+		"alias":            optionalString(tag.Alias),
+		"created_at":       tag.CreatedAt.Format(time.RFC3339Nano),
+		"updated_at":       tag.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,88 @@
+package cloud_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/tbe-team/raybot/internal/handlers/cloud"
+	"github.com/tbe-team/raybot/internal/handlers/cloud/tunneltest"
+)
+
+func TestIntegrationRailMapHandler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	testEnv := tunneltest.SetupTunnelTestEnv(t)
+
+	invoke := func(method string, fields map[string]any) (map[string]any, error) {
+		req, err := structpb.NewStruct(fields)
+		require.NoError(t, err)
+		res := new(structpb.Struct)
+		if err := testEnv.TunnelChannel.Invoke(context.Background(), method, req, res); err != nil {
+			return nil, err
+		}
+		return res.AsMap(), nil
+	}
+
+	t.Run("Should replace the rail map and edit its tags", func(t *testing.T) {
+		m, err := invoke(cloud.UpdateRailMapMethod, map[string]any{
+			"topology": "LOOP",
+			"tags": []any{
+				map[string]any{"location": "A", "distance_to_next": 100, "alias": "dock-A"},
+				map[string]any{"location": "B"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "LOOP", m["topology"])
+		require.Len(t, m["tags"], 2)
+
+		tag, err := invoke(cloud.CreateRailMapTagMethod, map[string]any{
+			"location": "C",
+			"position": 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "C", tag["location"])
+		require.Equal(t, float64(1), tag["position"])
+
+		tag, err = invoke(cloud.UpdateRailMapTagMethod, map[string]any{
+			"tag_id":           tag["id"],
+			"distance_to_next": 50,
+			"alias":            "dock-C",
+		})
+		require.NoError(t, err)
+		require.Equal(t, float64(50), tag["distance_to_next"])
+		require.Equal(t, "dock-C", tag["alias"])
+
+		m, err = invoke(cloud.GetRailMapMethod, map[string]any{})
+		require.NoError(t, err)
+		tags := m["tags"].([]any)
+		require.Len(t, tags, 3)
+		require.Equal(t, "C", tags[1].(map[string]any)["location"])
+
+		_, err = invoke(cloud.DeleteRailMapTagMethod, map[string]any{"tag_id": tag["id"]})
+		require.NoError(t, err)
+
+		railMap, err := testEnv.RailMapService.GetRailMap(context.Background())
+		require.NoError(t, err)
+		require.Len(t, railMap.Tags, 2)
+	})
+
+	t.Run("Should not create a tag with a duplicated alias", func(t *testing.T) {
+		_, err := invoke(cloud.CreateRailMapTagMethod, map[string]any{
+			"location": "D",
+			"alias":    "dock-A",
+		})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("Should not delete an unknown tag", func(t *testing.T) {
+		_, err := invoke(cloud.DeleteRailMapTagMethod, map[string]any{"tag_id": 99999})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
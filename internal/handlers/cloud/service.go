@@ -32,6 +32,7 @@ import (
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
 	"github.com/tbe-team/raybot/internal/services/limitswitch"
 	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/internal/services/railmap"
 	"github.com/tbe-team/raybot/internal/services/system"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
@@ -45,6 +46,7 @@ type Service struct {
 	subscriber eventbus.Subscriber
 
 	commandService        command.Service
+	railMapService        railmap.Service
 	systemService         system.Service
 	batteryService        battery.Service
 	cargoService          cargo.Service
@@ -81,6 +83,7 @@ func New(
 	publisher eventbus.Publisher,
 	subscriber eventbus.Subscriber,
 	commandService command.Service,
+	railMapService railmap.Service,
 	systemService system.Service,
 	batteryService battery.Service,
 	cargoService cargo.Service,
@@ -103,6 +106,7 @@ func New(
 		publisher:             publisher,
 		subscriber:            subscriber,
 		commandService:        commandService,
+		railMapService:        railMapService,
 		systemService:         systemService,
 		batteryService:        batteryService,
 		cargoService:          cargoService,
@@ -230,6 +234,27 @@ func (s *Service) registerHandlers(sr grpc.ServiceRegistrar) {
 	commandHandler := newCommandHandler(s.commandService)
 	commandv1.RegisterCommandServiceServer(sr, commandHandler)
 
+	commandBatchHandler := newCommandBatchHandler(s.commandService)
+	sr.RegisterService(&commandBatchServiceDesc, commandBatchHandler)
+
+	commandCancelHandler := newCommandCancelHandler(s.commandService)
+	sr.RegisterService(&commandCancelServiceDesc, commandCancelHandler)
+
+	commandQueueHandler := newCommandQueueHandler(s.commandService)
+	sr.RegisterService(&commandQueueServiceDesc, commandQueueHandler)
+
+	missionHandler := newMissionHandler(s.commandService)
+	sr.RegisterService(&missionServiceDesc, missionHandler)
+
+	commandProgressHandler := newCommandProgressHandler(s.log, s.subscriber)
+	sr.RegisterService(&commandProgressServiceDesc, commandProgressHandler)
+
+	commandEventHandler := newCommandEventHandler(s.log, s.subscriber)
+	sr.RegisterService(&commandEventServiceDesc, commandEventHandler)
+
+	railMapHandler := newRailMapHandler(s.railMapService)
+	sr.RegisterService(&railMapServiceDesc, railMapHandler)
+
 	systemHandler := newSystemHandler(s.systemService)
 	sysv1.RegisterSysServiceServer(sr, systemHandler)
 
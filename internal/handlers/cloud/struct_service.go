@@ -0,0 +1,58 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// localPackage is the package of the services described by hand.
+// The raybot-api protos use top-level packages such as command.v1, so a service of the robot
+// can not clash with a service added to them later.
+const localPackage = "raybot.local."
+
+// structMethod returns the description of a unary method of a hand-described service,
+// the request and the response are a google.protobuf.Struct.
+func structMethod[S any](
+	serviceName, methodName string,
+	call func(S, context.Context, *structpb.Struct) (*structpb.Struct, error),
+) grpc.MethodDesc {
+	fullMethod := "/" + serviceName + "/" + methodName
+	return grpc.MethodDesc{
+		MethodName: methodName,
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			in := new(structpb.Struct)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(S), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: fullMethod,
+			}
+			handler := func(ctx context.Context, req any) (any, error) {
+				return call(srv.(S), ctx, req.(*structpb.Struct))
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
+
+// decodeStructRequest decodes the google.protobuf.Struct request of a hand-described service into r.
+func decodeStructRequest(req *structpb.Struct, r any) error {
+	b, err := req.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal request: %v", err)
+	}
+
+	if err := json.Unmarshal(b, r); err != nil {
+		return fmt.Errorf("invalid request: %v", err)
+	}
+
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/led"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/internal/services/railmap/railmapimpl"
 	"github.com/tbe-team/raybot/internal/services/system/systemimpl"
 	"github.com/tbe-team/raybot/internal/storage/db"
 	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
@@ -31,7 +33,9 @@ import (
 type TunnelTestEnv struct {
 	TunnelChannel grpctunnel.TunnelChannel
 
+	EventBus       eventbus.EventBus
 	CommandService command.Service
+	RailMapService railmap.Service
 }
 
 // SetupTunnelTestEnv sets up a temporary cloud server with gRPC reverse tunnel,
@@ -65,18 +69,25 @@ func SetupTunnelTestEnv(t *testing.T) TunnelTestEnv {
 	require.NoError(t, db.AutoMigrate())
 	queries := sqlc.New()
 	log := logging.NewNoopLogger()
-	bus := eventbus.NewNoopEventBus()
+	bus := eventbus.NewInProcEventBus(log)
 	validator := validator.New()
 	commandService := commandimpl.NewService(
 		config.DeleteOldCommand{},
+		config.Recovery{},
 		log,
 		validator,
 		bus,
 		commandimpl.NewRunningCmdRepository(),
 		commandimpl.NewCommandRepository(db, queries),
+		commandimpl.NewMissionRepository(db, queries),
+		commandimpl.NewQueueStateRepository(db, queries),
 		processinglockimpl.New(),
 		noopExecutorService{},
 	)
+	railMapService := railmapimpl.NewService(
+		validator,
+		railmapimpl.NewRepository(db, queries),
+	)
 	systemService := systemimpl.NewService(
 		log,
 		commandService,
@@ -94,6 +105,7 @@ func SetupTunnelTestEnv(t *testing.T) TunnelTestEnv {
 		bus,
 		bus,
 		commandService,
+		railMapService,
 		systemService,
 		nil,
 		nil,
@@ -117,7 +129,9 @@ func SetupTunnelTestEnv(t *testing.T) TunnelTestEnv {
 	})
 
 	return TunnelTestEnv{
+		EventBus:       bus,
 		CommandService: commandService,
+		RailMapService: railMapService,
 		TunnelChannel:  tc,
 	}
 }
@@ -128,6 +142,14 @@ func (e noopExecutorService) Execute(_ context.Context, _ command.Command) error
 	return nil
 }
 
+func (e noopExecutorService) EnterSafeState(_ context.Context) error {
+	return nil
+}
+
+func (e noopExecutorService) Plan(_ context.Context, _ []command.Command) (command.Plan, error) {
+	return command.Plan{}, nil
+}
+
 type noopDriveMotorService struct{}
 
 func (noopDriveMotorService) GetDriveMotorState(_ context.Context) (drivemotor.DriveMotorState, error) {
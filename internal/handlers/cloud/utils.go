@@ -2,11 +2,25 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
 
 	"google.golang.org/grpc/metadata"
+
+	"github.com/tbe-team/raybot/internal/services/command"
 )
 
-const RequestIDKey = "request-id"
+const (
+	RequestIDKey = "request-id"
+	PriorityKey  = "priority"
+	PreemptKey   = "preempt"
+	NotBeforeKey = "not-before"
+	TimeoutMsKey = "timeout-ms"
+
+	PreconditionsKey = "preconditions"
+)
 
 // GetRequestIDFromContext retrieves the request ID from the context metadata.
 // If the request ID is not present, it returns nil.
@@ -21,3 +35,114 @@ func GetRequestIDFromContext(ctx context.Context) *string {
 
 	return requestID
 }
+
+// GetPriorityFromContext retrieves the command priority from the context metadata.
+// The command.v1 CreateCommandRequest has no priority field, so it is carried by the metadata.
+// If the priority is not present, it returns 0.
+func GetPriorityFromContext(ctx context.Context) (uint8, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	values := md.Get(PriorityKey)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	priority, err := strconv.ParseUint(values[0], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority %q: %w", values[0], err)
+	}
+
+	return uint8(priority), nil
+}
+
+// GetPreemptFromContext retrieves the preempt flag from the context metadata.
+// If the preempt flag is not present, it returns false.
+func GetPreemptFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+
+	values := md.Get(PreemptKey)
+	if len(values) == 0 {
+		return false, nil
+	}
+
+	preempt, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false, fmt.Errorf("invalid preempt %q: %w", values[0], err)
+	}
+
+	return preempt, nil
+}
+
+// GetNotBeforeFromContext retrieves the not before time from the context metadata.
+// The value is a RFC 3339 date. If the not before time is not present, it returns nil.
+func GetNotBeforeFromContext(ctx context.Context) (*time.Time, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	values := md.Get(NotBeforeKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	notBefore, err := time.Parse(time.RFC3339, values[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid not before %q: %w", values[0], err)
+	}
+
+	return &notBefore, nil
+}
+
+// GetTimeoutMsFromContext retrieves the command execution timeout in milliseconds from the context metadata.
+// The command.v1 CreateCommandRequest has no timeout field, so it is carried by the metadata.
+// If the timeout is not present, it returns nil and the default timeout of the command type applies.
+func GetTimeoutMsFromContext(ctx context.Context) (*int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	values := md.Get(TimeoutMsKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	timeoutMs, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timeout ms %q: %w", values[0], err)
+	}
+	if timeoutMs <= 0 {
+		return nil, fmt.Errorf("invalid timeout ms %q: must be positive", values[0])
+	}
+
+	return &timeoutMs, nil
+}
+
+// GetPreconditionsFromContext retrieves the command preconditions from the context metadata.
+// The value is a JSON array of conditions, for example [{"type":"BATTERY_AT_LEAST","battery_percent":30}].
+// If the preconditions are not present, it returns nil.
+func GetPreconditionsFromContext(ctx context.Context) ([]command.Condition, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	values := md.Get(PreconditionsKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	var preconditions []command.Condition
+	if err := json.Unmarshal([]byte(values[0]), &preconditions); err != nil {
+		return nil, fmt.Errorf("invalid preconditions %q: %w", values[0], err)
+	}
+
+	return preconditions, nil
+}
@@ -0,0 +1,137 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/led"
+	"github.com/tbe-team/raybot/internal/services/system"
+)
+
+const (
+	// commandBlinkDuration bounds the system led blink of a running command,
+	// the blink is stopped as soon as the command finishes.
+	commandBlinkDuration = 24 * time.Hour
+
+	// commandFailedBlinkDuration is the duration of the alert led blink of a failed command.
+	commandFailedBlinkDuration = 3 * time.Second
+)
+
+// commandLedState is the state of the system led blink of the running command.
+type commandLedState struct {
+	// cancel stops the blink and done is closed once it is stopped.
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// runningID is the ID of the command the blink belongs to.
+	runningID int64
+
+	// lastFinishedID is the ID of the last finished command, used to ignore
+	// a started event handled after the event of the command finishing.
+	lastFinishedID int64
+}
+
+func (s *Service) HandleCommandStartedEvent(ctx context.Context, event events.CommandStartedEvent) {
+	s.commandLedMu.Lock()
+	defer s.commandLedMu.Unlock()
+
+	if event.Command.ID == s.commandLed.lastFinishedID {
+		return
+	}
+	s.stopCommandBlink()
+
+	blinkCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	s.commandLed.cancel = cancel
+	s.commandLed.done = done
+	s.commandLed.runningID = event.Command.ID
+
+	go func() {
+		defer close(done)
+		err := s.ledService.BlinkSystemLed(blinkCtx, led.BlinkSystemLedParams{
+			Duration: commandBlinkDuration,
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			s.logLedError("failed to blink system led", err)
+		}
+	}()
+}
+
+func (s *Service) HandleCommandSucceededEvent(ctx context.Context, event events.CommandSucceededEvent) {
+	s.finishCommandLed(ctx, event.Command)
+}
+
+func (s *Service) HandleCommandCanceledEvent(ctx context.Context, event events.CommandCanceledEvent) {
+	s.finishCommandLed(ctx, event.Command)
+}
+
+func (s *Service) HandleCommandFailedEvent(ctx context.Context, event events.CommandFailedEvent) {
+	s.finishCommandLed(ctx, event.Command)
+
+	if err := s.ledService.BlinkAlertLed(ctx, led.BlinkAlertLedParams{
+		Duration: commandFailedBlinkDuration,
+	}); err != nil {
+		s.logLedError("failed to blink alert led", err)
+		return
+	}
+
+	// The blink leaves the alert led off, restore it if the system is in error
+	status, err := s.systemService.GetStatus(ctx)
+	if err != nil {
+		s.log.Error("failed to get system status", slog.Any("error", err))
+		return
+	}
+	if status == system.StatusError {
+		if err := s.ledService.SetAlertLedOn(ctx); err != nil {
+			s.logLedError("failed to set alert led on", err)
+		}
+	}
+}
+
+// finishCommandLed stops the system led blink of the command and sets the system led on.
+// The blink of another command is left untouched.
+func (s *Service) finishCommandLed(ctx context.Context, cmd command.Command) {
+	s.commandLedMu.Lock()
+	defer s.commandLedMu.Unlock()
+
+	// A command canceled while QUEUED has never blinked
+	if cmd.StartedAt == nil {
+		return
+	}
+
+	s.commandLed.lastFinishedID = cmd.ID
+	if cmd.ID != s.commandLed.runningID {
+		return
+	}
+	s.stopCommandBlink()
+
+	if err := s.ledService.SetSystemLedOn(ctx); err != nil {
+		s.logLedError("failed to set system led on", err)
+	}
+}
+
+// stopCommandBlink stops the system led blink and waits for it to stop.
+// It must be called with commandLedMu held.
+func (s *Service) stopCommandBlink() {
+	if s.commandLed.cancel == nil {
+		return
+	}
+
+	s.commandLed.cancel()
+	<-s.commandLed.done
+	s.commandLed.cancel = nil
+	s.commandLed.done = nil
+	s.commandLed.runningID = 0
+}
+
+func (s *Service) logLedError(msg string, err error) {
+	if errors.Is(err, led.ErrLedNotConnected) {
+		s.log.Warn("led is not connected, skipping", slog.String("action", msg))
+		return
+	}
+	s.log.Error(msg, slog.Any("error", err))
+}
@@ -19,6 +19,9 @@ func (s *Service) HandlePICSerialConnectedEvent(ctx context.Context, _ events.PI
 		SetConnected:       true,
 		LastConnectedAt:    ptr.New(time.Now()),
 		SetLastConnectedAt: true,
+		// Clear the error of a previous disconnect
+		Error:    nil,
+		SetError: true,
 	}); err != nil {
 		s.log.Error("failed to update PIC serial connection", slog.Any("error", err))
 	}
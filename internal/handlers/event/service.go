@@ -3,10 +3,12 @@ package event
 import (
 	"context"
 	"log/slog"
+	"sync"
 
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/led"
 	"github.com/tbe-team/raybot/internal/services/system"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
@@ -19,6 +21,10 @@ type Service struct {
 	appStateService appstate.Service
 	commandService  command.Service
 	systemService   system.Service
+	ledService      led.Service
+
+	commandLedMu sync.Mutex
+	commandLed   commandLedState
 }
 
 type CleanupFunc func(context.Context) error
@@ -29,6 +35,7 @@ func New(
 	appStateService appstate.Service,
 	commandService command.Service,
 	systemService system.Service,
+	ledService led.Service,
 ) *Service {
 	return &Service{
 		log:             log.With("service", "event"),
@@ -36,6 +43,7 @@ func New(
 		appStateService: appStateService,
 		commandService:  commandService,
 		systemService:   systemService,
+		ledService:      ledService,
 	}
 }
 
@@ -163,4 +171,60 @@ func (s *Service) registerHandlers(ctx context.Context) {
 			s.HandleRFIDUSBDisconnectedEvent(ctx, ev)
 		},
 	)
+
+	s.subscriber.Subscribe(
+		ctx,
+		events.CommandStartedTopic,
+		func(msg *eventbus.Message) {
+			ev, ok := msg.Payload.(events.CommandStartedEvent)
+			if !ok {
+				s.log.Error("received invalid event", slog.Any("event", msg.Payload))
+				return
+			}
+
+			s.HandleCommandStartedEvent(ctx, ev)
+		},
+	)
+
+	s.subscriber.Subscribe(
+		ctx,
+		events.CommandSucceededTopic,
+		func(msg *eventbus.Message) {
+			ev, ok := msg.Payload.(events.CommandSucceededEvent)
+			if !ok {
+				s.log.Error("received invalid event", slog.Any("event", msg.Payload))
+				return
+			}
+
+			s.HandleCommandSucceededEvent(ctx, ev)
+		},
+	)
+
+	s.subscriber.Subscribe(
+		ctx,
+		events.CommandFailedTopic,
+		func(msg *eventbus.Message) {
+			ev, ok := msg.Payload.(events.CommandFailedEvent)
+			if !ok {
+				s.log.Error("received invalid event", slog.Any("event", msg.Payload))
+				return
+			}
+
+			s.HandleCommandFailedEvent(ctx, ev)
+		},
+	)
+
+	s.subscriber.Subscribe(
+		ctx,
+		events.CommandCanceledTopic,
+		func(msg *eventbus.Message) {
+			ev, ok := msg.Payload.(events.CommandCanceledEvent)
+			if !ok {
+				s.log.Error("received invalid event", slog.Any("event", msg.Payload))
+				return
+			}
+
+			s.HandleCommandCanceledEvent(ctx, ev)
+		},
+	)
 }
@@ -0,0 +1,213 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+const (
+	exportFormatCSV   = "csv"
+	exportFormatJSONL = "jsonl"
+)
+
+var commandCSVHeader = []string{
+	"id",
+	"type",
+	"status",
+	"source",
+	"request_id",
+	"mission_id",
+	"priority",
+	"inputs",
+	"error",
+	"created_at",
+	"started_at",
+	"completed_at",
+	"duration_ms",
+}
+
+func (h commandHandler) ExportCommands(ctx context.Context, req gen.ExportCommandsRequestObject) (gen.ExportCommandsResponseObject, error) {
+	if req.Params.Format != exportFormatCSV && req.Params.Format != exportFormatJSONL {
+		return nil, xerror.ValidationFailed(nil, "invalid format")
+	}
+
+	filter, err := h.convertReqCommandFilter(commandFilterParams{
+		Statuses:      req.Params.Statuses,
+		Types:         req.Params.Types,
+		Sources:       req.Params.Sources,
+		RequestID:     req.Params.RequestId,
+		CreatedFrom:   req.Params.CreatedFrom,
+		CreatedTo:     req.Params.CreatedTo,
+		CompletedFrom: req.Params.CompletedFrom,
+		CompletedTo:   req.Params.CompletedTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commandExportResponse{
+		ctx:     ctx,
+		handler: h,
+		format:  req.Params.Format,
+		params:  command.ExportCommandsParams{CommandFilter: filter},
+	}, nil
+}
+
+// commandExportResponse writes the exported commands while they are read.
+// The headers are written with the first command, so that an error before it
+// is still returned with its own status code.
+type commandExportResponse struct {
+	ctx     context.Context
+	handler commandHandler
+	format  string
+	params  command.ExportCommandsParams
+}
+
+func (r commandExportResponse) VisitExportCommandsResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+	// The export of a long history outlives the write timeout of the server
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("set write deadline: %w", err)
+	}
+
+	var enc commandEncoder
+	if r.format == exportFormatCSV {
+		enc = &commandCSVEncoder{w: csv.NewWriter(w)}
+	} else {
+		enc = &commandJSONLEncoder{w: w, handler: r.handler}
+	}
+
+	started := false
+	start := func() error {
+		started = true
+		contentType, ext := "text/csv", exportFormatCSV
+		if r.format == exportFormatJSONL {
+			contentType, ext = "application/x-ndjson", exportFormatJSONL
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="commands.%s"`, ext))
+		w.WriteHeader(http.StatusOK)
+		return enc.begin()
+	}
+
+	err := r.handler.commandService.ExportCommands(r.ctx, r.params, func(cmd command.Command) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return enc.encode(cmd)
+	})
+	if err != nil {
+		return fmt.Errorf("export commands: %w", err)
+	}
+
+	if !started {
+		if err := start(); err != nil {
+			return err
+		}
+	}
+
+	return enc.end()
+}
+
+type commandEncoder interface {
+	begin() error
+	encode(cmd command.Command) error
+	end() error
+}
+
+type commandCSVEncoder struct {
+	w *csv.Writer
+}
+
+func (e *commandCSVEncoder) begin() error {
+	return e.w.Write(commandCSVHeader)
+}
+
+func (e *commandCSVEncoder) encode(cmd command.Command) error {
+	inputs, err := json.Marshal(cmd.Inputs)
+	if err != nil {
+		return fmt.Errorf("marshal inputs: %w", err)
+	}
+
+	var durationMs string
+	if cmd.StartedAt != nil && cmd.CompletedAt != nil {
+		durationMs = strconv.FormatInt(cmd.CompletedAt.Sub(*cmd.StartedAt).Milliseconds(), 10)
+	}
+
+	return e.w.Write([]string{
+		strconv.FormatInt(cmd.ID, 10),
+		cmd.Type.String(),
+		cmd.Status.String(),
+		cmd.Source.String(),
+		stringOrEmpty(cmd.RequestID),
+		int64OrEmpty(cmd.MissionID),
+		strconv.Itoa(int(cmd.Priority)),
+		string(inputs),
+		stringOrEmpty(cmd.Error),
+		cmd.CreatedAt.Format(time.RFC3339),
+		timeOrEmpty(cmd.StartedAt),
+		timeOrEmpty(cmd.CompletedAt),
+		durationMs,
+	})
+}
+
+func (e *commandCSVEncoder) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type commandJSONLEncoder struct {
+	w       http.ResponseWriter
+	handler commandHandler
+}
+
+func (*commandJSONLEncoder) begin() error {
+	return nil
+}
+
+func (e *commandJSONLEncoder) encode(cmd command.Command) error {
+	res, err := e.handler.convertCommandToResponse(cmd)
+	if err != nil {
+		return fmt.Errorf("convert command to response: %w", err)
+	}
+
+	// Encode writes the trailing newline of the JSON line
+	return json.NewEncoder(e.w).Encode(res)
+}
+
+func (*commandJSONLEncoder) end() error {
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func int64OrEmpty(i *int64) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.FormatInt(*i, 10)
+}
+
+func timeOrEmpty(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -2,22 +2,32 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/tbe-team/raybot/internal/handlers/http/gen"
 	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
 	"github.com/tbe-team/raybot/pkg/sort"
 	"github.com/tbe-team/raybot/pkg/xerror"
 )
 
 type commandHandler struct {
+	log            *slog.Logger
+	subscriber     eventbus.Subscriber
 	commandService command.Service
 }
 
-func newCommandHandler(commandService command.Service) *commandHandler {
+func newCommandHandler(log *slog.Logger, subscriber eventbus.Subscriber, commandService command.Service) *commandHandler {
 	return &commandHandler{
+		log:            log,
+		subscriber:     subscriber,
 		commandService: commandService,
 	}
 }
@@ -72,22 +82,24 @@ func (h commandHandler) ListCommands(ctx context.Context, req gen.ListCommandsRe
 		}
 	}
 
-	statuses := []command.Status{}
-	if req.Params.Statuses != nil && len(*req.Params.Statuses) > 0 {
-		stripped := strings.TrimSpace(*req.Params.Statuses)
-		if stripped == "" {
-			return nil, xerror.ValidationFailed(nil, "invalid statuses")
-		}
-		ss := strings.Split(stripped, ",")
-		for _, s := range ss {
-			statuses = append(statuses, command.Status(s))
-		}
+	filter, err := h.convertReqCommandFilter(commandFilterParams{
+		Statuses:      req.Params.Statuses,
+		Types:         req.Params.Types,
+		Sources:       req.Params.Sources,
+		RequestID:     req.Params.RequestId,
+		CreatedFrom:   req.Params.CreatedFrom,
+		CreatedTo:     req.Params.CreatedTo,
+		CompletedFrom: req.Params.CompletedFrom,
+		CompletedTo:   req.Params.CompletedTo,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	commands, err := h.commandService.ListCommands(ctx, command.ListCommandsParams{
-		PagingParams: paging.NewParams(paging.Page(page), paging.PageSize(pageSize)),
-		Sorts:        sorts,
-		Statuses:     statuses,
+		CommandFilter: filter,
+		PagingParams:  paging.NewParams(paging.Page(page), paging.PageSize(pageSize)),
+		Sorts:         sorts,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list commands: %w", err)
@@ -108,16 +120,120 @@ func (h commandHandler) ListCommands(ctx context.Context, req gen.ListCommandsRe
 	}, nil
 }
 
+func (h commandHandler) GetCommandStats(ctx context.Context, req gen.GetCommandStatsRequestObject) (gen.GetCommandStatsResponseObject, error) {
+	filter, err := h.convertReqCommandFilter(commandFilterParams{
+		Statuses:      req.Params.Statuses,
+		Types:         req.Params.Types,
+		Sources:       req.Params.Sources,
+		RequestID:     req.Params.RequestId,
+		CreatedFrom:   req.Params.CreatedFrom,
+		CreatedTo:     req.Params.CreatedTo,
+		CompletedFrom: req.Params.CompletedFrom,
+		CompletedTo:   req.Params.CompletedTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := h.commandService.GetCommandStats(ctx, command.GetCommandStatsParams{
+		CommandFilter: filter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get command stats: %w", err)
+	}
+
+	types := make([]gen.CommandTypeStats, 0, len(stats.Types))
+	for _, t := range stats.Types {
+		types = append(types, gen.CommandTypeStats{
+			Type:           t.Type.String(),
+			Total:          t.Total,
+			Succeeded:      t.Succeeded,
+			MeanDurationMs: t.MeanDuration.Milliseconds(),
+			P50DurationMs:  t.P50Duration.Milliseconds(),
+			P95DurationMs:  t.P95Duration.Milliseconds(),
+		})
+	}
+
+	sources := make([]gen.SourceStats, 0, len(stats.Sources))
+	for _, s := range stats.Sources {
+		sources = append(sources, gen.SourceStats{
+			Source:    s.Source.String(),
+			Total:     s.Total,
+			Succeeded: s.Succeeded,
+		})
+	}
+
+	routes := make([]gen.RouteStats, 0, len(stats.Routes))
+	for _, r := range stats.Routes {
+		routes = append(routes, gen.RouteStats{
+			From:           r.From,
+			To:             r.To,
+			Count:          r.Count,
+			MeanDurationMs: r.MeanDuration.Milliseconds(),
+			P50DurationMs:  r.P50Duration.Milliseconds(),
+			P95DurationMs:  r.P95Duration.Milliseconds(),
+		})
+	}
+
+	failures := make([]gen.FailureStats, 0, len(stats.Failures))
+	for _, f := range stats.Failures {
+		failures = append(failures, gen.FailureStats{
+			Error: f.Error,
+			Count: f.Count,
+		})
+	}
+
+	return gen.GetCommandStats200JSONResponse{
+		Total:       stats.Total,
+		Succeeded:   stats.Succeeded,
+		Failed:      stats.Failed,
+		TimedOut:    stats.TimedOut,
+		Canceled:    stats.Canceled,
+		SuccessRate: stats.SuccessRate(),
+		Types:       types,
+		Sources:     sources,
+		Routes:      routes,
+		Failures:    failures,
+	}, nil
+}
+
 func (h commandHandler) CreateCommand(ctx context.Context, req gen.CreateCommandRequestObject) (gen.CreateCommandResponseObject, error) {
 	inputs, err := h.convertReqInputsToCommandInputs(req.Body.Type, req.Body.Inputs)
 	if err != nil {
 		return nil, xerror.ValidationFailed(err, "invalid inputs")
 	}
 
-	cmd, err := h.commandService.CreateCommand(ctx, command.CreateCommandParams{
-		Source: command.SourceApp,
-		Inputs: inputs,
-	})
+	var priority uint8
+	if req.Body.Priority != nil {
+		priority = *req.Body.Priority
+	}
+
+	params := command.CreateCommandParams{
+		Source:       command.SourceApp,
+		Inputs:       inputs,
+		Retry:        h.convertReqRetryPolicyToRetryPolicy(req.Body.Retry),
+		Priority:     priority,
+		Preempt:      req.Body.Preempt != nil && *req.Body.Preempt,
+		NotBefore:    req.Body.NotBefore,
+		InsertBefore: req.Body.InsertBefore,
+		InsertAfter:  req.Body.InsertAfter,
+	}
+
+	if req.Params.DryRun != nil && *req.Params.DryRun {
+		plan, err := h.commandService.PlanCommand(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("plan command: %w", err)
+		}
+
+		res, err := h.convertPlanToResponse(plan)
+		if err != nil {
+			return nil, fmt.Errorf("convert plan to response: %w", err)
+		}
+
+		return gen.CreateCommand200JSONResponse(res), nil
+	}
+
+	cmd, err := h.commandService.CreateCommand(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("create command: %w", err)
 	}
@@ -130,6 +246,85 @@ func (h commandHandler) CreateCommand(ctx context.Context, req gen.CreateCommand
 	return gen.CreateCommand201JSONResponse(res), nil
 }
 
+func (h commandHandler) CreateCommands(ctx context.Context, req gen.CreateCommandsRequestObject) (gen.CreateCommandsResponseObject, error) {
+	items := make([]command.CreateCommandsItem, len(req.Body.Commands))
+	for i, c := range req.Body.Commands {
+		inputs, err := h.convertReqInputsToCommandInputs(c.Type, c.Inputs)
+		if err != nil {
+			return nil, xerror.ValidationFailed(err, fmt.Sprintf("invalid inputs of command %d", i))
+		}
+
+		var priority uint8
+		if c.Priority != nil {
+			priority = *c.Priority
+		}
+
+		items[i] = command.CreateCommandsItem{
+			Inputs:    inputs,
+			RequestID: c.RequestId,
+			Retry:     h.convertReqRetryPolicyToRetryPolicy(c.Retry),
+			Priority:  priority,
+			NotBefore: c.NotBefore,
+		}
+	}
+
+	cmds, err := h.commandService.CreateCommands(ctx, command.CreateCommandsParams{
+		Source:   command.SourceApp,
+		Commands: items,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create commands: %w", err)
+	}
+
+	ids := make([]int64, len(cmds))
+	for i, cmd := range cmds {
+		ids[i] = cmd.ID
+	}
+
+	return gen.CreateCommands201JSONResponse{
+		CommandIds: ids,
+	}, nil
+}
+
+func (h commandHandler) UpdateQueuedCommand(ctx context.Context, req gen.UpdateQueuedCommandRequestObject) (gen.UpdateQueuedCommandResponseObject, error) {
+	inputs, err := h.convertReqInputsToCommandInputs(req.Body.Type, req.Body.Inputs)
+	if err != nil {
+		return nil, xerror.ValidationFailed(err, "invalid inputs")
+	}
+
+	cmd, err := h.commandService.UpdateQueuedCommand(ctx, command.UpdateQueuedCommandParams{
+		CommandID: int64(req.CommandId),
+		Inputs:    inputs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update queued command: %w", err)
+	}
+
+	res, err := h.convertCommandToResponse(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("convert command to response: %w", err)
+	}
+
+	return gen.UpdateQueuedCommand200JSONResponse(res), nil
+}
+
+func (h commandHandler) MoveQueuedCommand(ctx context.Context, req gen.MoveQueuedCommandRequestObject) (gen.MoveQueuedCommandResponseObject, error) {
+	cmd, err := h.commandService.MoveQueuedCommand(ctx, command.MoveQueuedCommandParams{
+		CommandID: int64(req.CommandId),
+		Position:  req.Body.Position,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("move queued command: %w", err)
+	}
+
+	res, err := h.convertCommandToResponse(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("convert command to response: %w", err)
+	}
+
+	return gen.MoveQueuedCommand200JSONResponse(res), nil
+}
+
 //nolint:revive
 func (h commandHandler) DeleteCommandById(ctx context.Context, req gen.DeleteCommandByIdRequestObject) (gen.DeleteCommandByIdResponseObject, error) {
 	err := h.commandService.DeleteCommandByID(ctx, command.DeleteCommandByIDParams{
@@ -151,6 +346,64 @@ func (h commandHandler) CancelCurrentProcessingCommand(ctx context.Context, _ ge
 	return gen.CancelCurrentProcessingCommand204Response{}, nil
 }
 
+//nolint:revive
+func (h commandHandler) CancelCommandById(ctx context.Context, req gen.CancelCommandByIdRequestObject) (gen.CancelCommandByIdResponseObject, error) {
+	err := h.commandService.CancelCommandByID(ctx, command.CancelCommandByIDParams{
+		CommandID: req.CommandId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cancel command by id: %w", err)
+	}
+
+	return gen.CancelCommandById204Response{}, nil
+}
+
+//nolint:revive
+func (h commandHandler) CancelCommandByRequestId(ctx context.Context, req gen.CancelCommandByRequestIdRequestObject) (gen.CancelCommandByRequestIdResponseObject, error) {
+	err := h.commandService.CancelCommandByRequestID(ctx, command.CancelCommandByRequestIDParams{
+		RequestID: req.Body.RequestId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cancel command by request id: %w", err)
+	}
+
+	return gen.CancelCommandByRequestId204Response{}, nil
+}
+
+func (h commandHandler) GetCommandQueueState(ctx context.Context, _ gen.GetCommandQueueStateRequestObject) (gen.GetCommandQueueStateResponseObject, error) {
+	state, err := h.commandService.GetQueueState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get queue state: %w", err)
+	}
+
+	return gen.GetCommandQueueState200JSONResponse(h.convertQueueStateToResponse(state)), nil
+}
+
+func (h commandHandler) PauseCommandQueue(ctx context.Context, _ gen.PauseCommandQueueRequestObject) (gen.PauseCommandQueueResponseObject, error) {
+	state, err := h.commandService.PauseQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pause queue: %w", err)
+	}
+
+	return gen.PauseCommandQueue200JSONResponse(h.convertQueueStateToResponse(state)), nil
+}
+
+func (h commandHandler) ResumeCommandQueue(ctx context.Context, _ gen.ResumeCommandQueueRequestObject) (gen.ResumeCommandQueueResponseObject, error) {
+	state, err := h.commandService.ResumeQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resume queue: %w", err)
+	}
+
+	return gen.ResumeCommandQueue200JSONResponse(h.convertQueueStateToResponse(state)), nil
+}
+
+func (commandHandler) convertQueueStateToResponse(state command.QueueState) gen.CommandQueueStateResponse {
+	return gen.CommandQueueStateResponse{
+		Paused:    state.Paused,
+		UpdatedAt: state.UpdatedAt,
+	}
+}
+
 func (h commandHandler) convertCommandToResponse(cmd command.Command) (gen.CommandResponse, error) {
 	inputs, err := h.convertInputsToResponse(cmd.Inputs)
 	if err != nil {
@@ -163,152 +416,504 @@ func (h commandHandler) convertCommandToResponse(cmd command.Command) (gen.Comma
 	}
 
 	return gen.CommandResponse{
-		Id:          int(cmd.ID),
-		Type:        cmd.Type.String(),
-		Status:      cmd.Status.String(),
-		Source:      cmd.Source.String(),
-		Inputs:      inputs,
-		Outputs:     outputs,
-		Error:       cmd.Error,
-		StartedAt:   cmd.StartedAt,
-		CompletedAt: cmd.CompletedAt,
-		CreatedAt:   cmd.CreatedAt,
-		UpdatedAt:   cmd.UpdatedAt,
+		Id:                int(cmd.ID),
+		Type:              cmd.Type.String(),
+		Status:            cmd.Status.String(),
+		Source:            cmd.Source.String(),
+		Inputs:            inputs,
+		Outputs:           outputs,
+		Error:             cmd.Error,
+		StartedAt:         cmd.StartedAt,
+		CompletedAt:       cmd.CompletedAt,
+		CreatedAt:         cmd.CreatedAt,
+		UpdatedAt:         cmd.UpdatedAt,
+		MissionId:         cmd.MissionID,
+		PausedForObstacle: cmd.PausedForObstacle,
+		Retry:             h.convertRetryPolicyToResponse(cmd.RetryPolicy),
+		Priority:          cmd.Priority,
+		NotBefore:         cmd.NotBefore,
+		Progress:          h.convertProgressToResponse(cmd.Progress),
+		QueuePosition:     cmd.QueuePosition,
 	}, nil
 }
 
-func (commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.CommandInputs, error) {
+func (commandHandler) convertProgressToResponse(progress *command.Progress) *gen.CommandProgress {
+	if progress == nil {
+		return nil
+	}
+
+	var passedLocations *[]string
+	if progress.PassedLocations != nil {
+		passedLocations = &progress.PassedLocations
+	}
+
+	return &gen.CommandProgress{
+		CommandId:       progress.CommandID,
+		CommandType:     progress.CommandType.String(),
+		CurrentPosition: progress.CurrentPosition,
+		TargetPosition:  progress.TargetPosition,
+		PassedLocations: passedLocations,
+		WaitedMs:        progress.WaitedMs,
+		Message:         progress.Message,
+		UpdatedAt:       progress.UpdatedAt,
+	}
+}
+
+func (commandHandler) convertRetryPolicyToResponse(retryPolicy *command.RetryPolicy) *gen.RetryPolicy {
+	if retryPolicy == nil {
+		return nil
+	}
+
+	return &gen.RetryPolicy{
+		MaxAttempts:      retryPolicy.MaxAttempts,
+		InitialBackoffMs: retryPolicy.InitialBackoffMs,
+		MaxBackoffMs:     retryPolicy.MaxBackoffMs,
+	}
+}
+
+func (commandHandler) convertReqRetryPolicyToRetryPolicy(retryPolicy *gen.RetryPolicy) *command.RetryPolicy {
+	if retryPolicy == nil {
+		return nil
+	}
+
+	return &command.RetryPolicy{
+		MaxAttempts:      retryPolicy.MaxAttempts,
+		InitialBackoffMs: retryPolicy.InitialBackoffMs,
+		MaxBackoffMs:     retryPolicy.MaxBackoffMs,
+	}
+}
+
+func (h commandHandler) convertReqCommonInputs(timeoutMs *int64, preconditions *gen.Preconditions, abortMission *bool) command.CommonInputs {
+	common := command.CommonInputs{TimeoutMs: timeoutMs}
+	if preconditions != nil {
+		common.Preconditions = h.convertReqConditions(*preconditions)
+	}
+	if abortMission != nil {
+		common.AbortMission = *abortMission
+	}
+	return common
+}
+
+func (commandHandler) convertReqConditions(conditions []gen.Condition) []command.Condition {
+	res := make([]command.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		cond := command.Condition{
+			Type: command.ConditionType(c.Type),
+		}
+		if c.BatteryPercent != nil {
+			cond.BatteryPercent = *c.BatteryPercent
+		}
+		if c.Location != nil {
+			cond.Location = *c.Location
+		}
+		res = append(res, cond)
+	}
+	return res
+}
+
+func (commandHandler) convertConditionsToResponse(conditions []command.Condition) []gen.Condition {
+	res := make([]gen.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		cond := gen.Condition{
+			Type: c.Type.String(),
+		}
+		if c.Type == command.ConditionTypeBatteryAtLeast {
+			cond.BatteryPercent = ptr.New(c.BatteryPercent)
+		}
+		if c.Location != "" {
+			cond.Location = ptr.New(c.Location)
+		}
+		res = append(res, cond)
+	}
+	return res
+}
+
+func (h commandHandler) convertPreconditionsToResponse(preconditions []command.Condition) *gen.Preconditions {
+	if len(preconditions) == 0 {
+		return nil
+	}
+
+	res := h.convertConditionsToResponse(preconditions)
+	return &res
+}
+
+func (commandHandler) convertAbortMissionToResponse(abortMission bool) *gen.AbortMission {
+	if !abortMission {
+		return nil
+	}
+	return ptr.New(abortMission)
+}
+
+func (h commandHandler) convertFailedConditionToResponse(failedCondition *command.FailedCondition) *gen.FailedCondition {
+	if failedCondition == nil {
+		return nil
+	}
+
+	return &gen.FailedCondition{
+		Condition:    h.convertConditionsToResponse([]command.Condition{failedCondition.Condition})[0],
+		Actual:       failedCondition.Actual,
+		Precondition: failedCondition.Precondition,
+	}
+}
+
+// commandFilterParams are the filter query params shared by the command list, export and stats.
+type commandFilterParams struct {
+	Statuses      *string
+	Types         *string
+	Sources       *string
+	RequestID     *string
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	CompletedFrom *time.Time
+	CompletedTo   *time.Time
+}
+
+func (commandHandler) convertReqCommandFilter(params commandFilterParams) (command.CommandFilter, error) {
+	statuses, err := splitQueryList[command.Status](params.Statuses)
+	if err != nil {
+		return command.CommandFilter{}, xerror.ValidationFailed(err, "invalid statuses")
+	}
+	types, err := splitQueryList[command.CommandType](params.Types)
+	if err != nil {
+		return command.CommandFilter{}, xerror.ValidationFailed(err, "invalid types")
+	}
+	sources, err := splitQueryList[command.Source](params.Sources)
+	if err != nil {
+		return command.CommandFilter{}, xerror.ValidationFailed(err, "invalid sources")
+	}
+
+	return command.CommandFilter{
+		Statuses:      statuses,
+		Types:         types,
+		Sources:       sources,
+		RequestID:     params.RequestID,
+		CreatedFrom:   params.CreatedFrom,
+		CreatedTo:     params.CreatedTo,
+		CompletedFrom: params.CompletedFrom,
+		CompletedTo:   params.CompletedTo,
+	}, nil
+}
+
+// splitQueryList splits a comma separated query param, a nil param is an empty list.
+func splitQueryList[T ~string](param *string) ([]T, error) {
+	if param == nil || len(*param) == 0 {
+		return nil, nil
+	}
+
+	stripped := strings.TrimSpace(*param)
+	if stripped == "" {
+		return nil, errors.New("empty list")
+	}
+
+	var ret []T
+	for _, s := range strings.Split(stripped, ",") {
+		ret = append(ret, T(s))
+	}
+	return ret, nil
+}
+
+func (h commandHandler) convertPlanToResponse(plan command.Plan) (gen.PlanResponse, error) {
+	steps := make([]gen.PlanStep, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		inputs, err := h.convertInputsToResponse(step.Inputs)
+		if err != nil {
+			return gen.PlanResponse{}, fmt.Errorf("convert inputs to response: %w", err)
+		}
+
+		conditions := make([]gen.ConditionResult, 0, len(step.Conditions))
+		for _, c := range step.Conditions {
+			conditions = append(conditions, gen.ConditionResult{
+				Condition:    h.convertConditionsToResponse([]command.Condition{c.Condition})[0],
+				Met:          c.Met,
+				Actual:       c.Actual,
+				Precondition: c.Precondition,
+			})
+		}
+
+		expectedLocations := step.ExpectedLocations
+		if expectedLocations == nil {
+			expectedLocations = []string{}
+		}
+
+		var direction *gen.MoveDirection
+		if step.Direction != nil {
+			direction = ptr.New(step.Direction.String())
+		}
+
+		steps = append(steps, gen.PlanStep{
+			Type:              step.Type.String(),
+			Inputs:            inputs,
+			Location:          step.Location,
+			Direction:         direction,
+			ExpectedLocations: expectedLocations,
+			LiftPosition:      step.LiftPosition,
+			Conditions:        conditions,
+			Error:             step.Error,
+		})
+	}
+
+	return gen.PlanResponse{
+		Executable: plan.Executable(),
+		Steps:      steps,
+	}, nil
+}
+
+func (commandHandler) convertAttemptsToResponse(attempts []command.Attempt) *gen.Attempts {
+	if len(attempts) == 0 {
+		return nil
+	}
+
+	res := make(gen.Attempts, 0, len(attempts))
+	for _, a := range attempts {
+		res = append(res, gen.Attempt{
+			Attempt:     a.Attempt,
+			StartedAt:   a.StartedAt,
+			CompletedAt: a.CompletedAt,
+			Error:       a.Error,
+		})
+	}
+
+	return &res
+}
+
+func (h commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.CommandInputs, error) {
 	var res gen.CommandInputs
 	switch v := inputs.(type) {
 	case *command.StopMovementInputs:
-		if err := res.FromStopInputs(gen.StopInputs{}); err != nil {
+		if err := res.FromStopInputs(gen.StopInputs{
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
+		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from stop inputs: %w", err)
 		}
 
 	case *command.MoveToInputs:
+		var approachLocations *[]string
+		if len(v.ApproachLocations) > 0 {
+			approachLocations = &v.ApproachLocations
+		}
+		var direction *gen.MoveDirection
+		if v.Direction != "" {
+			direction = ptr.New(v.Direction.String())
+		}
 		if err := res.FromMoveToInputs(gen.MoveToInputs{
-			Location:   v.Location,
-			Direction:  v.Direction.String(),
-			MotorSpeed: v.MotorSpeed,
+			Location:           v.Location,
+			Direction:          direction,
+			MotorSpeed:         v.MotorSpeed,
+			ApproachLocations:  approachLocations,
+			ApproachSpeed:      v.ApproachSpeed,
+			AccelerationRampMs: v.AccelerationRampMs,
+			DecelerationRampMs: v.DecelerationRampMs,
+			TimeoutMs:          v.TimeoutMs,
+			Preconditions:      h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:       h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from move to inputs: %w", err)
 		}
 
 	case *command.MoveForwardInputs:
 		if err := res.FromMoveForwardInputs(gen.MoveForwardInputs{
-			MotorSpeed: v.MotorSpeed,
+			MotorSpeed:    v.MotorSpeed,
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from move forward inputs: %w", err)
 		}
 
 	case *command.MoveBackwardInputs:
 		if err := res.FromMoveBackwardInputs(gen.MoveBackwardInputs{
-			MotorSpeed: v.MotorSpeed,
+			MotorSpeed:    v.MotorSpeed,
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from move backward inputs: %w", err)
 		}
 
 	case *command.CargoOpenInputs:
 		if err := res.FromCargoOpenInputs(gen.CargoOpenInputs{
-			MotorSpeed: v.MotorSpeed,
+			MotorSpeed:    v.MotorSpeed,
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from cargo open inputs: %w", err)
 		}
 
 	case *command.CargoCloseInputs:
 		if err := res.FromCargoCloseInputs(gen.CargoCloseInputs{
-			MotorSpeed: v.MotorSpeed,
+			MotorSpeed:    v.MotorSpeed,
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from cargo close inputs: %w", err)
 		}
 
 	case *command.CargoLiftInputs:
 		if err := res.FromCargoLiftInputs(gen.CargoLiftInputs{
-			Position:   v.Position,
-			MotorSpeed: v.MotorSpeed,
+			Position:      v.Position,
+			MotorSpeed:    v.MotorSpeed,
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from cargo lift inputs: %w", err)
 		}
 
 	case *command.CargoLowerInputs:
 		if err := res.FromCargoLowerInputs(gen.CargoLowerInputs{
-			Position:   v.Position,
-			MotorSpeed: v.MotorSpeed,
+			Position:      v.Position,
+			MotorSpeed:    v.MotorSpeed,
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from cargo lower inputs: %w", err)
 		}
 
 	case *command.CargoCheckQRInputs:
 		if err := res.FromCargoCheckQRInputs(gen.CargoCheckQRInputs{
-			QrCode: v.QRCode,
+			QrCode:        v.QRCode,
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from cargo check qr inputs: %w", err)
 		}
 
 	case *command.ScanLocationInputs:
-		if err := res.FromScanLocationInputs(gen.ScanLocationInputs{}); err != nil {
+		if err := res.FromScanLocationInputs(gen.ScanLocationInputs{
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
+		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from scan location inputs: %w", err)
 		}
 
 	case *command.WaitInputs:
 		if err := res.FromWaitInputs(gen.WaitInputs{
-			DurationMs: int(v.DurationMs),
+			DurationMs:    int(v.DurationMs),
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from wait inputs: %w", err)
 		}
 
+	case *command.AssertInputs:
+		if err := res.FromAssertInputs(gen.AssertInputs{
+			Conditions:    h.convertConditionsToResponse(v.Conditions),
+			TimeoutMs:     v.TimeoutMs,
+			Preconditions: h.convertPreconditionsToResponse(v.Preconditions),
+			AbortMission:  h.convertAbortMissionToResponse(v.AbortMission),
+		}); err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("from assert inputs: %w", err)
+		}
+
 	default:
-		return gen.CommandInputs{}, fmt.Errorf("unknown inputs type: %T", v)
+		// Custom command types are encoded with their own JSON encoding.
+		if inputs == nil {
+			return gen.CommandInputs{}, fmt.Errorf("unknown inputs type: %T", v)
+		}
+		if _, ok := command.LookupType(inputs.CommandType()); !ok {
+			return gen.CommandInputs{}, fmt.Errorf("unknown inputs type: %T", v)
+		}
+		b, err := json.Marshal(inputs)
+		if err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("marshal %s inputs: %w", inputs.CommandType(), err)
+		}
+		if err := res.UnmarshalJSON(b); err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("from %s inputs: %w", inputs.CommandType(), err)
+		}
 	}
 
 	return res, nil
 }
 
-func (commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.CommandOutputs, error) {
+func (h commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.CommandOutputs, error) {
 	var res gen.CommandOutputs
 	switch v := outputs.(type) {
 	case *command.StopMovementOutputs:
-		if err := res.FromStopOutputs(gen.StopOutputs{}); err != nil {
+		if err := res.FromStopOutputs(gen.StopOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from stop outputs: %w", err)
 		}
 
 	case *command.MoveForwardOutputs:
-		if err := res.FromMoveForwardOutputs(gen.MoveForwardOutputs{}); err != nil {
+		if err := res.FromMoveForwardOutputs(gen.MoveForwardOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from move forward outputs: %w", err)
 		}
 
 	case *command.MoveBackwardOutputs:
-		if err := res.FromMoveBackwardOutputs(gen.MoveBackwardOutputs{}); err != nil {
+		if err := res.FromMoveBackwardOutputs(gen.MoveBackwardOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from move backward outputs: %w", err)
 		}
 
 	case *command.MoveToOutputs:
-		if err := res.FromMoveToOutputs(gen.MoveToOutputs{}); err != nil {
+		if err := res.FromMoveToOutputs(gen.MoveToOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from move to outputs: %w", err)
 		}
 
 	case *command.CargoOpenOutputs:
-		if err := res.FromCargoOpenOutputs(gen.CargoOpenOutputs{}); err != nil {
+		if err := res.FromCargoOpenOutputs(gen.CargoOpenOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from cargo open outputs: %w", err)
 		}
 
 	case *command.CargoCloseOutputs:
-		if err := res.FromCargoCloseOutputs(gen.CargoCloseOutputs{}); err != nil {
+		if err := res.FromCargoCloseOutputs(gen.CargoCloseOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from cargo close outputs: %w", err)
 		}
 
 	case *command.CargoLiftOutputs:
-		if err := res.FromCargoLiftOutputs(gen.CargoLiftOutputs{}); err != nil {
+		if err := res.FromCargoLiftOutputs(gen.CargoLiftOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from cargo lift outputs: %w", err)
 		}
 
 	case *command.CargoLowerOutputs:
-		if err := res.FromCargoLowerOutputs(gen.CargoLowerOutputs{}); err != nil {
+		if err := res.FromCargoLowerOutputs(gen.CargoLowerOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from cargo lower outputs: %w", err)
 		}
 
 	case *command.CargoCheckQROutputs:
-		if err := res.FromCargoCheckQROutputs(gen.CargoCheckQROutputs{}); err != nil {
+		if err := res.FromCargoCheckQROutputs(gen.CargoCheckQROutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from cargo check qr outputs: %w", err)
 		}
 
@@ -324,24 +929,53 @@ func (commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.Com
 		}
 
 		if err := res.FromScanLocationOutputs(gen.ScanLocationOutputs{
-			Locations: locs,
+			Locations:       locs,
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
 		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from scan location outputs: %w", err)
 		}
 
 	case *command.WaitOutputs:
-		if err := res.FromWaitOutputs(gen.WaitOutputs{}); err != nil {
+		if err := res.FromWaitOutputs(gen.WaitOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from wait outputs: %w", err)
 		}
 
+	case *command.AssertOutputs:
+		if err := res.FromAssertOutputs(gen.AssertOutputs{
+			ElapsedMs:       v.ElapsedMs,
+			Attempts:        h.convertAttemptsToResponse(v.Attempts),
+			FailedCondition: h.convertFailedConditionToResponse(v.FailedCondition),
+		}); err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("from assert outputs: %w", err)
+		}
+
 	default:
-		return gen.CommandOutputs{}, fmt.Errorf("unknown outputs type: %T", v)
+		// Custom command types are encoded with their own JSON encoding.
+		if outputs == nil {
+			return gen.CommandOutputs{}, fmt.Errorf("unknown outputs type: %T", v)
+		}
+		if _, ok := command.LookupType(outputs.CommandType()); !ok {
+			return gen.CommandOutputs{}, fmt.Errorf("unknown outputs type: %T", v)
+		}
+		b, err := json.Marshal(outputs)
+		if err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("marshal %s outputs: %w", outputs.CommandType(), err)
+		}
+		if err := res.UnmarshalJSON(b); err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("from %s outputs: %w", outputs.CommandType(), err)
+		}
 	}
 
 	return res, nil
 }
 
-func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, inputs gen.CommandInputs) (command.Inputs, error) {
+func (h commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, inputs gen.CommandInputs) (command.Inputs, error) {
 	i, err := inputs.MarshalJSON()
 	if err != nil {
 		return nil, fmt.Errorf("marshal inputs: %w", err)
@@ -352,17 +986,36 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 
 	switch command.CommandType(cmdType) {
 	case command.CommandTypeStopMovement:
-		return &command.StopMovementInputs{}, nil
+		i, err := inputs.AsStopInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as stop inputs: %w", err)
+		}
+		return &command.StopMovementInputs{
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+		}, nil
 
 	case command.CommandTypeMoveTo:
 		i, err := inputs.AsMoveToInputs()
 		if err != nil {
 			return nil, fmt.Errorf("as move to inputs: %w", err)
 		}
+		var approachLocations []string
+		if i.ApproachLocations != nil {
+			approachLocations = *i.ApproachLocations
+		}
+		var direction command.MoveDirection
+		if i.Direction != nil {
+			direction = command.MoveDirection(*i.Direction)
+		}
 		return &command.MoveToInputs{
-			Location:   i.Location,
-			Direction:  command.MoveDirection(i.Direction),
-			MotorSpeed: i.MotorSpeed,
+			CommonInputs:       h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			Location:           i.Location,
+			Direction:          direction,
+			MotorSpeed:         i.MotorSpeed,
+			ApproachLocations:  approachLocations,
+			ApproachSpeed:      i.ApproachSpeed,
+			AccelerationRampMs: i.AccelerationRampMs,
+			DecelerationRampMs: i.DecelerationRampMs,
 		}, nil
 
 	case command.CommandTypeMoveForward:
@@ -371,7 +1024,8 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as move forward inputs: %w", err)
 		}
 		return &command.MoveForwardInputs{
-			MotorSpeed: i.MotorSpeed,
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			MotorSpeed:   i.MotorSpeed,
 		}, nil
 
 	case command.CommandTypeMoveBackward:
@@ -380,7 +1034,8 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as move backward inputs: %w", err)
 		}
 		return &command.MoveBackwardInputs{
-			MotorSpeed: i.MotorSpeed,
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			MotorSpeed:   i.MotorSpeed,
 		}, nil
 
 	case command.CommandTypeCargoOpen:
@@ -389,7 +1044,8 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as cargo open inputs: %w", err)
 		}
 		return &command.CargoOpenInputs{
-			MotorSpeed: i.MotorSpeed,
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			MotorSpeed:   i.MotorSpeed,
 		}, nil
 
 	case command.CommandTypeCargoClose:
@@ -398,7 +1054,8 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as cargo close inputs: %w", err)
 		}
 		return &command.CargoCloseInputs{
-			MotorSpeed: i.MotorSpeed,
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			MotorSpeed:   i.MotorSpeed,
 		}, nil
 
 	case command.CommandTypeCargoLift:
@@ -407,8 +1064,9 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as cargo lift inputs: %w", err)
 		}
 		return &command.CargoLiftInputs{
-			Position:   i.Position,
-			MotorSpeed: i.MotorSpeed,
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			Position:     i.Position,
+			MotorSpeed:   i.MotorSpeed,
 		}, nil
 
 	case command.CommandTypeCargoLower:
@@ -417,8 +1075,9 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as cargo lower inputs: %w", err)
 		}
 		return &command.CargoLowerInputs{
-			Position:   i.Position,
-			MotorSpeed: i.MotorSpeed,
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			Position:     i.Position,
+			MotorSpeed:   i.MotorSpeed,
 		}, nil
 
 	case command.CommandTypeCargoCheckQR:
@@ -427,11 +1086,18 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as cargo check qr inputs: %w", err)
 		}
 		return &command.CargoCheckQRInputs{
-			QRCode: i.QrCode,
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			QRCode:       i.QrCode,
 		}, nil
 
 	case command.CommandTypeScanLocation:
-		return &command.ScanLocationInputs{}, nil
+		i, err := inputs.AsScanLocationInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as scan location inputs: %w", err)
+		}
+		return &command.ScanLocationInputs{
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+		}, nil
 
 	case command.CommandTypeWait:
 		i, err := inputs.AsWaitInputs()
@@ -439,10 +1105,29 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			return nil, fmt.Errorf("as wait inputs: %w", err)
 		}
 		return &command.WaitInputs{
-			DurationMs: int64(i.DurationMs),
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			DurationMs:   int64(i.DurationMs),
+		}, nil
+
+	case command.CommandTypeAssert:
+		i, err := inputs.AsAssertInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as assert inputs: %w", err)
+		}
+		return &command.AssertInputs{
+			CommonInputs: h.convertReqCommonInputs(i.TimeoutMs, i.Preconditions, i.AbortMission),
+			Conditions:   h.convertReqConditions(i.Conditions),
 		}, nil
 
 	default:
-		return nil, xerror.ValidationFailed(nil, "unknown command type")
+		// Custom command types are decoded with their own JSON encoding.
+		if _, ok := command.LookupType(command.CommandType(cmdType)); !ok {
+			return nil, xerror.ValidationFailed(nil, "unknown command type")
+		}
+		inputs, err := command.UnmarshalInputs(command.CommandType(cmdType), i)
+		if err != nil {
+			return nil, xerror.ValidationFailed(err, "invalid inputs")
+		}
+		return inputs, nil
 	}
 }
@@ -2,13 +2,17 @@ package http
 
 import (
 	"bytes"
+	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +21,7 @@ import (
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
 	"github.com/tbe-team/raybot/pkg/paging"
 	"github.com/tbe-team/raybot/pkg/ptr"
+	"github.com/tbe-team/raybot/pkg/xerror"
 )
 
 func TestCommandHandler_GetCommandById(t *testing.T) {
@@ -171,6 +176,158 @@ func TestCommandHandler_ListCommands(t *testing.T) {
 	})
 }
 
+func TestCommandHandler_ExportCommands(t *testing.T) {
+	exportFn := func(cmds ...command.Command) func(context.Context, command.ExportCommandsParams, func(command.Command) error) error {
+		return func(_ context.Context, _ command.ExportCommandsParams, fn func(command.Command) error) error {
+			for _, cmd := range cmds {
+				if err := fn(cmd); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	t.Run("Should stream the commands as CSV", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().ExportCommands(mock.Anything,
+			mock.MatchedBy(
+				func(params command.ExportCommandsParams) bool {
+					return len(params.Sources) == 1 &&
+						params.Sources[0] == command.SourceCloud &&
+						params.CreatedFrom != nil &&
+						params.CreatedFrom.Equal(time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC))
+				},
+			),
+			mock.Anything,
+		).RunAndReturn(exportFn(validCommand, validCommand))
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/export?format=csv&sources=CLOUD&createdFrom=2025-08-10T00:00:00Z", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, "id", records[0][0])
+		require.Equal(t, "1", records[1][0])
+		require.Equal(t, "STOP_MOVEMENT", records[1][1])
+	})
+
+	t.Run("Should stream the commands as JSON lines", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().ExportCommands(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(exportFn(validCommand, validCommand))
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/export?format=jsonl", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		require.Len(t, lines, 2)
+		var res gen.CommandResponse
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &res))
+		require.Equal(t, validCommand.ID, int64(res.Id))
+	})
+
+	t.Run("Should return the error if the export fails before the first command", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().ExportCommands(mock.Anything, mock.Anything, mock.Anything).
+			Return(xerror.ValidationFailed(nil, "invalid params"))
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/export?format=csv", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Should not able to export commands if the format is invalid", func(t *testing.T) {
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandmocks.NewFakeService(t)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/export?format=xml", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestCommandHandler_GetCommandStats(t *testing.T) {
+	t.Run("Should get the stats of the filtered commands", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().GetCommandStats(mock.Anything,
+			mock.MatchedBy(
+				func(params command.GetCommandStatsParams) bool {
+					return len(params.Types) == 2 &&
+						params.Types[0] == command.CommandTypeMoveTo &&
+						params.Types[1] == command.CommandTypeCargoLift
+				},
+			),
+		).Return(command.CommandStats{
+			Total:     4,
+			Succeeded: 3,
+			Failed:    1,
+			Types: []command.CommandTypeStats{
+				{
+					Type:         command.CommandTypeMoveTo,
+					Total:        4,
+					Succeeded:    3,
+					MeanDuration: 12 * time.Second,
+					P50Duration:  11 * time.Second,
+					P95Duration:  20 * time.Second,
+				},
+			},
+			Sources: []command.SourceStats{{Source: command.SourceCloud, Total: 4, Succeeded: 3}},
+			Routes: []command.RouteStats{
+				{From: "A", To: "B", Count: 2, MeanDuration: 30 * time.Second, P50Duration: 29 * time.Second, P95Duration: 41 * time.Second},
+			},
+			Failures: []command.FailureStats{{Error: "obstacle", Count: 1}},
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/stats?types=MOVE_TO,CARGO_LIFT", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		res := MustDecodeJSON[gen.CommandStatsResponse](t, rec.Body)
+		require.EqualValues(t, 4, res.Total)
+		require.InDelta(t, 0.75, res.SuccessRate, 0.001)
+		require.EqualValues(t, 12000, res.Types[0].MeanDurationMs)
+		require.EqualValues(t, 11000, res.Types[0].P50DurationMs)
+		require.EqualValues(t, 20000, res.Types[0].P95DurationMs)
+		require.Equal(t, []gen.SourceStats{{Source: "CLOUD", Total: 4, Succeeded: 3}}, res.Sources)
+		require.Equal(t, []gen.RouteStats{
+			{From: "A", To: "B", Count: 2, MeanDurationMs: 30000, P50DurationMs: 29000, P95DurationMs: 41000},
+		}, res.Routes)
+		require.Equal(t, "obstacle", res.Failures[0].Error)
+	})
+}
+
 func TestCommandHandler_CreateCommand(t *testing.T) {
 	t.Run("Should create command successfully", func(t *testing.T) {
 		commandService := commandmocks.NewFakeService(t)
@@ -211,6 +368,238 @@ func TestCommandHandler_CreateCommand(t *testing.T) {
 		require.Equal(t, http.StatusCreated, rec.Code)
 	})
 
+	t.Run("Should pass the priority and preempt flag to the service", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandParams) bool {
+					return params.Priority == 100 && params.Preempt
+				},
+			),
+		).Return(command.Command{
+			Type:     command.CommandTypeStopMovement,
+			Inputs:   &command.StopMovementInputs{},
+			Outputs:  &command.StopMovementOutputs{},
+			Priority: 100,
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		i := gen.CommandInputs{}
+		err := i.FromStopInputs(gen.StopInputs{})
+		require.NoError(t, err)
+
+		body := gen.CreateCommandRequest{
+			Type:     "STOP_MOVEMENT",
+			Inputs:   i,
+			Priority: ptr.New(uint8(100)),
+			Preempt:  ptr.New(true),
+		}
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var res gen.CommandResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, uint8(100), res.Priority)
+	})
+
+	t.Run("Should pass the command to insert after to the service", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandParams) bool {
+					return params.InsertBefore == nil && params.InsertAfter != nil && *params.InsertAfter == 7
+				},
+			),
+		).Return(validCommand, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		i := gen.CommandInputs{}
+		require.NoError(t, i.FromStopInputs(gen.StopInputs{}))
+		jsonBody, err := json.Marshal(gen.CreateCommandRequest{
+			Type:        "STOP_MOVEMENT",
+			Inputs:      i,
+			InsertAfter: ptr.New(int64(7)),
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("Should create an assert command with preconditions", func(t *testing.T) {
+		condition := command.Condition{Type: command.ConditionTypeBatteryAtLeast, BatteryPercent: 30}
+		inputs := &command.AssertInputs{
+			CommonInputs: command.CommonInputs{
+				Preconditions: []command.Condition{{Type: command.ConditionTypeCargoDoorClosed}},
+				AbortMission:  true,
+			},
+			Conditions: []command.Condition{condition},
+		}
+
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandParams) bool {
+					i, ok := params.Inputs.(*command.AssertInputs)
+					return ok && assert.ObjectsAreEqual(inputs, i)
+				},
+			),
+		).Return(command.Command{
+			Type:   command.CommandTypeAssert,
+			Status: command.StatusFailed,
+			Inputs: inputs,
+			Outputs: &command.AssertOutputs{
+				CommonOutputs: command.CommonOutputs{
+					FailedCondition: &command.FailedCondition{Condition: condition, Actual: "20%"},
+				},
+			},
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		i := gen.CommandInputs{}
+		err := i.FromAssertInputs(gen.AssertInputs{
+			Conditions:    []gen.Condition{{Type: "BATTERY_AT_LEAST", BatteryPercent: ptr.New(uint8(30))}},
+			Preconditions: &gen.Preconditions{{Type: "CARGO_DOOR_CLOSED"}},
+			AbortMission:  ptr.New(true),
+		})
+		require.NoError(t, err)
+
+		jsonBody, err := json.Marshal(gen.CreateCommandRequest{
+			Type:   "ASSERT",
+			Inputs: i,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var res gen.CommandResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		outputs, err := res.Outputs.AsAssertOutputs()
+		require.NoError(t, err)
+		require.NotNil(t, outputs.FailedCondition)
+		require.Equal(t, "BATTERY_AT_LEAST", outputs.FailedCondition.Condition.Type)
+		require.Equal(t, "20%", outputs.FailedCondition.Actual)
+	})
+
+	t.Run("Should create a command of a custom command type", func(t *testing.T) {
+		def, err := command.NewTypeDefinition[ringBellInputs, ringBellOutputs]()
+		require.NoError(t, err)
+		require.NoError(t, command.RegisterType(def))
+
+		inputs := &ringBellInputs{Times: 3}
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandParams) bool {
+					return assert.ObjectsAreEqual(inputs, params.Inputs)
+				},
+			),
+		).Return(command.Command{
+			Type:    "RING_BELL",
+			Status:  command.StatusSucceeded,
+			Inputs:  inputs,
+			Outputs: &ringBellOutputs{Rang: 3},
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands",
+			bytes.NewBufferString(`{"type":"RING_BELL","inputs":{"times":3}}`))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var res struct {
+			Type    string         `json:"type"`
+			Inputs  ringBellInputs `json:"inputs"`
+			Outputs struct {
+				Rang int `json:"rang"`
+			} `json:"outputs"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "RING_BELL", res.Type)
+		require.Equal(t, 3, res.Inputs.Times)
+		require.Equal(t, 3, res.Outputs.Rang)
+	})
+
+	t.Run("Should return the plan without creating the command on dry run", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().PlanCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandParams) bool {
+					i, ok := params.Inputs.(*command.MoveToInputs)
+					return ok && i.Location == "loc-3"
+				},
+			),
+		).Return(command.Plan{
+			Steps: []command.PlanStep{
+				{
+					Type:              command.CommandTypeMoveTo,
+					Inputs:            &command.MoveToInputs{Location: "loc-3", MotorSpeed: 50},
+					Location:          ptr.New("loc-3"),
+					Direction:         ptr.New(command.MoveDirectionForward),
+					ExpectedLocations: []string{"loc-2", "loc-3"},
+				},
+			},
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		i := gen.CommandInputs{}
+		err := i.FromMoveToInputs(gen.MoveToInputs{
+			Location:   "loc-3",
+			MotorSpeed: 50,
+		})
+		require.NoError(t, err)
+
+		body := gen.CreateCommandRequest{
+			Type:   "MOVE_TO",
+			Inputs: i,
+		}
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands?dryRun=true", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res gen.PlanResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.True(t, res.Executable)
+		require.Len(t, res.Steps, 1)
+		require.Equal(t, "FORWARD", *res.Steps[0].Direction)
+		require.Equal(t, []string{"loc-2", "loc-3"}, res.Steps[0].ExpectedLocations)
+	})
+
 	t.Run("Should not able to create command if validation fail", func(t *testing.T) {
 		t.Run("Type is invalid", func(t *testing.T) {
 			h := SetupAPITestHandler(t)
@@ -276,6 +665,145 @@ func TestCommandHandler_CreateCommand(t *testing.T) {
 	})
 }
 
+func TestCommandHandler_CreateCommands(t *testing.T) {
+	t.Run("Should create the commands and return their IDs in order", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommands(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandsParams) bool {
+					return params.Source == command.SourceApp &&
+						len(params.Commands) == 2 &&
+						*params.Commands[0].RequestID == "req-1" &&
+						params.Commands[1].Priority == 10
+				},
+			),
+		).Return([]command.Command{{ID: 3}, {ID: 4}}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		stop := gen.CommandInputs{}
+		require.NoError(t, stop.FromStopInputs(gen.StopInputs{}))
+		wait := gen.CommandInputs{}
+		require.NoError(t, wait.FromWaitInputs(gen.WaitInputs{DurationMs: 100}))
+
+		jsonBody, err := json.Marshal(gen.CreateCommandsRequest{
+			Commands: []gen.CreateCommandsItem{
+				{Type: "STOP_MOVEMENT", Inputs: stop, RequestId: ptr.New("req-1")},
+				{Type: "WAIT", Inputs: wait, Priority: ptr.New(uint8(10))},
+			},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/batch", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var res gen.CreateCommandsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, []int64{3, 4}, res.CommandIds)
+	})
+
+	t.Run("Should not create any command if the inputs of one are invalid", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		stop := gen.CommandInputs{}
+		require.NoError(t, stop.FromStopInputs(gen.StopInputs{}))
+
+		jsonBody, err := json.Marshal(gen.CreateCommandsRequest{
+			Commands: []gen.CreateCommandsItem{
+				{Type: "STOP_MOVEMENT", Inputs: stop},
+				{Type: "UNKNOWN", Inputs: stop},
+			},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/batch", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		commandService.AssertNotCalled(t, "CreateCommands")
+	})
+}
+
+func TestCommandHandler_MoveQueuedCommand(t *testing.T) {
+	t.Run("Should move the command", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().MoveQueuedCommand(mock.Anything, command.MoveQueuedCommandParams{
+			CommandID: 123,
+			Position:  1,
+		}).Return(validCommand, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/123/move", bytes.NewBufferString(`{"position":1}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Should return bad request if the command is not queued", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().MoveQueuedCommand(mock.Anything, mock.Anything).
+			Return(command.Command{}, command.ErrCommandNotQueued)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/123/move", bytes.NewBufferString(`{"position":1}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestCommandHandler_UpdateQueuedCommand(t *testing.T) {
+	t.Run("Should replace the inputs of the command", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().UpdateQueuedCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.UpdateQueuedCommandParams) bool {
+					i, ok := params.Inputs.(*command.WaitInputs)
+					return params.CommandID == 123 && ok && i.DurationMs == 500
+				},
+			),
+		).Return(validCommand, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		i := gen.CommandInputs{}
+		require.NoError(t, i.FromWaitInputs(gen.WaitInputs{DurationMs: 500}))
+		jsonBody, err := json.Marshal(gen.UpdateQueuedCommandRequest{
+			Type:   "WAIT",
+			Inputs: i,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/commands/123", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
 func TestCommandHandler_DeleteCommandById(t *testing.T) {
 	t.Run("Should delete command successfully", func(t *testing.T) {
 		commandService := commandmocks.NewFakeService(t)
@@ -348,6 +876,136 @@ func TestCommandHandler_CancelCurrentProcessingCommand(t *testing.T) {
 	})
 }
 
+func TestCommandHandler_CancelCommand(t *testing.T) {
+	t.Run("Should cancel command by id successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CancelCommandByID(mock.Anything, command.CancelCommandByIDParams{CommandID: 1}).Return(nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/1/cancel", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Should not able to cancel a finished command", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CancelCommandByID(mock.Anything, command.CancelCommandByIDParams{CommandID: 1}).
+			Return(command.ErrCommandAlreadyFinished)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/1/cancel", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Should cancel command by request id successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CancelCommandByRequestID(mock.Anything, command.CancelCommandByRequestIDParams{RequestID: "req-1"}).Return(nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/cancel", strings.NewReader(`{"requestId":"req-1"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Should not able to cancel command by request id if the command is not found", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CancelCommandByRequestID(mock.Anything, command.CancelCommandByRequestIDParams{RequestID: "req-1"}).
+			Return(command.ErrCommandNotFound)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/cancel", strings.NewReader(`{"requestId":"req-1"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestCommandHandler_CommandQueue(t *testing.T) {
+	t.Run("Should get command queue state successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().GetQueueState(mock.Anything).Return(command.QueueState{
+			Paused:    true,
+			UpdatedAt: time.Now(),
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/queue", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res gen.CommandQueueStateResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.True(t, res.Paused)
+	})
+
+	t.Run("Should pause command queue successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().PauseQueue(mock.Anything).Return(command.QueueState{
+			Paused:    true,
+			UpdatedAt: time.Now(),
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/queue/pause", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Should resume command queue successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().ResumeQueue(mock.Anything).Return(command.QueueState{
+			Paused:    false,
+			UpdatedAt: time.Now(),
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/queue/resume", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res gen.CommandQueueStateResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.False(t, res.Paused)
+	})
+}
+
 var validCommand = command.Command{
 	ID:          1,
 	Type:        command.CommandTypeStopMovement,
@@ -360,3 +1018,21 @@ var validCommand = command.Command{
 	CreatedAt:   time.Now(),
 	UpdatedAt:   time.Now(),
 }
+
+type ringBellInputs struct {
+	command.CommonInputs
+	Times int `json:"times"`
+}
+
+func (ringBellInputs) CommandType() command.CommandType {
+	return "RING_BELL"
+}
+
+type ringBellOutputs struct {
+	command.CommonOutputs
+	Rang int `json:"rang"`
+}
+
+func (ringBellOutputs) CommandType() command.CommandType {
+	return "RING_BELL"
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// progressKeepAliveInterval is the interval of the comments sent to keep the stream open
+// while no progress is reported.
+const progressKeepAliveInterval = 15 * time.Second
+
+func (h commandHandler) StreamCommandProgress(ctx context.Context, _ gen.StreamCommandProgressRequestObject) (gen.StreamCommandProgressResponseObject, error) {
+	return commandProgressStreamResponse{
+		ctx:     ctx,
+		handler: h,
+	}, nil
+}
+
+// commandProgressStreamResponse writes the command progress events as server-sent events
+// until the request is done.
+type commandProgressStreamResponse struct {
+	ctx     context.Context
+	handler commandHandler
+}
+
+func (r commandProgressStreamResponse) VisitStreamCommandProgressResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+	// The stream outlives the write timeout of the server
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("set write deadline: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	defer cancel()
+
+	progressCh := make(chan gen.CommandProgress, 16)
+	r.handler.subscriber.Subscribe(ctx, events.CommandProgressTopic, func(msg *eventbus.Message) {
+		ev, ok := msg.Payload.(events.CommandProgressEvent)
+		if !ok {
+			r.handler.log.Error("received invalid event", slog.Any("event", msg.Payload))
+			return
+		}
+
+		select {
+		case progressCh <- *r.handler.convertProgressToResponse(&ev.Progress):
+		default:
+			r.handler.log.Warn("dropped command progress, the client is too slow",
+				slog.Int64("command_id", ev.Progress.CommandID))
+		}
+	})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return fmt.Errorf("flush: %w", err)
+	}
+
+	keepAlive := time.NewTicker(progressKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case progress := <-progressCh:
+			data, err := json.Marshal(progress)
+			if err != nil {
+				return fmt.Errorf("marshal progress: %w", err)
+			}
+			if _, err := fmt.Fprintf(w, "event: progress\ndata: %s\n\n", data); err != nil {
+				return fmt.Errorf("write progress: %w", err)
+			}
+
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return fmt.Errorf("write keep-alive: %w", err)
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return fmt.Errorf("flush: %w", err)
+		}
+	}
+}
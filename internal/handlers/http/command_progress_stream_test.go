@@ -0,0 +1,67 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestCommandHandler_StreamCommandProgress(t *testing.T) {
+	t.Run("Should stream the command progress as server-sent events", func(t *testing.T) {
+		eventBus := eventbus.NewInProcEventBus(logging.NewNoopLogger())
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.subscriber = eventBus
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/api/v1/commands/processing/progress", nil)
+		require.NoError(t, err)
+		res, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		eventBus.Publish(events.CommandProgressTopic, eventbus.NewMessage(events.CommandProgressEvent{
+			Progress: command.Progress{
+				CommandID:       1,
+				CommandType:     command.CommandTypeCargoLower,
+				CurrentPosition: ptr.New(uint16(20)),
+				TargetPosition:  ptr.New(uint16(50)),
+				UpdatedAt:       time.Now(),
+			},
+		}))
+
+		scanner := bufio.NewScanner(res.Body)
+		require.True(t, scanner.Scan())
+		require.Equal(t, "event: progress", scanner.Text())
+		require.True(t, scanner.Scan())
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		require.True(t, ok)
+
+		var progress gen.CommandProgress
+		require.NoError(t, json.Unmarshal([]byte(data), &progress))
+		require.Equal(t, int64(1), progress.CommandId)
+		require.Equal(t, "CARGO_LOWER", progress.CommandType)
+		require.Equal(t, uint16(20), *progress.CurrentPosition)
+		require.Equal(t, uint16(50), *progress.TargetPosition)
+	})
+}
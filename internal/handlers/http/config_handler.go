@@ -55,6 +55,13 @@ func (h configHandler) GetHardwareConfig(ctx context.Context, _ gen.GetHardwareC
 }
 
 func (h configHandler) UpdateHardwareConfig(ctx context.Context, request gen.UpdateHardwareConfigRequestObject) (gen.UpdateHardwareConfigResponseObject, error) {
+	// The hardware mode, the simulator, the capture, the reconnect and the USB IDs
+	// of the serial ports are only set in the config file, keep them
+	currentCfg, err := h.configService.GetHardwareConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("config service get hardware config: %w", err)
+	}
+
 	//nolint:gosec
 	espSerial := config.Serial{
 		Port:        request.Body.Esp.Serial.Port,
@@ -63,6 +70,8 @@ func (h configHandler) UpdateHardwareConfig(ctx context.Context, request gen.Upd
 		Parity:      request.Body.Esp.Serial.Parity,
 		StopBits:    float32(request.Body.Esp.Serial.StopBits),
 		ReadTimeout: time.Duration(request.Body.Esp.Serial.ReadTimeout) * time.Second,
+		Protocol:    config.SerialProtocol(request.Body.Esp.Serial.Protocol),
+		USB:         currentCfg.ESP.Serial.USB,
 	}
 
 	//nolint:gosec
@@ -73,18 +82,27 @@ func (h configHandler) UpdateHardwareConfig(ctx context.Context, request gen.Upd
 		Parity:      request.Body.Pic.Serial.Parity,
 		StopBits:    float32(request.Body.Pic.Serial.StopBits),
 		ReadTimeout: time.Duration(request.Body.Pic.Serial.ReadTimeout) * time.Second,
+		Protocol:    config.SerialProtocol(request.Body.Pic.Serial.Protocol),
+		USB:         currentCfg.PIC.Serial.USB,
 	}
 
 	cfg, err := h.configService.UpdateHardwareConfig(ctx, config.Hardware{
+		Mode:    currentCfg.Mode,
+		Sim:     currentCfg.Sim,
+		Capture: currentCfg.Capture,
 		ESP: config.ESP{
 			Serial:            espSerial,
 			EnableACK:         request.Body.Esp.EnableAck,
 			CommandACKTimeout: time.Duration(request.Body.Esp.CommandAckTimeout) * time.Millisecond,
+			CommandACKRetry:   h.convertCommandACKRetryConfigFromRequest(request.Body.Esp.CommandAckRetry),
+			Reconnect:         currentCfg.ESP.Reconnect,
 		},
 		PIC: config.PIC{
 			Serial:            picSerial,
 			EnableACK:         request.Body.Pic.EnableAck,
 			CommandACKTimeout: time.Duration(request.Body.Pic.CommandAckTimeout) * time.Millisecond,
+			CommandACKRetry:   h.convertCommandACKRetryConfigFromRequest(request.Body.Pic.CommandAckRetry),
+			Reconnect:         currentCfg.PIC.Reconnect,
 		},
 		Leds: config.Leds{
 			System: config.Led{
@@ -188,6 +206,20 @@ func (h configHandler) GetCommandConfig(ctx context.Context, _ gen.GetCommandCon
 
 func (h configHandler) UpdateCommandConfig(ctx context.Context, req gen.UpdateCommandConfigRequestObject) (gen.UpdateCommandConfigResponseObject, error) {
 	cfg, err := h.configService.UpdateCommandConfig(ctx, config.Command{
+		Move: config.Move{
+			EnableObstacleTracking: req.Body.Move.EnableObstacleTracking,
+			FrontObstacleTracking: config.ObstacleTracking{
+				EnterDistance: req.Body.Move.FrontObstacleTracking.EnterDistance,
+				ExitDistance:  req.Body.Move.FrontObstacleTracking.ExitDistance,
+			},
+			BackObstacleTracking: config.ObstacleTracking{
+				EnterDistance: req.Body.Move.BackObstacleTracking.EnterDistance,
+				ExitDistance:  req.Body.Move.BackObstacleTracking.ExitDistance,
+			},
+			AccelerationRamp: time.Duration(req.Body.Move.AccelerationRampMs) * time.Millisecond,
+			DecelerationRamp: time.Duration(req.Body.Move.DecelerationRampMs) * time.Millisecond,
+			ApproachSpeed:    req.Body.Move.ApproachSpeed,
+		},
 		CargoLift: config.CargoLift{
 			StableReadCount: req.Body.CargoLift.StableReadCount,
 		},
@@ -198,6 +230,23 @@ func (h configHandler) UpdateCommandConfig(ctx context.Context, req gen.UpdateCo
 				ExitDistance:  req.Body.CargoLower.BottomObstacleTracking.ExitDistance,
 			},
 		},
+		Timeout: config.CommandTimeout{
+			StopMovement: time.Duration(req.Body.Timeout.StopMovementMs) * time.Millisecond,
+			MoveForward:  time.Duration(req.Body.Timeout.MoveForwardMs) * time.Millisecond,
+			MoveBackward: time.Duration(req.Body.Timeout.MoveBackwardMs) * time.Millisecond,
+			MoveTo:       time.Duration(req.Body.Timeout.MoveToMs) * time.Millisecond,
+			CargoOpen:    time.Duration(req.Body.Timeout.CargoOpenMs) * time.Millisecond,
+			CargoClose:   time.Duration(req.Body.Timeout.CargoCloseMs) * time.Millisecond,
+			CargoLift:    time.Duration(req.Body.Timeout.CargoLiftMs) * time.Millisecond,
+			CargoLower:   time.Duration(req.Body.Timeout.CargoLowerMs) * time.Millisecond,
+			CargoCheckQR: time.Duration(req.Body.Timeout.CargoCheckQRMs) * time.Millisecond,
+			ScanLocation: time.Duration(req.Body.Timeout.ScanLocationMs) * time.Millisecond,
+			Wait:         time.Duration(req.Body.Timeout.WaitMs) * time.Millisecond,
+		},
+		Recovery: config.Recovery{
+			Policy:           config.RecoveryPolicy(req.Body.Recovery.Policy),
+			SafeLiftPosition: req.Body.Recovery.SafeLiftPosition,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("config service update command config: %w", err)
@@ -284,11 +333,13 @@ func (h configHandler) convertHardwareConfigToResponse(cfg config.Hardware) gen.
 			Serial:            h.convertSerialConfigToResponse(cfg.PIC.Serial),
 			EnableAck:         cfg.PIC.EnableACK,
 			CommandAckTimeout: int(cfg.PIC.CommandACKTimeout.Milliseconds()),
+			CommandAckRetry:   h.convertCommandACKRetryConfigToResponse(cfg.PIC.CommandACKRetry),
 		},
 		Esp: gen.ESPConfig{
 			Serial:            h.convertSerialConfigToResponse(cfg.ESP.Serial),
 			EnableAck:         cfg.ESP.EnableACK,
 			CommandAckTimeout: int(cfg.ESP.CommandACKTimeout.Milliseconds()),
+			CommandAckRetry:   h.convertCommandACKRetryConfigToResponse(cfg.ESP.CommandACKRetry),
 		},
 		Leds: gen.LedsConfig{
 			System: gen.LedConfig{
@@ -309,6 +360,23 @@ func (configHandler) convertSerialConfigToResponse(cfg config.Serial) gen.Serial
 		Parity:      cfg.Parity,
 		StopBits:    float64(cfg.StopBits),
 		ReadTimeout: int(cfg.ReadTimeout.Seconds()),
+		Protocol:    string(cfg.Protocol),
+	}
+}
+
+func (configHandler) convertCommandACKRetryConfigToResponse(cfg config.CommandACKRetry) gen.CommandACKRetryConfig {
+	return gen.CommandACKRetryConfig{
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: cfg.InitialBackoff.Milliseconds(),
+		MaxBackoff:     cfg.MaxBackoff.Milliseconds(),
+	}
+}
+
+func (configHandler) convertCommandACKRetryConfigFromRequest(req gen.CommandACKRetryConfig) config.CommandACKRetry {
+	return config.CommandACKRetry{
+		MaxAttempts:    req.MaxAttempts,
+		InitialBackoff: time.Duration(req.InitialBackoff) * time.Millisecond,
+		MaxBackoff:     time.Duration(req.MaxBackoff) * time.Millisecond,
 	}
 }
 
@@ -412,6 +480,20 @@ func (configHandler) convertLogFormatReqToModel(format string) (config.LogFormat
 
 func (configHandler) convertCommandConfigToResponse(cfg config.Command) gen.CommandConfig {
 	return gen.CommandConfig{
+		Move: gen.MoveConfig{
+			EnableObstacleTracking: cfg.Move.EnableObstacleTracking,
+			FrontObstacleTracking: gen.ObstacleTracking{
+				EnterDistance: cfg.Move.FrontObstacleTracking.EnterDistance,
+				ExitDistance:  cfg.Move.FrontObstacleTracking.ExitDistance,
+			},
+			BackObstacleTracking: gen.ObstacleTracking{
+				EnterDistance: cfg.Move.BackObstacleTracking.EnterDistance,
+				ExitDistance:  cfg.Move.BackObstacleTracking.ExitDistance,
+			},
+			AccelerationRampMs: cfg.Move.AccelerationRamp.Milliseconds(),
+			DecelerationRampMs: cfg.Move.DecelerationRamp.Milliseconds(),
+			ApproachSpeed:      cfg.Move.ApproachSpeed,
+		},
 		CargoLift: gen.CargoLiftConfig{
 			StableReadCount: cfg.CargoLift.StableReadCount,
 		},
@@ -422,6 +504,23 @@ func (configHandler) convertCommandConfigToResponse(cfg config.Command) gen.Comm
 				ExitDistance:  cfg.CargoLower.BottomObstacleTracking.ExitDistance,
 			},
 		},
+		Timeout: gen.CommandTimeoutConfig{
+			StopMovementMs: cfg.Timeout.StopMovement.Milliseconds(),
+			MoveForwardMs:  cfg.Timeout.MoveForward.Milliseconds(),
+			MoveBackwardMs: cfg.Timeout.MoveBackward.Milliseconds(),
+			MoveToMs:       cfg.Timeout.MoveTo.Milliseconds(),
+			CargoOpenMs:    cfg.Timeout.CargoOpen.Milliseconds(),
+			CargoCloseMs:   cfg.Timeout.CargoClose.Milliseconds(),
+			CargoLiftMs:    cfg.Timeout.CargoLift.Milliseconds(),
+			CargoLowerMs:   cfg.Timeout.CargoLower.Milliseconds(),
+			CargoCheckQRMs: cfg.Timeout.CargoCheckQR.Milliseconds(),
+			ScanLocationMs: cfg.Timeout.ScanLocation.Milliseconds(),
+			WaitMs:         cfg.Timeout.Wait.Milliseconds(),
+		},
+		Recovery: gen.CommandRecoveryConfig{
+			Policy:           cfg.Recovery.Policy.String(),
+			SafeLiftPosition: cfg.Recovery.SafeLiftPosition,
+		},
 	}
 }
 
@@ -152,6 +152,7 @@ func TestConfigHandler_UpdateHardwareConfig(t *testing.T) {
 				StopBits:    1,
 				Parity:      "NONE",
 				ReadTimeout: 10,
+				Protocol:    "JSON",
 			},
 			CommandAckTimeout: 10,
 		},
@@ -163,6 +164,7 @@ func TestConfigHandler_UpdateHardwareConfig(t *testing.T) {
 				StopBits:    1,
 				Parity:      "NONE",
 				ReadTimeout: 10,
+				Protocol:    "JSON",
 			},
 			CommandAckTimeout: 10,
 		},
@@ -177,9 +179,19 @@ func TestConfigHandler_UpdateHardwareConfig(t *testing.T) {
 	}
 
 	t.Run("Should update hardware config successfully", func(t *testing.T) {
+		currentCfg := config.Hardware{
+			Mode:    config.HardwareModeSim,
+			Sim:     config.Sim{StartPosition: 100},
+			Capture: config.Capture{Enable: true, Path: "logs/serial.capture"},
+		}
 		configService := configmocks.NewFakeService(t)
-		configService.EXPECT().UpdateHardwareConfig(mock.Anything, mock.Anything).
-			Return(config.Hardware{}, nil)
+		configService.EXPECT().GetHardwareConfig(mock.Anything).Return(currentCfg, nil)
+		configService.EXPECT().UpdateHardwareConfig(mock.Anything, mock.MatchedBy(func(cfg config.Hardware) bool {
+			// The mode, the simulator and the capture are not part of the request
+			return cfg.Mode == currentCfg.Mode &&
+				cfg.Sim.StartPosition == currentCfg.Sim.StartPosition &&
+				cfg.Capture == currentCfg.Capture
+		})).Return(config.Hardware{}, nil)
 
 		h := SetupAPITestHandler(t, func(hs *Service) {
 			hs.configService = configService
@@ -198,6 +210,7 @@ func TestConfigHandler_UpdateHardwareConfig(t *testing.T) {
 
 	t.Run("Should not able to update hardware config if updating failed", func(t *testing.T) {
 		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetHardwareConfig(mock.Anything).Return(config.Hardware{}, nil)
 		configService.EXPECT().UpdateHardwareConfig(mock.Anything, mock.Anything).
 			Return(config.Hardware{}, errors.New("updating failed"))
 
@@ -504,6 +517,9 @@ func TestConfigHandler_UpdateCommandConfig(t *testing.T) {
 		CargoLower: gen.CargoLowerConfig{
 			StableReadCount: 3,
 		},
+		Recovery: gen.CommandRecoveryConfig{
+			Policy: "CANCEL_ALL",
+		},
 	}
 
 	t.Run("Should update command config successfully", func(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/dashboarddata"
 )
 
@@ -99,11 +100,15 @@ func (h dashboardDataHandler) convertRobotStateToResponse(state dashboarddata.Ro
 				Connected:       state.AppState.ESPSerialConnection.Connected,
 				LastConnectedAt: state.AppState.ESPSerialConnection.LastConnectedAt,
 				Error:           state.AppState.ESPSerialConnection.Error,
+				FrameStats:      h.convertSerialFrameStatsToResponse(state.AppState.ESPSerialConnection.FrameStats),
+				Board:           h.convertSerialBoardInfoToResponse(state.AppState.ESPSerialConnection.Board),
 			},
 			PicSerialConnection: gen.PICSerialConnection{
 				Connected:       state.AppState.PICSerialConnection.Connected,
 				LastConnectedAt: state.AppState.PICSerialConnection.LastConnectedAt,
 				Error:           state.AppState.PICSerialConnection.Error,
+				FrameStats:      h.convertSerialFrameStatsToResponse(state.AppState.PICSerialConnection.FrameStats),
+				Board:           h.convertSerialBoardInfoToResponse(state.AppState.PICSerialConnection.Board),
 			},
 			RfidUsbConnection: gen.RFIDUSBConnection{
 				Connected:       state.AppState.RFIDUSBConnection.Connected,
@@ -138,6 +143,10 @@ func (h dashboardDataHandler) convertRobotStateToResponse(state dashboarddata.Ro
 				},
 			},
 		},
+		CommandQueue: gen.CommandQueueStateResponse{
+			Paused:    state.CommandQueue.Paused,
+			UpdatedAt: state.CommandQueue.UpdatedAt,
+		},
 	}
 }
 
@@ -150,3 +159,37 @@ func (dashboardDataHandler) getUptime(connected bool, lastConnectedAt *time.Time
 	}
 	return float32(time.Since(*lastConnectedAt).Seconds())
 }
+
+func (dashboardDataHandler) convertSerialFrameStatsToResponse(stats appstate.FrameStats) gen.SerialFrameStats {
+	return gen.SerialFrameStats{
+		CrcErrors:    stats.CRCErrors,
+		Resyncs:      stats.Resyncs,
+		SequenceGaps: stats.SequenceGaps,
+	}
+}
+
+func (dashboardDataHandler) convertSerialBoardInfoToResponse(info *appstate.BoardInfo) *gen.SerialBoardInfo {
+	if info == nil {
+		return nil
+	}
+
+	return &gen.SerialBoardInfo{
+		FirmwareVersion: info.FirmwareVersion,
+		ProtocolVersion: info.ProtocolVersion,
+		Commands:        convertMessageTypesToResponse(info.Commands),
+		SyncStates:      convertMessageTypesToResponse(info.SyncStates),
+		Legacy:          info.Legacy,
+		Compatible:      info.Compatible,
+		UpdatedAt:       info.UpdatedAt,
+	}
+}
+
+// convertMessageTypesToResponse converts the message types to ints, a []uint8
+// would be encoded to base64.
+func convertMessageTypesToResponse(types []uint8) []int {
+	res := make([]int, len(types))
+	for i, t := range types {
+		res[i] = int(t)
+	}
+	return res
+}
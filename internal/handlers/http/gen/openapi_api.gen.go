@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -43,6 +44,9 @@ type APConfig struct {
 	Ip string `json:"ip"`
 }
 
+// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+type AbortMission = bool
+
 // AlarmData defines model for AlarmData.
 type AlarmData struct {
 	union json.RawMessage
@@ -87,6 +91,51 @@ type AppConnection struct {
 	RfidUsbConnection   RFIDUSBConnection   `json:"rfidUsbConnection"`
 }
 
+// AssertInputs defines model for AssertInputs.
+type AssertInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
+	// Conditions The conditions that must all be met for the command to succeed
+	Conditions []Condition `json:"conditions"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
+}
+
+// AssertOutputs defines model for AssertOutputs.
+type AssertOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
+
+// Attempt defines model for Attempt.
+type Attempt struct {
+	// Attempt The attempt number, starting from 1
+	Attempt int `json:"attempt"`
+
+	// StartedAt The start date of the attempt
+	StartedAt time.Time `json:"startedAt"`
+
+	// CompletedAt The completion date of the attempt
+	CompletedAt time.Time `json:"completedAt"`
+
+	// Error The error of the attempt, not set if the attempt succeeded
+	Error *string `json:"error,omitempty"`
+}
+
+// Attempts The execution history, only set when the command has a retry policy
+type Attempts = []Attempt
+
 // BatteryCellVoltageDiffConfig defines model for BatteryCellVoltageDiffConfig.
 type BatteryCellVoltageDiffConfig struct {
 	// Enable Whether to enable battery cell voltage difference monitoring
@@ -217,23 +266,65 @@ type BottomObstacleTracking struct {
 	ExitDistance uint16 `json:"exitDistance"`
 }
 
+// CancelCommandByRequestIdRequest defines model for CancelCommandByRequestIdRequest.
+type CancelCommandByRequestIdRequest struct {
+	// RequestId The request ID the command was created with
+	RequestId string `json:"requestId"`
+}
+
 // CargoCheckQRInputs defines model for CargoCheckQRInputs.
 type CargoCheckQRInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
 	// QrCode The QR code to check
 	QrCode string `json:"qrCode"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // CargoCheckQROutputs defines model for CargoCheckQROutputs.
-type CargoCheckQROutputs = map[string]interface{}
+type CargoCheckQROutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // CargoCloseInputs defines model for CargoCloseInputs.
 type CargoCloseInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
 	// MotorSpeed The speed of the motor
 	MotorSpeed MotorSpeed `json:"motorSpeed"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // CargoCloseOutputs defines model for CargoCloseOutputs.
-type CargoCloseOutputs = map[string]interface{}
+type CargoCloseOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // CargoDoorMotorState defines model for CargoDoorMotorState.
 type CargoDoorMotorState struct {
@@ -266,10 +357,28 @@ type CargoLiftInputs struct {
 
 	// MotorSpeed The speed of the motor
 	MotorSpeed MotorSpeed `json:"motorSpeed"`
+
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // CargoLiftOutputs defines model for CargoLiftOutputs.
-type CargoLiftOutputs = map[string]interface{}
+type CargoLiftOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // CargoLowerConfig defines model for CargoLowerConfig.
 type CargoLowerConfig struct {
@@ -283,25 +392,61 @@ type CargoLowerInputs struct {
 	// Position The position to lower the cargo
 	Position uint16 `json:"position"`
 
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
 	// BottomObstacleTracking This field is deprecated and will be removed in the future, use command config instead
 	// Deprecated:
 	BottomObstacleTracking *BottomObstacleTracking `json:"bottomObstacleTracking,omitempty"`
 
 	// MotorSpeed The speed of the motor
 	MotorSpeed MotorSpeed `json:"motorSpeed"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // CargoLowerOutputs defines model for CargoLowerOutputs.
-type CargoLowerOutputs = map[string]interface{}
+type CargoLowerOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // CargoOpenInputs defines model for CargoOpenInputs.
 type CargoOpenInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
 	// MotorSpeed The speed of the motor
 	MotorSpeed MotorSpeed `json:"motorSpeed"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // CargoOpenOutputs defines model for CargoOpenOutputs.
-type CargoOpenOutputs = map[string]interface{}
+type CargoOpenOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // CargoState defines model for CargoState.
 type CargoState struct {
@@ -355,10 +500,29 @@ type CloudConnection struct {
 	Error  *string `json:"error"`
 }
 
+// CommandACKRetryConfig defines model for CommandACKRetryConfig.
+type CommandACKRetryConfig struct {
+	// MaxAttempts The number of attempts of a command whose ACK timed out, including the first one
+	MaxAttempts uint `json:"maxAttempts"`
+
+	// InitialBackoff The delay before the first retry in milliseconds, doubled after each retry
+	InitialBackoff int64 `json:"initialBackoff"`
+
+	// MaxBackoff The max delay between the retries in milliseconds, 0 means no limit
+	MaxBackoff int64 `json:"maxBackoff"`
+}
+
 // CommandConfig defines model for CommandConfig.
 type CommandConfig struct {
 	CargoLift  CargoLiftConfig  `json:"cargoLift"`
 	CargoLower CargoLowerConfig `json:"cargoLower"`
+	Move       MoveConfig       `json:"move"`
+
+	// Recovery How the commands left pending by the previous run are recovered on startup
+	Recovery CommandRecoveryConfig `json:"recovery"`
+
+	// Timeout The default execution timeout of each command type in milliseconds, 0 means no timeout
+	Timeout CommandTimeoutConfig `json:"timeout"`
 }
 
 // CommandInputs defines model for CommandInputs.
@@ -371,6 +535,54 @@ type CommandOutputs struct {
 	union json.RawMessage
 }
 
+// CommandProgress The progress of the running command, only the fields that apply to the command type are set
+type CommandProgress struct {
+	// CommandId The id of the command
+	CommandId int64 `json:"commandId"`
+
+	// CommandType The type of command
+	CommandType CommandType `json:"commandType"`
+
+	// CurrentPosition The current cargo position of CARGO_LIFT and CARGO_LOWER
+	CurrentPosition *uint16 `json:"currentPosition,omitempty"`
+
+	// TargetPosition The target cargo position of CARGO_LIFT and CARGO_LOWER
+	TargetPosition *uint16 `json:"targetPosition,omitempty"`
+
+	// PassedLocations The locations passed so far by MOVE_TO and SCAN_LOCATION
+	PassedLocations *[]string `json:"passedLocations,omitempty"`
+
+	// WaitedMs The time waited so far by WAIT in milliseconds
+	WaitedMs *int64 `json:"waitedMs,omitempty"`
+
+	// Message A free-form description of the progress
+	Message *string `json:"message,omitempty"`
+
+	// UpdatedAt The date the progress was reported
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CommandQueueStateResponse defines model for CommandQueueStateResponse.
+type CommandQueueStateResponse struct {
+	// Paused Whether the command queue is paused, no new command is started until it is resumed
+	Paused bool `json:"paused"`
+
+	// UpdatedAt The date the command queue was last paused or resumed
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CommandRecoveryConfig How the commands left pending by the previous run are recovered on startup
+type CommandRecoveryConfig struct {
+	// Policy CANCEL_ALL cancels the queued and the interrupted commands.
+	// FAIL_INTERRUPTED fails the interrupted command and resumes the queue.
+	// RERUN_IDEMPOTENT queues the interrupted command again if it is idempotent, fails it otherwise, and resumes the queue.
+	// The queue is resumed only if the motors are stopped and the lift has reached the safe position, otherwise it is paused.
+	Policy string `json:"policy"`
+
+	// SafeLiftPosition The lift position (bottom distance, cm) that must be reached before the queue resumes, 0 skips the check
+	SafeLiftPosition uint16 `json:"safeLiftPosition"`
+}
+
 // CommandResponse defines model for CommandResponse.
 type CommandResponse struct {
 	// Id The id of the command
@@ -401,17 +613,128 @@ type CommandResponse struct {
 
 	// UpdatedAt The update date of the command
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// MissionId The id of the mission the command belongs to
+	MissionId *int64 `json:"missionId"`
+
+	// PausedForObstacle Whether the running command is paused because of an obstacle in the direction of travel
+	PausedForObstacle bool `json:"pausedForObstacle"`
+
+	// Retry The retry policy of the command, null if the command is not retried
+	Retry *RetryPolicy `json:"retry"`
+
+	// Priority The priority of the command, commands with the same priority are executed in creation order
+	Priority Priority `json:"priority"`
+
+	// NotBefore The command is not executed before this date, null if it can be executed right away
+	NotBefore *time.Time `json:"notBefore"`
+
+	// Progress The latest progress of the running command, null if none is reported yet
+	Progress *CommandProgress `json:"progress"`
+
+	// QueuePosition Orders the queued commands with the same priority, lower positions are executed first
+	QueuePosition int64 `json:"queuePosition"`
 }
 
 // CommandSource The source of the command
 type CommandSource = string
 
+// CommandStatsResponse defines model for CommandStatsResponse.
+type CommandStatsResponse struct {
+	// Total The number of commands
+	Total int64 `json:"total"`
+
+	// Succeeded The number of SUCCEEDED commands
+	Succeeded int64 `json:"succeeded"`
+
+	// Failed The number of FAILED commands
+	Failed int64 `json:"failed"`
+
+	// TimedOut The number of TIMED_OUT commands
+	TimedOut int64 `json:"timedOut"`
+
+	// Canceled The number of CANCELED commands
+	Canceled int64 `json:"canceled"`
+
+	// SuccessRate The ratio of the SUCCEEDED commands to the SUCCEEDED, FAILED and TIMED_OUT commands
+	SuccessRate float64 `json:"successRate"`
+
+	// Types The stats by command type
+	Types []CommandTypeStats `json:"types"`
+
+	// Sources The stats by source
+	Sources []SourceStats `json:"sources"`
+
+	// Routes The travel times of the SUCCEEDED MOVE_TO commands by route. A MOVE_TO starts from the target of the move before it, if that move is a SUCCEEDED MOVE_TO. The routes are computed from every move in the time ranges of the filters, the other filters do not apply.
+	Routes []RouteStats `json:"routes"`
+
+	// Failures The most frequent errors of the FAILED and TIMED_OUT commands
+	Failures []FailureStats `json:"failures"`
+}
+
 // CommandStatus The status of the command
 type CommandStatus = string
 
+// CommandTimeoutConfig The default execution timeout of each command type in milliseconds, 0 means no timeout
+type CommandTimeoutConfig struct {
+	// StopMovementMs The default timeout of STOP_MOVEMENT commands (ms)
+	StopMovementMs int64 `json:"stopMovementMs"`
+
+	// MoveForwardMs The default timeout of MOVE_FORWARD commands (ms)
+	MoveForwardMs int64 `json:"moveForwardMs"`
+
+	// MoveBackwardMs The default timeout of MOVE_BACKWARD commands (ms)
+	MoveBackwardMs int64 `json:"moveBackwardMs"`
+
+	// MoveToMs The default timeout of MOVE_TO commands (ms)
+	MoveToMs int64 `json:"moveToMs"`
+
+	// CargoOpenMs The default timeout of CARGO_OPEN commands (ms)
+	CargoOpenMs int64 `json:"cargoOpenMs"`
+
+	// CargoCloseMs The default timeout of CARGO_CLOSE commands (ms)
+	CargoCloseMs int64 `json:"cargoCloseMs"`
+
+	// CargoLiftMs The default timeout of CARGO_LIFT commands (ms)
+	CargoLiftMs int64 `json:"cargoLiftMs"`
+
+	// CargoLowerMs The default timeout of CARGO_LOWER commands (ms)
+	CargoLowerMs int64 `json:"cargoLowerMs"`
+
+	// CargoCheckQRMs The default timeout of CARGO_CHECK_QR commands (ms)
+	CargoCheckQRMs int64 `json:"cargoCheckQRMs"`
+
+	// ScanLocationMs The default timeout of SCAN_LOCATION commands (ms)
+	ScanLocationMs int64 `json:"scanLocationMs"`
+
+	// WaitMs The default timeout of WAIT commands (ms)
+	WaitMs int64 `json:"waitMs"`
+}
+
 // CommandType The type of command
 type CommandType = string
 
+// CommandTypeStats defines model for CommandTypeStats.
+type CommandTypeStats struct {
+	// Type The type of command
+	Type CommandType `json:"type"`
+
+	// Total The number of commands of the type
+	Total int64 `json:"total"`
+
+	// Succeeded The number of SUCCEEDED commands of the type
+	Succeeded int64 `json:"succeeded"`
+
+	// MeanDurationMs The mean time from the start to the completion of the SUCCEEDED commands in milliseconds
+	MeanDurationMs int64 `json:"meanDurationMs"`
+
+	// P50DurationMs The median time from the start to the completion of the SUCCEEDED commands in milliseconds
+	P50DurationMs int64 `json:"p50DurationMs"`
+
+	// P95DurationMs The 95th percentile of the time from the start to the completion of the SUCCEEDED commands in milliseconds
+	P95DurationMs int64 `json:"p95DurationMs"`
+}
+
 // CommandsListResponse defines model for CommandsListResponse.
 type CommandsListResponse struct {
 	// Items The list of commands
@@ -421,11 +744,109 @@ type CommandsListResponse struct {
 	TotalItems int `json:"totalItems"`
 }
 
+// Condition defines model for Condition.
+type Condition struct {
+	// Type The robot state to check
+	Type string `json:"type"`
+
+	// BatteryPercent The minimum battery percent, required for BATTERY_AT_LEAST
+	BatteryPercent *uint8 `json:"batteryPercent,omitempty"`
+
+	// Location The location or the station alias, required for AT_LOCATION
+	Location *string `json:"location,omitempty"`
+}
+
+// ConditionResult defines model for ConditionResult.
+type ConditionResult struct {
+	Condition Condition `json:"condition"`
+
+	// Met Whether the condition is expected to be met
+	Met bool `json:"met"`
+
+	// Actual The expected state, e.g. the battery percent or the current location
+	Actual string `json:"actual"`
+
+	// Precondition Whether the condition is a precondition of the command, false if it is checked by an ASSERT command
+	Precondition bool `json:"precondition"`
+}
+
 // CreateCommandRequest defines model for CreateCommandRequest.
 type CreateCommandRequest struct {
 	// Type The type of command
 	Type   CommandType   `json:"type"`
 	Inputs CommandInputs `json:"inputs"`
+	Retry  *RetryPolicy  `json:"retry,omitempty"`
+
+	// Priority The priority of the command, commands with the same priority are executed in creation order
+	Priority *Priority `json:"priority,omitempty"`
+
+	// Preempt Queue the command at the head of the queue, with the highest priority of the queued commands, and cancel the current processing command so the created command runs next
+	Preempt *bool `json:"preempt,omitempty"`
+
+	// NotBefore Defer the execution of the command until this date, with a precision of one second. It can not be used with preempt.
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+
+	// InsertBefore Queue the command right before the queued command with this ID. The place must be within the commands of the same priority, or between a higher and a lower one. It can not be used with insertAfter or preempt.
+	InsertBefore *int64 `json:"insertBefore,omitempty"`
+
+	// InsertAfter Queue the command right after the queued command with this ID. The place must be within the commands of the same priority, or between a higher and a lower one. It can not be used with insertBefore or preempt.
+	InsertAfter *int64 `json:"insertAfter,omitempty"`
+}
+
+// CreateCommandsItem defines model for CreateCommandsItem.
+type CreateCommandsItem struct {
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+
+	// RequestId The request ID for idempotency, the command is not created again if the request ID already exists
+	RequestId *string      `json:"requestId,omitempty"`
+	Retry     *RetryPolicy `json:"retry,omitempty"`
+
+	// Priority The priority of the command, commands with the same priority are executed in creation order
+	Priority *Priority `json:"priority,omitempty"`
+
+	// NotBefore Defer the execution of the command until this date, with a precision of one second
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+}
+
+// CreateCommandsRequest defines model for CreateCommandsRequest.
+type CreateCommandsRequest struct {
+	// Commands The commands to queue, in order
+	Commands []CreateCommandsItem `json:"commands"`
+}
+
+// CreateCommandsResponse defines model for CreateCommandsResponse.
+type CreateCommandsResponse struct {
+	// CommandIds The IDs of the commands, in the order of the request
+	CommandIds []int64 `json:"commandIds"`
+}
+
+// CreateMissionRequest defines model for CreateMissionRequest.
+type CreateMissionRequest struct {
+	// Steps The steps of the mission in execution order
+	Steps []CreateCommandRequest `json:"steps"`
+
+	// OnFailure What to do when a step fails or times out. ABORT cancels the remaining steps, SKIP continues with the next step, RETRY executes the step again up to maxRetries times then aborts.
+	OnFailure MissionOnFailurePolicy `json:"onFailure"`
+
+	// MaxRetries The number of times a failed step is retried when the on failure policy is RETRY
+	MaxRetries *uint8 `json:"maxRetries,omitempty"`
+}
+
+// CreateRailMapTagRequest defines model for CreateRailMapTagRequest.
+type CreateRailMapTagRequest struct {
+	// Location The location of the RFID tag
+	Location string `json:"location"`
+
+	// Position The index to insert the tag at, the tag is appended if omitted
+	Position *int `json:"position"`
+
+	// DistanceToNext The distance in centimeters to the next tag in the forward direction
+	DistanceToNext *uint32 `json:"distanceToNext"`
+
+	// Alias The station name of the tag
+	Alias *string `json:"alias"`
 }
 
 // DataBatteryCellVoltageDiff defines model for DataBatteryCellVoltageDiff.
@@ -571,7 +992,8 @@ type ESPConfig struct {
 	EnableAck bool `json:"enableAck"`
 
 	// CommandAckTimeout The timeout for the command ACK in milliseconds
-	CommandAckTimeout int `json:"commandAckTimeout"`
+	CommandAckTimeout int                   `json:"commandAckTimeout"`
+	CommandAckRetry   CommandACKRetryConfig `json:"commandAckRetry"`
 }
 
 // ESPSerialConnection defines model for ESPSerialConnection.
@@ -579,8 +1001,17 @@ type ESPSerialConnection struct {
 	Connected       bool       `json:"connected"`
 	LastConnectedAt *time.Time `json:"lastConnectedAt"`
 	Error           *string    `json:"error"`
+
+	// FrameStats The faults seen on a serial connection using the FRAMED protocol, zero with the JSON protocol
+	FrameStats SerialFrameStats `json:"frameStats"`
+
+	// Board The info reported by the board in the handshake, null until the handshake is done
+	Board *SerialBoardInfo `json:"board"`
 }
 
+// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+type ElapsedMs = int64
+
 // ErrorCodeResponse defines model for ErrorCodeResponse.
 type ErrorCodeResponse struct {
 	Code    string `json:"code"`
@@ -597,6 +1028,26 @@ type ErrorResponse struct {
 	Details *[]FieldError `json:"details,omitempty"`
 }
 
+// FailedCondition The condition that was not met, only set when the command failed because of it
+type FailedCondition struct {
+	Condition Condition `json:"condition"`
+
+	// Actual The observed state, e.g. the battery percent or the current location
+	Actual string `json:"actual"`
+
+	// Precondition Whether the condition is a precondition of the command, false if it is checked by an ASSERT command
+	Precondition bool `json:"precondition"`
+}
+
+// FailureStats defines model for FailureStats.
+type FailureStats struct {
+	// Error The error of the commands
+	Error string `json:"error"`
+
+	// Count The number of commands that failed with the error
+	Count int64 `json:"count"`
+}
+
 // FieldError defines model for FieldError.
 type FieldError struct {
 	// Field field name
@@ -756,44 +1207,185 @@ type LogFileHandler struct {
 	Format string `json:"format"`
 }
 
+// MissionOnFailurePolicy What to do when a step fails or times out. ABORT cancels the remaining steps, SKIP continues with the next step, RETRY executes the step again up to maxRetries times then aborts.
+type MissionOnFailurePolicy = string
+
+// MissionResponse defines model for MissionResponse.
+type MissionResponse struct {
+	// Id The id of the mission
+	Id int64 `json:"id"`
+
+	// Status The status of the mission
+	Status MissionStatus `json:"status"`
+
+	// Source The source of the command
+	Source CommandSource `json:"source"`
+
+	// OnFailure What to do when a step fails or times out. ABORT cancels the remaining steps, SKIP continues with the next step, RETRY executes the step again up to maxRetries times then aborts.
+	OnFailure MissionOnFailurePolicy `json:"onFailure"`
+
+	// MaxRetries The number of times a failed step is retried when the on failure policy is RETRY
+	MaxRetries uint8 `json:"maxRetries"`
+
+	// Steps The steps of the mission in execution order
+	Steps []CommandResponse `json:"steps"`
+
+	// Error The error of the mission
+	Error *string `json:"error"`
+
+	// StartedAt The start date of the mission
+	StartedAt *time.Time `json:"startedAt"`
+
+	// CompletedAt The completion date of the mission
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CreatedAt The creation date of the mission
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt The update date of the mission
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// MissionStatus The status of the mission
+type MissionStatus = string
+
+// MissionsListResponse defines model for MissionsListResponse.
+type MissionsListResponse struct {
+	// TotalItems The total number of missions
+	TotalItems int `json:"totalItems"`
+
+	// Items The list of missions
+	Items []MissionResponse `json:"items"`
+}
+
 // MotorSpeed The speed of the motor
 type MotorSpeed = uint8
 
 // MoveBackwardInputs defines model for MoveBackwardInputs.
 type MoveBackwardInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
 	// MotorSpeed The speed of the motor
 	MotorSpeed MotorSpeed `json:"motorSpeed"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // MoveBackwardOutputs defines model for MoveBackwardOutputs.
-type MoveBackwardOutputs = map[string]interface{}
+type MoveBackwardOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
+
+// MoveConfig defines model for MoveConfig.
+type MoveConfig struct {
+	// EnableObstacleTracking Stop the drive motor while an obstacle is detected in the direction of travel
+	EnableObstacleTracking bool             `json:"enableObstacleTracking"`
+	FrontObstacleTracking  ObstacleTracking `json:"frontObstacleTracking"`
+	BackObstacleTracking   ObstacleTracking `json:"backObstacleTracking"`
+
+	// AccelerationRampMs The duration to ramp the drive motor up to the target speed (ms), 0 starts at the target speed
+	AccelerationRampMs int64 `json:"accelerationRampMs"`
+
+	// DecelerationRampMs The duration to ramp the drive motor down to the approach speed (ms)
+	DecelerationRampMs int64 `json:"decelerationRampMs"`
+
+	// ApproachSpeed The speed after the location just before the MOVE_TO target, 0 disables the slow-down zone
+	ApproachSpeed uint8 `json:"approachSpeed"`
+}
 
 // MoveDirection The direction when moving
 type MoveDirection = string
 
 // MoveForwardInputs defines model for MoveForwardInputs.
 type MoveForwardInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
 	// MotorSpeed The speed of the motor
 	MotorSpeed MotorSpeed `json:"motorSpeed"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // MoveForwardOutputs defines model for MoveForwardOutputs.
-type MoveForwardOutputs = map[string]interface{}
+type MoveForwardOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
+
+// MoveQueuedCommandRequest defines model for MoveQueuedCommandRequest.
+type MoveQueuedCommandRequest struct {
+	// Position The position in the queue, 1 being the next command to execute. A position past the end of the queue moves the command to the end.
+	Position int `json:"position"`
+}
 
 // MoveToInputs defines model for MoveToInputs.
 type MoveToInputs struct {
 	// Direction The direction when moving
-	Direction MoveDirection `json:"direction"`
-
-	// Location The location to move to
-	Location string `json:"location"`
+	Direction *MoveDirection `json:"direction,omitempty"`
 
 	// MotorSpeed The speed of the motor
 	MotorSpeed MotorSpeed `json:"motorSpeed"`
+
+	// ApproachLocations The locations where the robot starts slowing down to the approach speed.
+	// If empty, the location before the target in the rail map is used.
+	ApproachLocations *[]string `json:"approachLocations,omitempty"`
+
+	// ApproachSpeed The speed in the slow-down zone, overrides the move config
+	ApproachSpeed *uint8 `json:"approachSpeed,omitempty"`
+
+	// AccelerationRampMs The duration to ramp up to the motor speed in milliseconds, overrides the move config
+	AccelerationRampMs *int64 `json:"accelerationRampMs,omitempty"`
+
+	// DecelerationRampMs The duration to ramp down to the approach speed in milliseconds, overrides the move config
+	DecelerationRampMs *int64 `json:"decelerationRampMs,omitempty"`
+
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
+	// Location The location to move to, or the station alias of a rail map tag
+	Location string `json:"location"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // MoveToOutputs defines model for MoveToOutputs.
-type MoveToOutputs = map[string]interface{}
+type MoveToOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // ObstacleTracking defines model for ObstacleTracking.
 type ObstacleTracking struct {
@@ -812,7 +1404,8 @@ type PICConfig struct {
 	EnableAck bool `json:"enableAck"`
 
 	// CommandAckTimeout The timeout for the command ACK in milliseconds
-	CommandAckTimeout int `json:"commandAckTimeout"`
+	CommandAckTimeout int                   `json:"commandAckTimeout"`
+	CommandAckRetry   CommandACKRetryConfig `json:"commandAckRetry"`
 }
 
 // PICSerialConnection defines model for PICSerialConnection.
@@ -820,8 +1413,54 @@ type PICSerialConnection struct {
 	Connected       bool       `json:"connected"`
 	LastConnectedAt *time.Time `json:"lastConnectedAt"`
 	Error           *string    `json:"error"`
+
+	// FrameStats The faults seen on a serial connection using the FRAMED protocol, zero with the JSON protocol
+	FrameStats SerialFrameStats `json:"frameStats"`
+
+	// Board The info reported by the board in the handshake, null until the handshake is done
+	Board *SerialBoardInfo `json:"board"`
+}
+
+// PlanResponse defines model for PlanResponse.
+type PlanResponse struct {
+	// Executable Whether every step of the plan is expected to succeed
+	Executable bool `json:"executable"`
+
+	// Steps The expected outcome of each command, in execution order
+	Steps []PlanStep `json:"steps"`
+}
+
+// PlanStep defines model for PlanStep.
+type PlanStep struct {
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+
+	// Location The resolved target location of MOVE_TO
+	Location *string `json:"location"`
+
+	// Direction The direction when moving
+	Direction *MoveDirection `json:"direction,omitempty"`
+
+	// ExpectedLocations The locations MOVE_TO expects to pass, the target location being the last one. Empty if the rail map does not know the route.
+	ExpectedLocations []string `json:"expectedLocations"`
+
+	// LiftPosition The expected lift position of CARGO_LIFT and CARGO_LOWER
+	LiftPosition *uint16 `json:"liftPosition"`
+
+	// Conditions The results of the preconditions and of the conditions of ASSERT, checked against the robot state expected after the previous steps
+	Conditions []ConditionResult `json:"conditions"`
+
+	// Error The reason the command is expected to fail
+	Error *string `json:"error"`
 }
 
+// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+type Preconditions = []Condition
+
+// Priority The priority of the command, commands with the same priority are executed in creation order
+type Priority = uint8
+
 // RFIDUSBConnection defines model for RFIDUSBConnection.
 type RFIDUSBConnection struct {
 	Connected       bool       `json:"connected"`
@@ -829,30 +1468,115 @@ type RFIDUSBConnection struct {
 	Error           *string    `json:"error"`
 }
 
-// RobotStateResponse defines model for RobotStateResponse.
-type RobotStateResponse struct {
-	Battery        BatteryState        `json:"battery"`
-	Charge         ChargeState         `json:"charge"`
-	Discharge      DischargeState      `json:"discharge"`
-	DistanceSensor DistanceSensorState `json:"distanceSensor"`
-	LiftMotor      LiftMotorState      `json:"liftMotor"`
-	DriveMotor     DriveMotorState     `json:"driveMotor"`
-	Location       LocationState       `json:"location"`
-	Cargo          CargoState          `json:"cargo"`
-	CargoDoorMotor CargoDoorMotorState `json:"cargoDoorMotor"`
-	AppConnection  AppConnection       `json:"appConnection"`
-	Leds           struct {
-		AlertLed  Led `json:"alertLed"`
-		SystemLed Led `json:"systemLed"`
-	} `json:"leds"`
-}
+// RailMapResponse defines model for RailMapResponse.
+type RailMapResponse struct {
+	// Topology The topology of the rail. LOOP is a closed rail, the tag after the last one is the first one. LINEAR is an open rail with two ends.
+	Topology RailMapTopology `json:"topology"`
 
-// STAConfig defines model for STAConfig.
-type STAConfig struct {
-	// Enable Whether to enable the STA mode
-	Enable bool `json:"enable"`
+	// Tags The tags in the forward direction
+	Tags []RailMapTagResponse `json:"tags"`
 
-	// Ssid The SSID for the wifi connection
+	// UpdatedAt The update date of the rail map
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// RailMapTagInput defines model for RailMapTagInput.
+type RailMapTagInput struct {
+	// Location The location of the RFID tag
+	Location string `json:"location"`
+
+	// DistanceToNext The distance in centimeters to the next tag in the forward direction
+	DistanceToNext *uint32 `json:"distanceToNext"`
+
+	// Alias The station name of the tag
+	Alias *string `json:"alias"`
+}
+
+// RailMapTagResponse defines model for RailMapTagResponse.
+type RailMapTagResponse struct {
+	// Id The id of the tag
+	Id int64 `json:"id"`
+
+	// Location The location of the RFID tag
+	Location string `json:"location"`
+
+	// Position The index of the tag in the forward direction
+	Position int `json:"position"`
+
+	// DistanceToNext The distance in centimeters to the next tag in the forward direction
+	DistanceToNext *uint32 `json:"distanceToNext"`
+
+	// Alias The station name of the tag
+	Alias *string `json:"alias"`
+
+	// CreatedAt The creation date of the tag
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt The update date of the tag
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// RailMapTopology The topology of the rail. LOOP is a closed rail, the tag after the last one is the first one. LINEAR is an open rail with two ends.
+type RailMapTopology = string
+
+// RetryPolicy defines model for RetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts The number of executions including the first one
+	MaxAttempts uint8 `json:"maxAttempts"`
+
+	// InitialBackoffMs The delay in milliseconds before the second attempt, it doubles after each attempt
+	InitialBackoffMs int64 `json:"initialBackoffMs"`
+
+	// MaxBackoffMs The maximum delay in milliseconds between attempts, 0 means no limit
+	MaxBackoffMs int64 `json:"maxBackoffMs"`
+}
+
+// RobotStateResponse defines model for RobotStateResponse.
+type RobotStateResponse struct {
+	Battery        BatteryState        `json:"battery"`
+	Charge         ChargeState         `json:"charge"`
+	Discharge      DischargeState      `json:"discharge"`
+	DistanceSensor DistanceSensorState `json:"distanceSensor"`
+	LiftMotor      LiftMotorState      `json:"liftMotor"`
+	DriveMotor     DriveMotorState     `json:"driveMotor"`
+	Location       LocationState       `json:"location"`
+	Cargo          CargoState          `json:"cargo"`
+	CargoDoorMotor CargoDoorMotorState `json:"cargoDoorMotor"`
+	AppConnection  AppConnection       `json:"appConnection"`
+	Leds           struct {
+		AlertLed  Led `json:"alertLed"`
+		SystemLed Led `json:"systemLed"`
+	} `json:"leds"`
+	CommandQueue CommandQueueStateResponse `json:"commandQueue"`
+}
+
+// RouteStats defines model for RouteStats.
+type RouteStats struct {
+	// From The location the robot started from
+	From string `json:"from"`
+
+	// To The target location of the MOVE_TO command
+	To string `json:"to"`
+
+	// Count The number of SUCCEEDED MOVE_TO commands on the route
+	Count int64 `json:"count"`
+
+	// MeanDurationMs The mean travel time in milliseconds
+	MeanDurationMs int64 `json:"meanDurationMs"`
+
+	// P50DurationMs The median travel time in milliseconds
+	P50DurationMs int64 `json:"p50DurationMs"`
+
+	// P95DurationMs The 95th percentile of the travel time in milliseconds
+	P95DurationMs int64 `json:"p95DurationMs"`
+}
+
+// STAConfig defines model for STAConfig.
+type STAConfig struct {
+	// Enable Whether to enable the STA mode
+	Enable bool `json:"enable"`
+
+	// Ssid The SSID for the wifi connection
 	Ssid string `json:"ssid"`
 
 	// Password The password for the wifi connection
@@ -863,11 +1587,142 @@ type STAConfig struct {
 }
 
 // ScanLocationInputs defines model for ScanLocationInputs.
-type ScanLocationInputs = map[string]interface{}
+type ScanLocationInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
+}
 
 // ScanLocationOutputs defines model for ScanLocationOutputs.
 type ScanLocationOutputs struct {
-	Locations []Location `json:"locations"`
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+	Locations       []Location       `json:"locations"`
+}
+
+// ScheduleCommandTemplate defines model for ScheduleCommandTemplate.
+type ScheduleCommandTemplate struct {
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+	Retry  *RetryPolicy  `json:"retry,omitempty"`
+
+	// Priority The priority of the command, commands with the same priority are executed in creation order
+	Priority *Priority `json:"priority,omitempty"`
+}
+
+// ScheduleMissionStep defines model for ScheduleMissionStep.
+type ScheduleMissionStep struct {
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+}
+
+// ScheduleMissionTemplate defines model for ScheduleMissionTemplate.
+type ScheduleMissionTemplate struct {
+	// Steps The steps of the mission in execution order
+	Steps []ScheduleMissionStep `json:"steps"`
+
+	// OnFailure What to do when a step fails or times out. ABORT cancels the remaining steps, SKIP continues with the next step, RETRY executes the step again up to maxRetries times then aborts.
+	OnFailure MissionOnFailurePolicy `json:"onFailure"`
+
+	// MaxRetries The number of times a failed step is retried when the on failure policy is RETRY
+	MaxRetries *uint8 `json:"maxRetries,omitempty"`
+}
+
+// ScheduleRequest defines model for ScheduleRequest.
+type ScheduleRequest struct {
+	// Name The name of the schedule
+	Name string `json:"name"`
+
+	// Cron The cron expression of the schedule, evaluated in the time zone of the schedule. It accepts the five fields "minute hour day-of-month month day-of-week", the descriptors @hourly, @daily, @weekly, @monthly, @yearly and "@every <duration>".
+	Cron string `json:"cron"`
+
+	// Timezone The IANA time zone the cron expression is evaluated in, UTC if not set
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Enabled Whether the schedule enqueues its command or mission
+	Enabled bool                     `json:"enabled"`
+	Command *ScheduleCommandTemplate `json:"command,omitempty"`
+	Mission *ScheduleMissionTemplate `json:"mission,omitempty"`
+}
+
+// ScheduleResponse defines model for ScheduleResponse.
+type ScheduleResponse struct {
+	// Id The id of the schedule
+	Id int64 `json:"id"`
+
+	// Name The name of the schedule
+	Name string `json:"name"`
+
+	// Cron The cron expression of the schedule, evaluated in its time zone
+	Cron string `json:"cron"`
+
+	// Timezone The IANA time zone the cron expression is evaluated in
+	Timezone string `json:"timezone"`
+
+	// Enabled Whether the schedule enqueues its command or mission
+	Enabled bool `json:"enabled"`
+
+	// Command The command enqueued by the schedule, null if the schedule enqueues a mission
+	Command *ScheduleCommandTemplate `json:"command"`
+
+	// Mission The mission enqueued by the schedule, null if the schedule enqueues a command
+	Mission *ScheduleMissionTemplate `json:"mission"`
+
+	// LastRunAt The last time the schedule ran
+	LastRunAt *time.Time `json:"lastRunAt"`
+
+	// NextRunAt The next time the schedule runs, null if the schedule is disabled
+	NextRunAt *time.Time `json:"nextRunAt"`
+
+	// CreatedAt The creation date of the schedule
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt The update date of the schedule
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SchedulesListResponse defines model for SchedulesListResponse.
+type SchedulesListResponse struct {
+	// TotalItems The total number of schedules
+	TotalItems int `json:"totalItems"`
+
+	// Items The list of schedules
+	Items []ScheduleResponse `json:"items"`
+}
+
+// SerialBoardInfo The firmware of a board and the messages it supports, reported in the protocol version handshake
+type SerialBoardInfo struct {
+	// FirmwareVersion The firmware version, empty for a legacy firmware
+	FirmwareVersion string `json:"firmwareVersion"`
+
+	// ProtocolVersion The protocol version spoken by the board
+	ProtocolVersion uint8 `json:"protocolVersion"`
+
+	// Commands The command types accepted by the board
+	Commands []int `json:"commands"`
+
+	// SyncStates The sync state types sent by the board
+	SyncStates []int `json:"syncStates"`
+
+	// Legacy True when the board did not reply to the handshake and protocol version 1 is assumed
+	Legacy bool `json:"legacy"`
+
+	// Compatible False when the protocol version is not supported, the commands to the board are then refused
+	Compatible bool      `json:"compatible"`
+	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
 // SerialConfig defines model for SerialConfig.
@@ -889,12 +1744,39 @@ type SerialConfig struct {
 
 	// ReadTimeout The read timeout for the serial connection in seconds
 	ReadTimeout int `json:"readTimeout"`
+
+	// Protocol The message framing on the serial connection, it must match the board firmware. FRAMED adds a length prefix, a sequence number and a CRC-16 to each message.
+	Protocol string `json:"protocol"`
+}
+
+// SerialFrameStats The faults seen on a serial connection using the FRAMED protocol, zero with the JSON protocol
+type SerialFrameStats struct {
+	// CrcErrors The number of frames dropped because of a CRC mismatch
+	CrcErrors uint64 `json:"crcErrors"`
+
+	// Resyncs The number of times the frame alignment was lost and bytes were skipped
+	Resyncs uint64 `json:"resyncs"`
+
+	// SequenceGaps The number of times a sequence number was skipped, i.e. frames were lost
+	SequenceGaps uint64 `json:"sequenceGaps"`
 }
 
 // SerialPort defines model for SerialPort.
 type SerialPort struct {
 	// Port The port of the serial port
 	Port string `json:"port"`
+
+	// IsUsb Whether the port is a USB serial adapter
+	IsUsb bool `json:"isUsb"`
+
+	// Vid The USB vendor ID of the adapter, empty for other ports
+	Vid string `json:"vid"`
+
+	// Pid The USB product ID of the adapter, empty for other ports
+	Pid string `json:"pid"`
+
+	// SerialNumber The USB serial number of the adapter, empty when it has none
+	SerialNumber string `json:"serialNumber"`
 }
 
 // SerialPortListResponse defines model for SerialPortListResponse.
@@ -903,11 +1785,41 @@ type SerialPortListResponse struct {
 	Items []SerialPort `json:"items"`
 }
 
+// SourceStats defines model for SourceStats.
+type SourceStats struct {
+	// Source The source of the command
+	Source CommandSource `json:"source"`
+
+	// Total The number of commands from the source
+	Total int64 `json:"total"`
+
+	// Succeeded The number of SUCCEEDED commands from the source
+	Succeeded int64 `json:"succeeded"`
+}
+
 // StopInputs defines model for StopInputs.
-type StopInputs = map[string]interface{}
+type StopInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
+}
 
 // StopOutputs defines model for StopOutputs.
-type StopOutputs = map[string]interface{}
+type StopOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // SystemInfo defines model for SystemInfo.
 type SystemInfo struct {
@@ -933,6 +1845,34 @@ type SystemStatus struct {
 	Status string `json:"status"`
 }
 
+// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+type TimeoutMs = int64
+
+// UpdateQueuedCommandRequest defines model for UpdateQueuedCommandRequest.
+type UpdateQueuedCommandRequest struct {
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+}
+
+// UpdateRailMapRequest defines model for UpdateRailMapRequest.
+type UpdateRailMapRequest struct {
+	// Topology The topology of the rail. LOOP is a closed rail, the tag after the last one is the first one. LINEAR is an open rail with two ends.
+	Topology RailMapTopology `json:"topology"`
+
+	// Tags The tags in the forward direction, replacing the current ones
+	Tags []RailMapTagInput `json:"tags"`
+}
+
+// UpdateRailMapTagRequest defines model for UpdateRailMapTagRequest.
+type UpdateRailMapTagRequest struct {
+	// DistanceToNext The distance in centimeters to the next tag in the forward direction
+	DistanceToNext *uint32 `json:"distanceToNext"`
+
+	// Alias The station name of the tag
+	Alias *string `json:"alias"`
+}
+
 // Version defines model for Version.
 type Version struct {
 	BuildDate string `json:"buildDate"`
@@ -942,12 +1882,30 @@ type Version struct {
 
 // WaitInputs defines model for WaitInputs.
 type WaitInputs struct {
+	// AbortMission Abort the rest of the mission if a precondition or an asserted condition is not met, whatever the on failure policy of the mission is
+	AbortMission *AbortMission `json:"abortMission,omitempty"`
+
 	// DurationMs The duration in milliseconds
 	DurationMs int `json:"durationMs"`
+
+	// Preconditions The conditions checked before the command is executed, the command fails without being executed if one of them is not met
+	Preconditions *Preconditions `json:"preconditions,omitempty"`
+
+	// TimeoutMs The execution timeout in milliseconds, overrides the default timeout of the command type
+	TimeoutMs *TimeoutMs `json:"timeoutMs,omitempty"`
 }
 
 // WaitOutputs defines model for WaitOutputs.
-type WaitOutputs = map[string]interface{}
+type WaitOutputs struct {
+	// Attempts The execution history, only set when the command has a retry policy
+	Attempts *Attempts `json:"attempts,omitempty"`
+
+	// ElapsedMs The execution time in milliseconds before the command timed out, only set when the command status is TIMED_OUT
+	ElapsedMs *ElapsedMs `json:"elapsedMs,omitempty"`
+
+	// FailedCondition The condition that was not met, only set when the command failed because of it
+	FailedCondition *FailedCondition `json:"failedCondition,omitempty"`
+}
 
 // WifiConfig defines model for WifiConfig.
 type WifiConfig struct {
@@ -955,12 +1913,36 @@ type WifiConfig struct {
 	Sta STAConfig `json:"sta"`
 }
 
+// CompletedFrom defines model for CompletedFrom.
+type CompletedFrom = time.Time
+
+// CompletedTo defines model for CompletedTo.
+type CompletedTo = time.Time
+
+// CreatedFrom defines model for CreatedFrom.
+type CreatedFrom = time.Time
+
+// CreatedTo defines model for CreatedTo.
+type CreatedTo = time.Time
+
 // Page defines model for Page.
 type Page = uint
 
 // PageSize defines model for PageSize.
 type PageSize = uint
 
+// RequestId defines model for RequestId.
+type RequestId = string
+
+// Sources defines model for Sources.
+type Sources = string
+
+// Statuses defines model for Statuses.
+type Statuses = string
+
+// Types defines model for Types.
+type Types = string
+
 // ListAlarmsParams defines parameters for ListAlarms.
 type ListAlarmsParams struct {
 	// Page The page number
@@ -990,6 +1972,8 @@ type ListCommandsParams struct {
 	//   - type
 	//   - status
 	//   - source
+	//   - priority
+	//   - queue_position
 	//   - created_at
 	//   - updated_at
 	//   - completed_at
@@ -998,15 +1982,151 @@ type ListCommandsParams struct {
 	// Statuses Filter the commands by the given statuses. Use `,` to filter by multiple statuses. Example: `QUEUED,PROCESSING` Allowed values:
 	//   - QUEUED
 	//   - PROCESSING
+	//   - CANCELING
+	//   - SUCCEEDED
+	//   - FAILED
+	//   - CANCELED
+	//   - TIMED_OUT
+	Statuses *Statuses `form:"statuses,omitempty" json:"statuses,omitempty"`
+
+	// Types Filter the commands by the given types. Use `,` to filter by multiple types. Example: `MOVE_TO,CARGO_LIFT`
+	Types *Types `form:"types,omitempty" json:"types,omitempty"`
+
+	// Sources Filter the commands by the given sources. Use `,` to filter by multiple sources. Example: `APP,CLOUD`
+	Sources *Sources `form:"sources,omitempty" json:"sources,omitempty"`
+
+	// RequestId Filter the commands by the request ID they were created with
+	RequestId *RequestId `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// CreatedFrom Filter the commands created at or after the given date
+	CreatedFrom *CreatedFrom `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Filter the commands created before the given date
+	CreatedTo *CreatedTo `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// CompletedFrom Filter the commands completed at or after the given date
+	CompletedFrom *CompletedFrom `form:"completedFrom,omitempty" json:"completedFrom,omitempty"`
+
+	// CompletedTo Filter the commands completed before the given date
+	CompletedTo *CompletedTo `form:"completedTo,omitempty" json:"completedTo,omitempty"`
+}
+
+// CreateCommandParams defines parameters for CreateCommand.
+type CreateCommandParams struct {
+	// DryRun Plan the command from the current robot state without creating it. The plan is returned instead of the created command.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ExportCommandsParams defines parameters for ExportCommands.
+type ExportCommandsParams struct {
+	// Format The format of the export
+	Format string `form:"format" json:"format"`
+
+	// Statuses Filter the commands by the given statuses. Use `,` to filter by multiple statuses. Example: `QUEUED,PROCESSING` Allowed values:
+	//   - QUEUED
+	//   - PROCESSING
+	//   - CANCELING
+	//   - SUCCEEDED
+	//   - FAILED
+	//   - CANCELED
+	//   - TIMED_OUT
+	Statuses *Statuses `form:"statuses,omitempty" json:"statuses,omitempty"`
+
+	// Types Filter the commands by the given types. Use `,` to filter by multiple types. Example: `MOVE_TO,CARGO_LIFT`
+	Types *Types `form:"types,omitempty" json:"types,omitempty"`
+
+	// Sources Filter the commands by the given sources. Use `,` to filter by multiple sources. Example: `APP,CLOUD`
+	Sources *Sources `form:"sources,omitempty" json:"sources,omitempty"`
+
+	// RequestId Filter the commands by the request ID they were created with
+	RequestId *RequestId `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// CreatedFrom Filter the commands created at or after the given date
+	CreatedFrom *CreatedFrom `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Filter the commands created before the given date
+	CreatedTo *CreatedTo `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// CompletedFrom Filter the commands completed at or after the given date
+	CompletedFrom *CompletedFrom `form:"completedFrom,omitempty" json:"completedFrom,omitempty"`
+
+	// CompletedTo Filter the commands completed before the given date
+	CompletedTo *CompletedTo `form:"completedTo,omitempty" json:"completedTo,omitempty"`
+}
+
+// GetCommandStatsParams defines parameters for GetCommandStats.
+type GetCommandStatsParams struct {
+	// Statuses Filter the commands by the given statuses. Use `,` to filter by multiple statuses. Example: `QUEUED,PROCESSING` Allowed values:
+	//   - QUEUED
+	//   - PROCESSING
+	//   - CANCELING
 	//   - SUCCEEDED
 	//   - FAILED
 	//   - CANCELED
-	Statuses *string `form:"statuses,omitempty" json:"statuses,omitempty"`
+	//   - TIMED_OUT
+	Statuses *Statuses `form:"statuses,omitempty" json:"statuses,omitempty"`
+
+	// Types Filter the commands by the given types. Use `,` to filter by multiple types. Example: `MOVE_TO,CARGO_LIFT`
+	Types *Types `form:"types,omitempty" json:"types,omitempty"`
+
+	// Sources Filter the commands by the given sources. Use `,` to filter by multiple sources. Example: `APP,CLOUD`
+	Sources *Sources `form:"sources,omitempty" json:"sources,omitempty"`
+
+	// RequestId Filter the commands by the request ID they were created with
+	RequestId *RequestId `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// CreatedFrom Filter the commands created at or after the given date
+	CreatedFrom *CreatedFrom `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Filter the commands created before the given date
+	CreatedTo *CreatedTo `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// CompletedFrom Filter the commands completed at or after the given date
+	CompletedFrom *CompletedFrom `form:"completedFrom,omitempty" json:"completedFrom,omitempty"`
+
+	// CompletedTo Filter the commands completed before the given date
+	CompletedTo *CompletedTo `form:"completedTo,omitempty" json:"completedTo,omitempty"`
+}
+
+// ListMissionsParams defines parameters for ListMissions.
+type ListMissionsParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// CreateMissionParams defines parameters for CreateMission.
+type CreateMissionParams struct {
+	// DryRun Plan the mission from the current robot state without creating it. The plan is returned instead of the created mission.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ListSchedulesParams defines parameters for ListSchedules.
+type ListSchedulesParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
 // CreateCommandJSONRequestBody defines body for CreateCommand for application/json ContentType.
 type CreateCommandJSONRequestBody = CreateCommandRequest
 
+// CreateCommandsJSONRequestBody defines body for CreateCommands for application/json ContentType.
+type CreateCommandsJSONRequestBody = CreateCommandsRequest
+
+// CancelCommandByRequestIdJSONRequestBody defines body for CancelCommandByRequestId for application/json ContentType.
+type CancelCommandByRequestIdJSONRequestBody = CancelCommandByRequestIdRequest
+
+// UpdateQueuedCommandJSONRequestBody defines body for UpdateQueuedCommand for application/json ContentType.
+type UpdateQueuedCommandJSONRequestBody = UpdateQueuedCommandRequest
+
+// MoveQueuedCommandJSONRequestBody defines body for MoveQueuedCommand for application/json ContentType.
+type MoveQueuedCommandJSONRequestBody = MoveQueuedCommandRequest
+
 // UpdateCloudConfigJSONRequestBody defines body for UpdateCloudConfig for application/json ContentType.
 type UpdateCloudConfigJSONRequestBody = CloudConfig
 
@@ -1028,6 +2148,24 @@ type UpdateBatteryMonitoringConfigJSONRequestBody = BatteryMonitoringConfig
 // UpdateWifiConfigJSONRequestBody defines body for UpdateWifiConfig for application/json ContentType.
 type UpdateWifiConfigJSONRequestBody = WifiConfig
 
+// CreateMissionJSONRequestBody defines body for CreateMission for application/json ContentType.
+type CreateMissionJSONRequestBody = CreateMissionRequest
+
+// UpdateRailMapJSONRequestBody defines body for UpdateRailMap for application/json ContentType.
+type UpdateRailMapJSONRequestBody = UpdateRailMapRequest
+
+// CreateRailMapTagJSONRequestBody defines body for CreateRailMapTag for application/json ContentType.
+type CreateRailMapTagJSONRequestBody = CreateRailMapTagRequest
+
+// UpdateRailMapTagJSONRequestBody defines body for UpdateRailMapTag for application/json ContentType.
+type UpdateRailMapTagJSONRequestBody = UpdateRailMapTagRequest
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = ScheduleRequest
+
+// UpdateScheduleJSONRequestBody defines body for UpdateSchedule for application/json ContentType.
+type UpdateScheduleJSONRequestBody = ScheduleRequest
+
 // AsDataBatteryVoltageLow returns the union data inside the AlarmData as a DataBatteryVoltageLow
 func (t AlarmData) AsDataBatteryVoltageLow() (DataBatteryVoltageLow, error) {
 	var body DataBatteryVoltageLow
@@ -1558,6 +2696,32 @@ func (t *CommandInputs) MergeWaitInputs(v WaitInputs) error {
 	return err
 }
 
+// AsAssertInputs returns the union data inside the CommandInputs as a AssertInputs
+func (t CommandInputs) AsAssertInputs() (AssertInputs, error) {
+	var body AssertInputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAssertInputs overwrites any union data inside the CommandInputs as the provided AssertInputs
+func (t *CommandInputs) FromAssertInputs(v AssertInputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAssertInputs performs a merge with any union data inside the CommandInputs, using the provided AssertInputs
+func (t *CommandInputs) MergeAssertInputs(v AssertInputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t CommandInputs) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1854,42 +3018,101 @@ func (t *CommandOutputs) MergeWaitOutputs(v WaitOutputs) error {
 	return err
 }
 
-func (t CommandOutputs) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// AsAssertOutputs returns the union data inside the CommandOutputs as a AssertOutputs
+func (t CommandOutputs) AsAssertOutputs() (AssertOutputs, error) {
+	var body AssertOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t *CommandOutputs) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+// FromAssertOutputs overwrites any union data inside the CommandOutputs as the provided AssertOutputs
+func (t *CommandOutputs) FromAssertOutputs(v AssertOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
 	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Delete all deactive alarms
-	// (DELETE /alarms)
-	DeleteDeactiveAlarms(w http.ResponseWriter, r *http.Request)
-	// List alarms
-	// (GET /alarms)
-	ListAlarms(w http.ResponseWriter, r *http.Request, params ListAlarmsParams)
+// MergeAssertOutputs performs a merge with any union data inside the CommandOutputs, using the provided AssertOutputs
+func (t *CommandOutputs) MergeAssertOutputs(v AssertOutputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t CommandOutputs) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *CommandOutputs) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Delete all deactive alarms
+	// (DELETE /alarms)
+	DeleteDeactiveAlarms(w http.ResponseWriter, r *http.Request)
+	// List alarms
+	// (GET /alarms)
+	ListAlarms(w http.ResponseWriter, r *http.Request, params ListAlarmsParams)
 	// List all commands
 	// (GET /commands)
 	ListCommands(w http.ResponseWriter, r *http.Request, params ListCommandsParams)
 	// Create a command
 	// (POST /commands)
-	CreateCommand(w http.ResponseWriter, r *http.Request)
+	CreateCommand(w http.ResponseWriter, r *http.Request, params CreateCommandParams)
+	// Create commands in a batch
+	// (POST /commands/batch)
+	CreateCommands(w http.ResponseWriter, r *http.Request)
+	// Cancel a command by request ID
+	// (POST /commands/cancel)
+	CancelCommandByRequestId(w http.ResponseWriter, r *http.Request)
+	// Export commands
+	// (GET /commands/export)
+	ExportCommands(w http.ResponseWriter, r *http.Request, params ExportCommandsParams)
 	// Get current processing command
 	// (GET /commands/processing)
 	GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request)
 	// Cancel current processing command
 	// (POST /commands/processing/cancel)
 	CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request)
+	// Stream command progress
+	// (GET /commands/processing/progress)
+	StreamCommandProgress(w http.ResponseWriter, r *http.Request)
+	// Get command queue state
+	// (GET /commands/queue)
+	GetCommandQueueState(w http.ResponseWriter, r *http.Request)
+	// Pause command queue
+	// (POST /commands/queue/pause)
+	PauseCommandQueue(w http.ResponseWriter, r *http.Request)
+	// Resume command queue
+	// (POST /commands/queue/resume)
+	ResumeCommandQueue(w http.ResponseWriter, r *http.Request)
+	// Get command stats
+	// (GET /commands/stats)
+	GetCommandStats(w http.ResponseWriter, r *http.Request, params GetCommandStatsParams)
 	// Delete a command by ID
 	// (DELETE /commands/{commandId})
 	DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int)
 	// Get a command by ID
 	// (GET /commands/{commandId})
 	GetCommandById(w http.ResponseWriter, r *http.Request, commandId int)
+	// Update a queued command
+	// (PATCH /commands/{commandId})
+	UpdateQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int)
+	// Cancel a command by ID
+	// (POST /commands/{commandId}/cancel)
+	CancelCommandById(w http.ResponseWriter, r *http.Request, commandId int64)
+	// Move a queued command
+	// (POST /commands/{commandId}/move)
+	MoveQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int)
 	// Get the cloud configuration
 	// (GET /configs/cloud)
 	GetCloudConfig(w http.ResponseWriter, r *http.Request)
@@ -1938,12 +3161,54 @@ type ServerInterface interface {
 	// Get the health of the server
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
+	// List all missions
+	// (GET /missions)
+	ListMissions(w http.ResponseWriter, r *http.Request, params ListMissionsParams)
+	// Create a mission
+	// (POST /missions)
+	CreateMission(w http.ResponseWriter, r *http.Request, params CreateMissionParams)
+	// Get a mission by ID
+	// (GET /missions/{missionId})
+	GetMissionById(w http.ResponseWriter, r *http.Request, missionId int64)
+	// Cancel a mission by ID
+	// (POST /missions/{missionId}/cancel)
+	CancelMissionById(w http.ResponseWriter, r *http.Request, missionId int64)
 	// List available serial ports
 	// (GET /peripherals/serials)
 	ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request)
+	// Get the rail map
+	// (GET /railmap)
+	GetRailMap(w http.ResponseWriter, r *http.Request)
+	// Update the rail map
+	// (PUT /railmap)
+	UpdateRailMap(w http.ResponseWriter, r *http.Request)
+	// Create a rail map tag
+	// (POST /railmap/tags)
+	CreateRailMapTag(w http.ResponseWriter, r *http.Request)
+	// Delete a rail map tag
+	// (DELETE /railmap/tags/{tagId})
+	DeleteRailMapTag(w http.ResponseWriter, r *http.Request, tagId int64)
+	// Update a rail map tag
+	// (PUT /railmap/tags/{tagId})
+	UpdateRailMapTag(w http.ResponseWriter, r *http.Request, tagId int64)
 	// Get robot state
 	// (GET /robot-state)
 	GetRobotState(w http.ResponseWriter, r *http.Request)
+	// List all schedules
+	// (GET /schedules)
+	ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams)
+	// Create a schedule
+	// (POST /schedules)
+	CreateSchedule(w http.ResponseWriter, r *http.Request)
+	// Delete a schedule
+	// (DELETE /schedules/{scheduleId})
+	DeleteSchedule(w http.ResponseWriter, r *http.Request, scheduleId int64)
+	// Get a schedule by ID
+	// (GET /schedules/{scheduleId})
+	GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int64)
+	// Update a schedule
+	// (PUT /schedules/{scheduleId})
+	UpdateSchedule(w http.ResponseWriter, r *http.Request, scheduleId int64)
 	// Get the limit switch state
 	// (GET /states/limit-switch)
 	GetLimitSwitchState(w http.ResponseWriter, r *http.Request)
@@ -1988,7 +3253,25 @@ func (_ Unimplemented) ListCommands(w http.ResponseWriter, r *http.Request, para
 
 // Create a command
 // (POST /commands)
-func (_ Unimplemented) CreateCommand(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateCommand(w http.ResponseWriter, r *http.Request, params CreateCommandParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create commands in a batch
+// (POST /commands/batch)
+func (_ Unimplemented) CreateCommands(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel a command by request ID
+// (POST /commands/cancel)
+func (_ Unimplemented) CancelCommandByRequestId(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export commands
+// (GET /commands/export)
+func (_ Unimplemented) ExportCommands(w http.ResponseWriter, r *http.Request, params ExportCommandsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -2004,6 +3287,36 @@ func (_ Unimplemented) CancelCurrentProcessingCommand(w http.ResponseWriter, r *
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Stream command progress
+// (GET /commands/processing/progress)
+func (_ Unimplemented) StreamCommandProgress(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get command queue state
+// (GET /commands/queue)
+func (_ Unimplemented) GetCommandQueueState(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pause command queue
+// (POST /commands/queue/pause)
+func (_ Unimplemented) PauseCommandQueue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume command queue
+// (POST /commands/queue/resume)
+func (_ Unimplemented) ResumeCommandQueue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get command stats
+// (GET /commands/stats)
+func (_ Unimplemented) GetCommandStats(w http.ResponseWriter, r *http.Request, params GetCommandStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a command by ID
 // (DELETE /commands/{commandId})
 func (_ Unimplemented) DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
@@ -2016,6 +3329,24 @@ func (_ Unimplemented) GetCommandById(w http.ResponseWriter, r *http.Request, co
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update a queued command
+// (PATCH /commands/{commandId})
+func (_ Unimplemented) UpdateQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel a command by ID
+// (POST /commands/{commandId}/cancel)
+func (_ Unimplemented) CancelCommandById(w http.ResponseWriter, r *http.Request, commandId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move a queued command
+// (POST /commands/{commandId}/move)
+func (_ Unimplemented) MoveQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the cloud configuration
 // (GET /configs/cloud)
 func (_ Unimplemented) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
@@ -2112,18 +3443,102 @@ func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List all missions
+// (GET /missions)
+func (_ Unimplemented) ListMissions(w http.ResponseWriter, r *http.Request, params ListMissionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a mission
+// (POST /missions)
+func (_ Unimplemented) CreateMission(w http.ResponseWriter, r *http.Request, params CreateMissionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a mission by ID
+// (GET /missions/{missionId})
+func (_ Unimplemented) GetMissionById(w http.ResponseWriter, r *http.Request, missionId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel a mission by ID
+// (POST /missions/{missionId}/cancel)
+func (_ Unimplemented) CancelMissionById(w http.ResponseWriter, r *http.Request, missionId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List available serial ports
 // (GET /peripherals/serials)
 func (_ Unimplemented) ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the rail map
+// (GET /railmap)
+func (_ Unimplemented) GetRailMap(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the rail map
+// (PUT /railmap)
+func (_ Unimplemented) UpdateRailMap(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a rail map tag
+// (POST /railmap/tags)
+func (_ Unimplemented) CreateRailMapTag(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a rail map tag
+// (DELETE /railmap/tags/{tagId})
+func (_ Unimplemented) DeleteRailMapTag(w http.ResponseWriter, r *http.Request, tagId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a rail map tag
+// (PUT /railmap/tags/{tagId})
+func (_ Unimplemented) UpdateRailMapTag(w http.ResponseWriter, r *http.Request, tagId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get robot state
 // (GET /robot-state)
 func (_ Unimplemented) GetRobotState(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List all schedules
+// (GET /schedules)
+func (_ Unimplemented) ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a schedule
+// (POST /schedules)
+func (_ Unimplemented) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a schedule
+// (DELETE /schedules/{scheduleId})
+func (_ Unimplemented) DeleteSchedule(w http.ResponseWriter, r *http.Request, scheduleId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a schedule by ID
+// (GET /schedules/{scheduleId})
+func (_ Unimplemented) GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a schedule
+// (PUT /schedules/{scheduleId})
+func (_ Unimplemented) UpdateSchedule(w http.ResponseWriter, r *http.Request, scheduleId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the limit switch state
 // (GET /states/limit-switch)
 func (_ Unimplemented) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
@@ -2273,6 +3688,62 @@ func (siw *ServerInterfaceWrapper) ListCommands(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Optional query parameter "types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "types", r.URL.Query(), &params.Types)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "types", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sources" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sources", r.URL.Query(), &params.Sources)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sources", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "requestId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requestId", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdTo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "completedFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completedFrom", r.URL.Query(), &params.CompletedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completedFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "completedTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completedTo", r.URL.Query(), &params.CompletedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completedTo", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListCommands(w, r, params)
 	}))
@@ -2287,8 +3758,21 @@ func (siw *ServerInterfaceWrapper) ListCommands(w http.ResponseWriter, r *http.R
 // CreateCommand operation middleware
 func (siw *ServerInterfaceWrapper) CreateCommand(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCommandParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCommand(w, r)
+		siw.Handler.CreateCommand(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2298,11 +3782,11 @@ func (siw *ServerInterfaceWrapper) CreateCommand(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetCurrentProcessingCommand operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
+// CreateCommands operation middleware
+func (siw *ServerInterfaceWrapper) CreateCommands(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCurrentProcessingCommand(w, r)
+		siw.Handler.CreateCommands(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2312,11 +3796,11 @@ func (siw *ServerInterfaceWrapper) GetCurrentProcessingCommand(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// CancelCurrentProcessingCommand operation middleware
-func (siw *ServerInterfaceWrapper) CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
+// CancelCommandByRequestId operation middleware
+func (siw *ServerInterfaceWrapper) CancelCommandByRequestId(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelCurrentProcessingCommand(w, r)
+		siw.Handler.CancelCommandByRequestId(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2326,75 +3810,95 @@ func (siw *ServerInterfaceWrapper) CancelCurrentProcessingCommand(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCommandById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCommandById(w http.ResponseWriter, r *http.Request) {
+// ExportCommands operation middleware
+func (siw *ServerInterfaceWrapper) ExportCommands(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "commandId" -------------
-	var commandId int
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportCommandsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCommandById(w, r, commandId)
-	}))
+	// ------------- Optional query parameter "statuses" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "statuses", r.URL.Query(), &params.Statuses)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statuses", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "types" -------------
 
-// GetCommandById operation middleware
-func (siw *ServerInterfaceWrapper) GetCommandById(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "types", r.URL.Query(), &params.Types)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "types", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "sources" -------------
 
-	// ------------- Path parameter "commandId" -------------
-	var commandId int
+	err = runtime.BindQueryParameter("form", true, false, "sources", r.URL.Query(), &params.Sources)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sources", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "requestId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requestId", r.URL.Query(), &params.RequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommandById(w, r, commandId)
-	}))
+	// ------------- Optional query parameter "createdFrom" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdFrom", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "createdTo" -------------
 
-// GetCloudConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdTo", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCloudConfig(w, r)
-	}))
+	// ------------- Optional query parameter "completedFrom" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "completedFrom", r.URL.Query(), &params.CompletedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completedFrom", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "completedTo" -------------
 
-// UpdateCloudConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "completedTo", r.URL.Query(), &params.CompletedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completedTo", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCloudConfig(w, r)
+		siw.Handler.ExportCommands(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2404,11 +3908,11 @@ func (siw *ServerInterfaceWrapper) UpdateCloudConfig(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetCommandConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
+// GetCurrentProcessingCommand operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommandConfig(w, r)
+		siw.Handler.GetCurrentProcessingCommand(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2418,11 +3922,11 @@ func (siw *ServerInterfaceWrapper) GetCommandConfig(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCommandConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCommandConfig(w http.ResponseWriter, r *http.Request) {
+// CancelCurrentProcessingCommand operation middleware
+func (siw *ServerInterfaceWrapper) CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCommandConfig(w, r)
+		siw.Handler.CancelCurrentProcessingCommand(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2432,11 +3936,11 @@ func (siw *ServerInterfaceWrapper) UpdateCommandConfig(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetHardwareConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetHardwareConfig(w http.ResponseWriter, r *http.Request) {
+// StreamCommandProgress operation middleware
+func (siw *ServerInterfaceWrapper) StreamCommandProgress(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHardwareConfig(w, r)
+		siw.Handler.StreamCommandProgress(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2446,11 +3950,11 @@ func (siw *ServerInterfaceWrapper) GetHardwareConfig(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateHardwareConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateHardwareConfig(w http.ResponseWriter, r *http.Request) {
+// GetCommandQueueState operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandQueueState(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateHardwareConfig(w, r)
+		siw.Handler.GetCommandQueueState(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2460,11 +3964,11 @@ func (siw *ServerInterfaceWrapper) UpdateHardwareConfig(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetHTTPConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
+// PauseCommandQueue operation middleware
+func (siw *ServerInterfaceWrapper) PauseCommandQueue(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHTTPConfig(w, r)
+		siw.Handler.PauseCommandQueue(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2474,11 +3978,11 @@ func (siw *ServerInterfaceWrapper) GetHTTPConfig(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateHTTPConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request) {
+// ResumeCommandQueue operation middleware
+func (siw *ServerInterfaceWrapper) ResumeCommandQueue(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateHTTPConfig(w, r)
+		siw.Handler.ResumeCommandQueue(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2488,25 +3992,80 @@ func (siw *ServerInterfaceWrapper) UpdateHTTPConfig(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetLogConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetLogConfig(w http.ResponseWriter, r *http.Request) {
+// GetCommandStats operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandStats(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogConfig(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCommandStatsParams
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "statuses" -------------
 
-// UpdateLogConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLogConfig(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "statuses", r.URL.Query(), &params.Statuses)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "statuses", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "types", r.URL.Query(), &params.Types)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "types", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sources" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sources", r.URL.Query(), &params.Sources)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sources", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "requestId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requestId", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdTo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "completedFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completedFrom", r.URL.Query(), &params.CompletedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completedFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "completedTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completedTo", r.URL.Query(), &params.CompletedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completedTo", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateLogConfig(w, r)
+		siw.Handler.GetCommandStats(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2516,11 +4075,22 @@ func (siw *ServerInterfaceWrapper) UpdateLogConfig(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetBatteryMonitoringConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
+// DeleteCommandById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCommandById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBatteryMonitoringConfig(w, r)
+		siw.Handler.DeleteCommandById(w, r, commandId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2530,11 +4100,22 @@ func (siw *ServerInterfaceWrapper) GetBatteryMonitoringConfig(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateBatteryMonitoringConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
+// GetCommandById operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateBatteryMonitoringConfig(w, r)
+		siw.Handler.GetCommandById(w, r, commandId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2544,11 +4125,22 @@ func (siw *ServerInterfaceWrapper) UpdateBatteryMonitoringConfig(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// GetWifiConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
+// UpdateQueuedCommand operation middleware
+func (siw *ServerInterfaceWrapper) UpdateQueuedCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWifiConfig(w, r)
+		siw.Handler.UpdateQueuedCommand(w, r, commandId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2558,11 +4150,22 @@ func (siw *ServerInterfaceWrapper) GetWifiConfig(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWifiConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWifiConfig(w http.ResponseWriter, r *http.Request) {
+// CancelCommandById operation middleware
+func (siw *ServerInterfaceWrapper) CancelCommandById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWifiConfig(w, r)
+		siw.Handler.CancelCommandById(w, r, commandId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2572,11 +4175,22 @@ func (siw *ServerInterfaceWrapper) UpdateWifiConfig(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetErrorCodes operation middleware
-func (siw *ServerInterfaceWrapper) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
+// MoveQueuedCommand operation middleware
+func (siw *ServerInterfaceWrapper) MoveQueuedCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetErrorCodes(w, r)
+		siw.Handler.MoveQueuedCommand(w, r, commandId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2586,11 +4200,11 @@ func (siw *ServerInterfaceWrapper) GetErrorCodes(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// GetCloudConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.GetCloudConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2600,11 +4214,11 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListAvailableSerialPorts operation middleware
-func (siw *ServerInterfaceWrapper) ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request) {
+// UpdateCloudConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAvailableSerialPorts(w, r)
+		siw.Handler.UpdateCloudConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2614,11 +4228,11 @@ func (siw *ServerInterfaceWrapper) ListAvailableSerialPorts(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetRobotState operation middleware
-func (siw *ServerInterfaceWrapper) GetRobotState(w http.ResponseWriter, r *http.Request) {
+// GetCommandConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRobotState(w, r)
+		siw.Handler.GetCommandConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2628,11 +4242,11 @@ func (siw *ServerInterfaceWrapper) GetRobotState(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetLimitSwitchState operation middleware
-func (siw *ServerInterfaceWrapper) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
+// UpdateCommandConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCommandConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLimitSwitchState(w, r)
+		siw.Handler.UpdateCommandConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2642,11 +4256,11 @@ func (siw *ServerInterfaceWrapper) GetLimitSwitchState(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetSystemInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetSystemInfo(w http.ResponseWriter, r *http.Request) {
+// GetHardwareConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetHardwareConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSystemInfo(w, r)
+		siw.Handler.GetHardwareConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2656,11 +4270,11 @@ func (siw *ServerInterfaceWrapper) GetSystemInfo(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// RebootSystem operation middleware
-func (siw *ServerInterfaceWrapper) RebootSystem(w http.ResponseWriter, r *http.Request) {
+// UpdateHardwareConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHardwareConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RebootSystem(w, r)
+		siw.Handler.UpdateHardwareConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2670,11 +4284,11 @@ func (siw *ServerInterfaceWrapper) RebootSystem(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetSystemStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetSystemStatus(w http.ResponseWriter, r *http.Request) {
+// GetHTTPConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSystemStatus(w, r)
+		siw.Handler.GetHTTPConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2684,11 +4298,11 @@ func (siw *ServerInterfaceWrapper) GetSystemStatus(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// StopEmergency operation middleware
-func (siw *ServerInterfaceWrapper) StopEmergency(w http.ResponseWriter, r *http.Request) {
+// UpdateHTTPConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StopEmergency(w, r)
+		siw.Handler.UpdateHTTPConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2698,11 +4312,11 @@ func (siw *ServerInterfaceWrapper) StopEmergency(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetVersion operation middleware
-func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
+// GetLogConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetLogConfig(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVersion(w, r)
+		siw.Handler.GetLogConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2712,1052 +4326,2446 @@ func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// UpdateLogConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLogConfig(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLogConfig(w, r)
+	}))
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// GetBatteryMonitoringConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBatteryMonitoringConfig(w, r)
+	}))
 
-type RequiredParamError struct {
-	ParamName string
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// UpdateBatteryMonitoringConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBatteryMonitoringConfig(w, r)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// GetWifiConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWifiConfig(w, r)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	handler.ServeHTTP(w, r)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+// UpdateWifiConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWifiConfig(w http.ResponseWriter, r *http.Request) {
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWifiConfig(w, r)
+	}))
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetErrorCodes operation middleware
+func (siw *ServerInterfaceWrapper) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetErrorCodes(w, r)
+	}))
 
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/alarms", wrapper.DeleteDeactiveAlarms)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/alarms", wrapper.ListAlarms)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/commands", wrapper.ListCommands)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/commands", wrapper.CreateCommand)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/commands/processing", wrapper.GetCurrentProcessingCommand)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/commands/processing/cancel", wrapper.CancelCurrentProcessingCommand)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/commands/{commandId}", wrapper.DeleteCommandById)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/commands/{commandId}", wrapper.GetCommandById)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/configs/cloud", wrapper.GetCloudConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/configs/cloud", wrapper.UpdateCloudConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/configs/command", wrapper.GetCommandConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/configs/command", wrapper.UpdateCommandConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/configs/hardware", wrapper.GetHardwareConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/configs/hardware", wrapper.UpdateHardwareConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/configs/http", wrapper.GetHTTPConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/configs/http", wrapper.UpdateHTTPConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/configs/log", wrapper.GetLogConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/configs/log", wrapper.UpdateLogConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/configs/monitoring/battery", wrapper.GetBatteryMonitoringConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/configs/monitoring/battery", wrapper.UpdateBatteryMonitoringConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/configs/wifi", wrapper.GetWifiConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/configs/wifi", wrapper.UpdateWifiConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/error-codes", wrapper.GetErrorCodes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/peripherals/serials", wrapper.ListAvailableSerialPorts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/robot-state", wrapper.GetRobotState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/states/limit-switch", wrapper.GetLimitSwitchState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/system/info", wrapper.GetSystemInfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/system/reboot", wrapper.RebootSystem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/system/status", wrapper.GetSystemStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/system/stop-emergency", wrapper.StopEmergency)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
-	})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return r
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteDeactiveAlarmsRequestObject struct {
-}
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
-type DeleteDeactiveAlarmsResponseObject interface {
-	VisitDeleteDeactiveAlarmsResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
 
-type DeleteDeactiveAlarms204Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteDeactiveAlarms204Response) VisitDeleteDeactiveAlarmsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteDeactiveAlarms400JSONResponse ErrorResponse
-
-func (response DeleteDeactiveAlarms400JSONResponse) VisitDeleteDeactiveAlarmsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ListMissions operation middleware
+func (siw *ServerInterfaceWrapper) ListMissions(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type ListAlarmsRequestObject struct {
-	Params ListAlarmsParams
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMissionsParams
 
-type ListAlarmsResponseObject interface {
-	VisitListAlarmsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "page" -------------
 
-type ListAlarms200JSONResponse AlarmsListResponse
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-func (response ListAlarms200JSONResponse) VisitListAlarmsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "pageSize" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
 
-type ListAlarms400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMissions(w, r, params)
+	}))
 
-func (response ListAlarms400JSONResponse) VisitListAlarmsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListCommandsRequestObject struct {
-	Params ListCommandsParams
-}
+// CreateMission operation middleware
+func (siw *ServerInterfaceWrapper) CreateMission(w http.ResponseWriter, r *http.Request) {
 
-type ListCommandsResponseObject interface {
-	VisitListCommandsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type ListCommands200JSONResponse CommandsListResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateMissionParams
 
-func (response ListCommands200JSONResponse) VisitListCommandsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "dryRun" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
 
-type ListCommands400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMission(w, r, params)
+	}))
 
-func (response ListCommands400JSONResponse) VisitListCommandsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCommandRequestObject struct {
-	Body *CreateCommandJSONRequestBody
-}
+// GetMissionById operation middleware
+func (siw *ServerInterfaceWrapper) GetMissionById(w http.ResponseWriter, r *http.Request) {
 
-type CreateCommandResponseObject interface {
-	VisitCreateCommandResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateCommand201JSONResponse CommandResponse
+	// ------------- Path parameter "missionId" -------------
+	var missionId int64
 
-func (response CreateCommand201JSONResponse) VisitCreateCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateCommand400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "missionId", chi.URLParam(r, "missionId"), &missionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "missionId", Err: err})
+		return
+	}
 
-func (response CreateCommand400JSONResponse) VisitCreateCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMissionById(w, r, missionId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCurrentProcessingCommandRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type GetCurrentProcessingCommandResponseObject interface {
-	VisitGetCurrentProcessingCommandResponse(w http.ResponseWriter) error
-}
+// CancelMissionById operation middleware
+func (siw *ServerInterfaceWrapper) CancelMissionById(w http.ResponseWriter, r *http.Request) {
 
-type GetCurrentProcessingCommand200JSONResponse CommandResponse
+	var err error
 
-func (response GetCurrentProcessingCommand200JSONResponse) VisitGetCurrentProcessingCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "missionId" -------------
+	var missionId int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "missionId", chi.URLParam(r, "missionId"), &missionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "missionId", Err: err})
+		return
+	}
 
-type GetCurrentProcessingCommand404JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelMissionById(w, r, missionId)
+	}))
 
-func (response GetCurrentProcessingCommand404JSONResponse) VisitGetCurrentProcessingCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CancelCurrentProcessingCommandRequestObject struct {
-}
+// ListAvailableSerialPorts operation middleware
+func (siw *ServerInterfaceWrapper) ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request) {
 
-type CancelCurrentProcessingCommandResponseObject interface {
-	VisitCancelCurrentProcessingCommandResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAvailableSerialPorts(w, r)
+	}))
 
-type CancelCurrentProcessingCommand204Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response CancelCurrentProcessingCommand204Response) VisitCancelCurrentProcessingCommandResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type CancelCurrentProcessingCommand400JSONResponse ErrorResponse
+// GetRailMap operation middleware
+func (siw *ServerInterfaceWrapper) GetRailMap(w http.ResponseWriter, r *http.Request) {
 
-func (response CancelCurrentProcessingCommand400JSONResponse) VisitCancelCurrentProcessingCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRailMap(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteCommandByIdRequestObject struct {
-	CommandId int `json:"commandId"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteCommandByIdResponseObject interface {
-	VisitDeleteCommandByIdResponse(w http.ResponseWriter) error
-}
+// UpdateRailMap operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRailMap(w http.ResponseWriter, r *http.Request) {
 
-type DeleteCommandById204Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRailMap(w, r)
+	}))
 
-func (response DeleteCommandById204Response) VisitDeleteCommandByIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteCommandById400JSONResponse ErrorResponse
+	handler.ServeHTTP(w, r)
+}
 
-func (response DeleteCommandById400JSONResponse) VisitDeleteCommandByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateRailMapTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateRailMapTag(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRailMapTag(w, r)
+	}))
 
-type GetCommandByIdRequestObject struct {
-	CommandId int `json:"commandId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCommandByIdResponseObject interface {
-	VisitGetCommandByIdResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetCommandById200JSONResponse CommandResponse
-
-func (response GetCommandById200JSONResponse) VisitGetCommandByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteRailMapTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRailMapTag(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetCommandById404JSONResponse ErrorResponse
+	// ------------- Path parameter "tagId" -------------
+	var tagId int64
 
-func (response GetCommandById404JSONResponse) VisitGetCommandByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", chi.URLParam(r, "tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRailMapTag(w, r, tagId)
+	}))
 
-type GetCloudConfigRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCloudConfigResponseObject interface {
-	VisitGetCloudConfigResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetCloudConfig200JSONResponse CloudConfig
+// UpdateRailMapTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRailMapTag(w http.ResponseWriter, r *http.Request) {
 
-func (response GetCloudConfig200JSONResponse) VisitGetCloudConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "tagId" -------------
+	var tagId int64
 
-type GetCloudConfig400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", chi.URLParam(r, "tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		return
+	}
 
-func (response GetCloudConfig400JSONResponse) VisitGetCloudConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRailMapTag(w, r, tagId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateCloudConfigRequestObject struct {
-	Body *UpdateCloudConfigJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateCloudConfigResponseObject interface {
-	VisitUpdateCloudConfigResponse(w http.ResponseWriter) error
-}
+// GetRobotState operation middleware
+func (siw *ServerInterfaceWrapper) GetRobotState(w http.ResponseWriter, r *http.Request) {
 
-type UpdateCloudConfig200JSONResponse CloudConfig
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRobotState(w, r)
+	}))
 
-func (response UpdateCloudConfig200JSONResponse) VisitUpdateCloudConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateCloudConfig400JSONResponse ErrorResponse
+// ListSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListSchedules(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateCloudConfig400JSONResponse) VisitUpdateCloudConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSchedulesParams
 
-type GetCommandConfigRequestObject struct {
-}
+	// ------------- Optional query parameter "page" -------------
 
-type GetCommandConfigResponseObject interface {
-	VisitGetCommandConfigResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type GetCommandConfig200JSONResponse CommandConfig
+	// ------------- Optional query parameter "pageSize" -------------
 
-func (response GetCommandConfig200JSONResponse) VisitGetCommandConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSchedules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetCommandConfig400JSONResponse ErrorResponse
+// CreateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchedule(w http.ResponseWriter, r *http.Request) {
 
-func (response GetCommandConfig400JSONResponse) VisitGetCommandConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSchedule(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateCommandConfigRequestObject struct {
-	Body *UpdateCommandConfigJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateCommandConfigResponseObject interface {
-	VisitUpdateCommandConfigResponse(w http.ResponseWriter) error
-}
+// DeleteSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
 
-type UpdateCommandConfig200JSONResponse CommandConfig
+	var err error
 
-func (response UpdateCommandConfig200JSONResponse) VisitUpdateCommandConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
 
-type UpdateCommandConfig400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSchedule(w, r, scheduleId)
+	}))
 
-func (response UpdateCommandConfig400JSONResponse) VisitUpdateCommandConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetHardwareConfigRequestObject struct {
-}
+// GetScheduleById operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduleById(w http.ResponseWriter, r *http.Request) {
 
-type GetHardwareConfigResponseObject interface {
-	VisitGetHardwareConfigResponse(w http.ResponseWriter) error
-}
-
-type GetHardwareConfig200JSONResponse HardwareConfig
-
-func (response GetHardwareConfig200JSONResponse) VisitGetHardwareConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetHardwareConfig400JSONResponse ErrorResponse
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int64
 
-func (response GetHardwareConfig400JSONResponse) VisitGetHardwareConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScheduleById(w, r, scheduleId)
+	}))
 
-type UpdateHardwareConfigRequestObject struct {
-	Body *UpdateHardwareConfigJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateHardwareConfigResponseObject interface {
-	VisitUpdateHardwareConfigResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateHardwareConfig200JSONResponse HardwareConfig
+// UpdateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateHardwareConfig200JSONResponse) VisitUpdateHardwareConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int64
 
-type UpdateHardwareConfig400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
 
-func (response UpdateHardwareConfig400JSONResponse) VisitUpdateHardwareConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchedule(w, r, scheduleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetHTTPConfigRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type GetHTTPConfigResponseObject interface {
-	VisitGetHTTPConfigResponse(w http.ResponseWriter) error
-}
+// GetLimitSwitchState operation middleware
+func (siw *ServerInterfaceWrapper) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
 
-type GetHTTPConfig200JSONResponse HTTPConfig
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLimitSwitchState(w, r)
+	}))
 
-func (response GetHTTPConfig200JSONResponse) VisitGetHTTPConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetHTTPConfig400JSONResponse ErrorResponse
+// GetSystemInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemInfo(w http.ResponseWriter, r *http.Request) {
 
-func (response GetHTTPConfig400JSONResponse) VisitGetHTTPConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSystemInfo(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateHTTPConfigRequestObject struct {
-	Body *UpdateHTTPConfigJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateHTTPConfigResponseObject interface {
-	VisitUpdateHTTPConfigResponse(w http.ResponseWriter) error
-}
+// RebootSystem operation middleware
+func (siw *ServerInterfaceWrapper) RebootSystem(w http.ResponseWriter, r *http.Request) {
 
-type UpdateHTTPConfig200JSONResponse HTTPConfig
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RebootSystem(w, r)
+	}))
 
-func (response UpdateHTTPConfig200JSONResponse) VisitUpdateHTTPConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateHTTPConfig400JSONResponse ErrorResponse
+// GetSystemStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemStatus(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateHTTPConfig400JSONResponse) VisitUpdateHTTPConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSystemStatus(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetLogConfigRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type GetLogConfigResponseObject interface {
-	VisitGetLogConfigResponse(w http.ResponseWriter) error
-}
+// StopEmergency operation middleware
+func (siw *ServerInterfaceWrapper) StopEmergency(w http.ResponseWriter, r *http.Request) {
 
-type GetLogConfig200JSONResponse LogConfig
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopEmergency(w, r)
+	}))
 
-func (response GetLogConfig200JSONResponse) VisitGetLogConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetLogConfig400JSONResponse ErrorResponse
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
 
-func (response GetLogConfig400JSONResponse) VisitGetLogConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVersion(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateLogConfigRequestObject struct {
-	Body *UpdateLogConfigJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateLogConfigResponseObject interface {
-	VisitUpdateLogConfigResponse(w http.ResponseWriter) error
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type UpdateLogConfig200JSONResponse LogConfig
-
-func (response UpdateLogConfig200JSONResponse) VisitUpdateLogConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type UpdateLogConfig400JSONResponse ErrorResponse
-
-func (response UpdateLogConfig400JSONResponse) VisitUpdateLogConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetBatteryMonitoringConfigRequestObject struct {
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetBatteryMonitoringConfigResponseObject interface {
-	VisitGetBatteryMonitoringConfigResponse(w http.ResponseWriter) error
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type GetBatteryMonitoringConfig200JSONResponse BatteryMonitoringConfig
-
-func (response GetBatteryMonitoringConfig200JSONResponse) VisitGetBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetBatteryMonitoringConfig400JSONResponse ErrorResponse
-
-func (response GetBatteryMonitoringConfig400JSONResponse) VisitGetBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type RequiredParamError struct {
+	ParamName string
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type UpdateBatteryMonitoringConfigRequestObject struct {
-	Body *UpdateBatteryMonitoringConfigJSONRequestBody
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type UpdateBatteryMonitoringConfigResponseObject interface {
-	VisitUpdateBatteryMonitoringConfigResponse(w http.ResponseWriter) error
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type UpdateBatteryMonitoringConfig200JSONResponse BatteryMonitoringConfig
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
 
-func (response UpdateBatteryMonitoringConfig200JSONResponse) VisitUpdateBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type UpdateBatteryMonitoringConfig400JSONResponse ErrorResponse
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
 
-func (response UpdateBatteryMonitoringConfig400JSONResponse) VisitUpdateBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type GetWifiConfigRequestObject struct {
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
 }
 
-type GetWifiConfigResponseObject interface {
-	VisitGetWifiConfigResponse(w http.ResponseWriter) error
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type GetWifiConfig200JSONResponse WifiConfig
-
-func (response GetWifiConfig200JSONResponse) VisitGetWifiConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
 
-	return json.NewEncoder(w).Encode(response)
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
 }
 
-type GetWifiConfig400JSONResponse ErrorResponse
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
 
-func (response GetWifiConfig400JSONResponse) VisitGetWifiConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/alarms", wrapper.DeleteDeactiveAlarms)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/alarms", wrapper.ListAlarms)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands", wrapper.ListCommands)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands", wrapper.CreateCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/batch", wrapper.CreateCommands)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/cancel", wrapper.CancelCommandByRequestId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/export", wrapper.ExportCommands)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/processing", wrapper.GetCurrentProcessingCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/processing/cancel", wrapper.CancelCurrentProcessingCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/processing/progress", wrapper.StreamCommandProgress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/queue", wrapper.GetCommandQueueState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/queue/pause", wrapper.PauseCommandQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/queue/resume", wrapper.ResumeCommandQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/stats", wrapper.GetCommandStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/commands/{commandId}", wrapper.DeleteCommandById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/{commandId}", wrapper.GetCommandById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/commands/{commandId}", wrapper.UpdateQueuedCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/{commandId}/cancel", wrapper.CancelCommandById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/{commandId}/move", wrapper.MoveQueuedCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs/cloud", wrapper.GetCloudConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/configs/cloud", wrapper.UpdateCloudConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs/command", wrapper.GetCommandConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/configs/command", wrapper.UpdateCommandConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs/hardware", wrapper.GetHardwareConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/configs/hardware", wrapper.UpdateHardwareConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs/http", wrapper.GetHTTPConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/configs/http", wrapper.UpdateHTTPConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs/log", wrapper.GetLogConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/configs/log", wrapper.UpdateLogConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs/monitoring/battery", wrapper.GetBatteryMonitoringConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/configs/monitoring/battery", wrapper.UpdateBatteryMonitoringConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/configs/wifi", wrapper.GetWifiConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/configs/wifi", wrapper.UpdateWifiConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/error-codes", wrapper.GetErrorCodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/missions", wrapper.ListMissions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/missions", wrapper.CreateMission)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/missions/{missionId}", wrapper.GetMissionById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/missions/{missionId}/cancel", wrapper.CancelMissionById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/peripherals/serials", wrapper.ListAvailableSerialPorts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/railmap", wrapper.GetRailMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/railmap", wrapper.UpdateRailMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/railmap/tags", wrapper.CreateRailMapTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/railmap/tags/{tagId}", wrapper.DeleteRailMapTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/railmap/tags/{tagId}", wrapper.UpdateRailMapTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/robot-state", wrapper.GetRobotState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules", wrapper.ListSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schedules", wrapper.CreateSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/schedules/{scheduleId}", wrapper.DeleteSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules/{scheduleId}", wrapper.GetScheduleById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/schedules/{scheduleId}", wrapper.UpdateSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/states/limit-switch", wrapper.GetLimitSwitchState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/system/info", wrapper.GetSystemInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/system/reboot", wrapper.RebootSystem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/system/status", wrapper.GetSystemStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/system/stop-emergency", wrapper.StopEmergency)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
+	})
 
-type UpdateWifiConfigRequestObject struct {
-	Body *UpdateWifiConfigJSONRequestBody
+	return r
 }
 
-type UpdateWifiConfigResponseObject interface {
-	VisitUpdateWifiConfigResponse(w http.ResponseWriter) error
+type DeleteDeactiveAlarmsRequestObject struct {
 }
 
-type UpdateWifiConfig200JSONResponse WifiConfig
+type DeleteDeactiveAlarmsResponseObject interface {
+	VisitDeleteDeactiveAlarmsResponse(w http.ResponseWriter) error
+}
 
-func (response UpdateWifiConfig200JSONResponse) VisitUpdateWifiConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type DeleteDeactiveAlarms204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response DeleteDeactiveAlarms204Response) VisitDeleteDeactiveAlarmsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type UpdateWifiConfig400JSONResponse ErrorResponse
+type DeleteDeactiveAlarms400JSONResponse ErrorResponse
 
-func (response UpdateWifiConfig400JSONResponse) VisitUpdateWifiConfigResponse(w http.ResponseWriter) error {
+func (response DeleteDeactiveAlarms400JSONResponse) VisitDeleteDeactiveAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetErrorCodesRequestObject struct {
+type ListAlarmsRequestObject struct {
+	Params ListAlarmsParams
 }
 
-type GetErrorCodesResponseObject interface {
-	VisitGetErrorCodesResponse(w http.ResponseWriter) error
+type ListAlarmsResponseObject interface {
+	VisitListAlarmsResponse(w http.ResponseWriter) error
 }
 
-type GetErrorCodes200JSONResponse []ErrorCodeResponse
+type ListAlarms200JSONResponse AlarmsListResponse
 
-func (response GetErrorCodes200JSONResponse) VisitGetErrorCodesResponse(w http.ResponseWriter) error {
+func (response ListAlarms200JSONResponse) VisitListAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetErrorCodes400JSONResponse ErrorResponse
+type ListAlarms400JSONResponse ErrorResponse
 
-func (response GetErrorCodes400JSONResponse) VisitGetErrorCodesResponse(w http.ResponseWriter) error {
+func (response ListAlarms400JSONResponse) VisitListAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHealthRequestObject struct {
+type ListCommandsRequestObject struct {
+	Params ListCommandsParams
 }
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
+type ListCommandsResponseObject interface {
+	VisitListCommandsResponse(w http.ResponseWriter) error
 }
 
-type GetHealth200JSONResponse HealthResponse
+type ListCommands200JSONResponse CommandsListResponse
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+func (response ListCommands200JSONResponse) VisitListCommandsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHealth400JSONResponse ErrorResponse
+type ListCommands400JSONResponse ErrorResponse
 
-func (response GetHealth400JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+func (response ListCommands400JSONResponse) VisitListCommandsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAvailableSerialPortsRequestObject struct {
+type CreateCommandRequestObject struct {
+	Params CreateCommandParams
+	Body   *CreateCommandJSONRequestBody
 }
 
-type ListAvailableSerialPortsResponseObject interface {
-	VisitListAvailableSerialPortsResponse(w http.ResponseWriter) error
+type CreateCommandResponseObject interface {
+	VisitCreateCommandResponse(w http.ResponseWriter) error
 }
 
-type ListAvailableSerialPorts200JSONResponse SerialPortListResponse
+type CreateCommand200JSONResponse PlanResponse
 
-func (response ListAvailableSerialPorts200JSONResponse) VisitListAvailableSerialPortsResponse(w http.ResponseWriter) error {
+func (response CreateCommand200JSONResponse) VisitCreateCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAvailableSerialPorts400JSONResponse ErrorResponse
+type CreateCommand201JSONResponse CommandResponse
 
-func (response ListAvailableSerialPorts400JSONResponse) VisitListAvailableSerialPortsResponse(w http.ResponseWriter) error {
+func (response CreateCommand201JSONResponse) VisitCreateCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRobotStateRequestObject struct {
-}
+type CreateCommand400JSONResponse ErrorResponse
 
-type GetRobotStateResponseObject interface {
-	VisitGetRobotStateResponse(w http.ResponseWriter) error
+func (response CreateCommand400JSONResponse) VisitCreateCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRobotState200JSONResponse RobotStateResponse
+type CreateCommandsRequestObject struct {
+	Body *CreateCommandsJSONRequestBody
+}
 
-func (response GetRobotState200JSONResponse) VisitGetRobotStateResponse(w http.ResponseWriter) error {
+type CreateCommandsResponseObject interface {
+	VisitCreateCommandsResponse(w http.ResponseWriter) error
+}
+
+type CreateCommands201JSONResponse CreateCommandsResponse
+
+func (response CreateCommands201JSONResponse) VisitCreateCommandsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRobotState400JSONResponse ErrorResponse
+type CreateCommands400JSONResponse ErrorResponse
 
-func (response GetRobotState400JSONResponse) VisitGetRobotStateResponse(w http.ResponseWriter) error {
+func (response CreateCommands400JSONResponse) VisitCreateCommandsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLimitSwitchStateRequestObject struct {
+type CancelCommandByRequestIdRequestObject struct {
+	Body *CancelCommandByRequestIdJSONRequestBody
 }
 
-type GetLimitSwitchStateResponseObject interface {
-	VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error
+type CancelCommandByRequestIdResponseObject interface {
+	VisitCancelCommandByRequestIdResponse(w http.ResponseWriter) error
 }
 
-type GetLimitSwitchState200JSONResponse LimitSwitchState
+type CancelCommandByRequestId204Response struct {
+}
 
-func (response GetLimitSwitchState200JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+func (response CancelCommandByRequestId204Response) VisitCancelCommandByRequestIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelCommandByRequestId400JSONResponse ErrorResponse
+
+func (response CancelCommandByRequestId400JSONResponse) VisitCancelCommandByRequestIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelCommandByRequestId404JSONResponse ErrorResponse
+
+func (response CancelCommandByRequestId404JSONResponse) VisitCancelCommandByRequestIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportCommandsRequestObject struct {
+	Params ExportCommandsParams
+}
+
+type ExportCommandsResponseObject interface {
+	VisitExportCommandsResponse(w http.ResponseWriter) error
+}
+
+type ExportCommands200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportCommands200ApplicationxNdjsonResponse) VisitExportCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportCommands200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportCommands200TextcsvResponse) VisitExportCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportCommands400JSONResponse ErrorResponse
+
+func (response ExportCommands400JSONResponse) VisitExportCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLimitSwitchState400JSONResponse ErrorResponse
+type GetCurrentProcessingCommandRequestObject struct {
+}
 
-func (response GetLimitSwitchState400JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+type GetCurrentProcessingCommandResponseObject interface {
+	VisitGetCurrentProcessingCommandResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentProcessingCommand200JSONResponse CommandResponse
+
+func (response GetCurrentProcessingCommand200JSONResponse) VisitGetCurrentProcessingCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentProcessingCommand404JSONResponse ErrorResponse
+
+func (response GetCurrentProcessingCommand404JSONResponse) VisitGetCurrentProcessingCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelCurrentProcessingCommandRequestObject struct {
+}
+
+type CancelCurrentProcessingCommandResponseObject interface {
+	VisitCancelCurrentProcessingCommandResponse(w http.ResponseWriter) error
+}
+
+type CancelCurrentProcessingCommand204Response struct {
+}
+
+func (response CancelCurrentProcessingCommand204Response) VisitCancelCurrentProcessingCommandResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelCurrentProcessingCommand400JSONResponse ErrorResponse
+
+func (response CancelCurrentProcessingCommand400JSONResponse) VisitCancelCurrentProcessingCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemInfoRequestObject struct {
+type StreamCommandProgressRequestObject struct {
 }
 
-type GetSystemInfoResponseObject interface {
-	VisitGetSystemInfoResponse(w http.ResponseWriter) error
+type StreamCommandProgressResponseObject interface {
+	VisitStreamCommandProgressResponse(w http.ResponseWriter) error
 }
 
-type GetSystemInfo200JSONResponse SystemInfo
+type StreamCommandProgress200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response GetSystemInfo200JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+func (response StreamCommandProgress200TexteventStreamResponse) VisitStreamCommandProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCommandQueueStateRequestObject struct {
+}
+
+type GetCommandQueueStateResponseObject interface {
+	VisitGetCommandQueueStateResponse(w http.ResponseWriter) error
+}
+
+type GetCommandQueueState200JSONResponse CommandQueueStateResponse
+
+func (response GetCommandQueueState200JSONResponse) VisitGetCommandQueueStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemInfo400JSONResponse ErrorResponse
+type PauseCommandQueueRequestObject struct {
+}
 
-func (response GetSystemInfo400JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+type PauseCommandQueueResponseObject interface {
+	VisitPauseCommandQueueResponse(w http.ResponseWriter) error
+}
+
+type PauseCommandQueue200JSONResponse CommandQueueStateResponse
+
+func (response PauseCommandQueue200JSONResponse) VisitPauseCommandQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCommandQueueRequestObject struct {
+}
+
+type ResumeCommandQueueResponseObject interface {
+	VisitResumeCommandQueueResponse(w http.ResponseWriter) error
+}
+
+type ResumeCommandQueue200JSONResponse CommandQueueStateResponse
+
+func (response ResumeCommandQueue200JSONResponse) VisitResumeCommandQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandStatsRequestObject struct {
+	Params GetCommandStatsParams
+}
+
+type GetCommandStatsResponseObject interface {
+	VisitGetCommandStatsResponse(w http.ResponseWriter) error
+}
+
+type GetCommandStats200JSONResponse CommandStatsResponse
+
+func (response GetCommandStats200JSONResponse) VisitGetCommandStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandStats400JSONResponse ErrorResponse
+
+func (response GetCommandStats400JSONResponse) VisitGetCommandStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RebootSystemRequestObject struct {
+type DeleteCommandByIdRequestObject struct {
+	CommandId int `json:"commandId"`
 }
 
-type RebootSystemResponseObject interface {
-	VisitRebootSystemResponse(w http.ResponseWriter) error
+type DeleteCommandByIdResponseObject interface {
+	VisitDeleteCommandByIdResponse(w http.ResponseWriter) error
 }
 
-type RebootSystem204Response struct {
+type DeleteCommandById204Response struct {
 }
 
-func (response RebootSystem204Response) VisitRebootSystemResponse(w http.ResponseWriter) error {
+func (response DeleteCommandById204Response) VisitDeleteCommandByIdResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type RebootSystem400JSONResponse ErrorResponse
+type DeleteCommandById400JSONResponse ErrorResponse
 
-func (response RebootSystem400JSONResponse) VisitRebootSystemResponse(w http.ResponseWriter) error {
+func (response DeleteCommandById400JSONResponse) VisitDeleteCommandByIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemStatusRequestObject struct {
+type GetCommandByIdRequestObject struct {
+	CommandId int `json:"commandId"`
 }
 
-type GetSystemStatusResponseObject interface {
-	VisitGetSystemStatusResponse(w http.ResponseWriter) error
+type GetCommandByIdResponseObject interface {
+	VisitGetCommandByIdResponse(w http.ResponseWriter) error
 }
 
-type GetSystemStatus200JSONResponse SystemStatus
+type GetCommandById200JSONResponse CommandResponse
 
-func (response GetSystemStatus200JSONResponse) VisitGetSystemStatusResponse(w http.ResponseWriter) error {
+func (response GetCommandById200JSONResponse) VisitGetCommandByIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemStatus400JSONResponse ErrorResponse
+type GetCommandById404JSONResponse ErrorResponse
 
-func (response GetSystemStatus400JSONResponse) VisitGetSystemStatusResponse(w http.ResponseWriter) error {
+func (response GetCommandById404JSONResponse) VisitGetCommandByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQueuedCommandRequestObject struct {
+	CommandId int `json:"commandId"`
+	Body      *UpdateQueuedCommandJSONRequestBody
+}
+
+type UpdateQueuedCommandResponseObject interface {
+	VisitUpdateQueuedCommandResponse(w http.ResponseWriter) error
+}
+
+type UpdateQueuedCommand200JSONResponse CommandResponse
+
+func (response UpdateQueuedCommand200JSONResponse) VisitUpdateQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateQueuedCommand400JSONResponse ErrorResponse
+
+func (response UpdateQueuedCommand400JSONResponse) VisitUpdateQueuedCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopEmergencyRequestObject struct {
+type UpdateQueuedCommand404JSONResponse ErrorResponse
+
+func (response UpdateQueuedCommand404JSONResponse) VisitUpdateQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StopEmergencyResponseObject interface {
-	VisitStopEmergencyResponse(w http.ResponseWriter) error
+type CancelCommandByIdRequestObject struct {
+	CommandId int64 `json:"commandId"`
 }
 
-type StopEmergency204Response struct {
+type CancelCommandByIdResponseObject interface {
+	VisitCancelCommandByIdResponse(w http.ResponseWriter) error
 }
 
-func (response StopEmergency204Response) VisitStopEmergencyResponse(w http.ResponseWriter) error {
+type CancelCommandById204Response struct {
+}
+
+func (response CancelCommandById204Response) VisitCancelCommandByIdResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type StopEmergency400JSONResponse ErrorResponse
+type CancelCommandById400JSONResponse ErrorResponse
 
-func (response StopEmergency400JSONResponse) VisitStopEmergencyResponse(w http.ResponseWriter) error {
+func (response CancelCommandById400JSONResponse) VisitCancelCommandByIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionRequestObject struct {
+type CancelCommandById404JSONResponse ErrorResponse
+
+func (response CancelCommandById404JSONResponse) VisitCancelCommandByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionResponseObject interface {
-	VisitGetVersionResponse(w http.ResponseWriter) error
+type MoveQueuedCommandRequestObject struct {
+	CommandId int `json:"commandId"`
+	Body      *MoveQueuedCommandJSONRequestBody
 }
 
-type GetVersion200JSONResponse Version
+type MoveQueuedCommandResponseObject interface {
+	VisitMoveQueuedCommandResponse(w http.ResponseWriter) error
+}
 
-func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+type MoveQueuedCommand200JSONResponse CommandResponse
+
+func (response MoveQueuedCommand200JSONResponse) VisitMoveQueuedCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersion400JSONResponse ErrorResponse
+type MoveQueuedCommand400JSONResponse ErrorResponse
 
-func (response GetVersion400JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+func (response MoveQueuedCommand400JSONResponse) VisitMoveQueuedCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Delete all deactive alarms
-	// (DELETE /alarms)
-	DeleteDeactiveAlarms(ctx context.Context, request DeleteDeactiveAlarmsRequestObject) (DeleteDeactiveAlarmsResponseObject, error)
-	// List alarms
-	// (GET /alarms)
-	ListAlarms(ctx context.Context, request ListAlarmsRequestObject) (ListAlarmsResponseObject, error)
-	// List all commands
-	// (GET /commands)
-	ListCommands(ctx context.Context, request ListCommandsRequestObject) (ListCommandsResponseObject, error)
-	// Create a command
-	// (POST /commands)
-	CreateCommand(ctx context.Context, request CreateCommandRequestObject) (CreateCommandResponseObject, error)
-	// Get current processing command
-	// (GET /commands/processing)
-	GetCurrentProcessingCommand(ctx context.Context, request GetCurrentProcessingCommandRequestObject) (GetCurrentProcessingCommandResponseObject, error)
-	// Cancel current processing command
+type MoveQueuedCommand404JSONResponse ErrorResponse
+
+func (response MoveQueuedCommand404JSONResponse) VisitMoveQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCloudConfigRequestObject struct {
+}
+
+type GetCloudConfigResponseObject interface {
+	VisitGetCloudConfigResponse(w http.ResponseWriter) error
+}
+
+type GetCloudConfig200JSONResponse CloudConfig
+
+func (response GetCloudConfig200JSONResponse) VisitGetCloudConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCloudConfig400JSONResponse ErrorResponse
+
+func (response GetCloudConfig400JSONResponse) VisitGetCloudConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCloudConfigRequestObject struct {
+	Body *UpdateCloudConfigJSONRequestBody
+}
+
+type UpdateCloudConfigResponseObject interface {
+	VisitUpdateCloudConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateCloudConfig200JSONResponse CloudConfig
+
+func (response UpdateCloudConfig200JSONResponse) VisitUpdateCloudConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCloudConfig400JSONResponse ErrorResponse
+
+func (response UpdateCloudConfig400JSONResponse) VisitUpdateCloudConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandConfigRequestObject struct {
+}
+
+type GetCommandConfigResponseObject interface {
+	VisitGetCommandConfigResponse(w http.ResponseWriter) error
+}
+
+type GetCommandConfig200JSONResponse CommandConfig
+
+func (response GetCommandConfig200JSONResponse) VisitGetCommandConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandConfig400JSONResponse ErrorResponse
+
+func (response GetCommandConfig400JSONResponse) VisitGetCommandConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommandConfigRequestObject struct {
+	Body *UpdateCommandConfigJSONRequestBody
+}
+
+type UpdateCommandConfigResponseObject interface {
+	VisitUpdateCommandConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateCommandConfig200JSONResponse CommandConfig
+
+func (response UpdateCommandConfig200JSONResponse) VisitUpdateCommandConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommandConfig400JSONResponse ErrorResponse
+
+func (response UpdateCommandConfig400JSONResponse) VisitUpdateCommandConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHardwareConfigRequestObject struct {
+}
+
+type GetHardwareConfigResponseObject interface {
+	VisitGetHardwareConfigResponse(w http.ResponseWriter) error
+}
+
+type GetHardwareConfig200JSONResponse HardwareConfig
+
+func (response GetHardwareConfig200JSONResponse) VisitGetHardwareConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHardwareConfig400JSONResponse ErrorResponse
+
+func (response GetHardwareConfig400JSONResponse) VisitGetHardwareConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHardwareConfigRequestObject struct {
+	Body *UpdateHardwareConfigJSONRequestBody
+}
+
+type UpdateHardwareConfigResponseObject interface {
+	VisitUpdateHardwareConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateHardwareConfig200JSONResponse HardwareConfig
+
+func (response UpdateHardwareConfig200JSONResponse) VisitUpdateHardwareConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHardwareConfig400JSONResponse ErrorResponse
+
+func (response UpdateHardwareConfig400JSONResponse) VisitUpdateHardwareConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHTTPConfigRequestObject struct {
+}
+
+type GetHTTPConfigResponseObject interface {
+	VisitGetHTTPConfigResponse(w http.ResponseWriter) error
+}
+
+type GetHTTPConfig200JSONResponse HTTPConfig
+
+func (response GetHTTPConfig200JSONResponse) VisitGetHTTPConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHTTPConfig400JSONResponse ErrorResponse
+
+func (response GetHTTPConfig400JSONResponse) VisitGetHTTPConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHTTPConfigRequestObject struct {
+	Body *UpdateHTTPConfigJSONRequestBody
+}
+
+type UpdateHTTPConfigResponseObject interface {
+	VisitUpdateHTTPConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateHTTPConfig200JSONResponse HTTPConfig
+
+func (response UpdateHTTPConfig200JSONResponse) VisitUpdateHTTPConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHTTPConfig400JSONResponse ErrorResponse
+
+func (response UpdateHTTPConfig400JSONResponse) VisitUpdateHTTPConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogConfigRequestObject struct {
+}
+
+type GetLogConfigResponseObject interface {
+	VisitGetLogConfigResponse(w http.ResponseWriter) error
+}
+
+type GetLogConfig200JSONResponse LogConfig
+
+func (response GetLogConfig200JSONResponse) VisitGetLogConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogConfig400JSONResponse ErrorResponse
+
+func (response GetLogConfig400JSONResponse) VisitGetLogConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLogConfigRequestObject struct {
+	Body *UpdateLogConfigJSONRequestBody
+}
+
+type UpdateLogConfigResponseObject interface {
+	VisitUpdateLogConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateLogConfig200JSONResponse LogConfig
+
+func (response UpdateLogConfig200JSONResponse) VisitUpdateLogConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLogConfig400JSONResponse ErrorResponse
+
+func (response UpdateLogConfig400JSONResponse) VisitUpdateLogConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBatteryMonitoringConfigRequestObject struct {
+}
+
+type GetBatteryMonitoringConfigResponseObject interface {
+	VisitGetBatteryMonitoringConfigResponse(w http.ResponseWriter) error
+}
+
+type GetBatteryMonitoringConfig200JSONResponse BatteryMonitoringConfig
+
+func (response GetBatteryMonitoringConfig200JSONResponse) VisitGetBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBatteryMonitoringConfig400JSONResponse ErrorResponse
+
+func (response GetBatteryMonitoringConfig400JSONResponse) VisitGetBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBatteryMonitoringConfigRequestObject struct {
+	Body *UpdateBatteryMonitoringConfigJSONRequestBody
+}
+
+type UpdateBatteryMonitoringConfigResponseObject interface {
+	VisitUpdateBatteryMonitoringConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateBatteryMonitoringConfig200JSONResponse BatteryMonitoringConfig
+
+func (response UpdateBatteryMonitoringConfig200JSONResponse) VisitUpdateBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBatteryMonitoringConfig400JSONResponse ErrorResponse
+
+func (response UpdateBatteryMonitoringConfig400JSONResponse) VisitUpdateBatteryMonitoringConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWifiConfigRequestObject struct {
+}
+
+type GetWifiConfigResponseObject interface {
+	VisitGetWifiConfigResponse(w http.ResponseWriter) error
+}
+
+type GetWifiConfig200JSONResponse WifiConfig
+
+func (response GetWifiConfig200JSONResponse) VisitGetWifiConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWifiConfig400JSONResponse ErrorResponse
+
+func (response GetWifiConfig400JSONResponse) VisitGetWifiConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWifiConfigRequestObject struct {
+	Body *UpdateWifiConfigJSONRequestBody
+}
+
+type UpdateWifiConfigResponseObject interface {
+	VisitUpdateWifiConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateWifiConfig200JSONResponse WifiConfig
+
+func (response UpdateWifiConfig200JSONResponse) VisitUpdateWifiConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWifiConfig400JSONResponse ErrorResponse
+
+func (response UpdateWifiConfig400JSONResponse) VisitUpdateWifiConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetErrorCodesRequestObject struct {
+}
+
+type GetErrorCodesResponseObject interface {
+	VisitGetErrorCodesResponse(w http.ResponseWriter) error
+}
+
+type GetErrorCodes200JSONResponse []ErrorCodeResponse
+
+func (response GetErrorCodes200JSONResponse) VisitGetErrorCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetErrorCodes400JSONResponse ErrorResponse
+
+func (response GetErrorCodes400JSONResponse) VisitGetErrorCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse HealthResponse
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealth400JSONResponse ErrorResponse
+
+func (response GetHealth400JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMissionsRequestObject struct {
+	Params ListMissionsParams
+}
+
+type ListMissionsResponseObject interface {
+	VisitListMissionsResponse(w http.ResponseWriter) error
+}
+
+type ListMissions200JSONResponse MissionsListResponse
+
+func (response ListMissions200JSONResponse) VisitListMissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMissions400JSONResponse ErrorResponse
+
+func (response ListMissions400JSONResponse) VisitListMissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMissionRequestObject struct {
+	Params CreateMissionParams
+	Body   *CreateMissionJSONRequestBody
+}
+
+type CreateMissionResponseObject interface {
+	VisitCreateMissionResponse(w http.ResponseWriter) error
+}
+
+type CreateMission200JSONResponse PlanResponse
+
+func (response CreateMission200JSONResponse) VisitCreateMissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMission201JSONResponse MissionResponse
+
+func (response CreateMission201JSONResponse) VisitCreateMissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMission400JSONResponse ErrorResponse
+
+func (response CreateMission400JSONResponse) VisitCreateMissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMissionByIdRequestObject struct {
+	MissionId int64 `json:"missionId"`
+}
+
+type GetMissionByIdResponseObject interface {
+	VisitGetMissionByIdResponse(w http.ResponseWriter) error
+}
+
+type GetMissionById200JSONResponse MissionResponse
+
+func (response GetMissionById200JSONResponse) VisitGetMissionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMissionById404JSONResponse ErrorResponse
+
+func (response GetMissionById404JSONResponse) VisitGetMissionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelMissionByIdRequestObject struct {
+	MissionId int64 `json:"missionId"`
+}
+
+type CancelMissionByIdResponseObject interface {
+	VisitCancelMissionByIdResponse(w http.ResponseWriter) error
+}
+
+type CancelMissionById204Response struct {
+}
+
+func (response CancelMissionById204Response) VisitCancelMissionByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelMissionById400JSONResponse ErrorResponse
+
+func (response CancelMissionById400JSONResponse) VisitCancelMissionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelMissionById404JSONResponse ErrorResponse
+
+func (response CancelMissionById404JSONResponse) VisitCancelMissionByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAvailableSerialPortsRequestObject struct {
+}
+
+type ListAvailableSerialPortsResponseObject interface {
+	VisitListAvailableSerialPortsResponse(w http.ResponseWriter) error
+}
+
+type ListAvailableSerialPorts200JSONResponse SerialPortListResponse
+
+func (response ListAvailableSerialPorts200JSONResponse) VisitListAvailableSerialPortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAvailableSerialPorts400JSONResponse ErrorResponse
+
+func (response ListAvailableSerialPorts400JSONResponse) VisitListAvailableSerialPortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRailMapRequestObject struct {
+}
+
+type GetRailMapResponseObject interface {
+	VisitGetRailMapResponse(w http.ResponseWriter) error
+}
+
+type GetRailMap200JSONResponse RailMapResponse
+
+func (response GetRailMap200JSONResponse) VisitGetRailMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRailMapRequestObject struct {
+	Body *UpdateRailMapJSONRequestBody
+}
+
+type UpdateRailMapResponseObject interface {
+	VisitUpdateRailMapResponse(w http.ResponseWriter) error
+}
+
+type UpdateRailMap200JSONResponse RailMapResponse
+
+func (response UpdateRailMap200JSONResponse) VisitUpdateRailMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRailMap400JSONResponse ErrorResponse
+
+func (response UpdateRailMap400JSONResponse) VisitUpdateRailMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRailMap409JSONResponse ErrorResponse
+
+func (response UpdateRailMap409JSONResponse) VisitUpdateRailMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRailMapTagRequestObject struct {
+	Body *CreateRailMapTagJSONRequestBody
+}
+
+type CreateRailMapTagResponseObject interface {
+	VisitCreateRailMapTagResponse(w http.ResponseWriter) error
+}
+
+type CreateRailMapTag201JSONResponse RailMapTagResponse
+
+func (response CreateRailMapTag201JSONResponse) VisitCreateRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRailMapTag400JSONResponse ErrorResponse
+
+func (response CreateRailMapTag400JSONResponse) VisitCreateRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRailMapTag409JSONResponse ErrorResponse
+
+func (response CreateRailMapTag409JSONResponse) VisitCreateRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRailMapTagRequestObject struct {
+	TagId int64 `json:"tagId"`
+}
+
+type DeleteRailMapTagResponseObject interface {
+	VisitDeleteRailMapTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteRailMapTag204Response struct {
+}
+
+func (response DeleteRailMapTag204Response) VisitDeleteRailMapTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRailMapTag404JSONResponse ErrorResponse
+
+func (response DeleteRailMapTag404JSONResponse) VisitDeleteRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRailMapTagRequestObject struct {
+	TagId int64 `json:"tagId"`
+	Body  *UpdateRailMapTagJSONRequestBody
+}
+
+type UpdateRailMapTagResponseObject interface {
+	VisitUpdateRailMapTagResponse(w http.ResponseWriter) error
+}
+
+type UpdateRailMapTag200JSONResponse RailMapTagResponse
+
+func (response UpdateRailMapTag200JSONResponse) VisitUpdateRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRailMapTag400JSONResponse ErrorResponse
+
+func (response UpdateRailMapTag400JSONResponse) VisitUpdateRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRailMapTag404JSONResponse ErrorResponse
+
+func (response UpdateRailMapTag404JSONResponse) VisitUpdateRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRailMapTag409JSONResponse ErrorResponse
+
+func (response UpdateRailMapTag409JSONResponse) VisitUpdateRailMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRobotStateRequestObject struct {
+}
+
+type GetRobotStateResponseObject interface {
+	VisitGetRobotStateResponse(w http.ResponseWriter) error
+}
+
+type GetRobotState200JSONResponse RobotStateResponse
+
+func (response GetRobotState200JSONResponse) VisitGetRobotStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRobotState400JSONResponse ErrorResponse
+
+func (response GetRobotState400JSONResponse) VisitGetRobotStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSchedulesRequestObject struct {
+	Params ListSchedulesParams
+}
+
+type ListSchedulesResponseObject interface {
+	VisitListSchedulesResponse(w http.ResponseWriter) error
+}
+
+type ListSchedules200JSONResponse SchedulesListResponse
+
+func (response ListSchedules200JSONResponse) VisitListSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSchedules400JSONResponse ErrorResponse
+
+func (response ListSchedules400JSONResponse) VisitListSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScheduleRequestObject struct {
+	Body *CreateScheduleJSONRequestBody
+}
+
+type CreateScheduleResponseObject interface {
+	VisitCreateScheduleResponse(w http.ResponseWriter) error
+}
+
+type CreateSchedule201JSONResponse ScheduleResponse
+
+func (response CreateSchedule201JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchedule400JSONResponse ErrorResponse
+
+func (response CreateSchedule400JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScheduleRequestObject struct {
+	ScheduleId int64 `json:"scheduleId"`
+}
+
+type DeleteScheduleResponseObject interface {
+	VisitDeleteScheduleResponse(w http.ResponseWriter) error
+}
+
+type DeleteSchedule204Response struct {
+}
+
+func (response DeleteSchedule204Response) VisitDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSchedule404JSONResponse ErrorResponse
+
+func (response DeleteSchedule404JSONResponse) VisitDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScheduleByIdRequestObject struct {
+	ScheduleId int64 `json:"scheduleId"`
+}
+
+type GetScheduleByIdResponseObject interface {
+	VisitGetScheduleByIdResponse(w http.ResponseWriter) error
+}
+
+type GetScheduleById200JSONResponse ScheduleResponse
+
+func (response GetScheduleById200JSONResponse) VisitGetScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScheduleById404JSONResponse ErrorResponse
+
+func (response GetScheduleById404JSONResponse) VisitGetScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateScheduleRequestObject struct {
+	ScheduleId int64 `json:"scheduleId"`
+	Body       *UpdateScheduleJSONRequestBody
+}
+
+type UpdateScheduleResponseObject interface {
+	VisitUpdateScheduleResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchedule200JSONResponse ScheduleResponse
+
+func (response UpdateSchedule200JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchedule400JSONResponse ErrorResponse
+
+func (response UpdateSchedule400JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchedule404JSONResponse ErrorResponse
+
+func (response UpdateSchedule404JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLimitSwitchStateRequestObject struct {
+}
+
+type GetLimitSwitchStateResponseObject interface {
+	VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error
+}
+
+type GetLimitSwitchState200JSONResponse LimitSwitchState
+
+func (response GetLimitSwitchState200JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLimitSwitchState400JSONResponse ErrorResponse
+
+func (response GetLimitSwitchState400JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemInfoRequestObject struct {
+}
+
+type GetSystemInfoResponseObject interface {
+	VisitGetSystemInfoResponse(w http.ResponseWriter) error
+}
+
+type GetSystemInfo200JSONResponse SystemInfo
+
+func (response GetSystemInfo200JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemInfo400JSONResponse ErrorResponse
+
+func (response GetSystemInfo400JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RebootSystemRequestObject struct {
+}
+
+type RebootSystemResponseObject interface {
+	VisitRebootSystemResponse(w http.ResponseWriter) error
+}
+
+type RebootSystem204Response struct {
+}
+
+func (response RebootSystem204Response) VisitRebootSystemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RebootSystem400JSONResponse ErrorResponse
+
+func (response RebootSystem400JSONResponse) VisitRebootSystemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemStatusRequestObject struct {
+}
+
+type GetSystemStatusResponseObject interface {
+	VisitGetSystemStatusResponse(w http.ResponseWriter) error
+}
+
+type GetSystemStatus200JSONResponse SystemStatus
+
+func (response GetSystemStatus200JSONResponse) VisitGetSystemStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemStatus400JSONResponse ErrorResponse
+
+func (response GetSystemStatus400JSONResponse) VisitGetSystemStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopEmergencyRequestObject struct {
+}
+
+type StopEmergencyResponseObject interface {
+	VisitStopEmergencyResponse(w http.ResponseWriter) error
+}
+
+type StopEmergency204Response struct {
+}
+
+func (response StopEmergency204Response) VisitStopEmergencyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type StopEmergency400JSONResponse ErrorResponse
+
+func (response StopEmergency400JSONResponse) VisitStopEmergencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionRequestObject struct {
+}
+
+type GetVersionResponseObject interface {
+	VisitGetVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVersion200JSONResponse Version
+
+func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersion400JSONResponse ErrorResponse
+
+func (response GetVersion400JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Delete all deactive alarms
+	// (DELETE /alarms)
+	DeleteDeactiveAlarms(ctx context.Context, request DeleteDeactiveAlarmsRequestObject) (DeleteDeactiveAlarmsResponseObject, error)
+	// List alarms
+	// (GET /alarms)
+	ListAlarms(ctx context.Context, request ListAlarmsRequestObject) (ListAlarmsResponseObject, error)
+	// List all commands
+	// (GET /commands)
+	ListCommands(ctx context.Context, request ListCommandsRequestObject) (ListCommandsResponseObject, error)
+	// Create a command
+	// (POST /commands)
+	CreateCommand(ctx context.Context, request CreateCommandRequestObject) (CreateCommandResponseObject, error)
+	// Create commands in a batch
+	// (POST /commands/batch)
+	CreateCommands(ctx context.Context, request CreateCommandsRequestObject) (CreateCommandsResponseObject, error)
+	// Cancel a command by request ID
+	// (POST /commands/cancel)
+	CancelCommandByRequestId(ctx context.Context, request CancelCommandByRequestIdRequestObject) (CancelCommandByRequestIdResponseObject, error)
+	// Export commands
+	// (GET /commands/export)
+	ExportCommands(ctx context.Context, request ExportCommandsRequestObject) (ExportCommandsResponseObject, error)
+	// Get current processing command
+	// (GET /commands/processing)
+	GetCurrentProcessingCommand(ctx context.Context, request GetCurrentProcessingCommandRequestObject) (GetCurrentProcessingCommandResponseObject, error)
+	// Cancel current processing command
 	// (POST /commands/processing/cancel)
 	CancelCurrentProcessingCommand(ctx context.Context, request CancelCurrentProcessingCommandRequestObject) (CancelCurrentProcessingCommandResponseObject, error)
+	// Stream command progress
+	// (GET /commands/processing/progress)
+	StreamCommandProgress(ctx context.Context, request StreamCommandProgressRequestObject) (StreamCommandProgressResponseObject, error)
+	// Get command queue state
+	// (GET /commands/queue)
+	GetCommandQueueState(ctx context.Context, request GetCommandQueueStateRequestObject) (GetCommandQueueStateResponseObject, error)
+	// Pause command queue
+	// (POST /commands/queue/pause)
+	PauseCommandQueue(ctx context.Context, request PauseCommandQueueRequestObject) (PauseCommandQueueResponseObject, error)
+	// Resume command queue
+	// (POST /commands/queue/resume)
+	ResumeCommandQueue(ctx context.Context, request ResumeCommandQueueRequestObject) (ResumeCommandQueueResponseObject, error)
+	// Get command stats
+	// (GET /commands/stats)
+	GetCommandStats(ctx context.Context, request GetCommandStatsRequestObject) (GetCommandStatsResponseObject, error)
 	// Delete a command by ID
 	// (DELETE /commands/{commandId})
 	DeleteCommandById(ctx context.Context, request DeleteCommandByIdRequestObject) (DeleteCommandByIdResponseObject, error)
 	// Get a command by ID
 	// (GET /commands/{commandId})
 	GetCommandById(ctx context.Context, request GetCommandByIdRequestObject) (GetCommandByIdResponseObject, error)
+	// Update a queued command
+	// (PATCH /commands/{commandId})
+	UpdateQueuedCommand(ctx context.Context, request UpdateQueuedCommandRequestObject) (UpdateQueuedCommandResponseObject, error)
+	// Cancel a command by ID
+	// (POST /commands/{commandId}/cancel)
+	CancelCommandById(ctx context.Context, request CancelCommandByIdRequestObject) (CancelCommandByIdResponseObject, error)
+	// Move a queued command
+	// (POST /commands/{commandId}/move)
+	MoveQueuedCommand(ctx context.Context, request MoveQueuedCommandRequestObject) (MoveQueuedCommandResponseObject, error)
 	// Get the cloud configuration
 	// (GET /configs/cloud)
 	GetCloudConfig(ctx context.Context, request GetCloudConfigRequestObject) (GetCloudConfigResponseObject, error)
@@ -3806,12 +6814,54 @@ type StrictServerInterface interface {
 	// Get the health of the server
 	// (GET /health)
 	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// List all missions
+	// (GET /missions)
+	ListMissions(ctx context.Context, request ListMissionsRequestObject) (ListMissionsResponseObject, error)
+	// Create a mission
+	// (POST /missions)
+	CreateMission(ctx context.Context, request CreateMissionRequestObject) (CreateMissionResponseObject, error)
+	// Get a mission by ID
+	// (GET /missions/{missionId})
+	GetMissionById(ctx context.Context, request GetMissionByIdRequestObject) (GetMissionByIdResponseObject, error)
+	// Cancel a mission by ID
+	// (POST /missions/{missionId}/cancel)
+	CancelMissionById(ctx context.Context, request CancelMissionByIdRequestObject) (CancelMissionByIdResponseObject, error)
 	// List available serial ports
 	// (GET /peripherals/serials)
 	ListAvailableSerialPorts(ctx context.Context, request ListAvailableSerialPortsRequestObject) (ListAvailableSerialPortsResponseObject, error)
+	// Get the rail map
+	// (GET /railmap)
+	GetRailMap(ctx context.Context, request GetRailMapRequestObject) (GetRailMapResponseObject, error)
+	// Update the rail map
+	// (PUT /railmap)
+	UpdateRailMap(ctx context.Context, request UpdateRailMapRequestObject) (UpdateRailMapResponseObject, error)
+	// Create a rail map tag
+	// (POST /railmap/tags)
+	CreateRailMapTag(ctx context.Context, request CreateRailMapTagRequestObject) (CreateRailMapTagResponseObject, error)
+	// Delete a rail map tag
+	// (DELETE /railmap/tags/{tagId})
+	DeleteRailMapTag(ctx context.Context, request DeleteRailMapTagRequestObject) (DeleteRailMapTagResponseObject, error)
+	// Update a rail map tag
+	// (PUT /railmap/tags/{tagId})
+	UpdateRailMapTag(ctx context.Context, request UpdateRailMapTagRequestObject) (UpdateRailMapTagResponseObject, error)
 	// Get robot state
 	// (GET /robot-state)
 	GetRobotState(ctx context.Context, request GetRobotStateRequestObject) (GetRobotStateResponseObject, error)
+	// List all schedules
+	// (GET /schedules)
+	ListSchedules(ctx context.Context, request ListSchedulesRequestObject) (ListSchedulesResponseObject, error)
+	// Create a schedule
+	// (POST /schedules)
+	CreateSchedule(ctx context.Context, request CreateScheduleRequestObject) (CreateScheduleResponseObject, error)
+	// Delete a schedule
+	// (DELETE /schedules/{scheduleId})
+	DeleteSchedule(ctx context.Context, request DeleteScheduleRequestObject) (DeleteScheduleResponseObject, error)
+	// Get a schedule by ID
+	// (GET /schedules/{scheduleId})
+	GetScheduleById(ctx context.Context, request GetScheduleByIdRequestObject) (GetScheduleByIdResponseObject, error)
+	// Update a schedule
+	// (PUT /schedules/{scheduleId})
+	UpdateSchedule(ctx context.Context, request UpdateScheduleRequestObject) (UpdateScheduleResponseObject, error)
 	// Get the limit switch state
 	// (GET /states/limit-switch)
 	GetLimitSwitchState(ctx context.Context, request GetLimitSwitchStateRequestObject) (GetLimitSwitchStateResponseObject, error)
@@ -3832,52 +6882,673 @@ type StrictServerInterface interface {
 	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// DeleteDeactiveAlarms operation middleware
+func (sh *strictHandler) DeleteDeactiveAlarms(w http.ResponseWriter, r *http.Request) {
+	var request DeleteDeactiveAlarmsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDeactiveAlarms(ctx, request.(DeleteDeactiveAlarmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDeactiveAlarms")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDeactiveAlarmsResponseObject); ok {
+		if err := validResponse.VisitDeleteDeactiveAlarmsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAlarms operation middleware
+func (sh *strictHandler) ListAlarms(w http.ResponseWriter, r *http.Request, params ListAlarmsParams) {
+	var request ListAlarmsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAlarms(ctx, request.(ListAlarmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAlarms")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAlarmsResponseObject); ok {
+		if err := validResponse.VisitListAlarmsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCommands operation middleware
+func (sh *strictHandler) ListCommands(w http.ResponseWriter, r *http.Request, params ListCommandsParams) {
+	var request ListCommandsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCommands(ctx, request.(ListCommandsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCommands")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCommandsResponseObject); ok {
+		if err := validResponse.VisitListCommandsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCommand operation middleware
+func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request, params CreateCommandParams) {
+	var request CreateCommandRequestObject
+
+	request.Params = params
+
+	var body CreateCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCommand(ctx, request.(CreateCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCommandResponseObject); ok {
+		if err := validResponse.VisitCreateCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCommands operation middleware
+func (sh *strictHandler) CreateCommands(w http.ResponseWriter, r *http.Request) {
+	var request CreateCommandsRequestObject
+
+	var body CreateCommandsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCommands(ctx, request.(CreateCommandsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCommands")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCommandsResponseObject); ok {
+		if err := validResponse.VisitCreateCommandsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelCommandByRequestId operation middleware
+func (sh *strictHandler) CancelCommandByRequestId(w http.ResponseWriter, r *http.Request) {
+	var request CancelCommandByRequestIdRequestObject
+
+	var body CancelCommandByRequestIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelCommandByRequestId(ctx, request.(CancelCommandByRequestIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelCommandByRequestId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelCommandByRequestIdResponseObject); ok {
+		if err := validResponse.VisitCancelCommandByRequestIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportCommands operation middleware
+func (sh *strictHandler) ExportCommands(w http.ResponseWriter, r *http.Request, params ExportCommandsParams) {
+	var request ExportCommandsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportCommands(ctx, request.(ExportCommandsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportCommands")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportCommandsResponseObject); ok {
+		if err := validResponse.VisitExportCommandsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentProcessingCommand operation middleware
+func (sh *strictHandler) GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentProcessingCommandRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentProcessingCommand(ctx, request.(GetCurrentProcessingCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentProcessingCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentProcessingCommandResponseObject); ok {
+		if err := validResponse.VisitGetCurrentProcessingCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelCurrentProcessingCommand operation middleware
+func (sh *strictHandler) CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
+	var request CancelCurrentProcessingCommandRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelCurrentProcessingCommand(ctx, request.(CancelCurrentProcessingCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelCurrentProcessingCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelCurrentProcessingCommandResponseObject); ok {
+		if err := validResponse.VisitCancelCurrentProcessingCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamCommandProgress operation middleware
+func (sh *strictHandler) StreamCommandProgress(w http.ResponseWriter, r *http.Request) {
+	var request StreamCommandProgressRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamCommandProgress(ctx, request.(StreamCommandProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamCommandProgress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamCommandProgressResponseObject); ok {
+		if err := validResponse.VisitStreamCommandProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommandQueueState operation middleware
+func (sh *strictHandler) GetCommandQueueState(w http.ResponseWriter, r *http.Request) {
+	var request GetCommandQueueStateRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandQueueState(ctx, request.(GetCommandQueueStateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandQueueState")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandQueueStateResponseObject); ok {
+		if err := validResponse.VisitGetCommandQueueStateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PauseCommandQueue operation middleware
+func (sh *strictHandler) PauseCommandQueue(w http.ResponseWriter, r *http.Request) {
+	var request PauseCommandQueueRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseCommandQueue(ctx, request.(PauseCommandQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseCommandQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseCommandQueueResponseObject); ok {
+		if err := validResponse.VisitPauseCommandQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeCommandQueue operation middleware
+func (sh *strictHandler) ResumeCommandQueue(w http.ResponseWriter, r *http.Request) {
+	var request ResumeCommandQueueRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeCommandQueue(ctx, request.(ResumeCommandQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeCommandQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeCommandQueueResponseObject); ok {
+		if err := validResponse.VisitResumeCommandQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommandStats operation middleware
+func (sh *strictHandler) GetCommandStats(w http.ResponseWriter, r *http.Request, params GetCommandStatsParams) {
+	var request GetCommandStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandStats(ctx, request.(GetCommandStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandStatsResponseObject); ok {
+		if err := validResponse.VisitGetCommandStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCommandById operation middleware
+func (sh *strictHandler) DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request DeleteCommandByIdRequestObject
+
+	request.CommandId = commandId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCommandById(ctx, request.(DeleteCommandByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCommandById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCommandByIdResponseObject); ok {
+		if err := validResponse.VisitDeleteCommandByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommandById operation middleware
+func (sh *strictHandler) GetCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request GetCommandByIdRequestObject
+
+	request.CommandId = commandId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandById(ctx, request.(GetCommandByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandByIdResponseObject); ok {
+		if err := validResponse.VisitGetCommandByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateQueuedCommand operation middleware
+func (sh *strictHandler) UpdateQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request UpdateQueuedCommandRequestObject
+
+	request.CommandId = commandId
+
+	var body UpdateQueuedCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateQueuedCommand(ctx, request.(UpdateQueuedCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateQueuedCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateQueuedCommandResponseObject); ok {
+		if err := validResponse.VisitUpdateQueuedCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelCommandById operation middleware
+func (sh *strictHandler) CancelCommandById(w http.ResponseWriter, r *http.Request, commandId int64) {
+	var request CancelCommandByIdRequestObject
+
+	request.CommandId = commandId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelCommandById(ctx, request.(CancelCommandByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelCommandById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelCommandByIdResponseObject); ok {
+		if err := validResponse.VisitCancelCommandByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MoveQueuedCommand operation middleware
+func (sh *strictHandler) MoveQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request MoveQueuedCommandRequestObject
+
+	request.CommandId = commandId
+
+	var body MoveQueuedCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveQueuedCommand(ctx, request.(MoveQueuedCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveQueuedCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MoveQueuedCommandResponseObject); ok {
+		if err := validResponse.VisitMoveQueuedCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCloudConfig operation middleware
+func (sh *strictHandler) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetCloudConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCloudConfig(ctx, request.(GetCloudConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCloudConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCloudConfigResponseObject); ok {
+		if err := validResponse.VisitGetCloudConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCloudConfig operation middleware
+func (sh *strictHandler) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateCloudConfigRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body UpdateCloudConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCloudConfig(ctx, request.(UpdateCloudConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCloudConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCloudConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateCloudConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// GetCommandConfig operation middleware
+func (sh *strictHandler) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetCommandConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandConfig(ctx, request.(GetCommandConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandConfigResponseObject); ok {
+		if err := validResponse.VisitGetCommandConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// UpdateCommandConfig operation middleware
+func (sh *strictHandler) UpdateCommandConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateCommandConfigRequestObject
+
+	var body UpdateCommandConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCommandConfig(ctx, request.(UpdateCommandConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCommandConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCommandConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateCommandConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// DeleteDeactiveAlarms operation middleware
-func (sh *strictHandler) DeleteDeactiveAlarms(w http.ResponseWriter, r *http.Request) {
-	var request DeleteDeactiveAlarmsRequestObject
+// GetHardwareConfig operation middleware
+func (sh *strictHandler) GetHardwareConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetHardwareConfigRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDeactiveAlarms(ctx, request.(DeleteDeactiveAlarmsRequestObject))
+		return sh.ssi.GetHardwareConfig(ctx, request.(GetHardwareConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDeactiveAlarms")
+		handler = middleware(handler, "GetHardwareConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDeactiveAlarmsResponseObject); ok {
-		if err := validResponse.VisitDeleteDeactiveAlarmsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetHardwareConfigResponseObject); ok {
+		if err := validResponse.VisitGetHardwareConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3885,25 +7556,30 @@ func (sh *strictHandler) DeleteDeactiveAlarms(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// ListAlarms operation middleware
-func (sh *strictHandler) ListAlarms(w http.ResponseWriter, r *http.Request, params ListAlarmsParams) {
-	var request ListAlarmsRequestObject
+// UpdateHardwareConfig operation middleware
+func (sh *strictHandler) UpdateHardwareConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateHardwareConfigRequestObject
 
-	request.Params = params
+	var body UpdateHardwareConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAlarms(ctx, request.(ListAlarmsRequestObject))
+		return sh.ssi.UpdateHardwareConfig(ctx, request.(UpdateHardwareConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAlarms")
+		handler = middleware(handler, "UpdateHardwareConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAlarmsResponseObject); ok {
-		if err := validResponse.VisitListAlarmsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateHardwareConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateHardwareConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3911,25 +7587,54 @@ func (sh *strictHandler) ListAlarms(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// ListCommands operation middleware
-func (sh *strictHandler) ListCommands(w http.ResponseWriter, r *http.Request, params ListCommandsParams) {
-	var request ListCommandsRequestObject
+// GetHTTPConfig operation middleware
+func (sh *strictHandler) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetHTTPConfigRequestObject
 
-	request.Params = params
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHTTPConfig(ctx, request.(GetHTTPConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHTTPConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHTTPConfigResponseObject); ok {
+		if err := validResponse.VisitGetHTTPConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateHTTPConfig operation middleware
+func (sh *strictHandler) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateHTTPConfigRequestObject
+
+	var body UpdateHTTPConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCommands(ctx, request.(ListCommandsRequestObject))
+		return sh.ssi.UpdateHTTPConfig(ctx, request.(UpdateHTTPConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCommands")
+		handler = middleware(handler, "UpdateHTTPConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCommandsResponseObject); ok {
-		if err := validResponse.VisitListCommandsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateHTTPConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateHTTPConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3937,11 +7642,35 @@ func (sh *strictHandler) ListCommands(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreateCommand operation middleware
-func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
-	var request CreateCommandRequestObject
+// GetLogConfig operation middleware
+func (sh *strictHandler) GetLogConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetLogConfigRequestObject
 
-	var body CreateCommandJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogConfig(ctx, request.(GetLogConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogConfigResponseObject); ok {
+		if err := validResponse.VisitGetLogConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateLogConfig operation middleware
+func (sh *strictHandler) UpdateLogConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateLogConfigRequestObject
+
+	var body UpdateLogConfigJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3949,18 +7678,18 @@ func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCommand(ctx, request.(CreateCommandRequestObject))
+		return sh.ssi.UpdateLogConfig(ctx, request.(UpdateLogConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCommand")
+		handler = middleware(handler, "UpdateLogConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCommandResponseObject); ok {
-		if err := validResponse.VisitCreateCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateLogConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateLogConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3968,23 +7697,23 @@ func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetCurrentProcessingCommand operation middleware
-func (sh *strictHandler) GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
-	var request GetCurrentProcessingCommandRequestObject
+// GetBatteryMonitoringConfig operation middleware
+func (sh *strictHandler) GetBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetBatteryMonitoringConfigRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCurrentProcessingCommand(ctx, request.(GetCurrentProcessingCommandRequestObject))
+		return sh.ssi.GetBatteryMonitoringConfig(ctx, request.(GetBatteryMonitoringConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCurrentProcessingCommand")
+		handler = middleware(handler, "GetBatteryMonitoringConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCurrentProcessingCommandResponseObject); ok {
-		if err := validResponse.VisitGetCurrentProcessingCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetBatteryMonitoringConfigResponseObject); ok {
+		if err := validResponse.VisitGetBatteryMonitoringConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3992,23 +7721,30 @@ func (sh *strictHandler) GetCurrentProcessingCommand(w http.ResponseWriter, r *h
 	}
 }
 
-// CancelCurrentProcessingCommand operation middleware
-func (sh *strictHandler) CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
-	var request CancelCurrentProcessingCommandRequestObject
+// UpdateBatteryMonitoringConfig operation middleware
+func (sh *strictHandler) UpdateBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateBatteryMonitoringConfigRequestObject
+
+	var body UpdateBatteryMonitoringConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelCurrentProcessingCommand(ctx, request.(CancelCurrentProcessingCommandRequestObject))
+		return sh.ssi.UpdateBatteryMonitoringConfig(ctx, request.(UpdateBatteryMonitoringConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelCurrentProcessingCommand")
+		handler = middleware(handler, "UpdateBatteryMonitoringConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CancelCurrentProcessingCommandResponseObject); ok {
-		if err := validResponse.VisitCancelCurrentProcessingCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateBatteryMonitoringConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateBatteryMonitoringConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4016,25 +7752,23 @@ func (sh *strictHandler) CancelCurrentProcessingCommand(w http.ResponseWriter, r
 	}
 }
 
-// DeleteCommandById operation middleware
-func (sh *strictHandler) DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
-	var request DeleteCommandByIdRequestObject
-
-	request.CommandId = commandId
+// GetWifiConfig operation middleware
+func (sh *strictHandler) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetWifiConfigRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCommandById(ctx, request.(DeleteCommandByIdRequestObject))
+		return sh.ssi.GetWifiConfig(ctx, request.(GetWifiConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCommandById")
+		handler = middleware(handler, "GetWifiConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCommandByIdResponseObject); ok {
-		if err := validResponse.VisitDeleteCommandByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWifiConfigResponseObject); ok {
+		if err := validResponse.VisitGetWifiConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4042,25 +7776,30 @@ func (sh *strictHandler) DeleteCommandById(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetCommandById operation middleware
-func (sh *strictHandler) GetCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
-	var request GetCommandByIdRequestObject
+// UpdateWifiConfig operation middleware
+func (sh *strictHandler) UpdateWifiConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateWifiConfigRequestObject
 
-	request.CommandId = commandId
+	var body UpdateWifiConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommandById(ctx, request.(GetCommandByIdRequestObject))
+		return sh.ssi.UpdateWifiConfig(ctx, request.(UpdateWifiConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommandById")
+		handler = middleware(handler, "UpdateWifiConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCommandByIdResponseObject); ok {
-		if err := validResponse.VisitGetCommandByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWifiConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateWifiConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4068,23 +7807,23 @@ func (sh *strictHandler) GetCommandById(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetCloudConfig operation middleware
-func (sh *strictHandler) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetCloudConfigRequestObject
+// GetErrorCodes operation middleware
+func (sh *strictHandler) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
+	var request GetErrorCodesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCloudConfig(ctx, request.(GetCloudConfigRequestObject))
+		return sh.ssi.GetErrorCodes(ctx, request.(GetErrorCodesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCloudConfig")
+		handler = middleware(handler, "GetErrorCodes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCloudConfigResponseObject); ok {
-		if err := validResponse.VisitGetCloudConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetErrorCodesResponseObject); ok {
+		if err := validResponse.VisitGetErrorCodesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4092,30 +7831,23 @@ func (sh *strictHandler) GetCloudConfig(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// UpdateCloudConfig operation middleware
-func (sh *strictHandler) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateCloudConfigRequestObject
-
-	var body UpdateCloudConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCloudConfig(ctx, request.(UpdateCloudConfigRequestObject))
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCloudConfig")
+		handler = middleware(handler, "GetHealth")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCloudConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateCloudConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4123,23 +7855,25 @@ func (sh *strictHandler) UpdateCloudConfig(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetCommandConfig operation middleware
-func (sh *strictHandler) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetCommandConfigRequestObject
+// ListMissions operation middleware
+func (sh *strictHandler) ListMissions(w http.ResponseWriter, r *http.Request, params ListMissionsParams) {
+	var request ListMissionsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommandConfig(ctx, request.(GetCommandConfigRequestObject))
+		return sh.ssi.ListMissions(ctx, request.(ListMissionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommandConfig")
+		handler = middleware(handler, "ListMissions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCommandConfigResponseObject); ok {
-		if err := validResponse.VisitGetCommandConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListMissionsResponseObject); ok {
+		if err := validResponse.VisitListMissionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4147,11 +7881,13 @@ func (sh *strictHandler) GetCommandConfig(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// UpdateCommandConfig operation middleware
-func (sh *strictHandler) UpdateCommandConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateCommandConfigRequestObject
+// CreateMission operation middleware
+func (sh *strictHandler) CreateMission(w http.ResponseWriter, r *http.Request, params CreateMissionParams) {
+	var request CreateMissionRequestObject
 
-	var body UpdateCommandConfigJSONRequestBody
+	request.Params = params
+
+	var body CreateMissionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4159,18 +7895,18 @@ func (sh *strictHandler) UpdateCommandConfig(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCommandConfig(ctx, request.(UpdateCommandConfigRequestObject))
+		return sh.ssi.CreateMission(ctx, request.(CreateMissionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCommandConfig")
+		handler = middleware(handler, "CreateMission")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCommandConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateCommandConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateMissionResponseObject); ok {
+		if err := validResponse.VisitCreateMissionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4178,23 +7914,25 @@ func (sh *strictHandler) UpdateCommandConfig(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetHardwareConfig operation middleware
-func (sh *strictHandler) GetHardwareConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetHardwareConfigRequestObject
+// GetMissionById operation middleware
+func (sh *strictHandler) GetMissionById(w http.ResponseWriter, r *http.Request, missionId int64) {
+	var request GetMissionByIdRequestObject
+
+	request.MissionId = missionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHardwareConfig(ctx, request.(GetHardwareConfigRequestObject))
+		return sh.ssi.GetMissionById(ctx, request.(GetMissionByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHardwareConfig")
+		handler = middleware(handler, "GetMissionById")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHardwareConfigResponseObject); ok {
-		if err := validResponse.VisitGetHardwareConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMissionByIdResponseObject); ok {
+		if err := validResponse.VisitGetMissionByIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4202,30 +7940,25 @@ func (sh *strictHandler) GetHardwareConfig(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// UpdateHardwareConfig operation middleware
-func (sh *strictHandler) UpdateHardwareConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateHardwareConfigRequestObject
+// CancelMissionById operation middleware
+func (sh *strictHandler) CancelMissionById(w http.ResponseWriter, r *http.Request, missionId int64) {
+	var request CancelMissionByIdRequestObject
 
-	var body UpdateHardwareConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.MissionId = missionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateHardwareConfig(ctx, request.(UpdateHardwareConfigRequestObject))
+		return sh.ssi.CancelMissionById(ctx, request.(CancelMissionByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateHardwareConfig")
+		handler = middleware(handler, "CancelMissionById")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateHardwareConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateHardwareConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(CancelMissionByIdResponseObject); ok {
+		if err := validResponse.VisitCancelMissionByIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4233,23 +7966,23 @@ func (sh *strictHandler) UpdateHardwareConfig(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetHTTPConfig operation middleware
-func (sh *strictHandler) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetHTTPConfigRequestObject
+// ListAvailableSerialPorts operation middleware
+func (sh *strictHandler) ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request) {
+	var request ListAvailableSerialPortsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHTTPConfig(ctx, request.(GetHTTPConfigRequestObject))
+		return sh.ssi.ListAvailableSerialPorts(ctx, request.(ListAvailableSerialPortsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHTTPConfig")
+		handler = middleware(handler, "ListAvailableSerialPorts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHTTPConfigResponseObject); ok {
-		if err := validResponse.VisitGetHTTPConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListAvailableSerialPortsResponseObject); ok {
+		if err := validResponse.VisitListAvailableSerialPortsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4257,30 +7990,23 @@ func (sh *strictHandler) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateHTTPConfig operation middleware
-func (sh *strictHandler) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateHTTPConfigRequestObject
-
-	var body UpdateHTTPConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetRailMap operation middleware
+func (sh *strictHandler) GetRailMap(w http.ResponseWriter, r *http.Request) {
+	var request GetRailMapRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateHTTPConfig(ctx, request.(UpdateHTTPConfigRequestObject))
+		return sh.ssi.GetRailMap(ctx, request.(GetRailMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateHTTPConfig")
+		handler = middleware(handler, "GetRailMap")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateHTTPConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateHTTPConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRailMapResponseObject); ok {
+		if err := validResponse.VisitGetRailMapResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4288,23 +8014,30 @@ func (sh *strictHandler) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetLogConfig operation middleware
-func (sh *strictHandler) GetLogConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetLogConfigRequestObject
+// UpdateRailMap operation middleware
+func (sh *strictHandler) UpdateRailMap(w http.ResponseWriter, r *http.Request) {
+	var request UpdateRailMapRequestObject
+
+	var body UpdateRailMapJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLogConfig(ctx, request.(GetLogConfigRequestObject))
+		return sh.ssi.UpdateRailMap(ctx, request.(UpdateRailMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLogConfig")
+		handler = middleware(handler, "UpdateRailMap")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLogConfigResponseObject); ok {
-		if err := validResponse.VisitGetLogConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRailMapResponseObject); ok {
+		if err := validResponse.VisitUpdateRailMapResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4312,11 +8045,11 @@ func (sh *strictHandler) GetLogConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateLogConfig operation middleware
-func (sh *strictHandler) UpdateLogConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateLogConfigRequestObject
+// CreateRailMapTag operation middleware
+func (sh *strictHandler) CreateRailMapTag(w http.ResponseWriter, r *http.Request) {
+	var request CreateRailMapTagRequestObject
 
-	var body UpdateLogConfigJSONRequestBody
+	var body CreateRailMapTagJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4324,18 +8057,18 @@ func (sh *strictHandler) UpdateLogConfig(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateLogConfig(ctx, request.(UpdateLogConfigRequestObject))
+		return sh.ssi.CreateRailMapTag(ctx, request.(CreateRailMapTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateLogConfig")
+		handler = middleware(handler, "CreateRailMapTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateLogConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateLogConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateRailMapTagResponseObject); ok {
+		if err := validResponse.VisitCreateRailMapTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4343,23 +8076,25 @@ func (sh *strictHandler) UpdateLogConfig(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetBatteryMonitoringConfig operation middleware
-func (sh *strictHandler) GetBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetBatteryMonitoringConfigRequestObject
+// DeleteRailMapTag operation middleware
+func (sh *strictHandler) DeleteRailMapTag(w http.ResponseWriter, r *http.Request, tagId int64) {
+	var request DeleteRailMapTagRequestObject
+
+	request.TagId = tagId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBatteryMonitoringConfig(ctx, request.(GetBatteryMonitoringConfigRequestObject))
+		return sh.ssi.DeleteRailMapTag(ctx, request.(DeleteRailMapTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBatteryMonitoringConfig")
+		handler = middleware(handler, "DeleteRailMapTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetBatteryMonitoringConfigResponseObject); ok {
-		if err := validResponse.VisitGetBatteryMonitoringConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteRailMapTagResponseObject); ok {
+		if err := validResponse.VisitDeleteRailMapTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4367,11 +8102,13 @@ func (sh *strictHandler) GetBatteryMonitoringConfig(w http.ResponseWriter, r *ht
 	}
 }
 
-// UpdateBatteryMonitoringConfig operation middleware
-func (sh *strictHandler) UpdateBatteryMonitoringConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateBatteryMonitoringConfigRequestObject
+// UpdateRailMapTag operation middleware
+func (sh *strictHandler) UpdateRailMapTag(w http.ResponseWriter, r *http.Request, tagId int64) {
+	var request UpdateRailMapTagRequestObject
 
-	var body UpdateBatteryMonitoringConfigJSONRequestBody
+	request.TagId = tagId
+
+	var body UpdateRailMapTagJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4379,18 +8116,18 @@ func (sh *strictHandler) UpdateBatteryMonitoringConfig(w http.ResponseWriter, r
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateBatteryMonitoringConfig(ctx, request.(UpdateBatteryMonitoringConfigRequestObject))
+		return sh.ssi.UpdateRailMapTag(ctx, request.(UpdateRailMapTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateBatteryMonitoringConfig")
+		handler = middleware(handler, "UpdateRailMapTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateBatteryMonitoringConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateBatteryMonitoringConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRailMapTagResponseObject); ok {
+		if err := validResponse.VisitUpdateRailMapTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4398,23 +8135,23 @@ func (sh *strictHandler) UpdateBatteryMonitoringConfig(w http.ResponseWriter, r
 	}
 }
 
-// GetWifiConfig operation middleware
-func (sh *strictHandler) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetWifiConfigRequestObject
+// GetRobotState operation middleware
+func (sh *strictHandler) GetRobotState(w http.ResponseWriter, r *http.Request) {
+	var request GetRobotStateRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetWifiConfig(ctx, request.(GetWifiConfigRequestObject))
+		return sh.ssi.GetRobotState(ctx, request.(GetRobotStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetWifiConfig")
+		handler = middleware(handler, "GetRobotState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetWifiConfigResponseObject); ok {
-		if err := validResponse.VisitGetWifiConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRobotStateResponseObject); ok {
+		if err := validResponse.VisitGetRobotStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4422,30 +8159,25 @@ func (sh *strictHandler) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateWifiConfig operation middleware
-func (sh *strictHandler) UpdateWifiConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateWifiConfigRequestObject
+// ListSchedules operation middleware
+func (sh *strictHandler) ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams) {
+	var request ListSchedulesRequestObject
 
-	var body UpdateWifiConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateWifiConfig(ctx, request.(UpdateWifiConfigRequestObject))
+		return sh.ssi.ListSchedules(ctx, request.(ListSchedulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateWifiConfig")
+		handler = middleware(handler, "ListSchedules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateWifiConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateWifiConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSchedulesResponseObject); ok {
+		if err := validResponse.VisitListSchedulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4453,23 +8185,30 @@ func (sh *strictHandler) UpdateWifiConfig(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetErrorCodes operation middleware
-func (sh *strictHandler) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
-	var request GetErrorCodesRequestObject
+// CreateSchedule operation middleware
+func (sh *strictHandler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	var request CreateScheduleRequestObject
+
+	var body CreateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetErrorCodes(ctx, request.(GetErrorCodesRequestObject))
+		return sh.ssi.CreateSchedule(ctx, request.(CreateScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetErrorCodes")
+		handler = middleware(handler, "CreateSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetErrorCodesResponseObject); ok {
-		if err := validResponse.VisitGetErrorCodesResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateScheduleResponseObject); ok {
+		if err := validResponse.VisitCreateScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4477,23 +8216,25 @@ func (sh *strictHandler) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// DeleteSchedule operation middleware
+func (sh *strictHandler) DeleteSchedule(w http.ResponseWriter, r *http.Request, scheduleId int64) {
+	var request DeleteScheduleRequestObject
+
+	request.ScheduleId = scheduleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.DeleteSchedule(ctx, request.(DeleteScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "DeleteSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteScheduleResponseObject); ok {
+		if err := validResponse.VisitDeleteScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4501,23 +8242,25 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListAvailableSerialPorts operation middleware
-func (sh *strictHandler) ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request) {
-	var request ListAvailableSerialPortsRequestObject
+// GetScheduleById operation middleware
+func (sh *strictHandler) GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int64) {
+	var request GetScheduleByIdRequestObject
+
+	request.ScheduleId = scheduleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAvailableSerialPorts(ctx, request.(ListAvailableSerialPortsRequestObject))
+		return sh.ssi.GetScheduleById(ctx, request.(GetScheduleByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAvailableSerialPorts")
+		handler = middleware(handler, "GetScheduleById")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAvailableSerialPortsResponseObject); ok {
-		if err := validResponse.VisitListAvailableSerialPortsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetScheduleByIdResponseObject); ok {
+		if err := validResponse.VisitGetScheduleByIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4525,23 +8268,32 @@ func (sh *strictHandler) ListAvailableSerialPorts(w http.ResponseWriter, r *http
 	}
 }
 
-// GetRobotState operation middleware
-func (sh *strictHandler) GetRobotState(w http.ResponseWriter, r *http.Request) {
-	var request GetRobotStateRequestObject
+// UpdateSchedule operation middleware
+func (sh *strictHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request, scheduleId int64) {
+	var request UpdateScheduleRequestObject
+
+	request.ScheduleId = scheduleId
+
+	var body UpdateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRobotState(ctx, request.(GetRobotStateRequestObject))
+		return sh.ssi.UpdateSchedule(ctx, request.(UpdateScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRobotState")
+		handler = middleware(handler, "UpdateSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRobotStateResponseObject); ok {
-		if err := validResponse.VisitGetRobotStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateScheduleResponseObject); ok {
+		if err := validResponse.VisitUpdateScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4696,125 +8448,262 @@ func (sh *strictHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdb3PbNpP/Khze3UyfGdqWZDl1/U6RnVZXJ3Ytpb25nieFSUjCE4pgCciOn46+032G",
-	"+2Q3+EMSJAEQtCVFuXtmMhPbBIHF/nYXC2B3+Zcf4lWKE5hQ4l/85acgAytIYcZ/uwULyP6PIAkzlFKE",
-	"E//Cny2hl4IF9JL16gFmfuAj9uc/1zB79gM/ASvoX/ishR/4JFzCFRCdzME6pv5FP/DnOFsB6l/4a5RQ",
-	"P/BXKEGr9Yo/o88pex8lFC5g5m82Aadjiv5hoEWQ4eG5hyhcES+FmSdHNxHGO9MT1+tI3SbvhnNsdDvG",
-	"yRwtOC8znMKMIsifwAQ8xJoZ/LaEdAkzj2JPNPHoEnqjW2+FI0Yj/AJWKXuRZmtYjP+AcQxB4gf+lyOc",
-	"RTDzL/qbwEepnkWTWw9EUQYJ8eY4M43g938YHPffnB/3j/t+MRShGUoW6kjDTeCngJAnnEUm8RBPraMV",
-	"XViGOmXsJcgwzHQ6ubQOkYHnB0xtAwwYgBn8c40yGPkXv+c4yWEDlUqU+vdFV/jh7zCk/ibwRzHIVpeA",
-	"ckHCCbyZ+xe//+X/awbn/oX/Lyelhp1ISTlhrd8CSmH2/CuOKVjAa/zkb4Kub/2EFssur41hHL/+1Y60",
-	"Km9eovm806vrLIMJ7UrrDK7Sru/cwiyECe04t58giOmSv3Sfi8IdJClOCGzaABBS9AgojEZUL9CyAcKJ",
-	"FwEKmU1jog1YtxXBHvQGZ0e9/tGgN+v3Lk57F73ef/qK5WJvH1G0gjbZP9sErCGXW9t8SwFnL8DWWRRN",
-	"us2jf9GzziNZx7GwoRVT2JzXG2YJDSYDRUZi1HUJJfTN0G+Y+5q5XUFCjEsk79/Lm6iTlrLjPQql8BDx",
-	"Yvx04fVPj8/ajKF46IDXjDWsGzdUGNuSeCkDQUU66zgb7d5M0tOcP2vPeF3wOWFL6O/+g5j9Jzn7TzF+",
-	"8oPGX5dMfcs/hzCOnZ5Ve6s8ipjxUZ4J21LvjcJVWv9bKmxDrfMl133+x3sdagt8VP1jzjNyjQg1Wwnu",
-	"xeh5GiNCC54StiTlbVvloRivECIfZBl4ri6GgU8xBfHETAJ/rvhcBSmlHvV6dsWpCaUyYj4hrbil6Rgn",
-	"CQwFMXWuhTFeR9UGNp6Ma803gQ9JOoUZArF7L1fT28YrzDlCYdeebidjXU/ZHEUfyYN7P3fvJpcfp2/V",
-	"XmrsrjNKP3H9JHQE6bDSr/yvd42l6nlMrwvzyfQaZjAJobfCCaJYamAhkHMQk1bXmS4zSJY4NqwbxWPd",
-	"sA+QPkGYcLKEhw1imFHvu1//ptLROz5Tlza8Fo5msb0o1abYWalWZB5jIFYlB++1nI4bPsxd2hE+zJju",
-	"FZlHEK8hh4EPrSVKC9Dw+PRgAbrGTzvCJ8ZPXwkeNrI7OqfH5weGTrk72SI0otP9aU0+oElr5GMJyagC",
-	"yZvDAqTYjW0PDuHk7U1H5HB6FZEPJRL/VkWip4UCfJEnV8wn+3rAvC9YZ0ImrB0StLg5VgdjE6jd8YOA",
-	"zt0pSl3tju31O/dWiiTrTDnUcOypYWg2gb8sjh7cOqkrB/NTyzMPtz7KQ5KyE5oftrh1kZ/NlB08dsZJ",
-	"i9FjV3ya2NRkXOmxSmVTvhoiEjQkugq8wrcKDiquFoVqALEFUyep2KdLoAypN3lKA63ZGxy02ZtSQKHV",
-	"1hk22aozRPIDK8kTdfq/9wdB/u9eOQ2o31JotvzlvH+/X6OE9t/Uj/iluBoolG6BmTbTMUA5cHNYJjzy",
-	"IkY3KH9kGdJlwPP6SaHQN/2AcsV97SQrY35fGl7D5Ukp8+aBzzqPeyZttUEn4SqFGaDrzDbq4KzrqINN",
-	"4K/TyHZcLB97gHoUrWzD+4PeoH/UY/9m/Kz4Rcfe5+VioSco34VYYB90l+3TxkGM1C8JS0lUULUQpbjk",
-	"ylEIrcpbix2qrblbWCtUcdnbTkUd1LBbUZvIBeN//nvs4il/tUViJwcwh3b2Yt3Y988PEpGtOleHddhi",
-	"h2N4KHBgSvHq5oFQEMZwloHwM+OZBg0Ks0tEKEhCDShTCjLqRZDCkKJk4WHZofe0hIkXyfc8RLwHyJhE",
-	"l4gIvm3DnYFfELXRhlMn0sADfoQG0gYvIE2DicrEGt06dMYgW+DxEoaff7mbJOlaBhBVkPkzG+PIsMr+",
-	"cueFOIJMV0LWSzUSBZ4D8vfGKl4nWvbfRt7Nmub0GdrFmEDTJFaY4myaQhi1bSzfly3rlCqdmKllVLTS",
-	"eolxJgbS7y4ilJVXRZrL+fxx7t6ErFMvwjjzOJHKVe34+mZ65Qf+ze3VB0Z2iU/+xOHWs64PXPcji+3U",
-	"0MQ0IH+xQ0gU28UgcrdOEmk3uo2YyRc7jMjjlXJRaTKfP7Ix/hX7ipd72jZCigiNV7ncZ3V1KIU055eK",
-	"VCklbQ4u14hrNKemlZpQ1tEdBNEYr007rvI2WzT3Mggi4uUEcxOFE4IiKSwxmlMvxQRxPcogCJdVwTzt",
-	"Cl7jUrxOt3Xy27VcgZ9PzbA/zSdOseBEIUOvXy9rXCgICZwMKONFq/28xk8wM4nLg9HlsHGv0Z5ZgZ0I",
-	"HqN9z5IXmJhihoFRaZJJM4dBHDuEThqcws194EcwzWDIrEVuqOscR8SbIxhHzLqXrT2QRN4TimPvgSGw",
-	"wo8w8lDCOT5fs71k4K0J9EK8WrGmIRceDyWEQsAVZg+KxpE/HE1j5LSq2k0Kk6/uVTEiWik1+FJCWM3u",
-	"O0NJtCmddXVRfS1MzJ1YAjKhcOXiviwB8UDCw+C7u0mMTy6DIOJh1rRjaLrLVoBZP2+e4ZUy3C93HglB",
-	"ksCqWzJ6O/7y/A97ZPer/KE9OEGS5wVvgrq8leC3+kFLkC2g6b5BHDReoxVqOc2PWZOCDbzPreyBnXx+",
-	"PtwLPf1XgN2Y5XZOmU3HvQKFDh6uDNfTuisyqcMQgFzL+OCBfx6B2SMKqxOOcQjiJSb04qzXO+u3aVW3",
-	"VBbjsC5Wg+LPMDEFon6GicPkhtFgCM/PH4b90++HD6dDcDY8770Je/3B8GHYOxt0ArE4uco5n5Nog84c",
-	"syqeCc2wMwJmGc5YM+coeGbVY0DoOB9EKMbrQuuFnvG3DEpW0S0OSlhwgDlVBIY4iYivO0U0qk7Bp+aU",
-	"CnpyHmmREJ6bMfgk3zy0Rg3XtpubQL7L3CG3l5XdR2OaBRmVbi0TKp0rt7yjKcWpfKctyeU9foTvcPYE",
-	"sqjDG29B+LnjKzPs2LjuUjq1V0/2nF5Q9tNu7ZXNjhtFlSPTtlemIUiucchzaRxf+Q2gYgb3pawoPrC7",
-	"sOQvdZCWLq/k4tLlnRl2bd1w/90lptMb6rGDu8x0I6p6kN1FalzfYWJTtFXkxpyowjqJocXlkg3qaWBy",
-	"H/3qPK8fmPnNoM3p4487jm8+vu6pC3FzMP6oOYjzfL53ylsrO1Yz1+yJaqhYJ6ySVllUNoGPS5Ph8F4p",
-	"aD7B6yyEju9NRWNxZpZZwCTiIm8HknQuBqdr17lORWPHhDz5kkjJc9yobEdi+7YMQDnjAq5CTkrkc3lX",
-	"sQkqiq+qYOtepgK4HmP+TCPr5ZXUx0v3fLsqXCapomtiHvGXj1cfry79wL+9uxlfTaeTDz/6gT8efRhf",
-	"XYufpx/H46urS97o3WhyzX8QDa6609qeUdmkcTq7uf30/ubXq/dXH2Z+4LMfP727ufttdHeZ//p2NP5Z",
-	"/X12w6m8+/HmE7/fy3/Jr/bEb9eTd7Pyl5vfru7Khj9djX/+9Av7w3Q8+vDp+mY8mk1uWE+/jSazzhN/",
-	"fVqkZIxzYmR9hdtyaqRCjvUI5YUJkWOud8Uc/lxDQjVse5np72zW6nMQJkaOriPfUiHgBRGzeURLBkGE",
-	"kgURx4ggjisJRhUofh8e94PT47NgeDwIhmr4bDP6xSXipUV6IjSfT5IIfmlOYJJEKBSBviKF8AnRpS7R",
-	"EH4JIWTzKyN9quHAHYOA1cRye2SRhhglMG8JqEcztFhAfmuly643JD92DCVqJg8rfKhFTZYMdxe/PAlh",
-	"X+I3PD7dl/zhR5jNcm65CiJHVkidBHbfglfJC+wmcoZ0zh2KnIbH7rInM1f2Z/m+35Pk7Q5hQ0poZ4QD",
-	"f51EL1WPOeQ3xyJ0cOsK4i58uim0SV81FU17bWSsVLMGcXFx5ALWm62sAC4CVRDVRZbe7NxWSH62YPKT",
-	"mtdXRcSWqiIBqeWKukz8bE+wNEjrhE5v1/BI5ragc1tJmazCY83qqeGjJPm4TL+/Z4wq5HXBabBznHIu",
-	"twA1U9JSqzCZk6AkRmoSiZNs7gmcKl2HpT2cqS2QWH1sx/1Ht3n3t+UeWPPFpNiUJLoQtqXF0AJJTrMb",
-	"KlqLthtQhocKyulXBgWRcAdxPFHe7d5CeYoR9x7No53rYQX05NFdU5gQYxLHAwg/t4QdgvBzI+iw+J3w",
-	"zl8LOD+2wk+JnRLWYteUnG4Cf57hhNpJ4U12TUv/NdJpImQ7Mjqsy2iVZ0FVrmrgtgpuhh7hNjOPItZh",
-	"I+movLYobiwqqUfl8x0lHylk7T7vqDbYjlOO1NG+6x31e72/fbWsoxr621WEnSUcXU2NFb7ldc8o/DxD",
-	"K4jXBnZQ8bCMXJRZBaPxzx5KvBWKY1QGyWnu+LV+iKhUXhEKMaVR+Nk5UrOkpOtqTXilyLYLo6KepC76",
-	"TXah0h1oeGoARVdvc4/Blqe7CbbsFAdpDn+8Yk/GOIK2WB4RnN+4nFVqHNtzcUMRwp63N9LRTkNVVsM1",
-	"oXjliVLuMsYmrBd6RxSujj9g+g6vE2tB+T4vYU0BiquXyjaxfYdgHHHa2+4yjAWhdZPQlYaeULjyEsys",
-	"Q8tEBi/gvzKRBvN5XlSTcJEuxT+doNIp/2Bls5EZ5ul/ACu+Zyjm1YUBYgZ2Dvw0mxntd4ozU0UenJX2",
-	"mnXBA82rGSnnvbayx4FPngDbf7ra46lo7n2cdDPHjdSujJmHfHAtW0AWPYEMGutdkNShFHIZFB3DqFWr",
-	"rmFElFJ0KHQokWxYOhh5ogs5tHaO/HzXbH2Ic4yQPMBuVi3An+060cy0ZCPqiL0Wy5R2BXOoAn0Na7Wt",
-	"Se6xt7wlPHvDuiO9J97EQLRRt5BhU/Dj7eTGS1GZyCGip0qesgaDTmxlY5nJc/MPzA57DHn2qLoUd8po",
-	"aY0fleYrF7ba1qNhPF/romiiqQCh5fzqbnudni6pcfv3fK5h9N6YdrjCUX1acg968+6dH/g8lO3t9eTD",
-	"z9UdqHjqFttW6JQmATZy0UhO/os3Wa9Byx0d+d0d+85JsffNPLYYZtTNqMn1gjwTmRTr+Erd9or3Azm0",
-	"lmA0p7bjDnn+d2vN4c5PZItc7hwYNKcvLfvxwlPZcsjdH2tUx3rhqQYF2QK28Fe02SF7X37AoadhR+cb",
-	"dWFscO9lxx38cHv6hGioubJLM0hIu9StEPUI74LJQv5Sx2XzpRCUg+/BDpZzc+aqwbbEZYt+q5FTMKpT",
-	"VOlHS4rMEtKQoDzROAnyqffd3bvJpUfB4m/VAl7rL+CHPm5W8Ap8kc5v/noUoJBX5OBA8kJoVB3wCRBZ",
-	"EUC3tA2P+uez/qATpA2m5TNXabUxr+W+zsrI4soun58U3QyLL9d1KXnwCkUpp7xlSzUwXZuVI7YoCzYX",
-	"jccJwXG7F8V7YC1/AkkUi4i7OXJ68R1S3mocOPDr4ZwKM/Hq0K/8MKQczIvxoqsJzXHTq/PCE8+LjRhI",
-	"0xiVUiE943+fcq94dvUfs6pTLB90u5PhexL4CGM9VYsYP4CYE8dbtdB2efX2449+4E8+vLvhSSh3jKKr",
-	"u7ubuyqtecNuxJrLWIopFBw2CIIqSq+UAiZ5/0dE4OxbEgHx5VNTCCZ7woAyIeTHeEFOxBnwsXhmLbSQ",
-	"Ycrn51RAjMOHYkgYBZ8hTKuOr+1o0lzlgs+1ToiTvL+vlHdquZbUO+qtVfxtV5SchEY9gq9ShUqX6K4r",
-	"RMXaXbpeoXO/aIUfc3fednfudlLRLPjw1bhVqyRgYlZROcIeidBWUKDkOTNFbl4vxYz50KPYqWxtsB3W",
-	"KV6aep/twNGyaIKOma8usTxTg1vqRQuLosZse8Rc3e/CVeMjHluvrOxGUhhDkMGoQdLp16ioXF5w/DO2",
-	"4GBiC3Qf5vxnbEHJn+YHR//JHYU7bBfPjwcsXwWvf9jW+i3fSuNN8TVix29eyfs9WV/KqWJV9ZWi+rjT",
-	"u7Va5awTEZ3b9rISBs1Tp4nbe7UIavGqEm3r8H4jNpd1UkQ+tnZQi5FU7sI1Nx7X7d7AtSgUK64rXNtr",
-	"7zquufAW4zaktVo2JPDj/Pqj/fyxck9Sc6Ls5yvq+Vmd7PK7O0VItxreXUNWJbiCWKC6TXlpz5osBzUl",
-	"tIQRTGej138VhEd3zEbeqhbH5LKHR4bkpclto+LjE5ojr3J5r7iqPwyO+2/Oj/vH/V7vZDBUD/ZQ+jj0",
-	"W0oLpoCQJ5xFpq2weOpEStFVi5knxFQdaTqdXDoNJTbfnc4ri80wHz5QqUX6lCdNzTad160r0mU8hXeP",
-	"VisOVuuxaqbdhEHOVX9Kk5+wju7kobcuN2EdeRmgsMBEOGAGVH54Yw+d4mkIgIK3iBLjnQHwHhAlbgOe",
-	"209DGMYZos8m0WbP7APJ7fiHmw9XfuBf/crL69xc1iLZ5ePu52UtkWoJWDly3j+J4OMJpc8fp297bTcL",
-	"GQSRdffByzfXtyCN8atFQNU9iOsnhmr7kTc8vgmnZvFgTzuIR38LSWBDQxReoTiKSCvkF6JXZbdZQW+l",
-	"KHQKZZTHbpIJkrKXCIVujnZaX13jSSHauc6TwipLAG+zVpqx9JJSR1Vr2ZXKmdrn3CGbJHOs2Syl64/E",
-	"mNU4vv3ordXwMOHbMZ0qU7ZttXUH0j2LJ6n5iCtWnYnKQO0xvyucPVsmIBq8bg6neS2u97wzWzEuOVxj",
-	"oPdvbQMMO1U5Lnt1qG18pluFGRhBiXyVjdW5FoRpxZJTUha7e2mAa4F1uZDdvR9dqzcpXXOv3ENff4UZ",
-	"0R4jPKxRHF1Kp6NxwLrAyouNp4/GZ/VvPsuGgTKc2rmOYqXgbvMkep1xJ+u9yXORz63Hcz2HonHKQCYa",
-	"bSbpNzRHxui81uDvkRL7TShotcfFHqo+C5CKCGPNHDa8jqkwmU0+3onMitHthO8EQyjXF56ocOG/n8yY",
-	"6mSxf+EvKU3JxckJTtnOcZ2F8BhnixP5EjlhbZmFQZSvhpWeCznye8f94x4vkprCBKTIv/BPj3vHPXlr",
-	"xhl3wlPMJfIx1LnLl/zvvFxTBEFI0aNMTOflpHi5B7aLiIqml7LVKG+UydWUDzPoDZtjjJqde4KeyCPr",
-	"MISEzNdx/MxmM+z15JEdlaVMlIvWk78ToUMCyNaUgEq6DwewSthbEHl54UL2lKxXK8AMup0tFCwIFxbx",
-	"h3um/VDj7zBfI5/vHMWUZ/4/PHtF3dMqf1nzgqspyMAKUpgRY53sssnJLTPUpgLLtXZT9A/RtkrsO05g",
-	"Tm5B5TFnEP8YVNHPsTeKY/wEI/EpSHLxX4nnHXmj8Wzy65X4+fIq/40XYfQv/D/X4ixFKkTBg1L7xOFD",
-	"CW1u/EVPfuDnnertP2t+9AgyNgCHp+TnLaOciHVpxMH0A8PjXLz9+83mviHc25NNMXLFG9UI6KhwO6Ws",
-	"HY6GKMKtU4lN4J8U9Ucv/rIqSKxWKm3qxLh8uHetmLLtinJ/xDWD/b5AjzARH/M69j4S6P1x9IdHsUfY",
-	"CyjxWDcw4aUyuQ8iGwVlo4dnb7WOKUpjKPohx96VWHIvvD+OZFXjT4AGQlf+KLROtJZaxxRB/CSayZ/5",
-	"uiJ+LnsSv8twt+L3opoy/4tJX+V+p5Srhh9jsChm7gmCIanwRhjKCnfKdiV/RFXkoKyJ/IfeKIl24uey",
-	"sfi9qJssfhWlk8XPefXkFvsF7SzZpQHRFi22mpBCyQ7OiFQMQG5Kij/diw/VaeyHqEDsAaUiddV8VEoU",
-	"y8UGEvoWR8/bA0JXBnlTdSzZ0rZpCEN/28JgA6H4GgGMCnYdjiBokNTIgbqonKQZZp6jDBfRri8/worx",
-	"FkWYEMljnuNn7wEyCy27gk0B+hFSWaPythhOFafdKncrniqOw/3h+AEXLLVys4oxQ6NIRyq4+SLET0KQ",
-	"hCJk02AZ+HMBvm3Imrngb7kDPjR+doTz5gkQTxAK969rsyXMeC57UoJlx0fy7FUQ/SV/mkQbl11nSdmz",
-	"N7k07Dgl+98+T6KmC8jXZhkmKpfmggTr7kJzgXnZ6RMnuuXdQSDkxvdryIOqtChRAeZflQUJr/zwAEsa",
-	"tXviBmjaFdtokNtAZyb320H8/43Nr8txWSSkaeWdRETYjWSOFuSEf6ivfRnPP+c3Rwt52qiVHuWLlbvE",
-	"SxnGxC8NwYfjc9nZWiLGZ8idcN3F60fxtSBXfETzOkQ78Mrr6LQ543sVjDwL7rAFpBXahoxUdFoaKlfn",
-	"vF2vKx/R3L0lbtFtLdkHqN0G9r5Ev52QkhreAGsHOt7EaY9a7iIkhZ4fuLA4gGzV9aWs39Sq7HnDdm2v",
-	"lYTaIZK1kQxQGig/PIU3svgFGu8Il3hDg9j2dV4H1v6U3k1Ucq0/eJFxQdqu95SmrTrPC9a163tZGW+X",
-	"AJajGMDTUHt4Oq5l6Qv02wEaqdtVdHag1zVg9qjTrSKR6/NBi0YbqlY9jnH7IXqMF+1aXBbL2CFi5SAG",
-	"wJqkHp4K69j5Ag1uR0U0rgKzff2tYbI/9W0Vhlx7D1koWgC16u4KJ4jiDCWLEyUXz6rK+VeWylfbNVsm",
-	"8L0vXtm9npuGNADdOqvDMwIOQLzAJnSGV7xrQ3j7BsMK7v7MR0cZy43JNyRr3QTDamqe0By1Gpc8/c1u",
-	"TpR43x2iq4xiAFRD7eGZCS1LX2AYHKARrWvobF/7q8BsDkwE+AVXruoHGqHcBqtWkXmhgKMQR5BY9RjE",
-	"sfKlA6JT4OKbDuS1CuyUQtX8hEQzvbTBvZufD0yZm3zNYVKREViVH1u1n6iKYvBlSp34LkDzfEV0t8uN",
-	"dLXA/beAh4WBOTD5Z1k5JinMULqEGYjJiUgEdAhuBo8A8bIf9dxBTfh/3rTMGCS7hMyQF3no0AnWmtia",
-	"I6eAJeHjNU2Pim8R2K8lZRgUb10vitrQrrLOyi7h0lRz+Ra0jHNNMFKBRwVDwMN/Jie8ZvARKYo+20+l",
-	"1PLO+RDNY6l6weNdHkjUxzIdUjUpP8BTKh17cwQr2PGUzZM8Qc6KWZGwKrLcDTsEJUl5lxawHMWAk4ba",
-	"w8NJy9ICJ5FOWwEqgw8YU3MA7R1/rvR93MBINJnmubrtoZAfsDeW/DocDjYm2sK4MovZRcYNqX6FeE/L",
-	"NLidCrgcxy7iktiDle6CmXZ8cHoEVzBbwCR8Ngv4lOKUu2e8eiXJQ2BDGPO/yk9GBN6fa7iGEX/cjIhu",
-	"Isu6vSpG/2a1Ymvc0UKlZMObt6DltD3Zvm3F+LXInd+ZNuVDfBObzVYO5uDkeNzzMcQWSARbi4z1E5Ci",
-	"k8e+v7nf/G8AAAD///FS9P2x0QAA",
+	"H4sIAAAAAAAC/+x97XLbOLbgq6C4e6tmbtG25NjuJL+uYjvT2nFij6307N1OKg2LkMUJRXAAyI6my++0",
+	"z7BPtoVPgiRAgrLkKJmumuqJRZA4OF84ODgfv0dTvChwjnJGo9e/RwUkcIEYIuKvU7woMsRQ8pbgBf8h",
+	"QXRK0oKlOI9eR2/TjCEC2ByBKV4sYJ5QMNWvAMgAJgDO9JC79B7lIIEMRXGU8vf/uURkFcVRDhcoeh1N",
+	"K7PFEZ3O0QLyaWeYLCCLXkf87T2WLvgn2Krgb1FG0vwuenyMS3AnuC+wt2iGCeoJ5wSvBSVBsB9K5Qtr",
+	"INSaaX1Ag5GpwOyFSjPFGvBdwTvUBG0yR6CAdwjky8UtIp6J+YjKnAmawWXGotfDuJx/meYsiqNFmqeL",
+	"5UI8U2CkOUN3iBg4btJ/eWCRYAA8AylDCwoKRICa3QeY+JgbuEFv6K7RP5eIsnESRsXblfibyLfA+Iz/",
+	"uQIPiCBD4YeUzT3gEzObDf8Cfr1A+R2bR69PjlykvMFLMkW0F4iSuah8cx98oAj8Fv8GGAYz+dLtCiyW",
+	"GUuLDJXDzr9CLr2vwW+jq6v49OLyw9lvH3PPatRblbU4gGeQLel60KtXO8E340r4//bh/MP5WXx1fXl6",
+	"fnMzfv+X38Aoy/ADSsA9zJaIvv6YA7AH5Dj573Kw/Pt09P70/ML8efPh9PT8/EyPfjsaX5yf2SP1X5Px",
+	"u/Ozz5cfJn7UaaS0426yKtZCHP9SJ9bUoBJl7y5/Of88uYxPR9d/ufx8MX478dNevNwK/aN+KBYwujrF",
+	"+Sy94/8uCC4QYalcGsrhbebQDn+fIzbnq8RADhHrG12BBU5QFEdIwh29ZmSJjNTcYpwhyKH+uodJgkj0",
+	"evgYR2nhVj/jKwCThCBKwQwT3wzR8NXh/vDk5f5wf9jQtdZMR49xVEBKHzBJfKpXPm2dzXyiZaoXHL00",
+	"9UxzczM+a52CwNUtZm0THHICcn2VEpREr3/VdFLTxjaUaRF9Mp/Ct/9AUxY9xtHoFhP2LqVUAFaHUzxV",
+	"2pQyvgPwfy/kcJDOAAQFQVOcJyl/Q2zuOYCUIsK1bPkgpSDHDCwQi8HDHDJ0r2QD52AG02xJEChwlk5X",
+	"jUlo1GAcDncGyeIMMsHWOEeXs+j1r79H/5OgWfQ6+h8HpU14oDj8gI9+AxlDZPULzhi8Qxf4IXqM+771",
+	"c3o37/PaKcqyp7/aE1brzbN0Nuv16pIQlLO+sE7Qouj7zhUiU5Sznmv7GcGMzcVLnzQrXCNa4Jyipu6C",
+	"U5be801/xNyCqAZwZksgQ5oBIf9sRSAPB4fHe4Ph3uFgMhy8fjF4PRj8nygOsfUsmT1+jKNE8W3beksG",
+	"5y+gzlUkaK11DF8PWteRL7MM3jZUeHNdJ1yDe1RdmniBsW3VNGcnR1HDBKxtEwtEqddsFt8Heoi9aMU7",
+	"4F4KBddIGX54DYYv9o+7lLh8GEAvbgtEdaWcmk2iBF7xQFzhzjqdnfraTONcPx/PcW3wnHOz+tfoVq7+",
+	"s1r95ww/RHHj1zkX3/LnKcqyoGfVr1UeJVz5WM+kbql/jaFFUf+tkLqh9vG5kH3x4ycX1e7wXvVHjTN6",
+	"kVLm1xLiZOPGaZZSZnBKo7gc28kPZj7DRBEkBK6qm3gcMcxgNvaDIJ5b5zADSilHg0G74NSY0ppRL8jJ",
+	"bkVxivMcTZmyD6pYm2Z4mVQHtOHktDb8MY4QLW4QSWEW/pXzm6vGK9yoS6d9v3Q1PnV9iczS5AO9Df/O",
+	"9dvx2YebN/ZXauiuI8q9cPciXAA5aSXMrnFeLBltkgrWDL1W3rXHPsaRseM87Fk+B2wOGVgsKQMwy8At",
+	"AgvEjJWrDkL8uECX0ylCSag0neoZODiLNFeyMqyJFWcDyx7t/OxVZTAXxHSB8JK963xzYgY2SF1+0E+l",
+	"yyXzkIlxXci61Ysex2UogwVFSTfQ52bgYxxxsxslJWI73n1bG/746FqchMq7LM+mLR8q/RZzVwFhaX4H",
+	"ZgQvwLBmLrRbB8az6bOT1ICGlaQA7GvOcfMAEYKJezbxqDZHLI5CFDGQVn7XMoGSrvOrQJB/ieLxRlbX",
+	"OGCWHyphqCL9k58tPNoDfUXTpSDIPKUMk1UMcJ6tBIYe5iivqI45pAACghhZqQNj8IasQH9s6gz3menp",
+	"zhBltABuERnDk1tEiKB8isAC5ynDCumGx2cwo53OEjYniM5x5rG4zWPXtLeIPSCUC7CkTwVmiDDwp1/+",
+	"bMMx2D+2+QUvpWvBOGtLg8P4qW37a5ZhKO35AH9FuRwX/7gP0luiDzdDn5UywtUpyCCmdgLlJNDR/oud",
+	"JdAFftgSfTL88I3Iw2cOp86L/Zc7Rp3Sr7NB0siPPp/U6Al9UqMeK5KMKiQ52S2CGD/W5sghj8fPJiNq",
+	"OreIqIeKEv9RpcTASQr4Vd0DDgbfkjDvDOp8lJnW3KsdtkergcGt1pqLuPfnLKGufo57SXt/rWRJ/rFS",
+	"bYR+qaFoHuNorpk98CN14eBHu9JbHPaN0r1cfoRpN3XYJ7RXu/zAfW86OWl035c+TdrUeNz6YhXKJn81",
+	"WCRucHSV8BbeKnSw6doiUA1CbEDVKSie0ySwpnSrPGuAU+0d7rTa41EAqFXX+fw/ljFE9ZlT4cRe/q/D",
+	"w1j/75N1bKvHfDicpeW6f/3Eo0aGJ/VDsWJXD4TyYQtsPgdqOXFz2qFwoywzz6TiUcuUIRO+rN+xSHlz",
+	"TyifPXmRlTl/KhWve1KL5/0TH/ee91jpao9MokWBCGRL0jbr4XHfWbkzflkkbRdt6jGADLB00TY9v2gb",
+	"8ou2wXAyGHRctHl9MC/LzcINkHrYRvbD/rz9ouHXVPKlyFICFVc1RMkuWjgM09q4bdFDtT13A3uFzS7P",
+	"dlKxJ/WcVuwhasP4f//3NMRS/mabxFYcMLvme2k92A9f7iRFNmpc7ZazpZ0cR7tCDswYXlzeUganGZoQ",
+	"OP2S5k5qMETOUspgPnUQ5UZ67xFDU3EJgtUHpS88Ue/x+IVbxJHE5imVeNuEOYO+pqwNNlwEgQZv8T3y",
+	"gHa4BmgOmthIrMHtos4pf5KdymuENysTU6z+0SQT8UcdTxrhxeZ+4gHSepCxWXh0dDucDZIjtJegLL1H",
+	"ZLU3jOJ6aPEizfWfQ1egsY2FEkb3kskdPp2j6Ze/XW/jWvhpV63/JKc48Zg0f7sGU5wgrpimHP5qoCd6",
+	"Cek/mvH0G7m9VVB1ofMHvb+VS8wwRdvglwVmmNwUCCVdb74rR+7Clb4F+KdWrP3IbHGGMZF0cbsJkpSU",
+	"0TJNkTaP9Tllyj8KEowJEPi1otVOLy5vzqM4urw6fx99smVfPwkI/KpvbGITT1qMIAdMfCvTL/aIZufu",
+	"iJReL/NcGQD9ZiTqxR4zilBzLVlN5ItHbYh/goNg/SNzGyAmSPVJZ+fjuiSXTKrxZVOq5JKuk6qQiIt0",
+	"xnwmN2X8Q9cIJqd46XOdlAF9cjggCCYUaIDF9odzmiaKWbJ0xkCBqYypJwhO51XGfNGXeI24wDrcrYvf",
+	"qU1CocXjpNJIY1hi0fDfJozmb75BmcXHQXsVp92PvFVd4AdEfJJ56z2mtU3eGP8YN2RlMzLOYX9mIY99",
+	"SPnUiuFtiL+fOjDLApJ8PIfwx09xlCAup1yp6/20Tq2UglmKsoRvwuVoIM51qQxnJWiB71ECUhmWNluy",
+	"JUExWNLyCDgVjAfSnDIEk2fSaYJr/r2VGkfBj6zVLguU/3Es63ks40j7kZnCcxiTatTvyOP6Q44p3XZ4",
+	"tjkFws8jc0h5mH7I+UdE9eaivED/cxYnccgkKQWYD+2Zlhzip+J7uoxWL6f72zWgU5jnqHquGb05/br6",
+	"V3vQ9ZMOVM9wilI4N7iJ6/xWEr/zIDWH5A75Ig/kleNFukg77vUzPsSgQXxzI97wIKeBmG5NV8ETiN1Y",
+	"5Wbum30Xv5IKPY7IKuXJaYSrhH73guvZ/iJ5ClBE7tNpdcEZnsJsjil7fTwYHA+7pKpfGQPvtCFag+Ev",
+	"KPcl831BecDijpLDI/Ty5e3R8MVPR7cvjuDx0cvByXQwPDy6PRocH/YiornD0pjXILaRzp/3J59JyWhH",
+	"hEmOCc4k5lo9g5Sd6kmkYDwtPVnKmXjLI2QV2RJEmRoMcHOfCgPGqkZg3Sd6RcfgqbkkA4/GkZMS8kwx",
+	"Ov3rNWJk5ROnNE9ZCrM3cPoFz2buFSYogyu7tNEsJZSpVJo0B4s0y1K1yBjIi9RE1Wrih1A5sqFVHRet",
+	"bg2rs7srJFnAr+3JQVbOqxon/l3et80xRWB0+lehGxOAlywGaT7Nlgm/oSzXiXNUO0RbYXetJYiaG0Uj",
+	"JR1+bUX9An416JepN0zcIDKSItrE/QAsEMwpyLHc12o4fxLSGyxqUyCuM1JlaS386Q2T1t6mzvzOmj+V",
+	"x/OZQ13Yy5bPRxxq7lH3ceYelW8QNMX8TrZzNrnkazW8/IA60QS+r441nlheAX5s4a+Cj3IuC+wW8pSn",
+	"xrBKKfyaX73zGHfj8C0mD5AkPd7gLNXzlQkOHFw/KweNt+88g16w3N9h4y2HWRhElXv7rldupjC/wFNR",
+	"/SPwlb/DNHQFlczyx08lY1kn63DO0i/1YK0+r2je6vPOBIeObjgVwtmr1xu2iz6cwfoBVQ1l6MNioe9w",
+	"HgsdW02Mt7nsiuA7/2mhUE+17aZuT7WFoJKJpSGAskSVKIBFwX/F1boEqwIBSBCgiEVxw+aVurSzxo0a",
+	"GPWubKNenARUmzm1hpax71et/mI1SLknjPcYz0BZTE74utWfl38/v45qxRqHJ+5VdPmCvEV7RmBGENrj",
+	"kwDricalJm7bWecnVdENJZo9fYVc9GMghwOKwQyKgnuqrJ5Y/s3p6P3ni8vT0WR8+T5qpilY8Uaeki4i",
+	"dp2fzjsoIsc8O0GOun0O/GGFACKwjaACE3ma6R/D/gBTpv2mDlTw05ccYpHl76PxpG4f95Ork+aRTItx",
+	"VeA63Rly7N+WaCndVf5aQgVc0k6nkVI5/+Tf474j+VLMjf4cPZjnKQWq0gJY5izNQMr4bwTR5cIuFOE5",
+	"fYeSuQoOpzU/riqgACbWhE+sIKGQE4jumpXdWMTP+MGGn4IM8RAJlIvTn6q3WRB0n+KlCKwR6l3ZzHxl",
+	"uUTvsmjoe1VdojGlLCP6eXRxAaYippSKSQTm5F0h/5OzIiHLQpZBlMDtf8x5PdLP4/eT8+vrD1eT8zNR",
+	"/JD63hBfk5i3Jtn/mF+fX394/3l8dv7u6nJy/n4iH7R85w6mol6j5J40QYsCM5SzWAGQMoA5bz6kFMXe",
+	"aSf63xYLyu1VVTIRNzJUbqEMF4WFEBF1MYdU32iLHymclfeYcQmCglPyyv7H3I4NM+iP4qiOziiO6qip",
+	"xY/Zb/cPIuPwcsusXa1Xw3T+VLtnicF08WerTtItMiixnDMSzYoI3CVAv6QFVY7fWkTsYR+PQEBstamr",
+	"0lhuq6T61OG6JXkcdtRarr9X3ECSIdleCPjjnvP7GWXQoyZQOUnwen4KqrRYfji8eFJq/AQBtqc5O0aq",
+	"VGu3ZawGVnYcnsSQ31HAsGtj9yDFBT93J+aYvREy5GU0vanmmKnCQ7bc8bgPyFAM+LxKX05hzmXUDCbp",
+	"3ZwB+ABXNsBrceaQJ3TiJeuB9fI0pXbSt5joaJd2i6N2Lir1K7hFU/4P4VjNy6QOFeJSDdwl8B5l7WYH",
+	"t/cLkmKSslV3WIAaJ94pj3lhwT7186GM8mmoY8gQt2W6zoma5jnO1QYnrV2wEqfBOlXLBXOBFPravy1c",
+	"8qEVQ8FYLDxLRG2GCwQ04uJaIJjcVA0TCp/2E+qovhQqn5FVOLLFBcSV3BrciLarctXUUIld1pRD6QhP",
+	"WnEsyp6JWvaBgiLr8UfrVEvb1MbzUk7OlqHCLcvwR4EVZ2s+gKBb5M1scMO2ErdqxYZcZlspVZ3eHv1V",
+	"5Owd216avdm4NKDmaksD2buCpWTqItti2twYvnNwj3jWxKiVyvDhLLxUrcUItMWiEucOlHRdmemOB0bZ",
+	"9Ds7H5vApa6JZJuFNad5oaZZEl99iQWmDMw4w6GcSfvJqHE1NVcnpqWDDUhQbcC3cnqB9jbHjjDtCF4y",
+	"H6RyfxTeDAOhaUlhvEx2QwjxtX0wMg+FSNAymkg5ibA+Yd0jba+k/Kpzpo4S+F4mXjan2wdCOQuoxS7C",
+	"ESB3ET4H4udr9b7c8Tn0gMDcKuYhW1PQWPwhjmn6J5BgocSFQ1Ue1YJQfs3h6UT4K6P2qVd7M4HHUtuE",
+	"zC5FunN6ocFNVc4OISgRv54cHOrJKL1W4VDN6Qg/pzQ5y3AUw9UncaeAWPUeXzkLPnrDHU7UpSc3SruQ",
+	"45y6B3KOdJXsronW+/xQMUEXl9k3BeG1i80+3clwPznrdEc2FxqdbCE/LreEKg/FZRMY0whI6S9L6bbt",
+	"fcZ+cWNlSf17n2zbE8VR2bMniiPTsCeKI8OmypdzfmYGiH8arum9g1av1z0BMbJAT1l/Vt2q8xWJiJfK",
+	"vVBbmEZ5He+If1D3bO9oOxjW5NLff/rz+elfP//tWoNBwZ8WtFYb5InRIK90nIW4oOwPIc/G3CJ4J49W",
+	"CERv6MT1yfaA+6kSo9IfOn6bs0XwXmrw+GV1b+h45u0WgTtWETr6rr4HfMKoeTM6/evfR9dnWwTxhQJR",
+	"RSD0hfDt5fWWATxUAE5wX9gml1sESxzUrVCBHsBVLl63CKKw4inDBQ/9WKC8j2q5mVxefeZYfMevXbYI",
+	"o7ox7QGauCzdIkSONMIKCuvy0hBxi1+ruqm2CVWVfk3LxvVNtcFuBnEtZk13B52mOVMhfRRHtpzrP7Vi",
+	"0n9PLoVBo1Wq+UPXMSh3qvIPdc1fNQK4sVSLS+D0juJodHNzfr2GgWTM0YZ7gZs1Z0vSKr58jDwomoOq",
+	"dKSVITX6Tsd/WGne7Nsl6waD3ieE4njQDXiSbhn0YV/Q+XZYvDruAv3VMZvrcotpZpxOW1zK4aDvUk6e",
+	"dmI2a5Lnq55epF4HxPWnOlzHQVs/18lZXce7mvjVubrOKi1a7uk9r/o60uqXwRvue+VyW7hyu9bsdlXJ",
+	"z6wlVFYKGXtUi9xb69WA4zLZf4YJeDOaTM6v//vzaPL54nx0M6lkJQwCqgH3qMuiI97a4+GASkWiTP4J",
+	"sxTSGtSjib31WMW4ll/hqyEO7aDXBILgW8zE1NVyXybcg++CP49uPo8n5+/Mtnj+7mry3+avs8vLa7ml",
+	"nlV/U3uuA+X2ej71DwVxSXMrR10jquoEN7pTLn06C30tRLKQxE4M0P7dvl3iVTOYpp9JhtRUr+YG/kcX",
+	"kaah6cnVblyIdYW6WV1gzZoYVt3Boq70SDvPvMdM9da0tftIUb+yDIkSfMfvwlf8DlzaVJYN2JaD7Ov/",
+	"FUncxJrGtZU42UVcehkl6qlMuGaYRppTRNhoxpAjMEXENVYuZ1Wcg+nVX7231tfWKQXjM3nDUGRwikxM",
+	"E3+e5tXgPDxzXXRjYtKioCjDiojwVkN1BY5ztA/GMhAjx+LjImxBQCAXJa/3+JcKgtCiYPsfK9zf73b8",
+	"pUGWL5jEh616ANeOokvwwKaw9VN73M0ZmikGKv2sVVlUga1W9I0AVcpvStULOEcqBdO/uspy+t1pn0hF",
+	"425S1yQ3lBWk5gia4CZB8LgM5xCkocxQrjKujP+Q0ZbSfV/R4gXBU0SpHbJDlVWv6ovqn8mSe6HR13ZV",
+	"erxmWI4JEwkODtmYdawUXaemNHUmNqInn5WZ12TUdcgYWs6Wm3smQni6il0hO5oFTWAxq34DZgTBZAXQ",
+	"15Qy2r8Ibqud8j1wpHfzNkeYtghFcY+rFErK7fMEEXNy6VpFUzJkirLumaqOFb4Wqu58ibA1t4QAy6QL",
+	"z7LHZ/VrRBrraASxeP1UsVi1qUh8GL9oaSfi8ms+hq163LpuVUPJS+oF/Hotc7y7fBIyXgQCebMLKEOF",
+	"jEDkbydlF0ycA3Vpq8PsUgquzyfX/x2U2977DMmlDecqOqYznVqi41KPL4WPr8d7h4wKWo8PTnNbv67N",
+	"/Jowfdi/vWweX4aNET9vXMM0eweLCbzzsoc4Zvtv1vnac1jWpGCwUhE2SvD0y94oqhcM71VmQ+ckTPB7",
+	"9NWXI2TKuudAeB4XSMT9KBcjtzs4bKYinvT/A7vSqtWQpcaOAfHdVfZ8cVjnz1Afh8Qib9XdQKWnoni9",
+	"IF5r0keaJ+grR4o0sTXJAGSx+Tc/lRYFyhOU8D0TL1LGquWC+qPHl8Rh0OLi0TPIoLsn3Trtn3R7Br7l",
+	"pzyWXzijRf9vq1tmxQr49Wh/GL/YP46P9g/jI1t5h8QfNds3dPgZefPbMSdQcwHjPEmnMtBNQCntNUfX",
+	"XPR1ipAqKqLbQFS2oZ4drWzvWHubDAcw5g0Z/cdIencnEto4q8EMkkVAJ9+efTGaXi8LD7UWQCXCw9lP",
+	"d9R7LvY72n/xXPzHsw0nGluhjCgoK7lOEfa5Ga/S5LYfy3l6E2+R5Rw4Duc91Ybx+TTfT8/EedujsKe/",
+	"cW8Kx9EyT9YVjxmHX/fB2biAhDOfawld3Fftq+qsfOgmmvQnG0dRCLFONrIDhDCUAaoPL51sXVcofHbQ",
+	"5Ge7SW2VIm19FxVBao2PQxZ+/ExkaYDWizqDbZNHIbeDOleV/r9V8rS2qKzRx+pYGbL84TPTqAJeHzod",
+	"bp1OGssdhJpYPZarZPJ39FQ0sjsiBvHmMxGnCtduSY9AagdJWm3swPNHv3UPN2UetDY/VWxTghgC2IY2",
+	"wxaSaJjDqOLUaNshytGuEuXFNyZKSqdbKEWd6M8+WzVqM+OzF6R2rnW3alLrAuU3KKfeRma3cPqlo3I+",
+	"nH5p1M03f1Px8acSXLit8EPeDgkfsW1IRH4uwTlrB0UM2TYsw6dwpw+QzfBoIxCoirO4ylc14nYyLknv",
+	"0Sa77yX8g43Ge2U0uwlkr5RPKp9vqQGfBdb2e+/VJtty2z17tj8N9oaDwZ+/Wee9GvU3Kwhba7p3fnPl",
+	"LTSt6qRPv1yHBAe4y6qXJThH0y+Tspqzu1ohXrKyiL98TdQhb8sMaLdnapXFXxihGU2/BDctKCHpu+tT",
+	"RFKYdaHuRozylK1Wn7DhduE0btDLQ24zmbcTwS2GJAkvYiM/+AaL2tMz7Clkk+YzXJb/UVUExUz6lnPO",
+	"QxTm8IsuFaWjfqwnoomYrDrvLWtzLKNut9dLQe7dcFGm+nRj5205fjudGHo1SdCVYqxVxIrqTqax2zO5",
+	"QqrtXO+6rNrxm1qQrLYCotIhRayMx9CDVCZ8Sss6B47cu7C4lHO+4FOcoLZwGtmQqJHbZZXZbW8cLr5Q",
+	"jndiksPRDUMVx9MlZXgBCFzxqH5BO6DmKneZlKHF/nvM3uKlHVztNhsSxHiFykq+SmsplxRliYC96/LL",
+	"W5PYtQg92F4HDyER0XCzroUcroH/t82WYa54MfVYHrJ54VYO0AK1sqsKM7LqvqXN2gVtmQn4ljeseZ7M",
+	"hMO1MxN2O3HgRVviQHjKQKV0kUNKAxqTlkGH4kpNMoeJYzYKuF+CXM8CmLRdD9RQpWGaensUW2qggRRR",
+	"B74JmvhZhFxV2FP90KqkvKrErzzew4WqMqiW1Ud9yBW064+fJxOvuVxgwnxNRUlp1vJPiNZU1R52L327",
+	"mYUR+gC5uy/UbL2Rw8GHcT+rtVG5lrConNyJFkiSB0iQDzWIFp2dHc05hNtHKOncky5QQss3inTaGbg9",
+	"PvVY2Bw8+Qk1tXON4jrNv3fT4KI+6r6wUXI4wl96Caya0QXsBUqaEE4rJn8Hbq3zgaq4iALeko4UjzGq",
+	"DqtiiAdor2ylnr36L1fjS1CkZes3WeOoxCkfcNgLrXwuP3hh7dv8uyLfBvgOZ9nnvXrgdW4BSn1pZqt5",
+	"ehrKs9epx3FucZWGpaxcX91LUoenTzPN7R6HPDR/521UusBJfVnK5Xf59m0URyKr983F+P1fqw4/+TSs",
+	"sISRqWYgPE5CJFKAv7ZP6ynUCqfOQhru7Y4qS987or4RYWFKTe0XdEVVelPgK3XdK9+P1dROgHmplRbv",
+	"cq/+MnYjE1P5v+Fr3OolWDnl9r3I1bnWdCL36BazPfSu7092w7Ald3KdGRvYW8+7LO4Sbx5SNnVESBQE",
+	"UdrNdfzml4pPcF7QL/XcNtclQTn5M+jBcm3BWPXolqwcMexUcuXYhpqrfMcJipUoUgMhLIXkTzp55M9B",
+	"JTBUIbS8o/2OdjKWbhozIXfnqE84SHq0N3w5GR72Iqk3S8SGtQ15HeERrYise4E064oSID2bpD9BUNw+",
+	"qMPh5oWkjpVOYcF3/guunOKs24oSX+Ajf4Z5kskA51ka9OLb1Hqr4XAQ0TgaCj/w9tSNRfTsvy2/BjJ8",
+	"11eFarq5xfkOyOfmIMZrV6clVyjL+H/dCKt4cv6/a02E1IN+V+DiTIJ48wwnVHcZvoWZAE6M6oDt7PzN",
+	"B15Ad/z+7aUoBXfNITq/vr68rsKqB/YD9tDbPlwuwWDYwwhv041xAee8H4QFjr8nFhDl9KAv4p0/0Rmg",
+	"LgpFGb6jB/IGZV8+a3XwEywzXk9D/NWCfGmGRA7qF4SKquHbXiXOx9hirXVAgvjdk/fs4G8oCvMlWG71",
+	"UOZ5ywZsmKgkcLxk+2D05pLfIljt5QhawFT0q+Ev0Rjc/HV8xVUkS/MlsrrIiHxcPiaWieG6Z4z8jJhR",
+	"Fm1YFhyYMkddzc8EaLeYMFptvCZgiuKITyz6rPGs8+CqkyZXftNdwlTO+DfrEtYx/2a6hJWTbLhLWPnh",
+	"dTsJyeT6b1XnoF/A1PGGyhk8Ux+iTbF2eB8iteayD9EzVm4Irxx5sla3o7UF1d3tqNnmqOSsikjEpm7E",
+	"uk2PWjad8PYM5fLb2zO0t2Toq++fXoFUwR1cgbS+0Wy4AqkFTqevzZu7E1aNVDpkA6NL3Q7AJ9USFSCU",
+	"BbzHpppWzaHNbQWF9S7ijOyxfOeoLLGVrOXIWmQH7a6CZQ9WPWvwkr3rfHNiBjavAQw4btKVeLtcMg/i",
+	"GEOLojsqb6THcYPBDm9rvaM2A00Tr9PQIJp65NHjo2eJ3juO6RRlSFYovoaLwlvEXlUx5oYogYuiESct",
+	"bVSrC5bkeV7bXvTEla2yIGsMqUnCkzsawKIgGE7nneJY1ss0Xq5/yBKPJrJQ92CQ8PJ1JCnlG7ky0TP8",
+	"sCfyOv4lo0e3Uh345FEmJOjOeRMCp1/UXUcbbzTGixC9DZFbrFoRXGPcIvkmiXpsrpJcGKjCfsNwE9aH",
+	"OT/2VvqlUpAgJm+SW3un9vNjEJyzp1PJeextDPNN5+GV2CXpTn6oS5BPaZ6FprGIo8IC3+s7nrb8lUCL",
+	"pWxe8cdW12+rU2j7gXc6UQE26SrM3F4hTD/VykHVdxyCWySrSinnjYk8x9p3w9tDmrcLSOV+h/Jq7Vku",
+	"DmoPsT6hRjZL/BrdOewsn1+0NUl9J3q6bENk1jYjSrNB6mq5hTQauOF7REiaKJxx7IGptGg2uNGcWNaD",
+	"vgii7ReNlGs3gsq7MW3ocNOAs4p/n9z/mI9ngAuRqtuqv2kbIMpQUmxIYJqBBRSOmCVFdU75VVbHSyNH",
+	"EZ1SefqOWL1sJwVQ1QAKJNMmbaPjp9g0LTbMt2FA7gisZIe27z32HhxeXZFhCT7DsbObhOgtX7JaYAHG",
+	"73nbtK6ZA3bQCf5BN0+X5Vq/DmSIdGS5q6eczfitcJqoM5axvQuCKMoZ+NN08ed6SZx1gsy+puypIE0z",
+	"BAlKGiC9WCcwq2G+2zirwevisTKg/I/U2X+D1Nmr8ekfqbN/pM72S529ymDL/aw8irRHbshW8uJWTx1M",
+	"igw22v6oXmdRl+ej5e7JfA4v2RQvUL1jc/yEiyiOhhuGivabg7pCLrGjAfehWHzbFd9lGSvNFRPRP8rc",
+	"5lTMGxFCqB5Yv+KZSkeMTZKiuPmnrHqyYBY+S/dlQdB9ipcU6NurwFu8ar+r1lbnT7FLW27OCYIU5/Wu",
+	"FTYHckOn7wWqfj/4DKfdvPJFEatSQEpj+whmnc60C0CkpIgePuf8FGd6a2jTOcFI5vh+yVXRU9HPfT9a",
+	"53h2/Biv2yWFh3y3x6sbjPOhlbB1qz83p06172nFdutdH354Uj98t59gCKI445nMdYqUzZLdJ5Ve+8hm",
+	"u4+4mDG2NYhT9dQPRC3J5FZWc7MggpAl4ZZK4kZWuQxE4vad5Gg9EqSy6Y3UUgvdRkb2R+unWZq9O+LI",
+	"NLpxrqvegclsEuofVvRUpdcWgARZK8jLACC9oVTq9j/xrpUHl3+4eROct7cNe2c3LBYX+6p+Gn77hME7",
+	"3xU+vKNt3SmC2M/u5xEaWVDgDN+tQr+sh68V3KK3h6dX1jNgxxKlXYEoJWLE3rBzbU5e/ABtTg6fr81J",
+	"ny4iDpnYGvWD6X28ZhynnLl/L7ZvwltP5KejoNjQGi36x4U+C8seBnbmKRcVhOZBV6/x/jp6HQ77yRl7",
+	"aPmWizLpscaJsZK9PlGF9b3IExInn9p7zz64uLy8ktVzphmmKBE/l62PrLgYdcDhgwUZUqJPPBfj9+ej",
+	"a/GVHOAC5XJfk9bZAwYoT2pB6XzWKI7ki+F3/XZzQEfbyJRxTxecfsGzmffiB2Vw1VZATP4ElPs+BikD",
+	"sqoxVcgQDgv1uBm5t/Z9z6GMyB5Z1wZtIdnGS8JtpGm2TPRB1NAlqLXcYd9LNhU43oFlNZkX26oPrlor",
+	"j6VaIJjzw4VMiN3kRVrDVLKxHDfZprY+p7xxH4xIqWzZQYviNLgayqgyWMR4iYJcXe+peuSqJkocTSG5",
+	"w51HMj6o+soZxkRcxAW9a0aXH5EFpLtetip1lzcPIkYj8JQtxlYRL/fyMABq1cItM0BWlg54v1GHmn/E",
+	"VPnt/ECtHrBViMhRbuKi+/b0Ql6byloRoeOdhSYuxNnOzNtg+2povXQkBa25VqSiZmC0vllJXq6DrUXE",
+	"cJ/NCA3K2gBXKFbZlqX8NIQirkmzolqNhd2aYsmeVOTNxPUbx2TZZzwv/Yj9Cr2p0uCLruiAaiALSkSf",
+	"LLeh31FnDeZnKuLCu2UgmKtYS2eRz+pdrNgTeqxYpEUeD7qBSNJwMA5f9QVDNPN+ddwFxqtjNte1GHm4",
+	"qrZEw8A6GvYF60T4PVrLl9TN/ho3NpniTb/KeJKxGDbVARtcUydgHZMu8buZjLwF43qlM99MRmBRK4ga",
+	"EgacetrmjK8ATBKCKDU36g/pLAWVOmbWeerV4f7w5OX+cH84GBwcHlXEvbg/ijoaxhaQ0gdMEl9WsHwa",
+	"BIr5VIfzhlLfUfXmZnwWNJXMQ+7FRyYvWEwf29Cm7mY7N1OY641mG9GQ3ySiqXWVP0DIUrlph1cY1svv",
+	"bCJeftrNL3OULDPdrJr3zMqctVXWvKArrDuRdlZR43a2s77GlMl5dF2gr4mlZ4feT+c/2sU/e7t4F2c9",
+	"b7d4DYE3p0DbRYErqWsT4Q33FmQimKNO1PKyTDKqvhUDdA+zJbQSmoTJ+K/yJteM3QdjBuB0igqm3Xr3",
+	"/D8oSyj4yFG4ZAjM8ZKABK728GxvgXM2B/K/6qcHhL58jKTTUEOLCQX/xd/LVjH4rwSm4v/5SPEP8b74",
+	"1wpBkq1EQMHH6L9kKNLH5WDwYqpjtMVf6GNUi3CPXgzAS/Cf4D/Bu8v3e2+vx13u5qAShBoxAOUiO4OC",
+	"lFFzV46JlZDdXoZwEWYu+NTMYywrWLtVinX1ogGuoOYdJqLoSIKy9F4ekhfwq7mHGwxaUDVUZgdnF4/x",
+	"Ono/sliKOVgypRUmjMGHySkPJsgxAxTVqobRFB78jD+fztPP79J83uveU5X5FsJSkrhdZFtqmWiZDYyt",
+	"9EmvM8ZSM5HiLBNmWcqtCKxMZx5GhC31RCpHuXXu0Sw2WqMgyqZ0FZc1w1hrC/uLZxX246BbOZeYrnE1",
+	"Bym7XuatdZEF/iqrI3AzxUgsldZPPhrKzSkf6vNPkI/SGeGVj582rlhb9xx+R9xCMHmF3CTYMqee1aZU",
+	"p50nG6mdtGlNv7ZmP1rrcnZ9teUuD1PdTgxy7HK0JZOV2qGUS5vkfa5wtag8vfKKRgnta01vq/aKDdC2",
+	"i6/Usx2cAM5SsuC9JGR2m8xyEGm3c6TL1vOdANBlUWDCaFxmRih7uiCY4SnOwD0iQgpMDkSjH49plOKr",
+	"jrYQU68KRJUhXkvAcEQIm4Tf9k5JnNaQpU4351vRDsccORsLUpGeCgW1mFETAqNwJ6/Oc0DQbEk7UgRO",
+	"RGVTSYBf5GQdVFIgxTI/VngOIcjQHZyuzKCuGwg53DERWVpYkMtJ0kSsnaAiW+mVGgILTmmga8gRBild",
+	"LlDSaS3ot1uX35iCFvgLyuus0b/1JF3lU3Gj5jurr/Kpyi+QXCky9J7OkQ0F/8SQmjoTNfEaR1aXImvZ",
+	"hh0qItKpoe00MEf34WVyrfxDrs7DywQQjlLt95Z5Yx7P96uTdu3IyZjwduSpL1CEPwW33JoNmvBl121h",
+	"AVvitMWz9olU3M/7y/fnURyd/3LOi55entX61KrH/cuzdjRGEmZdECKigwTdHzC2+nDzZtClVDS/+e4S",
+	"ZZcUnsbFTUacu6cXIUaLJWVgAdl0bikizeH74O316N35Gb8yokL38XM8KAiapV9jAAHlXqh8anyNXEVB",
+	"cHp9ujc8ERdZPGRJwbPfrJMrv76BSrk/CRGFSWsmKR/QSCdtYIXvtO58UoefMyS39ER4KHHhlxn+tIfM",
+	"DDfQ9/7I0wnLaBNLzi3wjTxW0W1xpF+Bva3kRjq2XSgS1ihCOWdZ6CDNkupgM8WXetoY/AsRXKZlcCYy",
+	"D5uWEZmKTm+dfnMuQYiChOCiqPY/FEzOD45CdAKDIKr748lRXawJ4ntFoDdf+Ew5fLxgwl2+4Hslr/+f",
+	"YcqEHN6uGK/ziwgC9EvKF7ABMGXyshT6v8Ai+Oahrig4pAqqGKT7aF/jWsDL17ABYJsdEw3hS2TX1uPn",
+	"3yul6mvnIvqB3rY7e8ROICJdP9y80VwNE1gwsYb2TPHC5+Hh3yoITpZTBsZn+liqPmubrFgAwoGoKLTo",
+	"p+PDF53X9+3bm5pULUmpkPW2NPmN91J9eResZrL4q7lmYVSnDMxFb9O8o1dMHN23Yfge5Qkm6yF4OHp5",
+	"0sulrBAoWUoCJhmghp52Hn36Ob4kZ/hR3kzfZok3XR/+87SokusJYFu3oLJMJPcVFXLFvplz54zghWR2",
+	"+bneYW/CjxDcWfVJ0zWCU8xHJBA2Jpy4Z7j4gSNSGC5+0OI5NyKgVvufakZPsfzg7jnLWfD06gNY2r0V",
+	"ZWwuN4ZVOCC8c5uZtZSzbFz4gzszO/ysMlF3IOcCk1XLAuSAp63BiOk78bE2J6OarjHRuzdtE0hPBGdk",
+	"n5/ZbjxUfrU8j3g/fewKJuLEiEvKV9FYXasB7JOXr8ra4et2hzW0Lo/l1+9GF3Ybkn7nvj59Yye2/ujq",
+	"9M+PiB3l1xIkTixmeDVZHKhAn3pP/5Yqip7+/h+EcyisoOTOBjXJRZikbA/4a+ZkCzd5Bqf6cKj7huE8",
+	"/C6inpa8rWTtjuzpTtyJ5FkP+r5x5vThD5A53VAoniRJF50sr3rNRbtMs+RM+WgbuYV32Hqx8fTe+6wG",
+	"6L3xPZfT2R93Qfx3mLJtGHpJR5qBft5awM3VNH4XCjUm7ZH/HKc/qHn593SWekv4d3bBH1lN8CmDnSdK",
+	"k0FRJ4CoWsG/0ET/o6hP5Lt+vRZh/WB0NRZZWVOkTsgyLCN6N55EcbQkWfQ6mjNW0NcHB7hAuTw97WNy",
+	"d6Beogd8rGAnJlRn5ctGZKPB/nB/wMfxz8Ai5eFE+4P9gWofJhB3ADNI9JE8Q66LnDPxO4BZBhIEp4xH",
+	"Sqq3xKclP44TM/RMjRrpQUT5A8Q0h4Oj5hyj5seBhCeRBdgonS2zTMTbHg0GqroMQzKRzOo4d/APKtlN",
+	"ErKTaQnBpAwA4ASsAvYGJkDveI/iEL9YQLIya/WgRZoSv0bqh09c0SLHhsS9JXq9szRjiMhbcNOup4pf",
+	"PtxgtYAEyr3LG5BUDjm44kb3Yxw07ib9lxxbuzsXAGpwDZT74FqJBzDf2QejLMMPKAE8RgbR1x9zAPbA",
+	"6HQy/uVc/vvsXP8lbLfodfTPpYwsUgJhcFBKn9xUS9KaZm/iS1Ec6Y+6bXk+fO8eEj6BIE+JzysOOZVn",
+	"jJEgZhR7Hmv2jj49Pn5qMPfmeFPOXPGnORh0ZBxnitd2R0Is5naJxGMcHdhRIm0CkhnflFMmTsuHzy4V",
+	"N5iwapCIihm4S+9RLmPK98EHisBve7+JgpL8hTQXIeMoFwUMhPmnBsXloNsVWCwzlhaZjk3fB+fSQnkN",
+	"fttTUVafIYulrPxmpE6OVlLHBUH+Sw5T/xb7ivy3Tr6Rf4m4ws+6VIf8rZxL/q1iBszfpjGY+MUn0cqn",
+	"W3Jew6jspISUQURDqMZPi0EDpZM2aKhi9XESMvhUYu0twYsewyc4aLDGePDX9QsTHG1VcWlpDFddRrh3",
+	"TnlVFI9WYeanT7Kaj0NvSVJWYnOraksOODVPa3qr+jVeiLVavFA76LWzwS6PqmsayoD3/A6kbB9MrPK2",
+	"BLElyUVYH2UIltVYJf/pWfa9gpyQ1fUyd0myvkdUW6PA7BucrDbHXDbeStI91s2Exy0yeKX0sIOrDK7r",
+	"FRxxnq1K7IurQolKThWKxDHncDDctCR2wVqj+w5JoUOMHEJoWxIHtyIkQra3cQjmLzBLRSQ1l2172xaR",
+	"nrnqTSOrfoowkDS/y0QNgpxC4QB6DVAq7lzrXxABoSqGHxNx+6s4YMFb4mjZFWEiECjh4Je7bA65riEI",
+	"JiuAvqaUUR2IqkkjSiELEK3bYDGUC7hV7NSSbZHVwT2TSEpyiwKi0TNIK+0lrsOtAdEuDOMzWpNbGmtn",
+	"oLDT9FOiV7Nr0mL4UTDwrQoR6pIb2b7bLzin4nnZv0nweEHwFFFq86DmWBMNVTJ6DLL0C1KNwhU93qzG",
+	"iZM9q4NKs2tLjOqZrhfLHrUHu/OoI7l29Pw6dlKtiay1zSzNUzqXxLxFGc7vhCe8zLkTcB59GzgfoFSC",
+	"M7zMkzqvS3Y0OwM/LJWcFsDv6KsOL3IePM/F46p+F9F2ZcG5TNwbCJ0gvTZpEgNIwenNLxydkMpAwCzN",
+	"Ea8DqIqMq3AsygiCC5TEMkrVjBTEAbWNWz6WbkaXsEhg/afgJoplPEe5kejgH4exJ4cG+WGm9D6KI84R",
+	"WWh1wz+OfLt55Pu6lydN0W4QL2LoKzvgdG8d5xRzyXWl3blLxz8l/u2Hv4o+KbdCr075C2LVS3pu96VU",
+	"H+KylSpDrz6FmqfGvyB2KgdfmenKM+S2T/SBevvZ94z32N7a/NiskphTQ4112DG9KN7HeGqd0mkDBRN8",
+	"x+0PguSpptyyW+mjcLYpEhUE3xFE/a7mG7Ej67TAOztKjCzz3JqY8r2dInKPyJ7IEkP3HBn7H/NzvpeL",
+	"v+Q+/pv+0m/q14c5pipNyd7or/SEHRu9hLH2UrfkCyUtANiTdsca2lq+aHnrSizJ5dfIp9BZHxxAtH/q",
+	"yqheJfpgxbfrCcRbHKkFXPpUZ72a6jPoTFfp1narVy6EmuKfNY3lGBWI0gOBGb+SuuKPmziVvkO/GHKc",
+	"62NEDG6XjIt4jh7s57qQZtk9zZCLIJE9KmeRtJPLAnRJ7lPeI5gg8T51SYQA2sb1d0ZTifTKuGB6StT5",
+	"CXotnjukRKFZXVQVTRCAQXID4fKj3zHGFVb6opzqZIBW0w7e3RF0J7iXj697kpxHyBZVpdvg9bvV/OPA",
+	"9L3ckQn6hrI01Z0ad+SMZG9HVDFqlxT9rv41Th5DYo5s7874rCEocpjlSWyKivBo8HCn0qFhQGj1abg8",
+	"wzVxdnd8LdvSf1rHQpco+eYOwjS3t3r+4xTmwht3i0oYnRFRDaI57029arSL6KV2/B4o/m9zCA913LpJ",
+	"7L5a11d5dbNGXGoJusigf5kTbWafpxkCqTiCyasKeXFmqnEa77bN0yqipcFujgSIHeS5zd+ItCR+PPN1",
+	"eyDjKwJ+s1vsmgLlbCW5b2dFUZIYQAVnD7+KtY2H+r5g67UhvyCvQiFcecpbBUh6N2cAPsBVDKDnDHo6",
+	"en96fjF+/xfrlKnDYnBBTRSMQw1Agsq5zMWlehpwO/mNt6Gu5Nw1LZE/7iq3eFc5PuspZgt833LU5x2n",
+	"G4IssWNaGasgBtupo8Z9QaiQRUB1JGgMqMyHMm+LUj23MroszaunWjxrtqN1iQ2H8t9vJ22serf3Uc5o",
+	"O7iLfndS7xTJNqHn6Ub0YJrhZdJ9hchHAfnO0uOp4wclPkwlMm2Ts6xpfBhzALxbrgw/WkuK8d/l0cRV",
+	"5kvZU6H0kcPrJNpCbFOdOs+ocroZw5jtO80gnaRt8EhFpssK60GBAd1yLQc+g2RXJurQhjsv3R70riPf",
+	"QZRSEt4g1hZkvEmnZzcsAuV8x5klgMitsj6HJBGlebuEXQ/slvaf1cjti3ttJg8pPZDvnsB7UbyGxAeS",
+	"S77hoNjmZd5FrOcT+jBW0VK/8ywTQul2uWes6JT5nyeTqwB5n0yunkHWy1k8xHNAu3sy7kTpGvIdQBol",
+	"21XqbEGua4R5RpnuZAktzzvNGl1UbZXjDHcH8Gb4rluKL/Dd9oW4nMRDsCaouyfCLnSuIcHdVJGDq4TZ",
+	"vPzWaPJ84tvJDFp6d5kpOgjaKrsLnKcM8yjWA90mvUuU1ThQvtot2W/kO+/MK9uXc9+UHkJ3rmr3lEAA",
+	"IdbQCb3JK99to/DmFUYrcZ9PffTkMa1MviNe68cYraqGN/DuVC66y3e7OrEKm22RutYsHoI6oN09NeFE",
+	"6RqKIYA0cnSNOpuX/iphHneMBcQVlxb1HS3F1kVWpyAjPt/eFCeoPaqbV3kQY4Ec6xBgAfqpevok6gUV",
+	"izXTeTvXObB3+dcdE+YmXjWZbMpIWs0RzNi826Mqhll9Ie4RcdHrZ/m5bR6kxQxtyNk5erQgUBNGPlY0",
+	"UdfvAaXb9EgZhbnAlAGCpihnvNMTZc6Kbu/017df0W2bMbt6GeGlsAxad7AU1qIkimYJ81NAKSw1NhaB",
+	"RjIKDxJd+lxWrJEFVnQBK5ivVHsTExGSg1vEHhDy1bN5Z3qThhXUUjBtuaBWJZJwJwtqKbx9JwW1DCc9",
+	"d0Etg6awglqVEKXdKqhltfhuirKt3w9+V/9SKTsteRtamER4I0jzabZMdK5b2YpB1Dvh4u/amhWCg0Nq",
+	"DWxrxwaWiHhqSO3gudnsW0bAaVp3p3pUuKIfv61RlMpEd9vaXXc55g/rVQd4q3GYrzwh3t8zP3pCvG3K",
+	"fdMQbw2II8R7Z1nahHIHcnWBSFrMEYEZPZCdzQIMZngPU9FGot4MzVENXA8tW6DRbR5sPI3edv2AI1Hr",
+	"Q6umnUUsRT4C02wBu+/1dSMVo2l0VbDrt+Mz2T5GiTf/omvjUw1Wtkk70/+mXQw4hIAv2n1GNI9LvGks",
+	"ef1udsZeA1V17KiPu3xxNpK2lfFW6xL0zJZwII20Q66k1bcUNT73q+ebeyS6ufE/RJm9HIimOHwbSZZy",
+	"UpT4PYSt/GvJ/IF86rM8xjlFhJs3sleQ6h6kPy5dHTPMy7ELCxjeycMunaczV5aptMvLLktbrcfabOb0",
+	"zBVZbQDCTlIM3v1b8bjuWai5nLOTZPNqnWDf8U4zomqz1c3pB78zeBdalYHzvPGYrM3z8nMVnu82sAWU",
+	"axvXtaZjmzWsOVYaFRye2YbVMPjsV0PCTgbpvkIzndTqrdP0zi4ZVmTaylla9vQdp/+WrY2+qnjwDVSx",
+	"tjl2QhV/e7H6BhtCwAZgUvrDNgDu5N6TRbI6c5OUY1yMNvY6/4DzOMMfbL3GXjnLd3XVZt0t2KSxiCHJ",
+	"w6dLlhkKcBmUQ11eghvr6fd8nWbWEX6fViJmBy/UbKppNih/C7hS04NlKWGUCycotSoNYGL5qoTnXfR4",
+	"ThkFU8J/+loQJJ+K0nQOBpKTadRv6WCiP/+NDiTl9GHHEY33XbzZoSWlXDxVUSwHv+t/hpr+enxca32S",
+	"m9suClKrm0mu/PL8IPAFFcxzCrDYq9sGLGFe2xC0kLSl04ARzW99JKgA0nku6OCe1rptZiZv4TZN5uD7",
+	"lN0i9eDZFU5V0ewi53hI79nO2rzDdeUizpNE3uXzJYggEeN2EBWGq1uY54T5femWHdlbn5/VTZDpTuyt",
+	"uypu5oAXss0zyBA9yNJFyvboQ6qqKbantvHBQA42R5RmbhsfdSMGbf2Q15jL5y5tQr6DqW4u9Br62We/",
+	"FWVocaDbibfSTI4FaS5VjCfN4EaMGvMPblPay1l8bN6Edvfo5ESpoZN4WCUUQbcYs7Za7Py59e19R2V1",
+	"PkQiMKi5x3sMThW+dgeDjYV2IE61+Q7kcU9jdMPeN/r5lhlczdPO4grYneVug8x2+uBiDy0QuUP5dOVn",
+	"8BuGCxkljBkmVJeMFtEyWaZbqMQ6Tkv1BKnV8aSOjie4ODezf7dSsTHsOEl1j4iwf9uEyFo2UOO7doxf",
+	"1Ge3KE16iu8iY6UTg5o46imnDv+KyKOQJ44lyaLX0QEs0oP7YfT46fH/DwA9/3iLELgBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
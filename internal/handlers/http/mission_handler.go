@@ -0,0 +1,158 @@
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+type missionHandler struct {
+	commandService command.Service
+	commandHandler commandHandler
+}
+
+func newMissionHandler(commandService command.Service) *missionHandler {
+	return &missionHandler{
+		commandService: commandService,
+		commandHandler: commandHandler{commandService: commandService},
+	}
+}
+
+func (h missionHandler) ListMissions(ctx context.Context, req gen.ListMissionsRequestObject) (gen.ListMissionsResponseObject, error) {
+	page := uint(1)
+	pageSize := uint(10)
+	if req.Params.Page != nil {
+		page = *req.Params.Page
+	}
+	if req.Params.PageSize != nil {
+		pageSize = *req.Params.PageSize
+	}
+
+	missions, err := h.commandService.ListMissions(ctx, command.ListMissionsParams{
+		PagingParams: paging.NewParams(paging.Page(page), paging.PageSize(pageSize)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list missions: %w", err)
+	}
+
+	res := make([]gen.MissionResponse, len(missions.Items))
+	for i, mission := range missions.Items {
+		r, err := h.convertMissionToResponse(mission)
+		if err != nil {
+			return nil, fmt.Errorf("convert mission to response: %w", err)
+		}
+		res[i] = r
+	}
+
+	return gen.ListMissions200JSONResponse{
+		TotalItems: int(missions.TotalItems),
+		Items:      res,
+	}, nil
+}
+
+//nolint:revive
+func (h missionHandler) GetMissionById(ctx context.Context, req gen.GetMissionByIdRequestObject) (gen.GetMissionByIdResponseObject, error) {
+	mission, err := h.commandService.GetMissionByID(ctx, command.GetMissionByIDParams{
+		MissionID: req.MissionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get mission by id: %w", err)
+	}
+
+	res, err := h.convertMissionToResponse(mission)
+	if err != nil {
+		return nil, fmt.Errorf("convert mission to response: %w", err)
+	}
+
+	return gen.GetMissionById200JSONResponse(res), nil
+}
+
+func (h missionHandler) CreateMission(ctx context.Context, req gen.CreateMissionRequestObject) (gen.CreateMissionResponseObject, error) {
+	steps := make([]command.Inputs, len(req.Body.Steps))
+	for i, step := range req.Body.Steps {
+		inputs, err := h.commandHandler.convertReqInputsToCommandInputs(step.Type, step.Inputs)
+		if err != nil {
+			return nil, xerror.ValidationFailed(err, fmt.Sprintf("invalid inputs of step %d", i+1))
+		}
+		steps[i] = inputs
+	}
+
+	var maxRetries uint8
+	if req.Body.MaxRetries != nil {
+		maxRetries = *req.Body.MaxRetries
+	}
+
+	params := command.CreateMissionParams{
+		Source:     command.SourceApp,
+		Steps:      steps,
+		OnFailure:  command.OnFailurePolicy(req.Body.OnFailure),
+		MaxRetries: maxRetries,
+	}
+
+	if req.Params.DryRun != nil && *req.Params.DryRun {
+		plan, err := h.commandService.PlanMission(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("plan mission: %w", err)
+		}
+
+		res, err := h.commandHandler.convertPlanToResponse(plan)
+		if err != nil {
+			return nil, fmt.Errorf("convert plan to response: %w", err)
+		}
+
+		return gen.CreateMission200JSONResponse(res), nil
+	}
+
+	mission, err := h.commandService.CreateMission(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create mission: %w", err)
+	}
+
+	res, err := h.convertMissionToResponse(mission)
+	if err != nil {
+		return nil, fmt.Errorf("convert mission to response: %w", err)
+	}
+
+	return gen.CreateMission201JSONResponse(res), nil
+}
+
+//nolint:revive
+func (h missionHandler) CancelMissionById(ctx context.Context, req gen.CancelMissionByIdRequestObject) (gen.CancelMissionByIdResponseObject, error) {
+	err := h.commandService.CancelMissionByID(ctx, command.CancelMissionByIDParams{
+		MissionID: req.MissionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cancel mission by id: %w", err)
+	}
+
+	return gen.CancelMissionById204Response{}, nil
+}
+
+func (h missionHandler) convertMissionToResponse(mission command.Mission) (gen.MissionResponse, error) {
+	steps := make([]gen.CommandResponse, len(mission.Steps))
+	for i, step := range mission.Steps {
+		s, err := h.commandHandler.convertCommandToResponse(step)
+		if err != nil {
+			return gen.MissionResponse{}, fmt.Errorf("convert step to response: %w", err)
+		}
+		steps[i] = s
+	}
+
+	return gen.MissionResponse{
+		Id:          mission.ID,
+		Status:      mission.Status.String(),
+		Source:      mission.Source.String(),
+		OnFailure:   mission.OnFailure.String(),
+		MaxRetries:  mission.MaxRetries,
+		Steps:       steps,
+		Error:       mission.Error,
+		StartedAt:   mission.StartedAt,
+		CompletedAt: mission.CompletedAt,
+		CreatedAt:   mission.CreatedAt,
+		UpdatedAt:   mission.UpdatedAt,
+	}, nil
+}
@@ -27,7 +27,11 @@ func (h peripheralHandler) ListAvailableSerialPorts(ctx context.Context, _ gen.L
 	items := make([]gen.SerialPort, len(ports))
 	for i, port := range ports {
 		items[i] = gen.SerialPort{
-			Port: port.Port,
+			Port:         port.Port,
+			IsUsb:        port.IsUSB,
+			Vid:          port.VID,
+			Pid:          port.PID,
+			SerialNumber: port.SerialNumber,
 		}
 	}
 
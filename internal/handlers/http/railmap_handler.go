@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+)
+
+type railMapHandler struct {
+	railMapService railmap.Service
+}
+
+func newRailMapHandler(railMapService railmap.Service) *railMapHandler {
+	return &railMapHandler{
+		railMapService: railMapService,
+	}
+}
+
+func (h railMapHandler) GetRailMap(ctx context.Context, _ gen.GetRailMapRequestObject) (gen.GetRailMapResponseObject, error) {
+	m, err := h.railMapService.GetRailMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get rail map: %w", err)
+	}
+
+	return gen.GetRailMap200JSONResponse(h.convertRailMapToResponse(m)), nil
+}
+
+func (h railMapHandler) UpdateRailMap(ctx context.Context, req gen.UpdateRailMapRequestObject) (gen.UpdateRailMapResponseObject, error) {
+	tags := make([]railmap.UpdateRailMapTag, len(req.Body.Tags))
+	for i, tag := range req.Body.Tags {
+		tags[i] = railmap.UpdateRailMapTag{
+			Location:       tag.Location,
+			DistanceToNext: tag.DistanceToNext,
+			Alias:          tag.Alias,
+		}
+	}
+
+	m, err := h.railMapService.UpdateRailMap(ctx, railmap.UpdateRailMapParams{
+		Topology: railmap.Topology(req.Body.Topology),
+		Tags:     tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update rail map: %w", err)
+	}
+
+	return gen.UpdateRailMap200JSONResponse(h.convertRailMapToResponse(m)), nil
+}
+
+func (h railMapHandler) CreateRailMapTag(ctx context.Context, req gen.CreateRailMapTagRequestObject) (gen.CreateRailMapTagResponseObject, error) {
+	tag, err := h.railMapService.CreateTag(ctx, railmap.CreateTagParams{
+		Location:       req.Body.Location,
+		Position:       req.Body.Position,
+		DistanceToNext: req.Body.DistanceToNext,
+		Alias:          req.Body.Alias,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create rail map tag: %w", err)
+	}
+
+	return gen.CreateRailMapTag201JSONResponse(h.convertTagToResponse(tag)), nil
+}
+
+func (h railMapHandler) UpdateRailMapTag(ctx context.Context, req gen.UpdateRailMapTagRequestObject) (gen.UpdateRailMapTagResponseObject, error) {
+	tag, err := h.railMapService.UpdateTag(ctx, railmap.UpdateTagParams{
+		ID:                req.TagId,
+		DistanceToNext:    req.Body.DistanceToNext,
+		SetDistanceToNext: true,
+		Alias:             req.Body.Alias,
+		SetAlias:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update rail map tag: %w", err)
+	}
+
+	return gen.UpdateRailMapTag200JSONResponse(h.convertTagToResponse(tag)), nil
+}
+
+func (h railMapHandler) DeleteRailMapTag(ctx context.Context, req gen.DeleteRailMapTagRequestObject) (gen.DeleteRailMapTagResponseObject, error) {
+	if err := h.railMapService.DeleteTag(ctx, railmap.DeleteTagParams{
+		ID: req.TagId,
+	}); err != nil {
+		return nil, fmt.Errorf("delete rail map tag: %w", err)
+	}
+
+	return gen.DeleteRailMapTag204Response{}, nil
+}
+
+func (h railMapHandler) convertRailMapToResponse(m railmap.RailMap) gen.RailMapResponse {
+	tags := make([]gen.RailMapTagResponse, len(m.Tags))
+	for i, tag := range m.Tags {
+		tags[i] = h.convertTagToResponse(tag)
+	}
+
+	return gen.RailMapResponse{
+		Topology:  m.Topology.String(),
+		Tags:      tags,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func (railMapHandler) convertTagToResponse(tag railmap.Tag) gen.RailMapTagResponse {
+	return gen.RailMapTagResponse{
+		Id:             tag.ID,
+		Location:       tag.Location,
+		Position:       tag.Position,
+		DistanceToNext: tag.DistanceToNext,
+		Alias:          tag.Alias,
+		CreatedAt:      tag.CreatedAt,
+		UpdatedAt:      tag.UpdatedAt,
+	}
+}
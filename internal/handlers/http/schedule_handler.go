@@ -0,0 +1,243 @@
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+type scheduleHandler struct {
+	scheduleService schedule.Service
+	commandHandler  commandHandler
+}
+
+func newScheduleHandler(scheduleService schedule.Service) *scheduleHandler {
+	return &scheduleHandler{
+		scheduleService: scheduleService,
+	}
+}
+
+func (h scheduleHandler) ListSchedules(ctx context.Context, req gen.ListSchedulesRequestObject) (gen.ListSchedulesResponseObject, error) {
+	page := uint(1)
+	pageSize := uint(10)
+	if req.Params.Page != nil {
+		page = *req.Params.Page
+	}
+	if req.Params.PageSize != nil {
+		pageSize = *req.Params.PageSize
+	}
+
+	schedules, err := h.scheduleService.ListSchedules(ctx, schedule.ListSchedulesParams{
+		PagingParams: paging.NewParams(paging.Page(page), paging.PageSize(pageSize)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list schedules: %w", err)
+	}
+
+	res := make([]gen.ScheduleResponse, len(schedules.Items))
+	for i, sch := range schedules.Items {
+		r, err := h.convertScheduleToResponse(sch)
+		if err != nil {
+			return nil, fmt.Errorf("convert schedule to response: %w", err)
+		}
+		res[i] = r
+	}
+
+	return gen.ListSchedules200JSONResponse{
+		TotalItems: int(schedules.TotalItems),
+		Items:      res,
+	}, nil
+}
+
+//nolint:revive
+func (h scheduleHandler) GetScheduleById(ctx context.Context, req gen.GetScheduleByIdRequestObject) (gen.GetScheduleByIdResponseObject, error) {
+	sch, err := h.scheduleService.GetScheduleByID(ctx, schedule.GetScheduleByIDParams{
+		ScheduleID: req.ScheduleId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get schedule by id: %w", err)
+	}
+
+	res, err := h.convertScheduleToResponse(sch)
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule to response: %w", err)
+	}
+
+	return gen.GetScheduleById200JSONResponse(res), nil
+}
+
+func (h scheduleHandler) CreateSchedule(ctx context.Context, req gen.CreateScheduleRequestObject) (gen.CreateScheduleResponseObject, error) {
+	commandTemplate, missionTemplate, err := h.convertReqTemplates(*req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var timezone string
+	if req.Body.Timezone != nil {
+		timezone = *req.Body.Timezone
+	}
+
+	sch, err := h.scheduleService.CreateSchedule(ctx, schedule.CreateScheduleParams{
+		Name:     req.Body.Name,
+		Cron:     req.Body.Cron,
+		Timezone: timezone,
+		Enabled:  req.Body.Enabled,
+		Command:  commandTemplate,
+		Mission:  missionTemplate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create schedule: %w", err)
+	}
+
+	res, err := h.convertScheduleToResponse(sch)
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule to response: %w", err)
+	}
+
+	return gen.CreateSchedule201JSONResponse(res), nil
+}
+
+func (h scheduleHandler) UpdateSchedule(ctx context.Context, req gen.UpdateScheduleRequestObject) (gen.UpdateScheduleResponseObject, error) {
+	commandTemplate, missionTemplate, err := h.convertReqTemplates(*req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var timezone string
+	if req.Body.Timezone != nil {
+		timezone = *req.Body.Timezone
+	}
+
+	sch, err := h.scheduleService.UpdateSchedule(ctx, schedule.UpdateScheduleParams{
+		ScheduleID: req.ScheduleId,
+		Name:       req.Body.Name,
+		Cron:       req.Body.Cron,
+		Timezone:   timezone,
+		Enabled:    req.Body.Enabled,
+		Command:    commandTemplate,
+		Mission:    missionTemplate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update schedule: %w", err)
+	}
+
+	res, err := h.convertScheduleToResponse(sch)
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule to response: %w", err)
+	}
+
+	return gen.UpdateSchedule200JSONResponse(res), nil
+}
+
+func (h scheduleHandler) DeleteSchedule(ctx context.Context, req gen.DeleteScheduleRequestObject) (gen.DeleteScheduleResponseObject, error) {
+	if err := h.scheduleService.DeleteScheduleByID(ctx, schedule.DeleteScheduleByIDParams{
+		ScheduleID: req.ScheduleId,
+	}); err != nil {
+		return nil, fmt.Errorf("delete schedule: %w", err)
+	}
+
+	return gen.DeleteSchedule204Response{}, nil
+}
+
+func (h scheduleHandler) convertReqTemplates(req gen.ScheduleRequest) (*schedule.CommandTemplate, *schedule.MissionTemplate, error) {
+	var commandTemplate *schedule.CommandTemplate
+	if req.Command != nil {
+		inputs, err := h.commandHandler.convertReqInputsToCommandInputs(req.Command.Type, req.Command.Inputs)
+		if err != nil {
+			return nil, nil, xerror.ValidationFailed(err, "invalid command inputs")
+		}
+
+		var priority uint8
+		if req.Command.Priority != nil {
+			priority = *req.Command.Priority
+		}
+
+		commandTemplate = &schedule.CommandTemplate{
+			Inputs:   inputs,
+			Retry:    h.commandHandler.convertReqRetryPolicyToRetryPolicy(req.Command.Retry),
+			Priority: priority,
+		}
+	}
+
+	var missionTemplate *schedule.MissionTemplate
+	if req.Mission != nil {
+		steps := make([]command.Inputs, len(req.Mission.Steps))
+		for i, step := range req.Mission.Steps {
+			inputs, err := h.commandHandler.convertReqInputsToCommandInputs(step.Type, step.Inputs)
+			if err != nil {
+				return nil, nil, xerror.ValidationFailed(err, fmt.Sprintf("invalid inputs of step %d", i+1))
+			}
+			steps[i] = inputs
+		}
+
+		var maxRetries uint8
+		if req.Mission.MaxRetries != nil {
+			maxRetries = *req.Mission.MaxRetries
+		}
+
+		missionTemplate = &schedule.MissionTemplate{
+			Steps:      steps,
+			OnFailure:  command.OnFailurePolicy(req.Mission.OnFailure),
+			MaxRetries: maxRetries,
+		}
+	}
+
+	return commandTemplate, missionTemplate, nil
+}
+
+func (h scheduleHandler) convertScheduleToResponse(sch schedule.Schedule) (gen.ScheduleResponse, error) {
+	var commandTemplate *gen.ScheduleCommandTemplate
+	if sch.Command != nil {
+		inputs, err := h.commandHandler.convertInputsToResponse(sch.Command.Inputs)
+		if err != nil {
+			return gen.ScheduleResponse{}, fmt.Errorf("convert command inputs to response: %w", err)
+		}
+
+		commandTemplate = &gen.ScheduleCommandTemplate{
+			Type:     sch.Command.Inputs.CommandType().String(),
+			Inputs:   inputs,
+			Retry:    h.commandHandler.convertRetryPolicyToResponse(sch.Command.Retry),
+			Priority: &sch.Command.Priority,
+		}
+	}
+
+	var missionTemplate *gen.ScheduleMissionTemplate
+	if sch.Mission != nil {
+		steps := make([]gen.ScheduleMissionStep, len(sch.Mission.Steps))
+		for i, step := range sch.Mission.Steps {
+			inputs, err := h.commandHandler.convertInputsToResponse(step)
+			if err != nil {
+				return gen.ScheduleResponse{}, fmt.Errorf("convert step inputs to response: %w", err)
+			}
+			steps[i] = gen.ScheduleMissionStep{
+				Type:   step.CommandType().String(),
+				Inputs: inputs,
+			}
+		}
+
+		missionTemplate = &gen.ScheduleMissionTemplate{
+			Steps:      steps,
+			OnFailure:  sch.Mission.OnFailure.String(),
+			MaxRetries: &sch.Mission.MaxRetries,
+		}
+	}
+
+	return gen.ScheduleResponse{
+		Id:        sch.ID,
+		Name:      sch.Name,
+		Cron:      sch.Cron,
+		Timezone:  sch.Timezone,
+		Enabled:   sch.Enabled,
+		Command:   commandTemplate,
+		Mission:   missionTemplate,
+		LastRunAt: sch.LastRunAt,
+		NextRunAt: sch.NextRunAt,
+		CreatedAt: sch.CreatedAt,
+		UpdatedAt: sch.UpdatedAt,
+	}, nil
+}
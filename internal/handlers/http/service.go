@@ -20,12 +20,16 @@ import (
 	"github.com/tbe-team/raybot/internal/services/dashboarddata"
 	"github.com/tbe-team/raybot/internal/services/limitswitch"
 	"github.com/tbe-team/raybot/internal/services/peripheral"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/internal/services/schedule"
 	"github.com/tbe-team/raybot/internal/services/system"
+	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
 type Service struct {
-	cfg config.HTTP
-	log *slog.Logger
+	cfg        config.HTTP
+	log        *slog.Logger
+	subscriber eventbus.Subscriber
 
 	configService        configsvc.Service
 	systemService        system.Service
@@ -35,6 +39,8 @@ type Service struct {
 	apperrorcodeService  apperrorcode.Service
 	limitSwitchService   limitswitch.Service
 	alarmService         alarm.Service
+	railMapService       railmap.Service
+	scheduleService      schedule.Service
 }
 
 type CleanupFunc func(ctx context.Context) error
@@ -42,6 +48,7 @@ type CleanupFunc func(ctx context.Context) error
 func New(
 	cfg config.HTTP,
 	log *slog.Logger,
+	subscriber eventbus.Subscriber,
 	configService configsvc.Service,
 	systemService system.Service,
 	dashboardDataService dashboarddata.Service,
@@ -50,10 +57,13 @@ func New(
 	apperrorcodeService apperrorcode.Service,
 	limitSwitchService limitswitch.Service,
 	alarmService alarm.Service,
+	railMapService railmap.Service,
+	scheduleService schedule.Service,
 ) *Service {
 	return &Service{
 		cfg:                  cfg,
 		log:                  log.With("service", "http"),
+		subscriber:           subscriber,
 		configService:        configService,
 		systemService:        systemService,
 		dashboardDataService: dashboardDataService,
@@ -62,6 +72,8 @@ func New(
 		apperrorcodeService:  apperrorcodeService,
 		limitSwitchService:   limitSwitchService,
 		alarmService:         alarmService,
+		railMapService:       railMapService,
+		scheduleService:      scheduleService,
 	}
 }
 
@@ -135,8 +147,11 @@ type handler struct {
 	*dashboardDataHandler
 	*peripheralHandler
 	*commandHandler
+	*missionHandler
 	*stateHandler
 	*alarmHandler
+	*railMapHandler
+	*scheduleHandler
 }
 
 func (s *Service) newHandler() *handler {
@@ -148,8 +163,11 @@ func (s *Service) newHandler() *handler {
 		systemHandler:        newSystemHandler(s.systemService),
 		dashboardDataHandler: newDashboardDataHandler(s.dashboardDataService),
 		peripheralHandler:    newPeripheralHandler(s.peripheralService),
-		commandHandler:       newCommandHandler(s.commandService),
+		commandHandler:       newCommandHandler(s.log, s.subscriber, s.commandService),
+		missionHandler:       newMissionHandler(s.commandService),
 		stateHandler:         newStateHandler(s.limitSwitchService),
 		alarmHandler:         newAlarmHandler(s.alarmService),
+		railMapHandler:       newRailMapHandler(s.railMapService),
+		scheduleHandler:      newScheduleHandler(s.scheduleService),
 	}
 }
@@ -48,7 +48,7 @@ func (h *deleteOldCommandHandler) run(ctx context.Context, stoppedCh chan struct
 		case <-ctx.Done():
 			return
 
-		case <-time.After(h.deleteOldCommandCfg.ScheduleDuration()):
+		case <-time.After(time.Until(h.deleteOldCommandCfg.NextRun(time.Now()))):
 			if err := h.commandService.DeleteOldCommands(ctx); err != nil {
 				h.log.Error("failed to delete old commands", slog.Any("error", err))
 			}
@@ -50,6 +50,11 @@ func (h *executeCommandHandler) Run(ctx context.Context) func() {
 func (h *executeCommandHandler) run(ctx context.Context, stoppedCh chan struct{}) {
 	defer close(stoppedCh)
 
+	// The pending commands of the previous run are recovered before the queue is processed.
+	if err := h.commandService.RecoverPendingCommands(ctx); err != nil {
+		h.log.Error("failed to recover pending commands", slog.Any("error", err))
+	}
+
 	ch := make(chan struct{}, 1)
 
 	h.subscriber.Subscribe(ctx, events.CommandCreatedTopic, func(_ *eventbus.Message) {
@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/schedule"
+)
+
+const (
+	runSchedulesInterval = 1 * time.Second
+)
+
+type runSchedulesHandler struct {
+	log             *slog.Logger
+	scheduleService schedule.Service
+}
+
+func newRunSchedulesHandler(
+	log *slog.Logger,
+	scheduleService schedule.Service,
+) *runSchedulesHandler {
+	return &runSchedulesHandler{
+		log:             log,
+		scheduleService: scheduleService,
+	}
+}
+
+func (h *runSchedulesHandler) Run(ctx context.Context) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	stoppedCh := make(chan struct{})
+
+	go h.run(ctx, stoppedCh)
+
+	return func() {
+		cancel()
+		<-stoppedCh
+	}
+}
+
+func (h *runSchedulesHandler) run(ctx context.Context, stoppedCh chan struct{}) {
+	defer close(stoppedCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-time.After(runSchedulesInterval):
+			if err := h.scheduleService.RunDueSchedules(ctx); err != nil {
+				h.log.Error("failed to run due schedules", slog.Any("error", err))
+			}
+		}
+	}
+}
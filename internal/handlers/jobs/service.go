@@ -8,6 +8,7 @@ import (
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/services/alarm"
 	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
@@ -15,9 +16,10 @@ type Service struct {
 	cronCfg config.Cron
 	log     *slog.Logger
 
-	subscriber     eventbus.Subscriber
-	commandService command.Service
-	alarmService   alarm.Service
+	subscriber      eventbus.Subscriber
+	commandService  command.Service
+	alarmService    alarm.Service
+	scheduleService schedule.Service
 }
 
 type CleanupFunc func(context.Context) error
@@ -28,13 +30,15 @@ func New(
 	subscriber eventbus.Subscriber,
 	commandService command.Service,
 	alarmService alarm.Service,
+	scheduleService schedule.Service,
 ) *Service {
 	return &Service{
-		cronCfg:        cronCfg,
-		log:            log.With("service", "jobs"),
-		subscriber:     subscriber,
-		commandService: commandService,
-		alarmService:   alarmService,
+		cronCfg:         cronCfg,
+		log:             log.With("service", "jobs"),
+		subscriber:      subscriber,
+		commandService:  commandService,
+		alarmService:    alarmService,
+		scheduleService: scheduleService,
 	}
 }
 
@@ -42,11 +46,13 @@ func (s *Service) Run(ctx context.Context) (CleanupFunc, error) {
 	deleteOldCommandHandler := newDeleteOldCommandHandler(s.cronCfg.DeleteOldCommand, s.log, s.commandService)
 	executeCommandHandler := newExecuteCommandHandler(s.log, s.commandService, s.subscriber)
 	deleteDeactivatedAlarmsHandler := newDeleteDeactivatedAlarmsHandler(s.log, s.alarmService)
+	runSchedulesHandler := newRunSchedulesHandler(s.log, s.scheduleService)
 
 	stopFuncs := []func(){}
 	stopFuncs = append(stopFuncs, deleteOldCommandHandler.Run(ctx))
 	stopFuncs = append(stopFuncs, executeCommandHandler.Run(ctx))
 	stopFuncs = append(stopFuncs, deleteDeactivatedAlarmsHandler.Run(ctx))
+	stopFuncs = append(stopFuncs, runSchedulesHandler.Run(ctx))
 
 	cleanup := func(_ context.Context) error {
 		wg := sync.WaitGroup{}
@@ -0,0 +1,174 @@
+package picserial
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/peripheral"
+	peripheralmocks "github.com/tbe-team/raybot/internal/services/peripheral/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestService_Reconnect(t *testing.T) {
+	reconnectCfg := config.SerialReconnect{
+		Enable:         true,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}
+
+	t.Run("Should reopen the port and publish connected after a read error", func(t *testing.T) {
+		cfg := config.PIC{
+			Serial:    config.Serial{Port: "/dev/ttyUSB0"},
+			Reconnect: reconnectCfg,
+		}
+		client := &fakeClient{connected: true, readErr: errors.New("device disconnected")}
+		bus := eventbus.NewInProcEventBus(logging.NewNoopLogger())
+		disconnected, connected := subscribeConnectionEvents(t, bus)
+
+		s := New(cfg, logging.NewNoopLogger(), client, bus, nil, nil, nil, nil, nil, nil, nil, nil, handshake.NewRegistry())
+		cleanup, err := s.Run(context.Background())
+		require.NoError(t, err)
+		defer func() { require.NoError(t, cleanup(context.Background())) }()
+
+		waitEvent(t, disconnected)
+		waitEvent(t, connected)
+		assert.Equal(t, []string{"/dev/ttyUSB0"}, client.reopenedPorts())
+	})
+
+	t.Run("Should resolve the port from the USB IDs", func(t *testing.T) {
+		cfg := config.PIC{
+			Serial: config.Serial{
+				Port: "/dev/ttyUSB0",
+				USB:  config.SerialUSB{VID: "1a86", PID: "7523", SerialNumber: "PIC01"},
+			},
+			Reconnect: reconnectCfg,
+		}
+		client := &fakeClient{}
+		bus := eventbus.NewInProcEventBus(logging.NewNoopLogger())
+		_, connected := subscribeConnectionEvents(t, bus)
+
+		peripheralService := peripheralmocks.NewFakeService(t)
+		peripheralService.EXPECT().FindUSBSerialPort(mock.Anything, peripheral.FindUSBSerialPortParams{
+			VID:          "1a86",
+			PID:          "7523",
+			SerialNumber: "PIC01",
+		}).Return(peripheral.SerialPort{}, peripheral.ErrSerialPortNotFound).Once()
+		peripheralService.EXPECT().FindUSBSerialPort(mock.Anything, mock.Anything).
+			Return(peripheral.SerialPort{Port: "/dev/ttyUSB3", IsUSB: true}, nil).Once()
+
+		s := New(cfg, logging.NewNoopLogger(), client, bus, nil, nil, nil, nil, nil, nil, nil, peripheralService, handshake.NewRegistry())
+		cleanup, err := s.Run(context.Background())
+		require.NoError(t, err)
+		defer func() { require.NoError(t, cleanup(context.Background())) }()
+
+		waitEvent(t, connected)
+		assert.Equal(t, []string{"/dev/ttyUSB3"}, client.reopenedPorts())
+	})
+
+	t.Run("Should stop reading after a read error when reconnect is disabled", func(t *testing.T) {
+		cfg := config.PIC{
+			Serial: config.Serial{Port: "/dev/ttyUSB0"},
+		}
+		client := &fakeClient{connected: true, readErr: errors.New("device disconnected")}
+		bus := eventbus.NewInProcEventBus(logging.NewNoopLogger())
+		disconnected, _ := subscribeConnectionEvents(t, bus)
+
+		s := New(cfg, logging.NewNoopLogger(), client, bus, nil, nil, nil, nil, nil, nil, nil, nil, handshake.NewRegistry())
+		s.readLoop(context.Background())
+
+		waitEvent(t, disconnected)
+		assert.Empty(t, client.reopenedPorts())
+	})
+}
+
+func subscribeConnectionEvents(t *testing.T, bus *eventbus.InProcEventBus) (disconnected, connected chan struct{}) {
+	t.Helper()
+
+	disconnected = make(chan struct{}, 1)
+	connected = make(chan struct{}, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	bus.Subscribe(ctx, events.PICSerialDisconnectedTopic, func(*eventbus.Message) { disconnected <- struct{}{} })
+	bus.Subscribe(ctx, events.PICSerialConnectedTopic, func(*eventbus.Message) { connected <- struct{}{} })
+
+	return disconnected, connected
+}
+
+func waitEvent(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+}
+
+// fakeClient fails the first read with readErr, then blocks until the context is done.
+type fakeClient struct {
+	mu        sync.Mutex
+	connected bool
+	readErr   error
+	reopened  []string
+}
+
+func (c *fakeClient) Open() error { return nil }
+
+func (c *fakeClient) Reopen(port string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reopened = append(c.reopened, port)
+	c.connected = true
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = false
+	return nil
+}
+
+func (c *fakeClient) Connected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.connected
+}
+
+func (c *fakeClient) Write(context.Context, []byte) error { return nil }
+
+func (c *fakeClient) Read(ctx context.Context) ([]byte, error) {
+	c.mu.Lock()
+	err := c.readErr
+	c.readErr = nil
+	c.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (c *fakeClient) Stats() serialframe.Stats { return serialframe.Stats{} }
+
+func (c *fakeClient) reopenedPorts() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.reopened...)
+}
@@ -10,7 +10,10 @@ import (
 
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/handlers/serialboard"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
 	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
 	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/cargo"
@@ -18,6 +21,7 @@ import (
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
 	"github.com/tbe-team/raybot/internal/services/limitswitch"
+	"github.com/tbe-team/raybot/internal/services/peripheral"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
@@ -35,6 +39,12 @@ type Service struct {
 	limitSwitchService    limitswitch.Service
 	cargoService          cargo.Service
 	appStateService       appstate.Service
+
+	// frameStats are the last frame stats reported to the app state
+	frameStats serialframe.Stats
+
+	// link reconnects the port and runs the handshake with the PIC
+	link *serialboard.Link
 }
 
 type CleanupFunc func(context.Context) error
@@ -51,6 +61,8 @@ func New(
 	limitSwitchService limitswitch.Service,
 	cargoService cargo.Service,
 	appStateService appstate.Service,
+	peripheralService peripheral.Service,
+	boards *handshake.Registry,
 ) *Service {
 	s := &Service{
 		cfg:                   cfg,
@@ -65,17 +77,44 @@ func New(
 		cargoService:          cargoService,
 		appStateService:       appStateService,
 	}
+	s.link = serialboard.New(
+		serialboard.Params{
+			Board:     handshake.BoardPIC,
+			Serial:    cfg.Serial,
+			Reconnect: cfg.Reconnect,
+			PublishConnected: func() {
+				s.publisher.Publish(
+					events.PICSerialConnectedTopic,
+					eventbus.NewMessage(events.PICSerialConnectedEvent{}),
+				)
+			},
+			UpdateBoardInfo: func(ctx context.Context, board *appstate.BoardInfo) error {
+				return s.appStateService.UpdatePICSerialConnection(ctx, appstate.UpdatePICSerialConnectionParams{
+					Board:    board,
+					SetBoard: true,
+				})
+			},
+		},
+		s.log,
+		client,
+		peripheralService,
+		boards,
+	)
 
 	return s
 }
 
 func (s *Service) Run(ctx context.Context) (CleanupFunc, error) {
-	if !s.client.Connected() {
+	// Without reconnect there is nothing to read when the port failed to open
+	if !s.client.Connected() && !s.cfg.Reconnect.Enable {
 		return func(_ context.Context) error { return nil }, nil
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	go s.readLoop(ctx)
+	if s.client.Connected() {
+		s.link.StartHandshake(ctx)
+	}
 
 	cleanup := func(_ context.Context) error {
 		// Cancel read loop before closing the serial client
@@ -86,64 +125,131 @@ func (s *Service) Run(ctx context.Context) (CleanupFunc, error) {
 	return cleanup, nil
 }
 
+// readLoop reads and routes the messages until the context is done.
+// When reconnect is enabled, a lost port is re-opened and reading resumes.
 func (s *Service) readLoop(ctx context.Context) {
 	for {
+		if !s.client.Connected() {
+			if !s.cfg.Reconnect.Enable {
+				return
+			}
+			if err := s.link.Reconnect(ctx); err != nil {
+				return
+			}
+		}
+
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			msg, err := s.client.Read(ctx)
-			if err != nil {
-				s.log.Error("failed to read from serial client", slog.Any("error", err))
-				if errors.Is(err, picserial.ErrPICSerialNotConnected) {
-					s.publisher.Publish(
-						events.PICSerialDisconnectedTopic,
-						eventbus.NewMessage(events.PICSerialDisconnectedEvent{
-							Error: err,
-						}),
-					)
-				}
+		}
+
+		msg, err := s.client.Read(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			s.log.Error("failed to read from serial client", slog.Any("error", err))
+			s.publisher.Publish(
+				events.PICSerialDisconnectedTopic,
+				eventbus.NewMessage(events.PICSerialDisconnectedEvent{
+					Error: err,
+				}),
+			)
+			if !s.cfg.Reconnect.Enable {
 				return
 			}
-			s.routeMessage(ctx, msg)
+
+			// Release the dead port, the next iteration re-opens it
+			if err := s.client.Close(); err != nil && !errors.Is(err, picserial.ErrPICSerialNotConnected) {
+				s.log.Debug("failed to close serial client", slog.Any("error", err))
+			}
+			continue
 		}
+
+		s.routeMessage(ctx, msg)
+		s.reportFrameStats(ctx)
+	}
+}
+
+// reportFrameStats updates the app state when the framed protocol saw new faults.
+func (s *Service) reportFrameStats(ctx context.Context) {
+	stats := s.client.Stats()
+	if stats == s.frameStats {
+		return
+	}
+	s.frameStats = stats
+
+	s.log.Warn("serial frame faults",
+		slog.Uint64("crc_errors", stats.CRCErrors),
+		slog.Uint64("resyncs", stats.Resyncs),
+		slog.Uint64("sequence_gaps", stats.SequenceGaps),
+	)
+
+	if err := s.appStateService.UpdatePICSerialConnection(ctx, appstate.UpdatePICSerialConnectionParams{
+		FrameStats: appstate.FrameStats{
+			CRCErrors:    stats.CRCErrors,
+			Resyncs:      stats.Resyncs,
+			SequenceGaps: stats.SequenceGaps,
+		},
+		SetFrameStats: true,
+	}); err != nil {
+		s.log.Error("failed to update PIC serial frame stats", slog.Any("error", err))
 	}
 }
 
 func (s *Service) routeMessage(ctx context.Context, msg []byte) {
+	if err := s.HandleMessage(ctx, msg); err != nil {
+		s.log.Error("failed to handle message", slog.Any("error", err), slog.Any("message", msg))
+	}
+}
+
+// HandleMessage decodes a message received from the PIC and passes it to
+// its handler. It is also the entry point of the capture replay.
+func (s *Service) HandleMessage(ctx context.Context, msg []byte) error {
 	var temp struct {
 		Type messageType `json:"type"`
 	}
 	if err := json.Unmarshal(msg, &temp); err != nil {
-		s.log.Error("failed to unmarshal message", slog.Any("error", err), slog.Any("message", msg))
-		return
+		return fmt.Errorf("unmarshal message type: %w", err)
 	}
 
-	//nolint:gocritic
 	switch temp.Type {
 	case messageTypeSyncState:
 		var syncStateMsg syncStateMessage
 		if err := json.Unmarshal(msg, &syncStateMsg); err != nil {
-			s.log.Error("failed to unmarshal sync state message", slog.Any("error", err), slog.Any("message", msg))
-			return
+			return fmt.Errorf("unmarshal sync state message: %w", err)
 		}
 		if err := s.HandleSyncState(ctx, syncStateMsg); err != nil {
-			s.log.Error("failed to handle sync state message", slog.Any("error", err), slog.Any("message", msg))
+			return fmt.Errorf("handle sync state message: %w", err)
 		}
 
 	case messageTypeACK:
 		var ackMsg ackMessage
 		if err := json.Unmarshal(msg, &ackMsg); err != nil {
-			s.log.Error("failed to unmarshal ack message", slog.Any("error", err), slog.Any("message", msg))
-			return
+			return fmt.Errorf("unmarshal ack message: %w", err)
+		}
+		if ackMsg.ID == handshake.HelloRequestID {
+			// A legacy firmware rejecting the hello request, the handshake times out
+			return nil
 		}
 		if err := s.HandleACK(ackMsg); err != nil {
-			s.log.Error("failed to handle ack message", slog.Any("error", err), slog.Any("message", msg))
+			return fmt.Errorf("handle ack message: %w", err)
 		}
 
+	case messageTypeHello:
+		var helloMsg serialboard.HelloMessage
+		if err := json.Unmarshal(msg, &helloMsg); err != nil {
+			return fmt.Errorf("unmarshal hello message: %w", err)
+		}
+		s.link.HandleHello(ctx, helloMsg)
+
 	default:
-		s.log.Error("unknown message type", slog.Any("type", temp.Type))
+		return fmt.Errorf("unknown message type: %d", temp.Type)
 	}
+
+	return nil
 }
 
 // messageType is the type of message received from the PIC
@@ -161,6 +267,8 @@ func (m *messageType) UnmarshalJSON(data []byte) error {
 		*m = messageTypeSyncState
 	case 1:
 		*m = messageTypeACK
+	case 2:
+		*m = messageTypeHello
 	default:
 		return fmt.Errorf("invalid message type: %s", string(data))
 	}
@@ -170,4 +278,5 @@ func (m *messageType) UnmarshalJSON(data []byte) error {
 const (
 	messageTypeSyncState messageType = iota
 	messageTypeACK
+	messageTypeHello
 )
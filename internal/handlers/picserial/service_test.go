@@ -0,0 +1,85 @@
+package picserial
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/appstate"
+	"github.com/tbe-team/raybot/internal/services/appstate/appstateimpl"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestService_HandleHelloMessage(t *testing.T) {
+	newService := func(t *testing.T) (*Service, *handshake.Registry, appstate.Repository) {
+		appStateRepository := appstateimpl.NewAppStateRepository()
+		t.Cleanup(appStateRepository.Cleanup)
+
+		boards := handshake.NewRegistry()
+		s := New(
+			config.PIC{},
+			logging.NewNoopLogger(),
+			&fakeClient{connected: true},
+			eventbus.NewNoopEventBus(),
+			nil, nil, nil, nil, nil, nil,
+			appstateimpl.NewService(appStateRepository),
+			nil,
+			boards,
+		)
+
+		return s, boards, appStateRepository
+	}
+
+	t.Run("Should store the info reported in the hello message", func(t *testing.T) {
+		s, boards, appStateRepository := newService(t)
+
+		err := s.HandleMessage(context.Background(), []byte(`{
+			"type": 2,
+			"firmware_version": "2.1.0",
+			"protocol_version": 2,
+			"commands": [0, 1, 2, 3],
+			"sync_states": [0, 1, 2, 3, 4, 5, 6, 7]
+		}`))
+		require.NoError(t, err)
+
+		info, ok := boards.Get(handshake.BoardPIC)
+		require.True(t, ok)
+		assert.Equal(t, handshake.Info{
+			FirmwareVersion: "2.1.0",
+			ProtocolVersion: 2,
+			Commands:        []uint8{0, 1, 2, 3},
+			SyncStates:      []uint8{0, 1, 2, 3, 4, 5, 6, 7},
+		}, info)
+
+		state, err := appStateRepository.GetAppState(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, state.PICSerialConnection.Board)
+		assert.Equal(t, "2.1.0", state.PICSerialConnection.Board.FirmwareVersion)
+		assert.True(t, state.PICSerialConnection.Board.Compatible)
+		assert.False(t, state.PICSerialConnection.Board.Legacy)
+	})
+
+	t.Run("Should flag a protocol version missing from the compatibility table", func(t *testing.T) {
+		s, _, appStateRepository := newService(t)
+
+		err := s.HandleMessage(context.Background(), []byte(`{"type":2,"firmware_version":"9.0.0","protocol_version":9,"commands":[0],"sync_states":[]}`))
+		require.NoError(t, err)
+
+		state, err := appStateRepository.GetAppState(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, state.PICSerialConnection.Board)
+		assert.False(t, state.PICSerialConnection.Board.Compatible)
+	})
+
+	t.Run("Should ignore the error ACK of a legacy firmware to the hello request", func(t *testing.T) {
+		s, _, _ := newService(t)
+
+		err := s.HandleMessage(context.Background(), []byte(`{"type":1,"id":"hello","status":0}`))
+		assert.NoError(t, err)
+	})
+}
@@ -32,6 +32,14 @@ var hidKeyMap = map[byte]byte{
 	0x27: '0',
 }
 
+// Client reads the RFID tags under the robot.
+type Client interface {
+	Open() error
+	// Read blocks until a tag is read.
+	Read() (string, error)
+	Close() error
+}
+
 type client struct {
 	device *hid.Device
 }
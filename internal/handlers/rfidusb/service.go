@@ -11,7 +11,7 @@ import (
 
 type Service struct {
 	log    *slog.Logger
-	client *client
+	client Client
 
 	publisher       eventbus.Publisher
 	locationService location.Service
@@ -19,17 +19,33 @@ type Service struct {
 
 type CleanupFunc func(context.Context) error
 
+type OptionFunc func(*Service)
+
+// WithClient replaces the USB reader, e.g. with the reader of the hardware simulator.
+func WithClient(client Client) OptionFunc {
+	return func(s *Service) {
+		s.client = client
+	}
+}
+
 func New(
 	log *slog.Logger,
 	publisher eventbus.Publisher,
 	locationService location.Service,
+	opts ...OptionFunc,
 ) *Service {
-	return &Service{
+	s := &Service{
 		log:             log.With("service", "rfidusb"),
 		publisher:       publisher,
 		client:          newClient(),
 		locationService: locationService,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 func (s *Service) Run(ctx context.Context) (CleanupFunc, error) {
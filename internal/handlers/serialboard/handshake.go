@@ -0,0 +1,103 @@
+package serialboard
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
+	"github.com/tbe-team/raybot/internal/services/appstate"
+)
+
+// HelloMessage is the reply of the board to the hello request.
+type HelloMessage struct {
+	FirmwareVersion string  `json:"firmware_version"`
+	ProtocolVersion uint8   `json:"protocol_version"`
+	Commands        []uint8 `json:"commands"`
+	SyncStates      []uint8 `json:"sync_states"`
+}
+
+// StartHandshake sends the hello request to the board. Without a reply
+// within the timeout, the board is assumed to run a legacy firmware.
+func (l *Link) StartHandshake(ctx context.Context) {
+	l.handshakeMu.Lock()
+	l.handshakeSeq++
+	seq := l.handshakeSeq
+	l.helloReceived = false
+	l.handshakeMu.Unlock()
+
+	if err := l.port.Write(ctx, handshake.HelloRequest()); err != nil {
+		l.log.Error("failed to send hello request", slog.Any("error", err))
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.params.HandshakeTimeout):
+		}
+
+		l.handshakeMu.Lock()
+		// A later handshake owns the result
+		timedOut := seq == l.handshakeSeq && !l.helloReceived
+		l.handshakeMu.Unlock()
+
+		if timedOut {
+			l.log.Warn("no reply to the hello request, assuming a legacy firmware")
+			l.updateBoardInfo(ctx, handshake.LegacyInfo(l.params.Board))
+		}
+	}()
+}
+
+// HandleHello stores the info reported by the board in its hello reply.
+func (l *Link) HandleHello(ctx context.Context, msg HelloMessage) {
+	l.handshakeMu.Lock()
+	l.helloReceived = true
+	l.handshakeMu.Unlock()
+
+	l.updateBoardInfo(ctx, handshake.Info{
+		FirmwareVersion: msg.FirmwareVersion,
+		ProtocolVersion: msg.ProtocolVersion,
+		Commands:        msg.Commands,
+		SyncStates:      msg.SyncStates,
+	})
+}
+
+// updateBoardInfo checks the info against the compatibility table and
+// stores it for the controller and the app state.
+func (l *Link) updateBoardInfo(ctx context.Context, info handshake.Info) {
+	board := l.params.Board
+	l.boards.Set(board, info)
+
+	compatibility := handshake.CompatibilityOf(board)
+	compatible := compatibility.Compatible(info)
+	log := l.log.With(
+		slog.String("board", string(board)),
+		slog.String("firmware_version", info.FirmwareVersion),
+		slog.Int("protocol_version", int(info.ProtocolVersion)),
+		slog.Bool("legacy", info.Legacy),
+	)
+	if compatible {
+		log.Info("handshake done")
+	} else {
+		log.Error("protocol version not supported, commands will be refused",
+			slog.Int("min_protocol_version", int(compatibility.MinProtocolVersion)),
+			slog.Int("max_protocol_version", int(compatibility.MaxProtocolVersion)),
+		)
+	}
+	if unknown := compatibility.UnknownSyncStates(info); len(unknown) > 0 {
+		log.Warn("board sends sync states that are not supported, they will be dropped", slog.Any("sync_states", unknown))
+	}
+
+	if err := l.params.UpdateBoardInfo(ctx, &appstate.BoardInfo{
+		FirmwareVersion: info.FirmwareVersion,
+		ProtocolVersion: info.ProtocolVersion,
+		Commands:        info.Commands,
+		SyncStates:      info.SyncStates,
+		Legacy:          info.Legacy,
+		Compatible:      compatible,
+		UpdatedAt:       time.Now(),
+	}); err != nil {
+		l.log.Error("failed to update board info", slog.String("board", string(board)), slog.Any("error", err))
+	}
+}
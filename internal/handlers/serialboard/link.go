@@ -0,0 +1,72 @@
+// Package serialboard is the connection logic shared by the PIC and ESP serial handlers:
+// re-opening a lost port and the protocol handshake with the board.
+package serialboard
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
+	"github.com/tbe-team/raybot/internal/services/appstate"
+	"github.com/tbe-team/raybot/internal/services/peripheral"
+)
+
+const defaultHandshakeTimeout = 2 * time.Second
+
+// Port is the part of the serial client used to reconnect and to send the hello request.
+type Port interface {
+	Reopen(port string) error
+	Write(ctx context.Context, data []byte) error
+}
+
+// Params are the board specific parts of a link.
+type Params struct {
+	Board     handshake.Board
+	Serial    config.Serial
+	Reconnect config.SerialReconnect
+
+	// PublishConnected publishes the connected event of the board.
+	PublishConnected func()
+	// UpdateBoardInfo stores the board info in the app state connection of the board.
+	UpdateBoardInfo func(ctx context.Context, board *appstate.BoardInfo) error
+
+	// HandshakeTimeout is the time to wait for the hello reply, 2s if zero.
+	HandshakeTimeout time.Duration
+}
+
+// Link is the serial link of a board.
+type Link struct {
+	params            Params
+	log               *slog.Logger
+	port              Port
+	peripheralService peripheral.Service
+	boards            *handshake.Registry
+
+	// handshakeMu guards the state of the running handshake
+	handshakeMu   sync.Mutex
+	handshakeSeq  uint64
+	helloReceived bool
+}
+
+func New(
+	params Params,
+	log *slog.Logger,
+	port Port,
+	peripheralService peripheral.Service,
+	boards *handshake.Registry,
+) *Link {
+	if params.HandshakeTimeout == 0 {
+		params.HandshakeTimeout = defaultHandshakeTimeout
+	}
+
+	return &Link{
+		params:            params,
+		log:               log,
+		port:              port,
+		peripheralService: peripheralService,
+		boards:            boards,
+	}
+}
@@ -0,0 +1,248 @@
+package serialboard
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/appstate"
+	"github.com/tbe-team/raybot/internal/services/peripheral"
+	peripheralmocks "github.com/tbe-team/raybot/internal/services/peripheral/mocks"
+)
+
+func TestLink_Handshake(t *testing.T) {
+	for _, board := range []handshake.Board{handshake.BoardPIC, handshake.BoardESP} {
+		t.Run(string(board), func(t *testing.T) {
+			t.Run("Should store the info reported in the hello message", func(t *testing.T) {
+				l, port, boards, infos := newTestLink(t, board, config.Serial{}, nil)
+
+				l.HandleHello(context.Background(), HelloMessage{
+					FirmwareVersion: "2.1.0",
+					ProtocolVersion: 2,
+					Commands:        []uint8{0, 1, 2, 3},
+				})
+
+				info, ok := boards.Get(board)
+				require.True(t, ok)
+				assert.Equal(t, handshake.Info{
+					FirmwareVersion: "2.1.0",
+					ProtocolVersion: 2,
+					Commands:        []uint8{0, 1, 2, 3},
+				}, info)
+
+				appInfo := infos.last(t)
+				assert.Equal(t, "2.1.0", appInfo.FirmwareVersion)
+				assert.True(t, appInfo.Compatible)
+				assert.False(t, appInfo.Legacy)
+				assert.Empty(t, port.written())
+			})
+
+			t.Run("Should flag a protocol version missing from the compatibility table", func(t *testing.T) {
+				l, _, _, infos := newTestLink(t, board, config.Serial{}, nil)
+
+				l.HandleHello(context.Background(), HelloMessage{FirmwareVersion: "9.0.0", ProtocolVersion: 9})
+
+				assert.False(t, infos.last(t).Compatible)
+			})
+
+			t.Run("Should assume a legacy firmware when the board does not reply", func(t *testing.T) {
+				l, port, boards, infos := newTestLink(t, board, config.Serial{}, nil)
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				l.StartHandshake(ctx)
+				assert.Equal(t, [][]byte{handshake.HelloRequest()}, port.written())
+
+				// The app state is updated last
+				require.Eventually(t, func() bool { return infos.count() == 1 }, time.Second, 5*time.Millisecond)
+
+				info, ok := boards.Get(board)
+				require.True(t, ok)
+				assert.Equal(t, handshake.LegacyInfo(board), info)
+				assert.True(t, infos.last(t).Legacy)
+			})
+
+			t.Run("Should keep the reply received before the timeout", func(t *testing.T) {
+				l, _, boards, _ := newTestLink(t, board, config.Serial{}, nil)
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				l.StartHandshake(ctx)
+				l.HandleHello(ctx, HelloMessage{FirmwareVersion: "2.1.0", ProtocolVersion: 2})
+
+				time.Sleep(3 * l.params.HandshakeTimeout)
+				info, ok := boards.Get(board)
+				require.True(t, ok)
+				assert.False(t, info.Legacy)
+				assert.Equal(t, "2.1.0", info.FirmwareVersion)
+			})
+		})
+	}
+}
+
+func TestLink_Reconnect(t *testing.T) {
+	t.Run("Should reopen the configured port, publish connected and start the handshake", func(t *testing.T) {
+		l, port, _, _ := newTestLink(t, handshake.BoardESP, config.Serial{Port: "/dev/ttyUSB0"}, nil)
+		port.reopenErrs = []error{errors.New("no such device")}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		require.NoError(t, l.Reconnect(ctx))
+		assert.Equal(t, []string{"/dev/ttyUSB0", "/dev/ttyUSB0"}, port.reopenedPorts())
+		assert.Equal(t, 1, port.connectedCount())
+		assert.Equal(t, [][]byte{handshake.HelloRequest()}, port.written())
+	})
+
+	t.Run("Should resolve the port from the USB IDs", func(t *testing.T) {
+		peripheralService := peripheralmocks.NewFakeService(t)
+		peripheralService.EXPECT().FindUSBSerialPort(mock.Anything, peripheral.FindUSBSerialPortParams{
+			VID:          "303a",
+			PID:          "1001",
+			SerialNumber: "ESP01",
+		}).Return(peripheral.SerialPort{}, peripheral.ErrSerialPortNotFound).Once()
+		peripheralService.EXPECT().FindUSBSerialPort(mock.Anything, mock.Anything).
+			Return(peripheral.SerialPort{Port: "/dev/ttyACM1", IsUSB: true}, nil).Once()
+
+		l, port, _, _ := newTestLink(t, handshake.BoardESP, config.Serial{
+			Port: "/dev/ttyUSB0",
+			USB:  config.SerialUSB{VID: "303a", PID: "1001", SerialNumber: "ESP01"},
+		}, peripheralService)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		require.NoError(t, l.Reconnect(ctx))
+		assert.Equal(t, []string{"/dev/ttyACM1"}, port.reopenedPorts())
+	})
+
+	t.Run("Should stop when the context is done", func(t *testing.T) {
+		l, port, _, _ := newTestLink(t, handshake.BoardPIC, config.Serial{Port: "/dev/ttyUSB1"}, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.ErrorIs(t, l.Reconnect(ctx), context.Canceled)
+		assert.Empty(t, port.reopenedPorts())
+		assert.Zero(t, port.connectedCount())
+	})
+}
+
+func newTestLink(
+	t *testing.T,
+	board handshake.Board,
+	serial config.Serial,
+	peripheralService peripheral.Service,
+) (*Link, *fakePort, *handshake.Registry, *boardInfos) {
+	t.Helper()
+
+	port := &fakePort{}
+	infos := &boardInfos{}
+	boards := handshake.NewRegistry()
+	l := New(
+		Params{
+			Board:  board,
+			Serial: serial,
+			Reconnect: config.SerialReconnect{
+				Enable:         true,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     10 * time.Millisecond,
+			},
+			PublishConnected: port.connected,
+			UpdateBoardInfo:  infos.add,
+			HandshakeTimeout: 20 * time.Millisecond,
+		},
+		logging.NewNoopLogger(),
+		port,
+		peripheralService,
+		boards,
+	)
+
+	return l, port, boards, infos
+}
+
+// fakePort fails the reopens with reopenErrs in order, then succeeds.
+type fakePort struct {
+	mu         sync.Mutex
+	reopenErrs []error
+	reopened   []string
+	writes     [][]byte
+	connects   int
+}
+
+func (p *fakePort) Reopen(port string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reopened = append(p.reopened, port)
+	if len(p.reopenErrs) > 0 {
+		err := p.reopenErrs[0]
+		p.reopenErrs = p.reopenErrs[1:]
+		return err
+	}
+	return nil
+}
+
+func (p *fakePort) Write(_ context.Context, data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.writes = append(p.writes, data)
+	return nil
+}
+
+func (p *fakePort) connected() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.connects++
+}
+
+func (p *fakePort) reopenedPorts() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.reopened...)
+}
+
+func (p *fakePort) written() [][]byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([][]byte(nil), p.writes...)
+}
+
+func (p *fakePort) connectedCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.connects
+}
+
+// boardInfos records the board infos stored in the app state.
+type boardInfos struct {
+	mu    sync.Mutex
+	infos []appstate.BoardInfo
+}
+
+func (b *boardInfos) add(_ context.Context, info *appstate.BoardInfo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.infos = append(b.infos, *info)
+	return nil
+}
+
+func (b *boardInfos) count() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.infos)
+}
+
+func (b *boardInfos) last(t *testing.T) appstate.BoardInfo {
+	t.Helper()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	require.NotEmpty(t, b.infos)
+	return b.infos[len(b.infos)-1]
+}
@@ -0,0 +1,61 @@
+package serialboard
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tbe-team/raybot/internal/services/peripheral"
+	"github.com/tbe-team/raybot/pkg/backoff"
+)
+
+// Reconnect re-opens the serial port with an exponential backoff until it
+// succeeds or the context is done, then publishes the connected event and
+// starts the handshake.
+func (l *Link) Reconnect(ctx context.Context) error {
+	b := backoff.Exponential{
+		Initial: l.params.Reconnect.InitialBackoff,
+		Max:     l.params.Reconnect.MaxBackoff,
+	}
+
+	for attempt := 1; ; attempt++ {
+		if err := backoff.Wait(ctx, b.Delay(attempt)); err != nil {
+			return err
+		}
+
+		port, err := l.resolvePort(ctx)
+		if err == nil {
+			err = l.port.Reopen(port)
+		}
+		if err != nil {
+			l.log.Warn("failed to reconnect serial port", slog.Int("attempt", attempt), slog.Any("error", err))
+			continue
+		}
+
+		l.log.Info("serial port reconnected", slog.String("port", port), slog.Int("attempt", attempt))
+		l.params.PublishConnected()
+		// The board may have been flashed with another firmware meanwhile
+		l.StartHandshake(ctx)
+		return nil
+	}
+}
+
+// resolvePort returns the port of the USB adapter matching the configured IDs,
+// or the configured port when no IDs are set.
+func (l *Link) resolvePort(ctx context.Context) (string, error) {
+	usb := l.params.Serial.USB
+	if !usb.IsSet() {
+		return l.params.Serial.Port, nil
+	}
+
+	port, err := l.peripheralService.FindUSBSerialPort(ctx, peripheral.FindUSBSerialPortParams{
+		VID:          usb.VID,
+		PID:          usb.PID,
+		SerialNumber: usb.SerialNumber,
+	})
+	if err != nil {
+		return "", fmt.Errorf("find usb serial port: %w", err)
+	}
+
+	return port.Port, nil
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/hardware/espserial"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
 	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/pkg/backoff"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
@@ -33,6 +35,9 @@ type controller struct {
 	picSerialClient picserial.Client
 	espSerialClient espserial.Client
 
+	// boards refuses the commands the boards do not support, nil to skip the check
+	boards *handshake.Registry
+
 	genIDFunc func() string
 }
 
@@ -61,11 +66,17 @@ func New(
 }
 
 func (c *controller) createPICCommand(ctx context.Context, cmd picCommand) error {
+	if err := c.checkCommand(handshake.BoardPIC, uint8(cmd.Type)); err != nil {
+		return err
+	}
+
 	if !c.cfg.PIC.EnableACK {
 		return c.writePICCommand(ctx, cmd)
 	}
 
-	return c.writePICCommandWithACK(ctx, cmd)
+	return c.retryOnACKTimeout(ctx, c.cfg.PIC.CommandACKRetry, cmd.ID, func(ctx context.Context) error {
+		return c.writePICCommandWithACK(ctx, cmd)
+	})
 }
 
 func (c *controller) writePICCommand(ctx context.Context, cmd picCommand) error {
@@ -143,11 +154,17 @@ func (c *controller) trackingPICCommandACK(ctx context.Context, id string) error
 }
 
 func (c *controller) createESPCommand(ctx context.Context, cmd espCommand) error {
+	if err := c.checkCommand(handshake.BoardESP, uint8(cmd.Type)); err != nil {
+		return err
+	}
+
 	if !c.cfg.ESP.EnableACK {
 		return c.writeESPCommand(ctx, cmd)
 	}
 
-	return c.writeESPCommandWithACK(ctx, cmd)
+	return c.retryOnACKTimeout(ctx, c.cfg.ESP.CommandACKRetry, cmd.ID, func(ctx context.Context) error {
+		return c.writeESPCommandWithACK(ctx, cmd)
+	})
 }
 
 func (c *controller) writeESPCommand(ctx context.Context, cmd espCommand) error {
@@ -223,3 +240,45 @@ func (c *controller) trackingESPCommandACK(ctx context.Context, id string) error
 		return ctx.Err()
 	}
 }
+
+// checkCommand refuses a command type the board reported it does not support.
+func (c *controller) checkCommand(board handshake.Board, commandType uint8) error {
+	if c.boards == nil {
+		return nil
+	}
+	return c.boards.CheckCommand(board, commandType)
+}
+
+// retryOnACKTimeout calls write again while it fails with ErrCommandACKTimeout,
+// up to the max attempts of the retry config.
+// The command is written again with the same ID, so a late ACK of a previous attempt is accepted.
+func (c *controller) retryOnACKTimeout(
+	ctx context.Context,
+	retry config.CommandACKRetry,
+	id string,
+	write func(context.Context) error,
+) error {
+	b := backoff.Exponential{
+		Initial: retry.InitialBackoff,
+		Max:     retry.MaxBackoff,
+	}
+	maxAttempts := max(int(retry.MaxAttempts), 1)
+
+	for attempt := 1; ; attempt++ {
+		err := write(ctx)
+		if err == nil || !errors.Is(err, ErrCommandACKTimeout) || attempt >= maxAttempts {
+			return err
+		}
+
+		delay := b.Delay(attempt)
+		c.log.Warn("command ack timeout, retrying",
+			slog.String("id", id),
+			slog.Int("attempt", attempt),
+			slog.Int("max_attempts", maxAttempts),
+			slog.Duration("backoff", delay))
+
+		if err := backoff.Wait(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
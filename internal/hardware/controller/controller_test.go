@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/logging"
+)
+
+func TestController_RetryOnACKTimeout(t *testing.T) {
+	retry := config.CommandACKRetry{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+
+	t.Run("Should retry until the ACK is received", func(t *testing.T) {
+		c := controller{log: logging.NewNoopLogger()}
+
+		attempts := 0
+		err := c.retryOnACKTimeout(context.Background(), retry, "abc", func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return ErrCommandACKTimeout
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("Should fail after the max attempts", func(t *testing.T) {
+		c := controller{log: logging.NewNoopLogger()}
+
+		attempts := 0
+		err := c.retryOnACKTimeout(context.Background(), retry, "abc", func(context.Context) error {
+			attempts++
+			return ErrCommandACKTimeout
+		})
+		assert.ErrorIs(t, err, ErrCommandACKTimeout)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("Should not retry other errors", func(t *testing.T) {
+		c := controller{log: logging.NewNoopLogger()}
+		writeErr := errors.New("write failed")
+
+		attempts := 0
+		err := c.retryOnACKTimeout(context.Background(), retry, "abc", func(context.Context) error {
+			attempts++
+			return writeErr
+		})
+		assert.ErrorIs(t, err, writeErr)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("Should not retry without retry config", func(t *testing.T) {
+		c := controller{log: logging.NewNoopLogger()}
+
+		attempts := 0
+		err := c.retryOnACKTimeout(context.Background(), config.CommandACKRetry{}, "abc", func(context.Context) error {
+			attempts++
+			return ErrCommandACKTimeout
+		})
+		assert.ErrorIs(t, err, ErrCommandACKTimeout)
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestController_CheckCommand(t *testing.T) {
+	newController := func(boards *handshake.Registry) (controller, *picserial.FakeSerialPort) {
+		mockPort := &picserial.FakeSerialPort{}
+		return controller{
+			log:             logging.NewNoopLogger(),
+			subscriber:      &fakeEventBus{},
+			picSerialClient: picserial.NewClientWithPort(mockPort),
+			boards:          boards,
+			genIDFunc:       func() string { return "abc" },
+		}, mockPort
+	}
+
+	t.Run("Should send commands before the handshake is done", func(t *testing.T) {
+		c, mockPort := newController(handshake.NewRegistry())
+
+		require.NoError(t, c.MoveForward(context.Background(), 10))
+		assert.NotEmpty(t, mockPort.WriteBuffer.Bytes())
+	})
+
+	t.Run("Should refuse a command the board does not support", func(t *testing.T) {
+		boards := handshake.NewRegistry()
+		boards.Set(handshake.BoardPIC, handshake.Info{
+			FirmwareVersion: "2.0.0",
+			ProtocolVersion: 2,
+			Commands:        []uint8{0, 1, 2},
+		})
+		c, mockPort := newController(boards)
+
+		err := c.MoveForward(context.Background(), 10)
+		assert.ErrorIs(t, err, handshake.ErrCommandNotSupported)
+		assert.Empty(t, mockPort.WriteBuffer.Bytes())
+	})
+
+	t.Run("Should refuse every command of an unsupported protocol version", func(t *testing.T) {
+		boards := handshake.NewRegistry()
+		boards.Set(handshake.BoardPIC, handshake.Info{
+			FirmwareVersion: "9.0.0",
+			ProtocolVersion: 9,
+			Commands:        []uint8{0, 1, 2, 3},
+		})
+		c, _ := newController(boards)
+
+		err := c.MoveForward(context.Background(), 10)
+		assert.ErrorIs(t, err, handshake.ErrProtocolVersionNotSupported)
+	})
+}
@@ -1,5 +1,7 @@
 package controller
 
+import "github.com/tbe-team/raybot/internal/hardware/handshake"
+
 type OptionFunc func(*controller)
 
 func WithIDGenerator(fn func() string) OptionFunc {
@@ -7,3 +9,10 @@ func WithIDGenerator(fn func() string) OptionFunc {
 		c.genIDFunc = fn
 	}
 }
+
+// WithBoards refuses the commands the boards reported they do not support in the handshake.
+func WithBoards(boards *handshake.Registry) OptionFunc {
+	return func(c *controller) {
+		c.boards = boards
+	}
+}
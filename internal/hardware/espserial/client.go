@@ -8,6 +8,7 @@ import (
 	"go.bug.st/serial"
 
 	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
 	"github.com/tbe-team/raybot/pkg/xerror"
 )
 
@@ -17,22 +18,50 @@ const readBufferSize = 64
 
 type Client interface {
 	Open() error
+	// Reopen closes the current port if any and opens the named port,
+	// e.g. after the device re-enumerated under another name.
+	Reopen(port string) error
 	Close() error
 	Connected() bool
 	Write(ctx context.Context, data []byte) error
 	Read(ctx context.Context) ([]byte, error)
+	// Stats returns the faults of the framed protocol, always zero with the JSON protocol.
+	Stats() serialframe.Stats
 }
 
 type DefaultClient struct {
 	cfg config.Serial
 
-	port serial.Port
-	mode serial.Mode
+	port   serial.Port
+	portMu sync.RWMutex
+	mode   serial.Mode
+
+	protocol config.SerialProtocol
+	decoder  *serialframe.Decoder
+	// wrapPort wraps every opened port, e.g. to capture the raw bytes
+	wrapPort func(serial.Port) serial.Port
 
 	writeMu sync.Mutex
+	seq     uint8
 }
 
-func NewClient(cfg config.Serial) *DefaultClient {
+type OptionFunc func(*DefaultClient)
+
+// WithProtocol sets the protocol of a client created on an open port.
+func WithProtocol(protocol config.SerialProtocol) OptionFunc {
+	return func(c *DefaultClient) {
+		c.protocol = protocol
+	}
+}
+
+// WithPortWrapper wraps the port of the client, and every port it re-opens.
+func WithPortWrapper(wrap func(serial.Port) serial.Port) OptionFunc {
+	return func(c *DefaultClient) {
+		c.wrapPort = wrap
+	}
+}
+
+func NewClient(cfg config.Serial, opts ...OptionFunc) *DefaultClient {
 	mode := serial.Mode{
 		BaudRate: cfg.BaudRate,
 		DataBits: int(cfg.DataBits),
@@ -56,42 +85,94 @@ func NewClient(cfg config.Serial) *DefaultClient {
 		mode.Parity = serial.EvenParity
 	}
 
-	return &DefaultClient{
-		cfg:  cfg,
-		mode: mode,
+	c := &DefaultClient{
+		cfg:      cfg,
+		mode:     mode,
+		protocol: cfg.Protocol,
+		decoder:  serialframe.NewDecoder(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
-func NewClientWithPort(port serial.Port) *DefaultClient {
-	return &DefaultClient{
-		port: port,
+func NewClientWithPort(port serial.Port, opts ...OptionFunc) *DefaultClient {
+	c := &DefaultClient{
+		port:     port,
+		protocol: config.SerialProtocolJSON,
+		decoder:  serialframe.NewDecoder(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+	if c.wrapPort != nil {
+		c.port = c.wrapPort(c.port)
+	}
+
+	return c
 }
 
 func (c *DefaultClient) Open() error {
-	port, err := serial.Open(c.cfg.Port, &c.mode)
+	return c.Reopen(c.cfg.Port)
+}
+
+// Reopen closes the current port if any and opens the named port with the
+// configured mode. The client stays disconnected when the port fails to open.
+// The bytes of a partial frame are dropped, the frame stats are kept.
+func (c *DefaultClient) Reopen(portName string) error {
+	c.portMu.Lock()
+	defer c.portMu.Unlock()
+
+	if c.port != nil {
+		// The old port is usually gone already, closing it only releases the handle
+		_ = c.port.Close()
+		c.port = nil
+	}
+	c.decoder.Reset()
+
+	port, err := serial.Open(portName, &c.mode)
 	if err != nil {
 		return fmt.Errorf("failed to open serial port: %w", err)
 	}
 
 	if err := port.SetReadTimeout(c.cfg.ReadTimeout); err != nil {
+		_ = port.Close()
 		return fmt.Errorf("failed to set read timeout: %w", err)
 	}
 
+	if c.wrapPort != nil {
+		port = c.wrapPort(port)
+	}
+
 	c.port = port
 	return nil
 }
 
 func (c *DefaultClient) Close() error {
+	c.portMu.Lock()
+	defer c.portMu.Unlock()
+
 	if c.port == nil {
 		return ErrESPSerialNotConnected
 	}
 
-	return c.port.Close()
+	err := c.port.Close()
+	c.port = nil
+	return err
 }
 
 func (c *DefaultClient) Connected() bool {
-	return c.port != nil
+	return c.getPort() != nil
+}
+
+func (c *DefaultClient) getPort() serial.Port {
+	c.portMu.RLock()
+	defer c.portMu.RUnlock()
+	return c.port
 }
 
 func (c *DefaultClient) Write(ctx context.Context, data []byte) error {
@@ -101,35 +182,52 @@ func (c *DefaultClient) Write(ctx context.Context, data []byte) error {
 	default:
 	}
 
-	if c.port == nil {
+	port := c.getPort()
+	if port == nil {
 		return ErrESPSerialNotConnected
 	}
 
-	data = append([]byte(">"), data...)
-	data = append(data, '\r', '\n')
-
 	c.writeMu.Lock()
-	_, err := c.port.Write(data)
-	c.writeMu.Unlock()
+	defer c.writeMu.Unlock()
+
+	if c.protocol == config.SerialProtocolFramed {
+		data = serialframe.Encode(c.seq, data)
+		c.seq++
+	} else {
+		data = append([]byte(">"), data...)
+		data = append(data, '\r', '\n')
+	}
 
+	_, err := port.Write(data)
 	return err
 }
 
 // Read reads data from the serial port.
 func (c *DefaultClient) Read(ctx context.Context) ([]byte, error) {
-	if c.port == nil {
+	port := c.getPort()
+	if port == nil {
 		return nil, ErrESPSerialNotConnected
 	}
 
-	return c.read(ctx)
+	if c.protocol == config.SerialProtocolFramed {
+		return c.readFrame(ctx, port)
+	}
+
+	return c.read(ctx, port)
+}
+
+func (c *DefaultClient) Stats() serialframe.Stats {
+	return c.decoder.Stats()
 }
 
 // read continuously reads from the port until a complete message is received.
 // A complete message starts with '>' and ends with CR LF (\r\n).
-// The message is returned without the prefix and suffix
-func (c *DefaultClient) read(ctx context.Context) ([]byte, error) {
+// The message is returned without the prefix and suffix.
+// It fails with serialframe.ErrNotSynced if no message is found in serialframe.MaxUnsyncedBytes bytes.
+func (c *DefaultClient) read(ctx context.Context, port serial.Port) ([]byte, error) {
 	var msg []byte
 	isMsgStarted := false
+	dropped := 0
 
 	for {
 		select {
@@ -138,7 +236,7 @@ func (c *DefaultClient) read(ctx context.Context) ([]byte, error) {
 
 		default:
 			buf := make([]byte, readBufferSize)
-			n, err := c.port.Read(buf)
+			n, err := port.Read(buf)
 			if err != nil {
 				return nil, err
 			}
@@ -149,6 +247,8 @@ func (c *DefaultClient) read(ctx context.Context) ([]byte, error) {
 				if !isMsgStarted {
 					if b == '>' {
 						isMsgStarted = true
+					} else {
+						dropped++
 					}
 					continue
 				}
@@ -162,6 +262,37 @@ func (c *DefaultClient) read(ctx context.Context) ([]byte, error) {
 					return msg, nil
 				}
 			}
+
+			if dropped+len(msg) > serialframe.MaxUnsyncedBytes {
+				return nil, serialframe.ErrNotSynced
+			}
+		}
+	}
+}
+
+// readFrame reads from the port until a valid frame is decoded.
+// Corrupted frames are dropped and counted in the stats.
+// It fails with serialframe.ErrNotSynced if no frame is found in serialframe.MaxUnsyncedBytes bytes.
+func (c *DefaultClient) readFrame(ctx context.Context, port serial.Port) ([]byte, error) {
+	buf := make([]byte, readBufferSize)
+	for {
+		if payload, ok := c.decoder.Next(); ok {
+			return payload, nil
+		}
+		if !c.decoder.Synced() {
+			return nil, serialframe.ErrNotSynced
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		n, err := port.Read(buf)
+		if err != nil {
+			return nil, err
 		}
+		c.decoder.Feed(buf[:n])
 	}
 }
@@ -0,0 +1,189 @@
+// Package handshake is the protocol version handshake of the PIC and ESP.
+//
+// When the serial port is opened the robot sends a hello request:
+//
+//	{"id":"hello","type":255,"data":{}}
+//
+// A board speaking protocol version 2 or later replies with a hello message
+// listing its firmware version and the message types it supports:
+//
+//	{"type":2,"firmware_version":"1.4.0","protocol_version":2,"commands":[0,1,2,3],"sync_states":[0,1,2,3,4,5,6,7]}
+//
+// A board that does not reply runs a firmware older than the handshake. It
+// is assumed to speak protocol version 1 with its fixed set of messages.
+package handshake
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	ErrCommandNotSupported = xerror.UnprocessableEntity(
+		nil, "hardware.commandNotSupported", "command not supported by the board firmware")
+	ErrProtocolVersionNotSupported = xerror.UnprocessableEntity(
+		nil, "hardware.protocolVersionNotSupported", "board protocol version not supported")
+)
+
+const (
+	// HelloRequestID is the ID of the hello request. A legacy firmware may
+	// reject the request with an error ACK carrying this ID.
+	HelloRequestID = "hello"
+	// CommandTypeHello is the command type of the hello request, out of
+	// the range of the board commands.
+	CommandTypeHello = 255
+)
+
+// HelloRequest returns the hello request sent to a board.
+func HelloRequest() []byte {
+	req, _ := json.Marshal(struct {
+		ID   string   `json:"id"`
+		Type uint8    `json:"type"`
+		Data struct{} `json:"data"`
+	}{
+		ID:   HelloRequestID,
+		Type: CommandTypeHello,
+	})
+	return req
+}
+
+// Board is a board on a serial port.
+type Board string
+
+const (
+	BoardPIC Board = "PIC"
+	BoardESP Board = "ESP"
+)
+
+// Info is what a board reported in the handshake.
+type Info struct {
+	FirmwareVersion string
+	ProtocolVersion uint8
+	// Commands are the command types the board accepts.
+	Commands []uint8
+	// SyncStates are the sync state types the board sends.
+	SyncStates []uint8
+	// Legacy is true when the board did not reply to the hello request,
+	// the info is then assumed from protocol version 1.
+	Legacy bool
+}
+
+// Compatibility is the protocol versions of a board supported by the robot
+// and the sync state types the robot can handle.
+type Compatibility struct {
+	MinProtocolVersion uint8
+	MaxProtocolVersion uint8
+	SyncStates         []uint8
+}
+
+// compatibilities is the compatibility table. Bump the max protocol version
+// once the handlers support the messages of a new firmware.
+var compatibilities = map[Board]Compatibility{
+	BoardPIC: {
+		MinProtocolVersion: 1,
+		MaxProtocolVersion: 2,
+		SyncStates:         []uint8{0, 1, 2, 3, 4, 5, 6, 7},
+	},
+	BoardESP: {
+		MinProtocolVersion: 1,
+		MaxProtocolVersion: 2,
+		SyncStates:         []uint8{0, 1, 2, 3},
+	},
+}
+
+// legacyInfos are the message sets of the firmwares older than the handshake.
+var legacyInfos = map[Board]Info{
+	BoardPIC: {
+		ProtocolVersion: 1,
+		Commands:        []uint8{0, 1, 2, 3},
+		SyncStates:      []uint8{0, 1, 2, 3, 4, 5, 6, 7},
+		Legacy:          true,
+	},
+	BoardESP: {
+		ProtocolVersion: 1,
+		Commands:        []uint8{0},
+		SyncStates:      []uint8{0, 1, 2, 3},
+		Legacy:          true,
+	},
+}
+
+// CompatibilityOf returns the compatibility of the board.
+func CompatibilityOf(board Board) Compatibility {
+	return compatibilities[board]
+}
+
+// LegacyInfo returns the info assumed for a board that did not reply to the hello request.
+func LegacyInfo(board Board) Info {
+	return legacyInfos[board]
+}
+
+// Compatible reports whether the protocol version of the board is supported.
+func (c Compatibility) Compatible(info Info) bool {
+	return info.ProtocolVersion >= c.MinProtocolVersion && info.ProtocolVersion <= c.MaxProtocolVersion
+}
+
+// UnknownSyncStates returns the sync state types sent by the board that the
+// robot cannot handle, their messages will be rejected.
+func (c Compatibility) UnknownSyncStates(info Info) []uint8 {
+	var unknown []uint8
+	for _, t := range info.SyncStates {
+		if !slices.Contains(c.SyncStates, t) {
+			unknown = append(unknown, t)
+		}
+	}
+	return unknown
+}
+
+// Registry holds the info of the boards. It is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	infos map[Board]Info
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		infos: make(map[Board]Info),
+	}
+}
+
+func (r *Registry) Set(board Board, info Info) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.infos[board] = info
+}
+
+// Get returns the info of the board, false until the handshake is done.
+func (r *Registry) Get(board Board) (Info, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	info, ok := r.infos[board]
+	return info, ok
+}
+
+// CheckCommand returns an error when the board cannot accept the command
+// type. Commands are let through until the handshake is done.
+func (r *Registry) CheckCommand(board Board, commandType uint8) error {
+	info, ok := r.Get(board)
+	if !ok {
+		return nil
+	}
+
+	compatibility := CompatibilityOf(board)
+	if !compatibility.Compatible(info) {
+		return fmt.Errorf("%s protocol version %d, supported %d to %d: %w",
+			board, info.ProtocolVersion,
+			compatibility.MinProtocolVersion, compatibility.MaxProtocolVersion,
+			ErrProtocolVersionNotSupported)
+	}
+
+	if !slices.Contains(info.Commands, commandType) {
+		return fmt.Errorf("%s firmware %q, command type %d: %w",
+			board, info.FirmwareVersion, commandType, ErrCommandNotSupported)
+	}
+
+	return nil
+}
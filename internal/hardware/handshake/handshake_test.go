@@ -0,0 +1,58 @@
+package handshake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelloRequest(t *testing.T) {
+	assert.JSONEq(t, `{"id":"hello","type":255,"data":{}}`, string(HelloRequest()))
+}
+
+func TestCompatibility(t *testing.T) {
+	compatibility := Compatibility{
+		MinProtocolVersion: 1,
+		MaxProtocolVersion: 2,
+		SyncStates:         []uint8{0, 1, 2},
+	}
+
+	testCases := []struct {
+		name            string
+		info            Info
+		compatible      bool
+		unknownSyncType []uint8
+	}{
+		{
+			name:       "Should accept a legacy firmware",
+			info:       Info{ProtocolVersion: 1, SyncStates: []uint8{0, 1}, Legacy: true},
+			compatible: true,
+		},
+		{
+			name:            "Should report the sync states the robot cannot handle",
+			info:            Info{ProtocolVersion: 2, SyncStates: []uint8{0, 1, 2, 8, 9}},
+			compatible:      true,
+			unknownSyncType: []uint8{8, 9},
+		},
+		{
+			name:       "Should reject a newer protocol version",
+			info:       Info{ProtocolVersion: 3},
+			compatible: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.compatible, compatibility.Compatible(tc.info))
+			assert.Equal(t, tc.unknownSyncType, compatibility.UnknownSyncStates(tc.info))
+		})
+	}
+}
+
+func TestLegacyInfoIsCompatible(t *testing.T) {
+	for _, board := range []Board{BoardPIC, BoardESP} {
+		info := LegacyInfo(board)
+		assert.True(t, CompatibilityOf(board).Compatible(info), board)
+		assert.Empty(t, CompatibilityOf(board).UnknownSyncStates(info), board)
+	}
+}
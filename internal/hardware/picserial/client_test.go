@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
 )
 
 func TestClientWrite(t *testing.T) {
@@ -201,3 +202,73 @@ func TestClientRead(t *testing.T) {
 		assert.Nil(t, res)
 	})
 }
+
+func TestClientFramedProtocol(t *testing.T) {
+	t.Run("Should write frames with increasing sequence numbers", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClientWithPort(mockPort, WithProtocol(config.SerialProtocolFramed))
+
+		assert.NoError(t, client.Write(context.Background(), []byte(`{"id":"1"}`)))
+		assert.NoError(t, client.Write(context.Background(), []byte(`{"id":"2"}`)))
+
+		expected := append(serialframe.Encode(0, []byte(`{"id":"1"}`)), serialframe.Encode(1, []byte(`{"id":"2"}`))...)
+		assert.Equal(t, expected, mockPort.WriteBuffer.Bytes())
+	})
+
+	t.Run("Should skip a corrupted frame and count the CRC error", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(config.Serial{Protocol: config.SerialProtocolFramed})
+		client.port = mockPort
+
+		corrupted := serialframe.Encode(0, []byte(`{"type":0}`))
+		corrupted[len(corrupted)-1] ^= 0xFF
+		mockPort.ReadBuffer.Write(corrupted)
+		mockPort.ReadBuffer.Write(serialframe.Encode(1, []byte(`{"type":1}`)))
+
+		res, err := client.Read(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"type":1}`), res)
+		assert.Equal(t, serialframe.Stats{CRCErrors: 1, Resyncs: 1}, client.Stats())
+	})
+
+	t.Run("Should return EOF error on a truncated frame", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(config.Serial{Protocol: config.SerialProtocolFramed})
+		client.port = mockPort
+
+		frame := serialframe.Encode(0, []byte(`{"type":0}`))
+		mockPort.ReadBuffer.Write(frame[:len(frame)-3])
+
+		res, err := client.Read(context.Background())
+		assert.Equal(t, io.EOF, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return not synced error when the board speaks the JSON protocol", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(config.Serial{Protocol: config.SerialProtocolFramed})
+		client.port = mockPort
+
+		for mockPort.ReadBuffer.Len() <= serialframe.MaxUnsyncedBytes {
+			mockPort.ReadBuffer.WriteString(">{\"type\":0}\r\n")
+		}
+
+		res, err := client.Read(context.Background())
+		assert.ErrorIs(t, err, serialframe.ErrNotSynced)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return not synced error when the board speaks the framed protocol", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(config.Serial{Protocol: config.SerialProtocolJSON})
+		client.port = mockPort
+
+		for mockPort.ReadBuffer.Len() <= serialframe.MaxUnsyncedBytes {
+			mockPort.ReadBuffer.Write(serialframe.Encode(0, []byte(`{"type":0}`)))
+		}
+
+		res, err := client.Read(context.Background())
+		assert.ErrorIs(t, err, serialframe.ErrNotSynced)
+		assert.Nil(t, res)
+	})
+}
@@ -0,0 +1,54 @@
+package serialcapture
+
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+)
+
+// SerialClient is the method set of the PIC and ESP serial clients.
+type SerialClient interface {
+	Open() error
+	Reopen(port string) error
+	Close() error
+	Connected() bool
+	Write(ctx context.Context, data []byte) error
+	Read(ctx context.Context) ([]byte, error)
+	Stats() serialframe.Stats
+}
+
+// Client records the messages written to and read from the wrapped client.
+// Failed reads and writes are not recorded.
+type Client struct {
+	SerialClient
+
+	board    Board
+	recorder *Recorder
+}
+
+func NewClient(client SerialClient, board Board, recorder *Recorder) *Client {
+	return &Client{
+		SerialClient: client,
+		board:        board,
+		recorder:     recorder,
+	}
+}
+
+func (c *Client) Write(ctx context.Context, data []byte) error {
+	if err := c.SerialClient.Write(ctx, data); err != nil {
+		return err
+	}
+
+	c.recorder.Record(c.board, DirectionTX, data)
+	return nil
+}
+
+func (c *Client) Read(ctx context.Context) ([]byte, error) {
+	data, err := c.SerialClient.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.recorder.Record(c.board, DirectionRX, data)
+	return data, nil
+}
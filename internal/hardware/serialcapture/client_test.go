@@ -0,0 +1,102 @@
+package serialcapture
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+	"github.com/tbe-team/raybot/internal/logging"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("Should record the messages written and read", func(t *testing.T) {
+		var buf bytes.Buffer
+		recorder := NewRecorderWithWriter(nopCloser{&buf}, logging.NewNoopLogger())
+		now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+		recorder.now = func() time.Time { return now }
+
+		client := NewClient(&fakeSerialClient{reads: [][]byte{[]byte(`{"type":1,"id":"a1","status":1}`)}}, BoardPIC, recorder)
+
+		require.NoError(t, client.Write(context.Background(), []byte(`{"id":"a1","type":2}`)))
+		msg, err := client.Read(context.Background())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":1,"id":"a1","status":1}`, string(msg))
+
+		reader := NewReader(&buf)
+		record, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, Record{Time: now, Board: BoardPIC, Direction: DirectionTX, Data: `{"id":"a1","type":2}`}, record)
+
+		record, err = reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, Record{Time: now, Board: BoardPIC, Direction: DirectionRX, Data: `{"type":1,"id":"a1","status":1}`}, record)
+
+		_, err = reader.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("Should not record failed reads and writes", func(t *testing.T) {
+		var buf bytes.Buffer
+		recorder := NewRecorderWithWriter(nopCloser{&buf}, logging.NewNoopLogger())
+
+		client := NewClient(&fakeSerialClient{err: errors.New("not connected")}, BoardESP, recorder)
+
+		require.Error(t, client.Write(context.Background(), []byte(`{}`)))
+		_, err := client.Read(context.Background())
+		require.Error(t, err)
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestReader(t *testing.T) {
+	t.Run("Should report the line of an invalid record", func(t *testing.T) {
+		reader := NewReader(bytes.NewBufferString("{\"board\":\"pic\",\"dir\":\"rx\",\"data\":\"{}\"}\n\nnot json\n"))
+
+		_, err := reader.Next()
+		require.NoError(t, err)
+
+		_, err = reader.Next()
+		assert.ErrorContains(t, err, "line 3")
+	})
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+type fakeSerialClient struct {
+	reads [][]byte
+	err   error
+}
+
+func (c *fakeSerialClient) Open() error           { return nil }
+func (c *fakeSerialClient) Reopen(_ string) error { return nil }
+func (c *fakeSerialClient) Close() error          { return nil }
+func (c *fakeSerialClient) Connected() bool       { return c.err == nil }
+
+func (c *fakeSerialClient) Write(_ context.Context, _ []byte) error {
+	return c.err
+}
+
+func (c *fakeSerialClient) Read(_ context.Context) ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if len(c.reads) == 0 {
+		return nil, io.EOF
+	}
+	msg := c.reads[0]
+	c.reads = c.reads[1:]
+	return msg, nil
+}
+
+func (c *fakeSerialClient) Stats() serialframe.Stats { return serialframe.Stats{} }
@@ -0,0 +1,45 @@
+package serialcapture
+
+import (
+	"go.bug.st/serial"
+)
+
+// Port records the bytes read from and written to the wrapped serial port,
+// below the protocol framing.
+type Port struct {
+	serial.Port
+	board    Board
+	recorder *Recorder
+}
+
+func NewPort(port serial.Port, board Board, recorder *Recorder) *Port {
+	return &Port{
+		Port:     port,
+		board:    board,
+		recorder: recorder,
+	}
+}
+
+// WrapPort returns the port wrapper of a serial client recording the bytes of the board.
+func WrapPort(board Board, recorder *Recorder) func(serial.Port) serial.Port {
+	return func(port serial.Port) serial.Port {
+		return NewPort(port, board, recorder)
+	}
+}
+
+// Read records the bytes read, a read timeout reads nothing and is not recorded.
+func (p *Port) Read(b []byte) (int, error) {
+	n, err := p.Port.Read(b)
+	if n > 0 {
+		p.recorder.RecordRaw(p.board, DirectionRX, b[:n])
+	}
+	return n, err
+}
+
+func (p *Port) Write(b []byte) (int, error) {
+	n, err := p.Port.Write(b)
+	if n > 0 {
+		p.recorder.RecordRaw(p.board, DirectionTX, b[:n])
+	}
+	return n, err
+}
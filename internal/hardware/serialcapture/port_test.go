@@ -0,0 +1,80 @@
+package serialcapture
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+	"github.com/tbe-team/raybot/internal/logging"
+)
+
+func TestPort(t *testing.T) {
+	t.Run("Should record the raw bytes next to the decoded messages", func(t *testing.T) {
+		var buf bytes.Buffer
+		recorder := NewRecorderWithWriter(nopCloser{&buf}, logging.NewNoopLogger())
+
+		port := &picserial.FakeSerialPort{}
+		corrupted := serialframe.Encode(0, []byte(`{"type":0}`))
+		corrupted[len(corrupted)-1] ^= 0xFF
+		valid := serialframe.Encode(1, []byte(`{"type":1}`))
+		port.ReadBuffer.Write(corrupted)
+		port.ReadBuffer.Write(valid)
+
+		serialClient := picserial.NewClientWithPort(port,
+			picserial.WithProtocol(config.SerialProtocolFramed),
+			picserial.WithPortWrapper(WrapPort(BoardPIC, recorder)),
+		)
+		client := NewClient(serialClient, BoardPIC, recorder)
+
+		msg, err := client.Read(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, `{"type":1}`, string(msg))
+		require.NoError(t, client.Write(context.Background(), []byte(`{"id":"a1"}`)))
+
+		var records []Record
+		reader := NewReader(&buf)
+		for {
+			record, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			records = append(records, record)
+		}
+
+		// The corrupted frame is only in the raw records
+		var rawRX []byte
+		for _, record := range records {
+			if record.IsRaw() && record.Direction == DirectionRX {
+				b, err := hex.DecodeString(record.Raw)
+				require.NoError(t, err)
+				rawRX = append(rawRX, b...)
+			}
+		}
+		assert.Equal(t, append(corrupted, valid...), rawRX)
+
+		// The bytes are written to the port before the message is recorded
+		rawTX := records[len(records)-2]
+		require.True(t, rawTX.IsRaw())
+		assert.Equal(t, DirectionTX, rawTX.Direction)
+		assert.Equal(t, hex.EncodeToString(serialframe.Encode(0, []byte(`{"id":"a1"}`))), rawTX.Raw)
+
+		var messages []Record
+		for _, record := range records {
+			if !record.IsRaw() {
+				messages = append(messages, record)
+			}
+		}
+		require.Len(t, messages, 2)
+		assert.Equal(t, `{"type":1}`, messages[0].Data)
+		assert.Equal(t, `{"id":"a1"}`, messages[1].Data)
+	})
+}
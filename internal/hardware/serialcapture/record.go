@@ -0,0 +1,94 @@
+// Package serialcapture records the messages exchanged with the PIC and ESP
+// over the serial ports, so the traffic of a field issue can be inspected
+// and replayed offline.
+//
+// A capture is a JSON Lines file, one record per message:
+//
+//	{"time":"2025-01-02T15:04:05.123Z","board":"pic","dir":"rx","data":"{\"type\":0,...}"}
+//
+// The data is the message payload without the protocol framing. Next to the
+// messages, the bytes read from and written to the port are recorded as they
+// are, hex encoded, so the corrupted frames, the resyncs and the partial
+// frames are visible too:
+//
+//	{"time":"2025-01-02T15:04:05.120Z","board":"pic","dir":"rx","raw":"aa55000a00..."}
+package serialcapture
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// maxRecordSize is the largest line of a capture file.
+const maxRecordSize = 64 * 1024
+
+// Board is the board on the other end of the serial port.
+type Board string
+
+const (
+	BoardPIC Board = "pic"
+	BoardESP Board = "esp"
+)
+
+// Direction is the direction of a message seen from the robot.
+type Direction string
+
+const (
+	// DirectionRX is a message received from the board.
+	DirectionRX Direction = "rx"
+	// DirectionTX is a message sent to the board.
+	DirectionTX Direction = "tx"
+)
+
+type Record struct {
+	Time      time.Time `json:"time"`
+	Board     Board     `json:"board"`
+	Direction Direction `json:"dir"`
+	// Data is the decoded message, empty for a raw record.
+	Data string `json:"data,omitempty"`
+	// Raw are the hex encoded bytes of a port read or write, empty for a message record.
+	Raw string `json:"raw,omitempty"`
+}
+
+// IsRaw reports whether the record holds the bytes of the port rather than a message.
+func (r Record) IsRaw() bool {
+	return r.Raw != ""
+}
+
+// Reader reads the records of a capture.
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func NewReader(r io.Reader) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxRecordSize)
+	return &Reader{scanner: scanner}
+}
+
+// Next returns the next record. It returns io.EOF at the end of the capture.
+// Empty lines are skipped.
+func (r *Reader) Next() (Record, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := r.scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			return Record{}, fmt.Errorf("line %d: unmarshal record: %w", r.line, err)
+		}
+		return record, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return Record{}, fmt.Errorf("line %d: %w", r.line+1, err)
+	}
+	return Record{}, io.EOF
+}
@@ -0,0 +1,96 @@
+package serialcapture
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+// Recorder writes the records to a capture. It is safe for concurrent use,
+// the PIC and ESP clients share one recorder so their traffic is interleaved
+// in order.
+type Recorder struct {
+	log *slog.Logger
+	now func() time.Time
+
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+// NewRecorder creates a recorder writing to the capture file of the config.
+// The file is rotated when it reaches the max size and on every start.
+func NewRecorder(cfg config.Capture, log *slog.Logger) (*Recorder, error) {
+	l := &lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    cfg.MaxSize,
+		MaxBackups: cfg.RotationCount,
+	}
+
+	// Rotate on restart, a capture covers a single run
+	if err := l.Rotate(); err != nil {
+		return nil, fmt.Errorf("failed to rotate capture file: %w", err)
+	}
+
+	return NewRecorderWithWriter(l, log), nil
+}
+
+// NewRecorderWithWriter creates a recorder writing to w.
+func NewRecorderWithWriter(w io.WriteCloser, log *slog.Logger) *Recorder {
+	return &Recorder{
+		log: log.With("component", "serialcapture"),
+		now: time.Now,
+		w:   w,
+	}
+}
+
+// Record writes a message. A failed write is logged, the capture must never
+// break the serial link.
+func (r *Recorder) Record(board Board, dir Direction, data []byte) {
+	r.write(Record{
+		Time:      r.now(),
+		Board:     board,
+		Direction: dir,
+		Data:      string(data),
+	})
+}
+
+// RecordRaw writes the bytes of a port read or write.
+func (r *Recorder) RecordRaw(board Board, dir Direction, data []byte) {
+	r.write(Record{
+		Time:      r.now(),
+		Board:     board,
+		Direction: dir,
+		Raw:       hex.EncodeToString(data),
+	})
+}
+
+func (r *Recorder) write(record Record) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		r.log.Error("failed to marshal capture record", slog.Any("error", err))
+		return
+	}
+	line = append(line, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.w.Write(line); err != nil {
+		r.log.Error("failed to write capture record", slog.Any("error", err))
+	}
+}
+
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.w.Close()
+}
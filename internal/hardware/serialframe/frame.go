@@ -0,0 +1,200 @@
+// Package serialframe is the framed protocol of the PIC and ESP serial links.
+//
+// A frame is laid out as:
+//
+//	0xAA 0x55 | length (uint16 BE) | seq (uint8) | payload | crc (uint16 BE)
+//
+// The length is the payload length. The sequence number is incremented by
+// the sender for every frame. The CRC-16/CCITT-FALSE covers the length, the
+// sequence number and the payload. The payload is the same JSON message as
+// in the text protocol.
+package serialframe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+)
+
+const (
+	syncByte1 = 0xAA
+	syncByte2 = 0x55
+
+	headerSize  = 5 // sync word, length, seq
+	trailerSize = 2 // crc
+
+	// MaxPayloadSize is the largest payload of a frame. A bigger length is
+	// treated as a corrupted header.
+	MaxPayloadSize = 1024
+
+	// MaxUnsyncedBytes is the number of bytes a reader drops without finding
+	// a message before it gives up on the link, see ErrNotSynced.
+	MaxUnsyncedBytes = 4 * (headerSize + MaxPayloadSize + trailerSize)
+)
+
+// ErrNotSynced is returned by the serial clients when no message is found in
+// MaxUnsyncedBytes received bytes. The protocol is selected by serial.protocol,
+// it is not negotiated, so this usually means the board firmware uses the other one.
+var ErrNotSynced = errors.New("no message found on the serial link, check that serial.protocol matches the board firmware")
+
+var syncWord = []byte{syncByte1, syncByte2}
+
+// Encode builds the frame of the payload.
+func Encode(seq uint8, payload []byte) []byte {
+	frame := make([]byte, 0, headerSize+len(payload)+trailerSize)
+	frame = append(frame, syncByte1, syncByte2)
+	frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload))) //nolint:gosec
+	frame = append(frame, seq)
+	frame = append(frame, payload...)
+	return binary.BigEndian.AppendUint16(frame, CRC16(frame[len(syncWord):]))
+}
+
+// CRC16 computes the CRC-16/CCITT-FALSE of the data:
+// polynomial 0x1021, initial value 0xFFFF, no reflection.
+func CRC16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// Stats are the faults seen by a decoder.
+type Stats struct {
+	// CRCErrors is the number of frames dropped because of a CRC mismatch.
+	CRCErrors uint64
+	// Resyncs is the number of times the decoder lost the frame alignment
+	// and skipped bytes to find the next frame.
+	Resyncs uint64
+	// SequenceGaps is the number of times a sequence number was skipped,
+	// i.e. frames were lost on the way.
+	SequenceGaps uint64
+}
+
+// Decoder extracts the payloads from a byte stream.
+type Decoder struct {
+	buf []byte
+
+	// aligned is false while bytes are skipped to find the next frame,
+	// so a single loss of alignment counts as one resync.
+	aligned bool
+	hasSeq  bool
+	lastSeq uint8
+	// skipped is the number of bytes dropped since the last valid frame.
+	skipped int
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+func NewDecoder() *Decoder {
+	return &Decoder{aligned: true}
+}
+
+// Feed appends the bytes read from the port.
+func (d *Decoder) Feed(data []byte) {
+	d.buf = append(d.buf, data...)
+}
+
+// Next returns the payload of the next valid frame in the buffered bytes.
+// It returns false when more bytes are needed.
+func (d *Decoder) Next() ([]byte, bool) {
+	for {
+		start := bytes.Index(d.buf, syncWord)
+		if start < 0 {
+			// Keep a trailing first sync byte, the second one may come with the next read
+			keep := 0
+			if len(d.buf) > 0 && d.buf[len(d.buf)-1] == syncByte1 {
+				keep = 1
+			}
+			if len(d.buf) > keep {
+				d.skip(len(d.buf) - keep)
+			}
+			return nil, false
+		}
+		if start > 0 {
+			d.skip(start)
+		}
+
+		if len(d.buf) < headerSize {
+			return nil, false
+		}
+
+		length := int(binary.BigEndian.Uint16(d.buf[2:4]))
+		if length > MaxPayloadSize {
+			d.skip(1)
+			continue
+		}
+
+		size := headerSize + length + trailerSize
+		if len(d.buf) < size {
+			return nil, false
+		}
+
+		crc := binary.BigEndian.Uint16(d.buf[headerSize+length : size])
+		if crc != CRC16(d.buf[len(syncWord):headerSize+length]) {
+			d.mu.Lock()
+			d.stats.CRCErrors++
+			d.mu.Unlock()
+			d.skip(1)
+			continue
+		}
+
+		seq := d.buf[4]
+		if d.hasSeq && seq != d.lastSeq+1 {
+			d.mu.Lock()
+			d.stats.SequenceGaps++
+			d.mu.Unlock()
+		}
+		d.hasSeq = true
+		d.lastSeq = seq
+
+		payload := bytes.Clone(d.buf[headerSize : headerSize+length])
+		d.buf = d.buf[size:]
+		d.aligned = true
+		d.skipped = 0
+
+		return payload, true
+	}
+}
+
+// Reset drops the buffered bytes and the last sequence number, e.g. when the
+// port is re-opened. The stats are kept. It must not be called concurrently with Next.
+func (d *Decoder) Reset() {
+	d.buf = nil
+	d.aligned = true
+	d.hasSeq = false
+	d.skipped = 0
+}
+
+// Synced reports whether a valid frame was found in the last MaxUnsyncedBytes
+// dropped bytes. It is false when the other end does not speak the framed protocol.
+func (d *Decoder) Synced() bool {
+	return d.skipped <= MaxUnsyncedBytes
+}
+
+// Stats returns the faults seen so far. It is safe to call concurrently with Next.
+func (d *Decoder) Stats() Stats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.stats
+}
+
+func (d *Decoder) skip(n int) {
+	d.buf = d.buf[n:]
+	d.skipped += n
+	if d.aligned {
+		d.aligned = false
+		d.mu.Lock()
+		d.stats.Resyncs++
+		d.mu.Unlock()
+	}
+}
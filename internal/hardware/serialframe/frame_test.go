@@ -0,0 +1,99 @@
+package serialframe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRC16(t *testing.T) {
+	// Check value of CRC-16/CCITT-FALSE
+	assert.Equal(t, uint16(0x29B1), CRC16([]byte("123456789")))
+}
+
+func TestDecoder(t *testing.T) {
+	t.Run("Should decode frames split across reads", func(t *testing.T) {
+		d := NewDecoder()
+		stream := append(Encode(0, []byte(`{"type":0}`)), Encode(1, []byte(`{"type":1}`))...)
+
+		var payloads []string
+		for _, b := range stream {
+			d.Feed([]byte{b})
+			if payload, ok := d.Next(); ok {
+				payloads = append(payloads, string(payload))
+			}
+		}
+
+		assert.Equal(t, []string{`{"type":0}`, `{"type":1}`}, payloads)
+		assert.Equal(t, Stats{}, d.Stats())
+	})
+
+	t.Run("Should drop a corrupted frame and resync on the next one", func(t *testing.T) {
+		d := NewDecoder()
+		corrupted := Encode(0, []byte(`{"type":0}`))
+		corrupted[7] ^= 0xFF
+
+		d.Feed(corrupted)
+		d.Feed(Encode(1, []byte(`{"type":1}`)))
+
+		payload, ok := d.Next()
+		require.True(t, ok)
+		assert.Equal(t, `{"type":1}`, string(payload))
+
+		_, ok = d.Next()
+		assert.False(t, ok)
+		assert.Equal(t, Stats{CRCErrors: 1, Resyncs: 1}, d.Stats())
+	})
+
+	t.Run("Should skip garbage before a frame", func(t *testing.T) {
+		d := NewDecoder()
+		d.Feed([]byte(">noise\r\n\xAA"))
+		_, ok := d.Next()
+		require.False(t, ok)
+
+		d.Feed(Encode(0, []byte(`{}`))[1:])
+		payload, ok := d.Next()
+		require.True(t, ok)
+		assert.Equal(t, `{}`, string(payload))
+		assert.Equal(t, Stats{Resyncs: 1}, d.Stats())
+	})
+
+	t.Run("Should treat an oversized length as a corrupted header", func(t *testing.T) {
+		d := NewDecoder()
+		d.Feed([]byte{syncByte1, syncByte2, 0xFF, 0xFF, 0x00})
+		d.Feed(Encode(0, []byte(`{}`)))
+
+		payload, ok := d.Next()
+		require.True(t, ok)
+		assert.Equal(t, `{}`, string(payload))
+		assert.Equal(t, Stats{Resyncs: 1}, d.Stats())
+	})
+
+	t.Run("Should count skipped sequence numbers", func(t *testing.T) {
+		d := NewDecoder()
+		for _, seq := range []uint8{254, 255, 0, 2} {
+			d.Feed(Encode(seq, []byte(`{}`)))
+			_, ok := d.Next()
+			require.True(t, ok)
+		}
+
+		assert.Equal(t, Stats{SequenceGaps: 1}, d.Stats())
+	})
+
+	t.Run("Should not be synced after too many bytes without a frame", func(t *testing.T) {
+		d := NewDecoder()
+		text := bytes.Repeat([]byte(">{\"type\":0}\r\n"), MaxUnsyncedBytes/10)
+		d.Feed(text)
+
+		_, ok := d.Next()
+		require.False(t, ok)
+		assert.False(t, d.Synced())
+
+		d.Feed(Encode(0, []byte(`{}`)))
+		_, ok = d.Next()
+		require.True(t, ok)
+		assert.True(t, d.Synced())
+	})
+}
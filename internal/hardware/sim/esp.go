@@ -0,0 +1,98 @@
+package sim
+
+import (
+	"encoding/json"
+	"log/slog"
+)
+
+const (
+	espCommandTypeCargoDoorMotor = 0
+)
+
+const (
+	espStateTypeDoor                 = 0
+	espStateTypeMotor                = 1
+	espStateTypeQRScanner            = 2
+	espStateTypeBottomDistanceSensor = 3
+)
+
+// handleESPFrame applies a command sent to the ESP and acknowledges it.
+func (r *Robot) handleESPFrame(payload []byte) {
+	var cmd command
+	if err := json.Unmarshal(payload, &cmd); err != nil {
+		r.log.Error("failed to unmarshal ESP command", slog.Any("error", err), slog.String("payload", string(payload)))
+		return
+	}
+
+	if cmd.Type == commandTypeHello {
+		r.espPort.send(helloMessage(
+			[]uint8{espCommandTypeCargoDoorMotor},
+			[]uint8{espStateTypeDoor, espStateTypeMotor, espStateTypeQRScanner, espStateTypeBottomDistanceSensor},
+		))
+		return
+	}
+
+	status := uint8(ackStatusSuccess)
+	if err := r.applyESPCommand(cmd); err != nil {
+		r.log.Error("failed to apply ESP command", slog.Any("error", err), slog.String("payload", string(payload)))
+		status = ackStatusError
+	}
+
+	r.espPort.send(ackMessage(cmd.ID, status))
+}
+
+func (r *Robot) applyESPCommand(cmd command) error {
+	switch cmd.Type {
+	case espCommandTypeCargoDoorMotor:
+		var data struct {
+			State  uint8 `json:"state"`
+			Speed  uint8 `json:"speed"`
+			Enable uint8 `json:"enable"`
+		}
+		if err := json.Unmarshal(cmd.Data, &data); err != nil {
+			return err
+		}
+		if data.State > 1 {
+			return errInvalidValue("door state", data.State)
+		}
+
+		r.mu.Lock()
+		r.state.door.direction = data.State
+		r.state.door.speed = data.Speed
+		r.state.door.enabled = data.Enable == 1
+		r.mu.Unlock()
+
+	default:
+		return errInvalidValue("command type", cmd.Type)
+	}
+
+	return nil
+}
+
+// espSyncStates builds the sync state messages of the ESP. The caller holds the lock.
+func (r *Robot) espSyncStates() [][]byte {
+	door := r.state.door
+
+	msgs := [][]byte{
+		syncStateMessage(espStateTypeDoor, map[string]any{
+			"is_open": door.opening >= 1,
+		}),
+		syncStateMessage(espStateTypeMotor, map[string]any{
+			"state":      door.direction,
+			"enabled":    boolToUint8(door.enabled),
+			"speed":      door.speed,
+			"is_running": boolToUint8(door.isRunning()),
+		}),
+		syncStateMessage(espStateTypeBottomDistanceSensor, map[string]any{
+			"under": r.cfg.BottomDistance,
+		}),
+	}
+
+	if r.cfg.QRCode != "" {
+		msgs = append(msgs, syncStateMessage(espStateTypeQRScanner, map[string]any{
+			"code": r.cfg.QRCode,
+		}))
+	}
+
+	return msgs
+}
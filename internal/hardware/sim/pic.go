@@ -0,0 +1,243 @@
+package sim
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+)
+
+const (
+	picCommandTypeBatteryCharge    = 0
+	picCommandTypeBatteryDischarge = 1
+	picCommandTypeLiftMotor        = 2
+	picCommandTypeDriveMotor       = 3
+)
+
+const (
+	picStateTypeBattery        = 0
+	picStateTypeCharge         = 1
+	picStateTypeDischarge      = 2
+	picStateTypeDistanceSensor = 3
+	picStateTypeLiftMotor      = 4
+	picStateTypeDriveMotor     = 5
+	picStateTypeLimitSwitch1   = 6
+	picStateTypeCargoObstacle  = 7
+)
+
+const (
+	messageTypeSyncState = 0
+	messageTypeACK       = 1
+	messageTypeHello     = 2
+
+	// commandTypeHello asks the board for its firmware and protocol versions
+	commandTypeHello = 255
+	firmwareVersion  = "sim"
+	protocolVersion  = 2
+
+	ackStatusError   = 0
+	ackStatusSuccess = 1
+)
+
+type command struct {
+	ID   string          `json:"id"`
+	Type uint8           `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// handlePICFrame applies a command sent to the PIC and acknowledges it.
+func (r *Robot) handlePICFrame(payload []byte) {
+	var cmd command
+	if err := json.Unmarshal(payload, &cmd); err != nil {
+		r.log.Error("failed to unmarshal PIC command", slog.Any("error", err), slog.String("payload", string(payload)))
+		return
+	}
+
+	if cmd.Type == commandTypeHello {
+		r.picPort.send(helloMessage(
+			[]uint8{picCommandTypeBatteryCharge, picCommandTypeBatteryDischarge, picCommandTypeLiftMotor, picCommandTypeDriveMotor},
+			[]uint8{
+				picStateTypeBattery, picStateTypeCharge, picStateTypeDischarge, picStateTypeDistanceSensor,
+				picStateTypeLiftMotor, picStateTypeDriveMotor, picStateTypeLimitSwitch1, picStateTypeCargoObstacle,
+			},
+		))
+		return
+	}
+
+	status := uint8(ackStatusSuccess)
+	if err := r.applyPICCommand(cmd); err != nil {
+		r.log.Error("failed to apply PIC command", slog.Any("error", err), slog.String("payload", string(payload)))
+		status = ackStatusError
+	}
+
+	r.picPort.send(ackMessage(cmd.ID, status))
+}
+
+func (r *Robot) applyPICCommand(cmd command) error {
+	switch cmd.Type {
+	case picCommandTypeBatteryCharge, picCommandTypeBatteryDischarge:
+		var data struct {
+			CurrentLimit uint16 `json:"current_limit"`
+			Enable       uint8  `json:"enable"`
+		}
+		if err := json.Unmarshal(cmd.Data, &data); err != nil {
+			return err
+		}
+
+		setting := batterySetting{currentLimit: data.CurrentLimit, enabled: data.Enable == 1}
+		r.mu.Lock()
+		if cmd.Type == picCommandTypeBatteryCharge {
+			r.state.charge = setting
+		} else {
+			r.state.discharge = setting
+		}
+		r.mu.Unlock()
+
+	case picCommandTypeLiftMotor:
+		var data struct {
+			TargetPosition uint16 `json:"target_position"`
+			MaxOutput      uint16 `json:"max_output"`
+			Enable         uint8  `json:"enable"`
+		}
+		if err := json.Unmarshal(cmd.Data, &data); err != nil {
+			return err
+		}
+
+		r.mu.Lock()
+		r.state.lift.target = data.TargetPosition
+		r.state.lift.maxOutput = data.MaxOutput
+		r.state.lift.enabled = data.Enable == 1
+		r.mu.Unlock()
+
+	case picCommandTypeDriveMotor:
+		var data struct {
+			Direction uint8 `json:"direction"`
+			Speed     uint8 `json:"speed"`
+			Enable    uint8 `json:"enable"`
+		}
+		if err := json.Unmarshal(cmd.Data, &data); err != nil {
+			return err
+		}
+		if data.Direction > 1 {
+			return errInvalidValue("direction", data.Direction)
+		}
+
+		r.mu.Lock()
+		r.state.drive = driveMotor{
+			direction: data.Direction,
+			speed:     data.Speed,
+			enabled:   data.Enable == 1,
+		}
+		r.mu.Unlock()
+
+	default:
+		return errInvalidValue("command type", cmd.Type)
+	}
+
+	return nil
+}
+
+// picSyncStates builds the sync state messages of the PIC. The caller holds the lock.
+func (r *Robot) picSyncStates() [][]byte {
+	s := r.state
+
+	percent := uint8(math.Round(s.batteryPercent))
+	cellVoltage := uint16(cellVoltageEmpty + (cellVoltageFull-cellVoltageEmpty)*s.batteryPercent/100)
+	cellVoltages := make([]uint16, batteryCells)
+	for i := range cellVoltages {
+		cellVoltages[i] = cellVoltage
+	}
+	current := uint16(currentIdle)
+	if s.drive.isRunning() || s.lift.isRunning() {
+		current = currentRunning
+	}
+
+	liftPosition := uint16(math.Round(s.lift.position))
+
+	return [][]byte{
+		syncStateMessage(picStateTypeBattery, map[string]any{
+			"current":       current,
+			"temp":          batteryTemp,
+			"voltage":       cellVoltage * batteryCells,
+			"cell_voltages": cellVoltages,
+			"percent":       percent,
+			"fault":         0,
+			"health":        batteryHealth,
+		}),
+		syncStateMessage(picStateTypeCharge, map[string]any{
+			"current_limit": s.charge.currentLimit,
+			"enabled":       boolToUint8(s.charge.enabled),
+		}),
+		syncStateMessage(picStateTypeDischarge, map[string]any{
+			"current_limit": s.discharge.currentLimit,
+			"enabled":       boolToUint8(s.discharge.enabled),
+		}),
+		syncStateMessage(picStateTypeDistanceSensor, map[string]any{
+			"front": r.cfg.FrontDistance,
+			"back":  r.cfg.BackDistance,
+			// The down distance sensor measures the lift position
+			"down": liftPosition,
+		}),
+		syncStateMessage(picStateTypeLiftMotor, map[string]any{
+			"current_position": liftPosition,
+			"target_position":  s.lift.target,
+			"is_running":       boolToUint8(s.lift.isRunning()),
+			"enabled":          boolToUint8(s.lift.enabled),
+		}),
+		syncStateMessage(picStateTypeDriveMotor, map[string]any{
+			"direction":  s.drive.direction,
+			"speed":      s.drive.speed,
+			"is_running": boolToUint8(s.drive.isRunning()),
+			"enabled":    boolToUint8(s.drive.enabled),
+		}),
+		syncStateMessage(picStateTypeLimitSwitch1, map[string]any{
+			"state": 0,
+		}),
+		syncStateMessage(picStateTypeCargoObstacle, map[string]any{
+			"object": 0,
+		}),
+	}
+}
+
+func syncStateMessage(stateType uint8, data map[string]any) []byte {
+	msg, _ := json.Marshal(map[string]any{
+		"type":       messageTypeSyncState,
+		"state_type": stateType,
+		"data":       data,
+	})
+	return msg
+}
+
+func ackMessage(id string, status uint8) []byte {
+	msg, _ := json.Marshal(map[string]any{
+		"type":   messageTypeACK,
+		"id":     id,
+		"status": status,
+	})
+	return msg
+}
+
+// helloMessage is the reply to the hello request. The types are sent as
+// numbers, a []uint8 would be marshaled as base64.
+func helloMessage(commands, syncStates []uint8) []byte {
+	toInts := func(types []uint8) []int {
+		ints := make([]int, len(types))
+		for i, t := range types {
+			ints[i] = int(t)
+		}
+		return ints
+	}
+
+	msg, _ := json.Marshal(map[string]any{
+		"type":             messageTypeHello,
+		"firmware_version": firmwareVersion,
+		"protocol_version": protocolVersion,
+		"commands":         toInts(commands),
+		"sync_states":      toInts(syncStates),
+	})
+	return msg
+}
+
+func errInvalidValue(name string, value uint8) error {
+	return fmt.Errorf("invalid %s: %d", name, value)
+}
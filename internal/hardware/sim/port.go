@@ -0,0 +1,193 @@
+package sim
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
+	"go.bug.st/serial"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+)
+
+var errPortClosed = errors.New("sim port closed")
+
+const (
+	defaultReadTimeout = 100 * time.Millisecond
+	outboxSize         = 256
+)
+
+// port is an in-memory serial port. Frames written by the client are passed
+// to the board handler, frames sent by the board are read back by the client.
+// Both directions use the real framing of the configured protocol.
+type port struct {
+	onFrame func(payload []byte)
+
+	framed  bool
+	decoder *serialframe.Decoder
+	seq     uint8
+
+	outbox  chan []byte
+	pending []byte
+
+	mu          sync.Mutex
+	readTimeout time.Duration
+	writeBuf    []byte
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func newPort(onFrame func(payload []byte)) *port {
+	return &port{
+		onFrame:     onFrame,
+		decoder:     serialframe.NewDecoder(),
+		outbox:      make(chan []byte, outboxSize),
+		readTimeout: defaultReadTimeout,
+		done:        make(chan struct{}),
+	}
+}
+
+// send frames the payload and queues it for the client. The frame is dropped
+// when the client does not keep up, like a serial buffer overrun.
+func (p *port) send(payload []byte) {
+	var frame []byte
+	if p.framed {
+		p.mu.Lock()
+		frame = serialframe.Encode(p.seq, payload)
+		p.seq++
+		p.mu.Unlock()
+	} else {
+		frame = make([]byte, 0, len(payload)+3)
+		frame = append(frame, '>')
+		frame = append(frame, payload...)
+		frame = append(frame, '\r', '\n')
+	}
+
+	select {
+	case <-p.done:
+	case p.outbox <- frame:
+	default:
+	}
+}
+
+// Read blocks until data is available, the read timeout elapses or the port
+// is closed. It returns 0 bytes on timeout like a real port.
+func (p *port) Read(b []byte) (int, error) {
+	if len(p.pending) == 0 {
+		p.mu.Lock()
+		timeout := p.readTimeout
+		p.mu.Unlock()
+
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case <-p.done:
+			return 0, errPortClosed
+		case <-timer.C:
+			return 0, nil
+		case frame := <-p.outbox:
+			p.pending = frame
+		}
+	}
+
+	n := copy(b, p.pending)
+	p.pending = p.pending[n:]
+	return n, nil
+}
+
+// Write buffers the data and hands every complete frame to the board.
+func (p *port) Write(b []byte) (int, error) {
+	select {
+	case <-p.done:
+		return 0, errPortClosed
+	default:
+	}
+
+	p.mu.Lock()
+	var frames [][]byte
+	if p.framed {
+		p.decoder.Feed(b)
+		for {
+			payload, ok := p.decoder.Next()
+			if !ok {
+				break
+			}
+			frames = append(frames, payload)
+		}
+	} else {
+		frames = p.splitTextFrames(b)
+	}
+	p.mu.Unlock()
+
+	for _, frame := range frames {
+		p.onFrame(frame)
+	}
+
+	return len(b), nil
+}
+
+// splitTextFrames buffers the data and returns the payloads of the complete
+// '>' + payload + CR LF frames. The caller holds the lock.
+func (p *port) splitTextFrames(b []byte) [][]byte {
+	p.writeBuf = append(p.writeBuf, b...)
+	var frames [][]byte
+	for {
+		start := bytes.IndexByte(p.writeBuf, '>')
+		if start < 0 {
+			p.writeBuf = p.writeBuf[:0]
+			break
+		}
+		end := bytes.Index(p.writeBuf[start:], []byte("\r\n"))
+		if end < 0 {
+			p.writeBuf = p.writeBuf[start:]
+			break
+		}
+		frames = append(frames, bytes.Clone(p.writeBuf[start+1:start+end]))
+		p.writeBuf = p.writeBuf[start+end+2:]
+	}
+	return frames
+}
+
+func (p *port) setProtocol(protocol config.SerialProtocol) {
+	p.framed = protocol == config.SerialProtocolFramed
+}
+
+func (p *port) SetReadTimeout(t time.Duration) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if t <= 0 {
+		t = defaultReadTimeout
+	}
+	p.readTimeout = t
+	return nil
+}
+
+func (p *port) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+	return nil
+}
+
+func (p *port) SetMode(*serial.Mode) error { return nil }
+
+func (p *port) Drain() error { return nil }
+
+func (p *port) ResetInputBuffer() error { return nil }
+
+func (p *port) ResetOutputBuffer() error { return nil }
+
+func (p *port) SetDTR(bool) error { return nil }
+
+func (p *port) SetRTS(bool) error { return nil }
+
+func (p *port) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	return &serial.ModemStatusBits{}, nil
+}
+
+func (p *port) Break(time.Duration) error { return nil }
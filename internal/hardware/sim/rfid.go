@@ -0,0 +1,53 @@
+package sim
+
+import (
+	"errors"
+	"sync"
+)
+
+var errReaderClosed = errors.New("sim rfid reader closed")
+
+// RFIDReader reads the tags the simulated robot passes on the rail.
+// It has the same methods as the USB RFID reader client.
+type RFIDReader struct {
+	tags chan string
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func newRFIDReader() *RFIDReader {
+	return &RFIDReader{
+		tags: make(chan string, tagReaderQueueSize),
+		done: make(chan struct{}),
+	}
+}
+
+func (r *RFIDReader) Open() error {
+	return nil
+}
+
+// Read blocks until the robot reaches a tag or the reader is closed.
+func (r *RFIDReader) Read() (string, error) {
+	select {
+	case <-r.done:
+		return "", errReaderClosed
+	case tag := <-r.tags:
+		return tag, nil
+	}
+}
+
+func (r *RFIDReader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+	return nil
+}
+
+// push queues a tag read. The read is lost when nobody reads the tags.
+func (r *RFIDReader) push(tag string) {
+	select {
+	case r.tags <- tag:
+	default:
+	}
+}
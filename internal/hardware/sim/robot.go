@@ -0,0 +1,321 @@
+// Package sim is a physics-lite simulator of the robot hardware. It stands in
+// for the PIC and ESP boards behind in-memory serial ports that speak the real
+// protocol, and for the RFID reader, so the whole stack runs without the robot.
+package sim
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"go.bug.st/serial"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+const (
+	batteryCells       = 4
+	cellVoltageEmpty   = 3300 // mV
+	cellVoltageFull    = 4200 // mV
+	batteryTemp        = 30   // °C
+	batteryHealth      = 100
+	currentIdle        = 300  // mA
+	currentRunning     = 2000 // mA
+	tagReaderQueueSize = 16
+)
+
+// Robot is the simulated robot. It owns the PIC and ESP ports and the RFID
+// reader, and moves its motors every tick.
+type Robot struct {
+	cfg config.Sim
+	log *slog.Logger
+
+	picPort *port
+	espPort *port
+	reader  *RFIDReader
+
+	mu    sync.Mutex
+	state state
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type state struct {
+	// position is the position on the rail in mm
+	position float64
+
+	drive driveMotor
+	lift  liftMotor
+	door  doorMotor
+
+	batteryPercent float64
+	charge         batterySetting
+	discharge      batterySetting
+}
+
+type driveMotor struct {
+	direction uint8 // 0: forward, 1: backward
+	speed     uint8
+	enabled   bool
+}
+
+func (m driveMotor) isRunning() bool {
+	return m.enabled && m.speed > 0
+}
+
+type liftMotor struct {
+	// position is the down distance in cm
+	position  float64
+	target    uint16
+	maxOutput uint16
+	enabled   bool
+}
+
+func (m liftMotor) isRunning() bool {
+	return m.enabled && m.maxOutput > 0 && m.position != float64(m.target)
+}
+
+type doorMotor struct {
+	// opening goes from 0 (closed) to 1 (open)
+	opening   float64
+	direction uint8 // 0: close, 1: open
+	speed     uint8
+	enabled   bool
+}
+
+func (m doorMotor) isRunning() bool {
+	if !m.enabled || m.speed == 0 {
+		return false
+	}
+	if m.direction == 1 {
+		return m.opening < 1
+	}
+	return m.opening > 0
+}
+
+type batterySetting struct {
+	currentLimit uint16
+	enabled      bool
+}
+
+type OptionFunc func(*Robot)
+
+// WithPICProtocol sets the protocol spoken on the PIC port, JSON by default.
+func WithPICProtocol(protocol config.SerialProtocol) OptionFunc {
+	return func(r *Robot) {
+		r.picPort.setProtocol(protocol)
+	}
+}
+
+// WithESPProtocol sets the protocol spoken on the ESP port, JSON by default.
+func WithESPProtocol(protocol config.SerialProtocol) OptionFunc {
+	return func(r *Robot) {
+		r.espPort.setProtocol(protocol)
+	}
+}
+
+// New creates the simulated robot. The config must be validated.
+func New(cfg config.Sim, log *slog.Logger, opts ...OptionFunc) *Robot {
+	r := &Robot{
+		cfg:    cfg,
+		log:    log.With("service", "sim"),
+		reader: newRFIDReader(),
+		state: state{
+			position:       float64(cfg.StartPosition),
+			lift:           liftMotor{position: float64(cfg.LiftPosition), target: cfg.LiftPosition},
+			batteryPercent: float64(cfg.BatteryPercent),
+		},
+	}
+	r.picPort = newPort(r.handlePICFrame)
+	r.espPort = newPort(r.handleESPFrame)
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	// The robot starts on a tag, the reader sees it right away
+	for _, tag := range cfg.Tags {
+		if tag.Position == cfg.StartPosition {
+			r.reader.push(tag.ID)
+		}
+	}
+
+	return r
+}
+
+// PICPort is the serial port of the simulated PIC board.
+func (r *Robot) PICPort() serial.Port {
+	return r.picPort
+}
+
+// ESPPort is the serial port of the simulated ESP board.
+func (r *Robot) ESPPort() serial.Port {
+	return r.espPort
+}
+
+// RFIDReader is the reader of the tags on the virtual rail.
+func (r *Robot) RFIDReader() *RFIDReader {
+	return r.reader
+}
+
+// Start runs the physics and the sync state loops until Stop is called.
+func (r *Robot) Start(ctx context.Context) {
+	r.log.Info("hardware simulator started",
+		slog.Int("start_position", int(r.cfg.StartPosition)),
+		slog.Int("tags", len(r.cfg.Tags)),
+	)
+
+	ctx, cancel := context.WithCancel(ctx)
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(ctx)
+	}()
+}
+
+func (r *Robot) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	r.reader.Close()
+}
+
+func (r *Robot) run(ctx context.Context) {
+	tick := time.NewTicker(r.cfg.TickInterval)
+	defer tick.Stop()
+
+	syncTicker := time.NewTicker(r.cfg.SyncInterval)
+	defer syncTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			r.step(r.cfg.TickInterval)
+		case <-syncTicker.C:
+			r.syncState()
+		}
+	}
+}
+
+// step advances the model by dt.
+func (r *Robot) step(dt time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	seconds := dt.Seconds()
+	s := &r.state
+
+	running := s.drive.isRunning() || s.lift.isRunning()
+
+	if s.drive.isRunning() {
+		distance := float64(r.cfg.DriveSpeed) * float64(s.drive.speed) / 100 * seconds
+		if s.drive.direction == 1 {
+			distance = -distance
+		}
+		from := s.position
+		s.position += distance
+		for _, id := range r.crossedTags(from, s.position) {
+			r.log.Debug("rfid tag reached", slog.String("tag", id), slog.Float64("position", s.position))
+			r.reader.push(id)
+		}
+	}
+
+	if s.lift.isRunning() {
+		output := min(s.lift.maxOutput, 100)
+		distance := float64(r.cfg.LiftSpeed) * float64(output) / 100 * seconds
+		target := float64(s.lift.target)
+		if math.Abs(target-s.lift.position) <= distance {
+			s.lift.position = target
+		} else if target > s.lift.position {
+			s.lift.position += distance
+		} else {
+			s.lift.position -= distance
+		}
+	}
+
+	if s.door.isRunning() {
+		delta := float64(s.door.speed) / 100 * seconds / r.cfg.DoorDuration.Seconds()
+		if s.door.direction == 1 {
+			s.door.opening = min(s.door.opening+delta, 1)
+		} else {
+			s.door.opening = max(s.door.opening-delta, 0)
+		}
+	}
+
+	drain := r.cfg.BatteryDrain * seconds / 60
+	if running {
+		s.batteryPercent -= drain
+	}
+	if s.charge.enabled {
+		s.batteryPercent += drain
+	}
+	s.batteryPercent = min(max(s.batteryPercent, 0), 100)
+}
+
+// crossedTags returns the IDs of the tags passed when moving from one position
+// to the other, in the order the robot passes them. The tag at the start
+// position was already read, the tag at the end position is included.
+func (r *Robot) crossedTags(from, to float64) []string {
+	type passedTag struct {
+		id       string
+		position float64
+	}
+
+	var passed []passedTag
+	for _, tag := range r.cfg.Tags {
+		p := float64(tag.Position)
+		if (from < p && p <= to) || (to <= p && p < from) {
+			passed = append(passed, passedTag{id: tag.ID, position: p})
+		}
+	}
+
+	slices.SortFunc(passed, func(a, b passedTag) int {
+		if to < from {
+			a, b = b, a
+		}
+		switch {
+		case a.position < b.position:
+			return -1
+		case a.position > b.position:
+			return 1
+		}
+		return 0
+	})
+
+	ids := make([]string, 0, len(passed))
+	for _, t := range passed {
+		ids = append(ids, t.id)
+	}
+	return ids
+}
+
+// syncState sends the state of every sensor and motor, like the boards do.
+func (r *Robot) syncState() {
+	r.mu.Lock()
+	pic := r.picSyncStates()
+	esp := r.espSyncStates()
+	r.mu.Unlock()
+
+	for _, msg := range pic {
+		r.picPort.send(msg)
+	}
+	for _, msg := range esp {
+		r.espPort.send(msg)
+	}
+}
+
+func boolToUint8(b bool) uint8 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,213 @@
+package sim
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/espserial"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+)
+
+func newTestRobot(t *testing.T, opts ...OptionFunc) *Robot {
+	t.Helper()
+
+	cfg := config.Sim{
+		Tags: []config.SimTag{
+			{ID: "0001", Position: 0},
+			{ID: "0003", Position: 2000},
+			{ID: "0002", Position: 1000},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	r := New(cfg, slog.New(slog.DiscardHandler), opts...)
+	t.Cleanup(func() {
+		require.NoError(t, r.PICPort().Close())
+		require.NoError(t, r.ESPPort().Close())
+		require.NoError(t, r.RFIDReader().Close())
+	})
+
+	return r
+}
+
+func readTags(t *testing.T, r *Robot, n int) []string {
+	t.Helper()
+
+	tags := make([]string, 0, n)
+	for range n {
+		tag, err := r.RFIDReader().Read()
+		require.NoError(t, err)
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func TestRobotStep(t *testing.T) {
+	t.Run("Should read the tags passed by the drive motor in order", func(t *testing.T) {
+		r := newTestRobot(t)
+		assert.Equal(t, []string{"0001"}, readTags(t, r, 1))
+
+		require.NoError(t, r.applyPICCommand(command{Type: picCommandTypeDriveMotor, Data: json.RawMessage(`{"direction":0,"speed":100,"enable":1}`)}))
+		r.step(10 * time.Second) // 2000mm at 200mm/s
+		assert.Equal(t, []string{"0002", "0003"}, readTags(t, r, 2))
+
+		require.NoError(t, r.applyPICCommand(command{Type: picCommandTypeDriveMotor, Data: json.RawMessage(`{"direction":1,"speed":50,"enable":1}`)}))
+		r.step(20 * time.Second)
+		assert.Equal(t, []string{"0002", "0001"}, readTags(t, r, 2))
+	})
+
+	t.Run("Should move the lift to the target position", func(t *testing.T) {
+		r := newTestRobot(t)
+
+		require.NoError(t, r.applyPICCommand(command{Type: picCommandTypeLiftMotor, Data: json.RawMessage(`{"target_position":50,"max_output":100,"enable":1}`)}))
+		r.step(time.Second)
+		assert.InDelta(t, 30, r.state.lift.position, 0.001)
+		assert.True(t, r.state.lift.isRunning())
+
+		r.step(time.Second)
+		assert.InDelta(t, 50, r.state.lift.position, 0.001)
+		assert.False(t, r.state.lift.isRunning())
+	})
+
+	t.Run("Should open the cargo door", func(t *testing.T) {
+		r := newTestRobot(t)
+
+		require.NoError(t, r.applyESPCommand(command{Type: espCommandTypeCargoDoorMotor, Data: json.RawMessage(`{"state":1,"speed":100,"enable":1}`)}))
+		r.step(time.Second)
+		assert.True(t, r.state.door.isRunning())
+
+		r.step(time.Second)
+		assert.Equal(t, float64(1), r.state.door.opening)
+		assert.False(t, r.state.door.isRunning())
+	})
+
+	t.Run("Should drain the battery only while a motor runs", func(t *testing.T) {
+		r := newTestRobot(t)
+
+		r.step(time.Minute)
+		assert.InDelta(t, 100, r.state.batteryPercent, 0.001)
+
+		require.NoError(t, r.applyPICCommand(command{Type: picCommandTypeDriveMotor, Data: json.RawMessage(`{"direction":0,"speed":100,"enable":1}`)}))
+		r.step(2 * time.Minute)
+		assert.InDelta(t, 99, r.state.batteryPercent, 0.001)
+	})
+}
+
+func TestRobotSerial(t *testing.T) {
+	t.Run("Should acknowledge a PIC command over the serial framing", func(t *testing.T) {
+		r := newTestRobot(t)
+		client := picserial.NewClientWithPort(r.PICPort())
+		ctx := context.Background()
+
+		err := client.Write(ctx, []byte(`{"id":"abc","type":3,"data":{"direction":0,"speed":80,"enable":1}}`))
+		require.NoError(t, err)
+
+		msg, err := client.Read(ctx)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":1,"id":"abc","status":1}`, string(msg))
+		assert.Equal(t, driveMotor{direction: 0, speed: 80, enabled: true}, r.state.drive)
+	})
+
+	t.Run("Should acknowledge a PIC command over the framed protocol", func(t *testing.T) {
+		r := newTestRobot(t, WithPICProtocol(config.SerialProtocolFramed))
+		client := picserial.NewClientWithPort(r.PICPort(), picserial.WithProtocol(config.SerialProtocolFramed))
+		ctx := context.Background()
+
+		err := client.Write(ctx, []byte(`{"id":"abc","type":2,"data":{"target_position":40,"max_output":50,"enable":1}}`))
+		require.NoError(t, err)
+
+		msg, err := client.Read(ctx)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":1,"id":"abc","status":1}`, string(msg))
+		assert.Equal(t, uint16(40), r.state.lift.target)
+	})
+
+	t.Run("Should reject an unknown ESP command", func(t *testing.T) {
+		r := newTestRobot(t)
+		client := espserial.NewClientWithPort(r.ESPPort())
+		ctx := context.Background()
+
+		require.NoError(t, client.Write(ctx, []byte(`{"id":"abc","type":9,"data":{}}`)))
+
+		msg, err := client.Read(ctx)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":1,"id":"abc","status":0}`, string(msg))
+	})
+
+	t.Run("Should reply to the hello request", func(t *testing.T) {
+		r := newTestRobot(t)
+		client := espserial.NewClientWithPort(r.ESPPort())
+		ctx := context.Background()
+
+		require.NoError(t, client.Write(ctx, []byte(`{"id":"hello","type":255,"data":{}}`)))
+
+		msg, err := client.Read(ctx)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"type": 2,
+			"firmware_version": "sim",
+			"protocol_version": 2,
+			"commands": [0],
+			"sync_states": [0, 1, 2, 3]
+		}`, string(msg))
+	})
+
+	t.Run("Should send the sync states", func(t *testing.T) {
+		r := newTestRobot(t)
+		client := picserial.NewClientWithPort(r.PICPort())
+		ctx := context.Background()
+
+		r.syncState()
+
+		var stateTypes []uint8
+		for range 8 {
+			msg, err := client.Read(ctx)
+			require.NoError(t, err)
+
+			var temp struct {
+				Type      uint8           `json:"type"`
+				StateType uint8           `json:"state_type"`
+				Data      json.RawMessage `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(msg, &temp))
+			require.Equal(t, uint8(messageTypeSyncState), temp.Type)
+			stateTypes = append(stateTypes, temp.StateType)
+
+			if temp.StateType == picStateTypeDistanceSensor {
+				assert.JSONEq(t, `{"front":500,"back":500,"down":10}`, string(temp.Data))
+			}
+		}
+		assert.Equal(t, []uint8{0, 1, 2, 3, 4, 5, 6, 7}, stateTypes)
+	})
+
+	t.Run("Should stop reading when the port is closed", func(t *testing.T) {
+		r := newTestRobot(t)
+		client := picserial.NewClientWithPort(r.PICPort())
+
+		require.NoError(t, r.PICPort().Close())
+		_, err := client.Read(context.Background())
+		assert.ErrorIs(t, err, errPortClosed)
+	})
+}
+
+func TestRobotStartStop(t *testing.T) {
+	r := newTestRobot(t)
+	client := espserial.NewClientWithPort(r.ESPPort())
+
+	r.Start(context.Background())
+	defer r.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	msg, err := client.Read(ctx)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":0,"state_type":0,"data":{"is_open":false}}`, string(msg))
+}
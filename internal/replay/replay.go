@@ -0,0 +1,200 @@
+// Package replay feeds a serial capture back through the PIC and ESP message
+// handlers against a fresh in-memory state, to reproduce field issues offline.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/tbe-team/raybot/internal/config"
+	espserialhandler "github.com/tbe-team/raybot/internal/handlers/espserial"
+	picserialhandler "github.com/tbe-team/raybot/internal/handlers/picserial"
+	"github.com/tbe-team/raybot/internal/hardware/controller"
+	"github.com/tbe-team/raybot/internal/hardware/espserial"
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/hardware/serialcapture"
+	"github.com/tbe-team/raybot/internal/services/appstate/appstateimpl"
+	"github.com/tbe-team/raybot/internal/services/battery/batteryimpl"
+	"github.com/tbe-team/raybot/internal/services/cargo/cargoimpl"
+	"github.com/tbe-team/raybot/internal/services/command/commandimpl"
+	"github.com/tbe-team/raybot/internal/services/dashboarddata"
+	"github.com/tbe-team/raybot/internal/services/dashboarddata/dashboarddataimpl"
+	"github.com/tbe-team/raybot/internal/services/distancesensor/distancesensorimpl"
+	"github.com/tbe-team/raybot/internal/services/drivemotor/drivemotorimpl"
+	"github.com/tbe-team/raybot/internal/services/led/ledimpl"
+	"github.com/tbe-team/raybot/internal/services/liftmotor/liftmotorimpl"
+	"github.com/tbe-team/raybot/internal/services/limitswitch/limitswitchimpl"
+	"github.com/tbe-team/raybot/internal/services/location/locationimpl"
+	"github.com/tbe-team/raybot/internal/services/peripheral/peripheralimpl"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+// Result is the outcome of a replayed record.
+type Result struct {
+	Record serialcapture.Record
+	// Handled is false for the messages sent to the boards and the raw bytes of the ports, they are not replayed.
+	Handled bool
+	Err     error
+}
+
+// Summary counts the replayed records.
+type Summary struct {
+	Records int
+	Handled int
+	Failed  int
+}
+
+type Replayer struct {
+	pic *picserialhandler.Service
+	esp *espserialhandler.Service
+
+	dashboardDataService dashboarddata.Service
+}
+
+type CleanupFunc func() error
+
+// New creates a replayer with a fresh in-memory state. The serial clients
+// are never opened, the replay only reads the capture.
+func New(log *slog.Logger) (*Replayer, CleanupFunc, error) {
+	db, err := db.NewInMemoryDB()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db: %w", err)
+	}
+
+	if err := db.AutoMigrate(); err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("failed to migrate db: %w", err), db.Close())
+	}
+
+	eventBus := eventbus.NewInProcEventBus(log)
+	queries := sqlc.New()
+	validator := validator.New()
+
+	batteryStateRepository := batteryimpl.NewBatteryStateRepository()
+	batterySettingRepository := batteryimpl.NewBatterySettingRepository(db, queries)
+	driveMotorStateRepository := drivemotorimpl.NewDriveMotorStateRepository()
+	liftMotorStateRepository := liftmotorimpl.NewLiftMotorStateRepository()
+	cargoRepository := cargoimpl.NewCargoRepository(db, queries)
+	locationRepository := locationimpl.NewLocationRepository(db, queries)
+	limitSwitchStateRepository := limitswitchimpl.NewRepository()
+	distanceSensorStateRepository := distancesensorimpl.NewDistanceSensorStateRepository()
+	appStateRepository := appstateimpl.NewAppStateRepository()
+	queueStateRepository := commandimpl.NewQueueStateRepository(db, queries)
+	ledRepository := ledimpl.NewRepository()
+
+	picSerialClient := picserial.NewClient(config.Serial{})
+	espSerialClient := espserial.NewClient(config.Serial{})
+	hardwareController := controller.New(config.Hardware{}, log, eventBus, picSerialClient, espSerialClient)
+
+	batteryService := batteryimpl.NewService(validator, eventBus, batteryStateRepository, batterySettingRepository, hardwareController)
+	distanceSensorService := distancesensorimpl.NewService(validator, eventBus, distanceSensorStateRepository)
+	driveMotorService := drivemotorimpl.NewService(validator, eventBus, driveMotorStateRepository, hardwareController)
+	liftMotorService := liftmotorimpl.NewService(validator, liftMotorStateRepository, hardwareController)
+	cargoService := cargoimpl.NewService(validator, eventBus, cargoRepository, hardwareController)
+	limitSwitchService := limitswitchimpl.NewService(log, validator, eventBus, limitSwitchStateRepository)
+	appStateService := appstateimpl.NewService(appStateRepository)
+	dashboardDataService := dashboarddataimpl.NewService(
+		batteryStateRepository,
+		batterySettingRepository,
+		distanceSensorStateRepository,
+		liftMotorStateRepository,
+		driveMotorStateRepository,
+		locationRepository,
+		cargoRepository,
+		appStateRepository,
+		ledRepository,
+		queueStateRepository,
+	)
+
+	peripheralService := peripheralimpl.NewNoopService()
+
+	boards := handshake.NewRegistry()
+	r := &Replayer{
+		pic: picserialhandler.New(
+			config.PIC{},
+			log,
+			picSerialClient,
+			eventBus,
+			batteryService,
+			distanceSensorService,
+			liftMotorService,
+			driveMotorService,
+			limitSwitchService,
+			cargoService,
+			appStateService,
+			peripheralService,
+			boards,
+		),
+		esp: espserialhandler.New(
+			config.ESP{},
+			log,
+			eventBus,
+			espSerialClient,
+			cargoService,
+			appStateService,
+			peripheralService,
+			boards,
+		),
+		dashboardDataService: dashboardDataService,
+	}
+
+	cleanup := func() error {
+		appStateRepository.Cleanup()
+		return db.Close()
+	}
+
+	return r, cleanup, nil
+}
+
+// Replay handles the messages received from the boards in the capture, in
+// order. onResult is called for every record. A failed message does not stop
+// the replay, only an unreadable capture does.
+func (r *Replayer) Replay(ctx context.Context, reader *serialcapture.Reader, onResult func(Result)) (Summary, error) {
+	var summary Summary
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return summary, nil
+		}
+		if err != nil {
+			return summary, fmt.Errorf("read record: %w", err)
+		}
+
+		summary.Records++
+		result := Result{Record: record}
+		if record.Direction == serialcapture.DirectionRX && !record.IsRaw() {
+			result.Handled = true
+			result.Err = r.handle(ctx, record)
+			summary.Handled++
+			if result.Err != nil {
+				summary.Failed++
+			}
+		}
+
+		if onResult != nil {
+			onResult(result)
+		}
+	}
+}
+
+// RobotState returns the state built by the replayed messages.
+func (r *Replayer) RobotState(ctx context.Context) (dashboarddata.RobotState, error) {
+	return r.dashboardDataService.GetRobotState(ctx)
+}
+
+func (r *Replayer) handle(ctx context.Context, record serialcapture.Record) error {
+	switch record.Board {
+	case serialcapture.BoardPIC:
+		return r.pic.HandleMessage(ctx, []byte(record.Data))
+	case serialcapture.BoardESP:
+		return r.esp.HandleMessage(ctx, []byte(record.Data))
+	default:
+		return fmt.Errorf("unknown board: %s", record.Board)
+	}
+}
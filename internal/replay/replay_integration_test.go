@@ -0,0 +1,54 @@
+package replay
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/hardware/serialcapture"
+	"github.com/tbe-team/raybot/internal/logging"
+)
+
+func TestIntegrationReplayer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	capture := strings.Join([]string{
+		`{"time":"2025-01-02T15:04:05Z","board":"pic","dir":"tx","data":"{\"id\":\"a1\",\"type\":2,\"data\":{\"speed\":50}}"}`,
+		`{"time":"2025-01-02T15:04:05.05Z","board":"pic","dir":"rx","raw":"3e7b2274797065223a312c226964223a226131222c22737461747573223a317d0d0a"}`,
+		`{"time":"2025-01-02T15:04:05.1Z","board":"pic","dir":"rx","data":"{\"type\":1,\"id\":\"a1\",\"status\":1}"}`,
+		`{"time":"2025-01-02T15:04:05.2Z","board":"pic","dir":"rx","data":"{\"type\":0,\"state_type\":3,\"data\":{\"front\":120,\"back\":80,\"down\":15}}"}`,
+		`{"time":"2025-01-02T15:04:05.3Z","board":"pic","dir":"rx","data":"{\"type\":1,\"id\":\"a2\",\"status\":0}"}`,
+		``,
+		`{"time":"2025-01-02T15:04:05.4Z","board":"esp","dir":"rx","data":"{\"type\":7}"}`,
+	}, "\n")
+
+	r, cleanup, err := New(logging.NewNoopLogger())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, cleanup()) }()
+
+	var results []Result
+	summary, err := r.Replay(context.Background(), serialcapture.NewReader(strings.NewReader(capture)), func(result Result) {
+		results = append(results, result)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, Summary{Records: 6, Handled: 4, Failed: 2}, summary)
+	require.Len(t, results, 6)
+	assert.False(t, results[0].Handled)
+	assert.False(t, results[1].Handled)
+	assert.NoError(t, results[2].Err)
+	assert.NoError(t, results[3].Err)
+	assert.ErrorContains(t, results[4].Err, "ack error: a2")
+	assert.ErrorContains(t, results[5].Err, "invalid message type")
+
+	state, err := r.RobotState(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint16(120), state.DistanceSensor.FrontDistance)
+	assert.Equal(t, uint16(80), state.DistanceSensor.BackDistance)
+	assert.Equal(t, uint16(15), state.DistanceSensor.DownDistance)
+}
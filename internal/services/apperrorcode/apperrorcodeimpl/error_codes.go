@@ -1,8 +1,12 @@
 package apperrorcodeimpl
 
 import (
+	"github.com/tbe-team/raybot/internal/hardware/handshake"
 	"github.com/tbe-team/raybot/internal/services/apperrorcode"
 	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/peripheral"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/internal/services/schedule"
 	"github.com/tbe-team/raybot/pkg/xerror"
 )
 
@@ -11,6 +15,22 @@ func init() {
 	register(command.ErrNoNextExecutableCommand)
 	register(command.ErrNoCommandBeingProcessed)
 	register(command.ErrCommandInProcessingCanNotBeDeleted)
+	register(command.ErrCommandNotQueued)
+	register(command.ErrCommandInMission)
+	register(command.ErrPositionCrossesPriority)
+	register(command.ErrCommandAlreadyFinished)
+	register(command.ErrMissionNotFound)
+	register(command.ErrMissionAlreadyFinished)
+	register(railmap.ErrTagNotFound)
+	register(railmap.ErrLocationNotFound)
+	register(railmap.ErrLocationAlreadyExists)
+	register(railmap.ErrAliasAlreadyExists)
+	register(railmap.ErrTagPositionOutOfBounds)
+	register(railmap.ErrAlreadyAtLocation)
+	register(schedule.ErrScheduleNotFound)
+	register(peripheral.ErrSerialPortNotFound)
+	register(handshake.ErrCommandNotSupported)
+	register(handshake.ErrProtocolVersionNotSupported)
 }
 
 var errorCodes = []apperrorcode.ErrorCode{}
@@ -21,6 +21,10 @@ type UpdateESPSerialConnectionParams struct {
 	SetLastConnectedAt bool
 	Error              *string
 	SetError           bool
+	FrameStats         FrameStats
+	SetFrameStats      bool
+	Board              *BoardInfo
+	SetBoard           bool
 }
 
 type UpdatePICSerialConnectionParams struct {
@@ -30,6 +34,10 @@ type UpdatePICSerialConnectionParams struct {
 	SetLastConnectedAt bool
 	Error              *string
 	SetError           bool
+	FrameStats         FrameStats
+	SetFrameStats      bool
+	Board              *BoardInfo
+	SetBoard           bool
 }
 
 type UpdateRFIDUSBConnectionParams struct {
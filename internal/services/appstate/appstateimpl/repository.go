@@ -76,6 +76,12 @@ func (r *repository) UpdateESPSerialConnection(_ context.Context, params appstat
 	if params.SetError {
 		espSerialConnection.Error = params.Error
 	}
+	if params.SetFrameStats {
+		espSerialConnection.FrameStats = params.FrameStats
+	}
+	if params.SetBoard {
+		espSerialConnection.Board = params.Board
+	}
 
 	r.mu.Lock()
 	r.appState.ESPSerialConnection = espSerialConnection
@@ -100,6 +106,12 @@ func (r *repository) UpdatePICSerialConnection(_ context.Context, params appstat
 	if params.SetError {
 		picSerialConnection.Error = params.Error
 	}
+	if params.SetFrameStats {
+		picSerialConnection.FrameStats = params.FrameStats
+	}
+	if params.SetBoard {
+		picSerialConnection.Board = params.Board
+	}
 
 	r.mu.Lock()
 	r.appState.PICSerialConnection = picSerialConnection
@@ -23,6 +23,10 @@ type ESPSerialConnection struct {
 	Connected       bool
 	LastConnectedAt *time.Time
 	Error           *string
+	// FrameStats are the faults of the framed protocol, zero with the JSON protocol.
+	FrameStats FrameStats
+	// Board is what the board reported in the protocol handshake, nil until the handshake is done.
+	Board *BoardInfo
 }
 
 func (c ESPSerialConnection) ServiceInitialized() bool {
@@ -33,12 +37,40 @@ type PICSerialConnection struct {
 	Connected       bool
 	LastConnectedAt *time.Time
 	Error           *string
+	// FrameStats are the faults of the framed protocol, zero with the JSON protocol.
+	FrameStats FrameStats
+	// Board is what the board reported in the protocol handshake, nil until the handshake is done.
+	Board *BoardInfo
 }
 
 func (c PICSerialConnection) ServiceInitialized() bool {
 	return c.LastConnectedAt != nil || c.Error != nil
 }
 
+// FrameStats are the faults seen on a serial link using the framed protocol.
+type FrameStats struct {
+	CRCErrors    uint64
+	Resyncs      uint64
+	SequenceGaps uint64
+}
+
+// BoardInfo is the firmware and the protocol of a board.
+type BoardInfo struct {
+	FirmwareVersion string
+	ProtocolVersion uint8
+	// Commands are the command types the board accepts.
+	Commands []uint8
+	// SyncStates are the sync state types the board sends.
+	SyncStates []uint8
+	// Legacy is true when the board did not reply to the handshake, the
+	// info is then assumed from the firmware older than the handshake.
+	Legacy bool
+	// Compatible is false when the protocol version is not in the compatibility
+	// table, the commands to the board are then refused.
+	Compatible bool
+	UpdatedAt  time.Time
+}
+
 type RFIDUSBConnection struct {
 	Connected       bool
 	LastConnectedAt *time.Time
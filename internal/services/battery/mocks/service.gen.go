@@ -0,0 +1,281 @@
+// Code generated by mockery v2.53.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	battery "github.com/tbe-team/raybot/internal/services/battery"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FakeService is an autogenerated mock type for the Service type
+type FakeService struct {
+	mock.Mock
+}
+
+type FakeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FakeService) EXPECT() *FakeService_Expecter {
+	return &FakeService_Expecter{mock: &_m.Mock}
+}
+
+// DisableCharge provides a mock function with given fields: ctx
+func (_m *FakeService) DisableCharge(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableCharge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_DisableCharge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableCharge'
+type FakeService_DisableCharge_Call struct {
+	*mock.Call
+}
+
+// DisableCharge is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) DisableCharge(ctx interface{}) *FakeService_DisableCharge_Call {
+	return &FakeService_DisableCharge_Call{Call: _e.mock.On("DisableCharge", ctx)}
+}
+
+func (_c *FakeService_DisableCharge_Call) Run(run func(ctx context.Context)) *FakeService_DisableCharge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_DisableCharge_Call) Return(_a0 error) *FakeService_DisableCharge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_DisableCharge_Call) RunAndReturn(run func(context.Context) error) *FakeService_DisableCharge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBatteryState provides a mock function with given fields: ctx
+func (_m *FakeService) GetBatteryState(ctx context.Context) (battery.BatteryState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatteryState")
+	}
+
+	var r0 battery.BatteryState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (battery.BatteryState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) battery.BatteryState); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(battery.BatteryState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetBatteryState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatteryState'
+type FakeService_GetBatteryState_Call struct {
+	*mock.Call
+}
+
+// GetBatteryState is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) GetBatteryState(ctx interface{}) *FakeService_GetBatteryState_Call {
+	return &FakeService_GetBatteryState_Call{Call: _e.mock.On("GetBatteryState", ctx)}
+}
+
+func (_c *FakeService_GetBatteryState_Call) Run(run func(ctx context.Context)) *FakeService_GetBatteryState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetBatteryState_Call) Return(_a0 battery.BatteryState, _a1 error) *FakeService_GetBatteryState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetBatteryState_Call) RunAndReturn(run func(context.Context) (battery.BatteryState, error)) *FakeService_GetBatteryState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBatteryState provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateBatteryState(ctx context.Context, params battery.UpdateBatteryStateParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBatteryState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, battery.UpdateBatteryStateParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_UpdateBatteryState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBatteryState'
+type FakeService_UpdateBatteryState_Call struct {
+	*mock.Call
+}
+
+// UpdateBatteryState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params battery.UpdateBatteryStateParams
+func (_e *FakeService_Expecter) UpdateBatteryState(ctx interface{}, params interface{}) *FakeService_UpdateBatteryState_Call {
+	return &FakeService_UpdateBatteryState_Call{Call: _e.mock.On("UpdateBatteryState", ctx, params)}
+}
+
+func (_c *FakeService_UpdateBatteryState_Call) Run(run func(ctx context.Context, params battery.UpdateBatteryStateParams)) *FakeService_UpdateBatteryState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(battery.UpdateBatteryStateParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateBatteryState_Call) Return(_a0 error) *FakeService_UpdateBatteryState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_UpdateBatteryState_Call) RunAndReturn(run func(context.Context, battery.UpdateBatteryStateParams) error) *FakeService_UpdateBatteryState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateChargeSetting provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateChargeSetting(ctx context.Context, params battery.UpdateChargeSettingParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChargeSetting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, battery.UpdateChargeSettingParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_UpdateChargeSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChargeSetting'
+type FakeService_UpdateChargeSetting_Call struct {
+	*mock.Call
+}
+
+// UpdateChargeSetting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params battery.UpdateChargeSettingParams
+func (_e *FakeService_Expecter) UpdateChargeSetting(ctx interface{}, params interface{}) *FakeService_UpdateChargeSetting_Call {
+	return &FakeService_UpdateChargeSetting_Call{Call: _e.mock.On("UpdateChargeSetting", ctx, params)}
+}
+
+func (_c *FakeService_UpdateChargeSetting_Call) Run(run func(ctx context.Context, params battery.UpdateChargeSettingParams)) *FakeService_UpdateChargeSetting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(battery.UpdateChargeSettingParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateChargeSetting_Call) Return(_a0 error) *FakeService_UpdateChargeSetting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_UpdateChargeSetting_Call) RunAndReturn(run func(context.Context, battery.UpdateChargeSettingParams) error) *FakeService_UpdateChargeSetting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDischargeSetting provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateDischargeSetting(ctx context.Context, params battery.UpdateDischargeSettingParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDischargeSetting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, battery.UpdateDischargeSettingParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_UpdateDischargeSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDischargeSetting'
+type FakeService_UpdateDischargeSetting_Call struct {
+	*mock.Call
+}
+
+// UpdateDischargeSetting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params battery.UpdateDischargeSettingParams
+func (_e *FakeService_Expecter) UpdateDischargeSetting(ctx interface{}, params interface{}) *FakeService_UpdateDischargeSetting_Call {
+	return &FakeService_UpdateDischargeSetting_Call{Call: _e.mock.On("UpdateDischargeSetting", ctx, params)}
+}
+
+func (_c *FakeService_UpdateDischargeSetting_Call) Run(run func(ctx context.Context, params battery.UpdateDischargeSettingParams)) *FakeService_UpdateDischargeSetting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(battery.UpdateDischargeSettingParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateDischargeSetting_Call) Return(_a0 error) *FakeService_UpdateDischargeSetting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_UpdateDischargeSetting_Call) RunAndReturn(run func(context.Context, battery.UpdateDischargeSettingParams) error) *FakeService_UpdateDischargeSetting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFakeService creates a new instance of FakeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFakeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FakeService {
+	mock := &FakeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
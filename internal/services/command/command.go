@@ -15,42 +15,178 @@ var (
 	ErrNoCommandBeingProcessed            = xerror.BadRequest(nil, "command.noCommandBeingProcessed", "no command being processed")
 	ErrCommandInProcessingCanNotBeDeleted = xerror.BadRequest(nil, "command.inProcessingCanNotBeDeleted", "command in processing can not be deleted")
 	ErrCommandAlreadyExists               = xerror.Conflict(nil, "command.alreadyExists", "command already exists")
+	ErrCommandNotQueued                   = xerror.BadRequest(nil, "command.notQueued", "command is not queued")
+	ErrCommandInMission                   = xerror.BadRequest(nil, "command.inMission", "command belongs to a mission")
+	ErrPositionCrossesPriority            = xerror.BadRequest(nil, "command.positionCrossesPriority", "position is among commands of another priority")
+	ErrCommandAlreadyFinished             = xerror.BadRequest(nil, "command.alreadyFinished", "command already finished")
 
 	ErrRunningCommandNotFound = xerror.NotFound(nil, "command.runningCommandNotFound", "running command not found")
 	ErrRunningCommandExists   = xerror.BadRequest(nil, "command.runningCommandExists", "running command already exists")
+
+	ErrMissionNotFound        = xerror.NotFound(nil, "command.missionNotFound", "mission not found")
+	ErrMissionAlreadyFinished = xerror.BadRequest(nil, "command.missionAlreadyFinished", "mission already finished")
 )
 
 type CreateCommandParams struct {
 	Source    Source  `validate:"enum"`
 	Inputs    Inputs  `validate:"required"`
 	RequestID *string `validate:"omitempty,max=64"` // Optional request ID for idempotency
+	// Retry re-runs the executor if the execution fails, nil disables retrying.
+	Retry *RetryPolicy `validate:"omitempty"`
+	// Priority orders the queue, the command with the highest priority is executed first.
+	Priority uint8 `validate:"max=100"`
+	// Preempt queues the command at the head of the queue and cancels the current processing command,
+	// so the created command runs next.
+	// It can not be used with NotBefore.
+	Preempt bool `validate:"excluded_with=NotBefore"`
+	// NotBefore defers the execution of the command until the time is reached.
+	NotBefore *time.Time
+	// InsertBefore queues the command right before the QUEUED command with this ID.
+	// The place must be within the commands of the same priority, or between a higher and a lower one.
+	InsertBefore *int64 `validate:"omitempty,min=1,excluded_with=InsertAfter Preempt"`
+	// InsertAfter queues the command right after the QUEUED command with this ID.
+	// The place must be within the commands of the same priority, or between a higher and a lower one.
+	InsertAfter *int64 `validate:"omitempty,min=1,excluded_with=Preempt"`
+}
+
+type CreateCommandsParams struct {
+	Source Source `validate:"enum"`
+	// Commands are created in order, in a single transaction.
+	Commands []CreateCommandsItem `validate:"required,min=1,max=100,dive"`
+}
+
+type CreateCommandsItem struct {
+	Inputs    Inputs  `validate:"required"`
+	RequestID *string `validate:"omitempty,max=64"` // Optional request ID for idempotency
+	// Retry re-runs the executor if the execution fails, nil disables retrying.
+	Retry *RetryPolicy `validate:"omitempty"`
+	// Priority orders the queue, the command with the highest priority is executed first.
+	Priority uint8 `validate:"max=100"`
+	// NotBefore defers the execution of the command until the time is reached.
+	NotBefore *time.Time
 }
 
 type GetCommandByIDParams struct {
 	CommandID int64 `validate:"required,min=1"`
 }
 
+// CommandFilter filters the commands, an empty field matches every command.
+// The time ranges include the start time and exclude the end time.
+//
+//nolint:revive
+type CommandFilter struct {
+	Statuses      []Status      `validate:"dive,enum"`
+	Types         []CommandType `validate:"dive,enum"`
+	Sources       []Source      `validate:"dive,enum"`
+	RequestID     *string       `validate:"omitempty,max=64"`
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	CompletedFrom *time.Time
+	CompletedTo   *time.Time
+}
+
 type ListCommandsParams struct {
+	CommandFilter
+
 	PagingParams paging.Params `validate:"required"`
-	Sorts        []sort.Sort   `validate:"sort=type status source created_at updated_at completed_at"`
-	Statuses     []Status      `validate:"dive,enum"`
+	Sorts        []sort.Sort   `validate:"sort=type status source priority queue_position created_at updated_at completed_at"`
+}
+
+type ExportCommandsParams struct {
+	CommandFilter
+}
+
+type GetCommandStatsParams struct {
+	CommandFilter
+}
+
+type MoveQueuedCommandParams struct {
+	CommandID int64 `validate:"required,min=1"`
+	// Position is the position in the queue, 1 being the next command to execute.
+	// A position past the end of the queue moves the command to the end.
+	Position int `validate:"required,min=1"`
+}
+
+type UpdateQueuedCommandParams struct {
+	CommandID int64  `validate:"required,min=1"`
+	Inputs    Inputs `validate:"required"`
+}
+
+type CancelCommandByIDParams struct {
+	CommandID int64 `validate:"required,min=1"`
+}
+
+type CancelCommandByRequestIDParams struct {
+	RequestID string `validate:"required,max=64"`
 }
 
 type DeleteCommandByIDParams struct {
 	CommandID int64 `validate:"required,min=1"`
 }
 
+type CreateMissionParams struct {
+	Source     Source          `validate:"enum"`
+	Steps      []Inputs        `validate:"required,min=1,max=100,dive,required"`
+	OnFailure  OnFailurePolicy `validate:"enum"`
+	MaxRetries uint8           `validate:"max=10"` // Only used by the RETRY policy
+}
+
+type GetMissionByIDParams struct {
+	MissionID int64 `validate:"required,min=1"`
+}
+
+type ListMissionsParams struct {
+	PagingParams paging.Params `validate:"required"`
+}
+
+type CancelMissionByIDParams struct {
+	MissionID int64 `validate:"required,min=1"`
+}
+
 type Service interface {
 	GetCommandByID(ctx context.Context, params GetCommandByIDParams) (Command, error)
 	GetCurrentProcessingCommand(ctx context.Context) (Command, error)
 	ListCommands(ctx context.Context, params ListCommandsParams) (paging.List[Command], error)
 	CreateCommand(ctx context.Context, params CreateCommandParams) (Command, error)
+	// CreateCommands validates all the commands, then queues them in a single transaction.
+	// A command with a request ID that already exists is not created again,
+	// the existing command is returned in its place. The commands are returned in order.
+	CreateCommands(ctx context.Context, params CreateCommandsParams) ([]Command, error)
 	CancelCurrentProcessingCommand(ctx context.Context) error
+	// CancelCommandByID cancels a QUEUED or PROCESSING command.
+	// A QUEUED command is CANCELED right away, a PROCESSING command is CANCELING until the executor stops it.
+	// Mission steps are canceled with their mission.
+	CancelCommandByID(ctx context.Context, params CancelCommandByIDParams) error
+	// CancelCommandByRequestID cancels the command created with the request ID, like CancelCommandByID.
+	CancelCommandByRequestID(ctx context.Context, params CancelCommandByRequestIDParams) error
+
+	// ExportCommands calls fn with each command matching the filter ordered by id.
+	// The commands are read in batches, so that the export does not hold them all in memory.
+	ExportCommands(ctx context.Context, params ExportCommandsParams, fn func(Command) error) error
+	// GetCommandStats returns the aggregate stats of the commands matching the filter.
+	GetCommandStats(ctx context.Context, params GetCommandStatsParams) (CommandStats, error)
+
+	// PlanCommand returns the plan of the command from the current robot state,
+	// the command is neither created nor executed.
+	PlanCommand(ctx context.Context, params CreateCommandParams) (Plan, error)
+	// PlanMission returns the plan of the steps of the mission from the current robot state,
+	// the mission is neither created nor executed.
+	PlanMission(ctx context.Context, params CreateMissionParams) (Plan, error)
+
+	// MoveQueuedCommand moves a QUEUED command to a position in the queue.
+	// The command keeps its priority, so the position must be within the commands of the same priority.
+	MoveQueuedCommand(ctx context.Context, params MoveQueuedCommandParams) (Command, error)
+	// UpdateQueuedCommand replaces the inputs of a command while it is QUEUED.
+	// The steps of a mission can not be updated.
+	UpdateQueuedCommand(ctx context.Context, params UpdateQueuedCommandParams) (Command, error)
 
 	// CancelActiveCloudCommands cancels all QUEUED and PROCESSING commands created by the cloud.
 	CancelActiveCloudCommands(ctx context.Context) error
 
 	RunNextExecutableCommand(ctx context.Context) error
+	// RecoverPendingCommands applies the startup recovery policy to the commands
+	// left pending by the previous run. It must be called once, before the queue is processed.
+	RecoverPendingCommands(ctx context.Context) error
 
 	// CancelAllRunningCommands cancels all running commands including the current processing command
 	// and the commands in the queue.
@@ -58,10 +194,30 @@ type Service interface {
 
 	DeleteCommandByID(ctx context.Context, params DeleteCommandByIDParams) error
 	DeleteOldCommands(ctx context.Context) error
+
+	GetMissionByID(ctx context.Context, params GetMissionByIDParams) (Mission, error)
+	ListMissions(ctx context.Context, params ListMissionsParams) (paging.List[Mission], error)
+	// CreateMission queues a mission, its steps are executed in order by RunNextExecutableCommand.
+	CreateMission(ctx context.Context, params CreateMissionParams) (Mission, error)
+	// CancelMissionByID cancels the queued steps of the mission and the step being processed if any.
+	CancelMissionByID(ctx context.Context, params CancelMissionByIDParams) error
+
+	// PauseQueue holds the queue: the current processing command is finished,
+	// but no new command is pulled until the queue is resumed.
+	// The paused state survives restarts.
+	PauseQueue(ctx context.Context) (QueueState, error)
+	// ResumeQueue releases the queue held by PauseQueue.
+	ResumeQueue(ctx context.Context) (QueueState, error)
+	GetQueueState(ctx context.Context) (QueueState, error)
 }
 
 type ExecutorService interface {
 	Execute(ctx context.Context, cmd Command) error
+	// Plan returns the expected outcome of the commands executed in order from the current robot state.
+	// It does not drive any motor.
+	Plan(ctx context.Context, cmds []Command) (Plan, error)
+	// EnterSafeState stops the drive and lift motors and checks that the lift is at the safe position.
+	EnterSafeState(ctx context.Context) error
 }
 
 type UpdateCommandParams struct {
@@ -81,12 +237,42 @@ type UpdateCommandParams struct {
 
 type Repository interface {
 	ListCommands(ctx context.Context, params ListCommandsParams) (paging.List[Command], error)
-	GetNextExecutableCommand(ctx context.Context) (Command, error)
+	// GetNextExecutableCommand returns the next queued command that can be executed at now.
+	GetNextExecutableCommand(ctx context.Context, now time.Time) (Command, error)
 	GetCurrentProcessingCommand(ctx context.Context) (Command, error)
 	GetCommandByID(ctx context.Context, id int64) (Command, error)
+	GetCommandByRequestID(ctx context.Context, requestID string) (Command, error)
+	// ListCommandsAfter returns at most limit commands matching the filter with an id greater than afterID, ordered by id.
+	ListCommandsAfter(ctx context.Context, filter CommandFilter, afterID int64, limit int) ([]Command, error)
+	// GetCommandStats returns the aggregate stats of the commands matching the filter.
+	// The routes are computed from every move in the time ranges of the filter,
+	// since any move changes the location the next MOVE_TO starts from.
+	GetCommandStats(ctx context.Context, filter CommandFilter) (CommandStats, error)
 	CreateCommand(ctx context.Context, command Command) (Command, error)
+	// CreateCommands creates the commands in a single transaction.
+	// A command with a request ID that already exists is not created,
+	// the existing command is returned in its place. The commands are returned in order.
+	CreateCommands(ctx context.Context, commands []Command) ([]Command, error)
 	UpdateCommand(ctx context.Context, params UpdateCommandParams) (Command, error)
 
+	// CreateCommandAtHead creates the command at the head of the queue, in a single transaction.
+	// The command takes the highest priority and the lowest queue position of the QUEUED commands.
+	CreateCommandAtHead(ctx context.Context, command Command) (Command, error)
+	// InsertCommand creates the command and places it right before the QUEUED command with the anchor ID,
+	// or right after it if after is set, in a single transaction.
+	// It returns ErrPositionCrossesPriority if the place does not match the priority of the command.
+	InsertCommand(ctx context.Context, command Command, anchorID int64, after bool) (Command, error)
+	// MoveQueuedCommand moves the QUEUED command to the position in the queue, 1 being the next command to execute.
+	// A position past the end of the queue moves the command to the end.
+	// It returns ErrPositionCrossesPriority if the position does not match the priority of the command.
+	MoveQueuedCommand(ctx context.Context, id int64, position int, updatedAt time.Time) (Command, error)
+	// UpdateQueuedCommandInputs replaces the inputs of the command if it is QUEUED and not a mission step.
+	UpdateQueuedCommandInputs(ctx context.Context, id int64, inputs Inputs, updatedAt time.Time) (Command, error)
+	// CancelQueuedCommand cancels the command if it is QUEUED.
+	CancelQueuedCommand(ctx context.Context, id int64, canceledAt time.Time) (Command, error)
+
+	// ListInterruptedCommands returns the commands by status PROCESSING and CANCELING ordered by id.
+	ListInterruptedCommands(ctx context.Context) ([]Command, error)
 	// CancelPendingCommands cancels all pending commands by status QUEUED, PROCESSING, and CANCELING.
 	CancelPendingCommands(ctx context.Context) error
 	CancelQueuedAndProcessingCommandsCreatedByCloud(ctx context.Context) error
@@ -97,10 +283,52 @@ type Repository interface {
 	DeleteOldCommands(ctx context.Context, cutoffTime time.Time) error
 }
 
+type UpdateMissionParams struct {
+	ID             int64
+	Status         MissionStatus
+	SetStatus      bool
+	Error          *string
+	SetError       bool
+	StartedAt      *time.Time
+	SetStartedAt   bool
+	CompletedAt    *time.Time
+	SetCompletedAt bool
+	UpdatedAt      time.Time
+}
+
+type MissionRepository interface {
+	ListMissions(ctx context.Context, params ListMissionsParams) (paging.List[Mission], error)
+	GetMissionByID(ctx context.Context, id int64) (Mission, error)
+	// CreateMission creates the mission and its steps in a single transaction.
+	CreateMission(ctx context.Context, mission Mission) (Mission, error)
+	UpdateMission(ctx context.Context, params UpdateMissionParams) (Mission, error)
+
+	// CancelQueuedMissionSteps cancels the steps of the mission in status QUEUED.
+	CancelQueuedMissionSteps(ctx context.Context, missionID int64) error
+	// CancelPendingMissions cancels all missions by status QUEUED and PROCESSING.
+	CancelPendingMissions(ctx context.Context) error
+	CancelQueuedAndProcessingMissionsCreatedByCloud(ctx context.Context) error
+
+	DeleteOldMissions(ctx context.Context, cutoffTime time.Time) error
+}
+
+type QueueStateRepository interface {
+	GetQueueState(ctx context.Context) (QueueState, error)
+	UpdateQueueState(ctx context.Context, state QueueState) (QueueState, error)
+}
+
 type RunningCommandRepository interface {
 	Get(ctx context.Context) (CancelableCommand, error)
 	Add(ctx context.Context, cmd CancelableCommand) error
 	Update(ctx context.Context, cmd CancelableCommand) error
+	// MarkCanceling moves the running command to CANCELING and cancels its context,
+	// leaving the progress and the paused for obstacle state untouched.
+	// It returns ErrRunningCommandNotFound if the command is not running anymore.
+	MarkCanceling(ctx context.Context, cmdID int64) error
+	// SetPausedForObstacle updates the paused for obstacle state of the running command.
+	SetPausedForObstacle(ctx context.Context, paused bool) error
+	// SetProgress updates the latest progress of the running command.
+	SetProgress(ctx context.Context, progress Progress) error
 	Remove(ctx context.Context) error
 }
 
@@ -115,7 +343,14 @@ type ProcessingLock interface {
 	// The lock is released when the function returns.
 	WithLock(fn func() error) error
 
-	// WaitUntilUnlocked blocks the execution until the lock is released.
+	// WaitUntilUnlocked blocks the execution until the lock is released and the lock is not paused.
 	// If the context is canceled, the function returns immediately.
 	WaitUntilUnlocked(ctx context.Context) error
+
+	// Pause holds the lock until Resume is called.
+	Pause()
+	// Resume releases the lock held by Pause.
+	Resume()
+	// IsPaused reports whether the lock is held by Pause.
+	IsPaused() bool
 }
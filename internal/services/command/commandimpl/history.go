@@ -0,0 +1,51 @@
+package commandimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+// exportBatchSize is the number of commands read at once by ExportCommands.
+// The database connection is released between the batches, so that a slow export
+// does not block the command execution.
+const exportBatchSize = 500
+
+func (s *Service) ExportCommands(ctx context.Context, params command.ExportCommandsParams, fn func(command.Command) error) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	var afterID int64
+	for {
+		cmds, err := s.commandRepository.ListCommandsAfter(ctx, params.CommandFilter, afterID, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("list commands after %d: %w", afterID, err)
+		}
+
+		for _, cmd := range cmds {
+			if err := fn(cmd); err != nil {
+				return err
+			}
+		}
+
+		if len(cmds) < exportBatchSize {
+			return nil
+		}
+		afterID = cmds[len(cmds)-1].ID
+	}
+}
+
+func (s *Service) GetCommandStats(ctx context.Context, params command.GetCommandStatsParams) (command.CommandStats, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.CommandStats{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	stats, err := s.commandRepository.GetCommandStats(ctx, params.CommandFilter)
+	if err != nil {
+		return command.CommandStats{}, fmt.Errorf("get command stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,288 @@
+package commandimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func (s *Service) GetMissionByID(ctx context.Context, params command.GetMissionByIDParams) (command.Mission, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Mission{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.missionRepository.GetMissionByID(ctx, params.MissionID)
+}
+
+func (s *Service) ListMissions(ctx context.Context, params command.ListMissionsParams) (paging.List[command.Mission], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[command.Mission]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.missionRepository.ListMissions(ctx, params)
+}
+
+func (s *Service) CreateMission(ctx context.Context, params command.CreateMissionParams) (command.Mission, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Mission{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	mission := command.NewMission(params.Source, params.Steps, params.OnFailure, params.MaxRetries)
+	mission, err := s.missionRepository.CreateMission(ctx, mission)
+	if err != nil {
+		return command.Mission{}, fmt.Errorf("create mission: %w", err)
+	}
+
+	// The first step is enough to wake up the executor, the remaining steps
+	// are picked up by the mission execution.
+	s.publisher.Publish(
+		events.CommandCreatedTopic,
+		eventbus.NewMessage(events.CommandCreatedEvent{
+			CommandID: mission.Steps[0].ID,
+		}),
+	)
+
+	return mission, nil
+}
+
+func (s *Service) CancelMissionByID(ctx context.Context, params command.CancelMissionByIDParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.processingLock.WithLock(func() error {
+		mission, err := s.missionRepository.GetMissionByID(ctx, params.MissionID)
+		if err != nil {
+			return fmt.Errorf("get mission: %w", err)
+		}
+		if mission.IsFinished() {
+			return command.ErrMissionAlreadyFinished
+		}
+
+		if err := s.missionRepository.CancelQueuedMissionSteps(ctx, mission.ID); err != nil {
+			return fmt.Errorf("cancel queued mission steps: %w", err)
+		}
+
+		// A mission in QUEUED is not picked up by the executor yet, so nobody else finishes it.
+		if mission.Status == command.MissionStatusQueued {
+			return s.finishMission(ctx, mission.ID, command.MissionStatusCanceled, nil)
+		}
+
+		runningCmd, err := s.runningCmdRepository.Get(ctx)
+		if err != nil {
+			if errors.Is(err, command.ErrRunningCommandNotFound) {
+				return nil
+			}
+			return fmt.Errorf("get running command: %w", err)
+		}
+
+		if runningCmd.MissionID == nil || *runningCmd.MissionID != mission.ID || !runningCmd.CanBeCanceled() {
+			return nil
+		}
+
+		if _, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+			ID:        runningCmd.ID,
+			Status:    command.StatusCanceling,
+			SetStatus: true,
+			UpdatedAt: time.Now(),
+		}); err != nil {
+			return fmt.Errorf("update command status: %w", err)
+		}
+
+		// Only the status is changed, the executor may update the progress and the obstacle state
+		// in the meantime. The command may also have finished since it was read.
+		if err := s.runningCmdRepository.MarkCanceling(ctx, runningCmd.ID); err != nil &&
+			!errors.Is(err, command.ErrRunningCommandNotFound) {
+			return fmt.Errorf("mark running command as canceling: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("cancel mission: %w", err)
+	}
+
+	return nil
+}
+
+// executeMission executes the steps of the mission one after another.
+// No other command is executed until the mission is finished.
+func (s *Service) executeMission(ctx context.Context, missionID int64) error {
+	mission, err := s.missionRepository.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return fmt.Errorf("get mission: %w", err)
+	}
+
+	log := s.log.With(slog.Int64("mission_id", mission.ID))
+
+	// The mission was finished before all of its steps were picked up, e.g. it was canceled.
+	if mission.IsFinished() {
+		return s.missionRepository.CancelQueuedMissionSteps(ctx, mission.ID)
+	}
+
+	if mission.Status == command.MissionStatusQueued {
+		now := time.Now()
+		if _, err := s.missionRepository.UpdateMission(ctx, command.UpdateMissionParams{
+			ID:           mission.ID,
+			Status:       command.MissionStatusProcessing,
+			SetStatus:    true,
+			StartedAt:    &now,
+			SetStartedAt: true,
+			UpdatedAt:    now,
+		}); err != nil {
+			return fmt.Errorf("update mission status: %w", err)
+		}
+	}
+
+	failedSteps := 0
+	for i, step := range mission.Steps {
+		result, err := s.executeMissionStep(ctx, mission, step.ID)
+		if err != nil {
+			return fmt.Errorf("execute mission step %d: %w", i+1, err)
+		}
+
+		status := result.status
+		switch status {
+		case command.StatusSucceeded:
+			continue
+
+		case command.StatusCanceled:
+			log.Info("mission canceled", slog.Int("step", i+1))
+			if err := s.missionRepository.CancelQueuedMissionSteps(ctx, mission.ID); err != nil {
+				return fmt.Errorf("cancel queued mission steps: %w", err)
+			}
+			return s.finishMission(ctx, mission.ID, command.MissionStatusCanceled, nil)
+
+		default:
+			if mission.OnFailure == command.OnFailureSkip && !result.abortMission {
+				log.Warn("mission step failed, skipping", slog.Int("step", i+1), slog.String("status", status.String()))
+				failedSteps++
+				continue
+			}
+
+			log.Error("mission step failed, aborting", slog.Int("step", i+1), slog.String("status", status.String()))
+			if err := s.missionRepository.CancelQueuedMissionSteps(ctx, mission.ID); err != nil {
+				return fmt.Errorf("cancel queued mission steps: %w", err)
+			}
+			msg := fmt.Sprintf("step %d (%s) ended with status %s", i+1, step.Type, status)
+			if result.abortMission {
+				msg = fmt.Sprintf("step %d (%s) aborted the mission: %s", i+1, step.Type, result.err)
+			}
+			return s.finishMission(ctx, mission.ID, command.MissionStatusFailed, &msg)
+		}
+	}
+
+	if failedSteps > 0 {
+		msg := fmt.Sprintf("%d of %d steps failed", failedSteps, len(mission.Steps))
+		return s.finishMission(ctx, mission.ID, command.MissionStatusFailed, &msg)
+	}
+
+	log.Info("mission executed successfully")
+	return s.finishMission(ctx, mission.ID, command.MissionStatusSucceeded, nil)
+}
+
+// missionStepResult is the outcome of a mission step.
+type missionStepResult struct {
+	status command.Status
+	// abortMission reports whether the step failed a condition and asks to abort the mission.
+	abortMission bool
+	// err is the error of the failed step.
+	err string
+}
+
+func newMissionStepResult(step command.Command) missionStepResult {
+	res := missionStepResult{status: step.Status}
+	if step.Error != nil {
+		res.err = *step.Error
+	}
+	if step.Status == command.StatusFailed &&
+		step.Inputs != nil && step.Inputs.Common().AbortMission &&
+		step.Outputs != nil && step.Outputs.Common().FailedCondition != nil {
+		res.abortMission = true
+	}
+	return res
+}
+
+// executeMissionStep executes the step and returns its final status.
+// Steps that are no longer QUEUED, e.g. canceled or done in a previous run, are not executed again.
+// A step that fails a condition and asks to abort the mission is not retried.
+func (s *Service) executeMissionStep(ctx context.Context, mission command.Mission, stepID int64) (missionStepResult, error) {
+	maxAttempts := 1
+	if mission.OnFailure == command.OnFailureRetry {
+		maxAttempts += int(mission.MaxRetries)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if err := s.processingLock.WaitUntilUnlocked(ctx); err != nil {
+			return missionStepResult{}, fmt.Errorf("wait for processing lock: %w", err)
+		}
+
+		step, err := s.commandRepository.GetCommandByID(ctx, stepID)
+		if err != nil {
+			return missionStepResult{}, fmt.Errorf("get mission step: %w", err)
+		}
+		if step.Status != command.StatusQueued {
+			return newMissionStepResult(step), nil
+		}
+
+		s.log.Info("executing mission step",
+			slog.Int64("mission_id", mission.ID),
+			slog.Int64("command_id", step.ID),
+			slog.String("command_type", step.Type.String()),
+			slog.Int("attempt", attempt),
+		)
+		if err := s.executorService.Execute(ctx, step); err != nil {
+			return missionStepResult{}, fmt.Errorf("execute command: %w", err)
+		}
+
+		step, err = s.commandRepository.GetCommandByID(ctx, stepID)
+		if err != nil {
+			return missionStepResult{}, fmt.Errorf("get mission step: %w", err)
+		}
+
+		res := newMissionStepResult(step)
+		failed := step.Status == command.StatusFailed || step.Status == command.StatusTimedOut
+		if !failed || res.abortMission || attempt >= maxAttempts {
+			return res, nil
+		}
+
+		// Put the step back in the queue so it is executed again on the next attempt.
+		if _, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+			ID:             step.ID,
+			Status:         command.StatusQueued,
+			SetStatus:      true,
+			Error:          nil,
+			SetError:       true,
+			CompletedAt:    nil,
+			SetCompletedAt: true,
+			UpdatedAt:      time.Now(),
+		}); err != nil {
+			return missionStepResult{}, fmt.Errorf("requeue mission step: %w", err)
+		}
+	}
+}
+
+func (s *Service) finishMission(ctx context.Context, id int64, status command.MissionStatus, errMsg *string) error {
+	now := time.Now()
+	if _, err := s.missionRepository.UpdateMission(ctx, command.UpdateMissionParams{
+		ID:             id,
+		Status:         status,
+		SetStatus:      true,
+		Error:          errMsg,
+		SetError:       true,
+		CompletedAt:    ptr.New(now),
+		SetCompletedAt: true,
+		UpdatedAt:      now,
+	}); err != nil {
+		return fmt.Errorf("update mission status: %w", err)
+	}
+
+	return nil
+}
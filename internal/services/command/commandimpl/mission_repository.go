@@ -0,0 +1,286 @@
+package commandimpl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+type missionRepository struct {
+	db      db.Provider
+	queries *sqlc.Queries
+
+	commandRepository repository
+}
+
+func NewMissionRepository(db db.Provider, queries *sqlc.Queries) command.MissionRepository {
+	return &missionRepository{
+		db:      db,
+		queries: queries,
+		commandRepository: repository{
+			db:      db,
+			queries: queries,
+		},
+	}
+}
+
+//nolint:gosec
+func (r missionRepository) ListMissions(ctx context.Context, params command.ListMissionsParams) (paging.List[command.Mission], error) {
+	var ret paging.List[command.Mission]
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		rows, err := r.queries.MissionList(gctx, r.db, sqlc.MissionListParams{
+			Limit:  int64(params.PagingParams.Limit()),
+			Offset: int64(params.PagingParams.Offset()),
+		})
+		if err != nil {
+			return fmt.Errorf("queries list missions: %w", err)
+		}
+
+		ret.Items, err = r.convertRowsToMissions(gctx, rows)
+		if err != nil {
+			return fmt.Errorf("convert rows to missions: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		count, err := r.queries.MissionCount(gctx, r.db)
+		if err != nil {
+			return fmt.Errorf("queries count missions: %w", err)
+		}
+		ret.TotalItems = count
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return paging.List[command.Mission]{}, fmt.Errorf("errgroup wait: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r missionRepository) GetMissionByID(ctx context.Context, id int64) (command.Mission, error) {
+	row, err := r.queries.MissionGetByID(ctx, r.db, id)
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return command.Mission{}, command.ErrMissionNotFound
+		}
+		return command.Mission{}, fmt.Errorf("queries get mission by id: %w", err)
+	}
+
+	missions, err := r.convertRowsToMissions(ctx, []sqlc.Mission{row})
+	if err != nil {
+		return command.Mission{}, fmt.Errorf("convert row to mission: %w", err)
+	}
+
+	return missions[0], nil
+}
+
+func (r missionRepository) CreateMission(ctx context.Context, mission command.Mission) (command.Mission, error) {
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		var err error
+		mission.ID, err = r.queries.MissionCreate(ctx, tx, sqlc.MissionCreateParams{
+			Status:      mission.Status.String(),
+			Source:      mission.Source.String(),
+			OnFailure:   mission.OnFailure.String(),
+			MaxRetries:  int64(mission.MaxRetries),
+			Error:       mission.Error,
+			StartedAt:   formatTime(mission.StartedAt),
+			CompletedAt: formatTime(mission.CompletedAt),
+			CreatedAt:   mission.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt:   mission.UpdatedAt.Format(time.RFC3339Nano),
+		})
+		if err != nil {
+			return fmt.Errorf("queries create mission: %w", err)
+		}
+
+		for i, step := range mission.Steps {
+			inputsBytes, err := json.Marshal(step.Inputs)
+			if err != nil {
+				return fmt.Errorf("failed to marshal inputs: %w", err)
+			}
+
+			row, err := r.queries.MissionCreateStep(ctx, tx, sqlc.MissionCreateStepParams{
+				Type:      step.Type.String(),
+				Status:    step.Status.String(),
+				Source:    step.Source.String(),
+				Inputs:    string(inputsBytes),
+				CreatedAt: step.CreatedAt.Format(time.RFC3339Nano),
+				UpdatedAt: step.UpdatedAt.Format(time.RFC3339Nano),
+				RequestID: step.RequestID,
+				MissionID: &mission.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("queries create mission step: %w", err)
+			}
+
+			mission.Steps[i], err = r.commandRepository.convertRowToCommand(row)
+			if err != nil {
+				return fmt.Errorf("convert row to command: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return command.Mission{}, fmt.Errorf("create mission in tx: %w", err)
+	}
+
+	return mission, nil
+}
+
+func (r missionRepository) UpdateMission(ctx context.Context, params command.UpdateMissionParams) (command.Mission, error) {
+	_, err := r.queries.MissionUpdate(ctx, r.db, sqlc.MissionUpdateParams{
+		ID:             params.ID,
+		Status:         params.Status.String(),
+		SetStatus:      params.SetStatus,
+		Error:          params.Error,
+		SetError:       params.SetError,
+		StartedAt:      formatTime(params.StartedAt),
+		SetStartedAt:   params.SetStartedAt,
+		CompletedAt:    formatTime(params.CompletedAt),
+		SetCompletedAt: params.SetCompletedAt,
+		UpdatedAt:      params.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return command.Mission{}, command.ErrMissionNotFound
+		}
+		return command.Mission{}, fmt.Errorf("queries update mission: %w", err)
+	}
+
+	return r.GetMissionByID(ctx, params.ID)
+}
+
+func (r missionRepository) CancelQueuedMissionSteps(ctx context.Context, missionID int64) error {
+	err := r.queries.MissionCancelQueuedSteps(ctx, r.db, sqlc.MissionCancelQueuedStepsParams{
+		MissionID: &missionID,
+		UpdatedAt: time.Now().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel queued mission steps: %w", err)
+	}
+	return nil
+}
+
+func (r missionRepository) CancelPendingMissions(ctx context.Context) error {
+	if err := r.queries.MissionCancelByStatusQueuedAndProcessing(ctx, r.db); err != nil {
+		return fmt.Errorf("failed to cancel queued and processing missions: %w", err)
+	}
+	return nil
+}
+
+func (r missionRepository) CancelQueuedAndProcessingMissionsCreatedByCloud(ctx context.Context) error {
+	if err := r.queries.MissionCancelByStatusQueuedAndProcessingAndCreatedByCloud(ctx, r.db); err != nil {
+		return fmt.Errorf("failed to cancel queued and processing missions created by cloud: %w", err)
+	}
+	return nil
+}
+
+func (r missionRepository) DeleteOldMissions(ctx context.Context, cutoffTime time.Time) error {
+	_, err := r.queries.MissionDeleteOldMissions(ctx, r.db, cutoffTime.Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("failed to delete old missions: %w", err)
+	}
+	return nil
+}
+
+func (r missionRepository) convertRowsToMissions(ctx context.Context, rows []sqlc.Mission) ([]command.Mission, error) {
+	missions := make([]command.Mission, 0, len(rows))
+	if len(rows) == 0 {
+		return missions, nil
+	}
+
+	missionIDs := make([]*int64, 0, len(rows))
+	for _, row := range rows {
+		missionIDs = append(missionIDs, ptr.New(row.ID))
+	}
+
+	stepRows, err := r.queries.MissionListSteps(ctx, r.db, missionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("queries list mission steps: %w", err)
+	}
+
+	stepsByMissionID := make(map[int64][]command.Command, len(rows))
+	for _, stepRow := range stepRows {
+		step, err := r.commandRepository.convertRowToCommand(stepRow)
+		if err != nil {
+			return nil, fmt.Errorf("convert row to command: %w", err)
+		}
+		stepsByMissionID[*stepRow.MissionID] = append(stepsByMissionID[*stepRow.MissionID], step)
+	}
+
+	for _, row := range rows {
+		mission, err := r.convertRowToMission(row)
+		if err != nil {
+			return nil, err
+		}
+		mission.Steps = stepsByMissionID[row.ID]
+		missions = append(missions, mission)
+	}
+
+	return missions, nil
+}
+
+func (missionRepository) convertRowToMission(row sqlc.Mission) (command.Mission, error) {
+	ret := command.Mission{
+		ID:         row.ID,
+		Status:     command.MissionStatus(row.Status),
+		Source:     command.Source(row.Source),
+		OnFailure:  command.OnFailurePolicy(row.OnFailure),
+		MaxRetries: uint8(row.MaxRetries), //nolint:gosec
+		Error:      row.Error,
+	}
+	var err error
+
+	ret.CreatedAt, err = time.Parse(time.RFC3339Nano, row.CreatedAt)
+	if err != nil {
+		return command.Mission{}, fmt.Errorf("failed to parse created at: %w", err)
+	}
+
+	ret.UpdatedAt, err = time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return command.Mission{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	ret.StartedAt, err = parseTime(row.StartedAt)
+	if err != nil {
+		return command.Mission{}, fmt.Errorf("failed to parse started at: %w", err)
+	}
+
+	ret.CompletedAt, err = parseTime(row.CompletedAt)
+	if err != nil {
+		return command.Mission{}, fmt.Errorf("failed to parse completed at: %w", err)
+	}
+
+	return ret, nil
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return ptr.New(t.Format(time.RFC3339Nano))
+}
+
+func parseTime(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,36 @@
+package commandimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+func (s *Service) PlanCommand(ctx context.Context, params command.CreateCommandParams) (command.Plan, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Plan{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cmd := command.NewCommand(params.Source, params.Inputs, params.RequestID)
+	plan, err := s.executorService.Plan(ctx, []command.Command{cmd})
+	if err != nil {
+		return command.Plan{}, fmt.Errorf("plan command: %w", err)
+	}
+
+	return plan, nil
+}
+
+func (s *Service) PlanMission(ctx context.Context, params command.CreateMissionParams) (command.Plan, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Plan{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	mission := command.NewMission(params.Source, params.Steps, params.OnFailure, params.MaxRetries)
+	plan, err := s.executorService.Plan(ctx, mission.Steps)
+	if err != nil {
+		return command.Plan{}, fmt.Errorf("plan mission: %w", err)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,89 @@
+package commandimpl
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+func (s *Service) PauseQueue(ctx context.Context) (command.QueueState, error) {
+	state, err := s.queueStateRepository.UpdateQueueState(ctx, command.QueueState{
+		Paused:    true,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("update queue state: %w", err)
+	}
+
+	s.processingLock.Pause()
+	s.log.Info("command queue paused")
+
+	return state, nil
+}
+
+func (s *Service) ResumeQueue(ctx context.Context) (command.QueueState, error) {
+	state, err := s.queueStateRepository.UpdateQueueState(ctx, command.QueueState{
+		Paused:    false,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("update queue state: %w", err)
+	}
+
+	s.processingLock.Resume()
+	s.log.Info("command queue resumed")
+
+	return state, nil
+}
+
+func (s *Service) GetQueueState(ctx context.Context) (command.QueueState, error) {
+	return s.queueStateRepository.GetQueueState(ctx)
+}
+
+// restoreQueueState pauses the processing lock if the queue was paused before the restart.
+func (s *Service) restoreQueueState(ctx context.Context) {
+	state, err := s.queueStateRepository.GetQueueState(ctx)
+	if err != nil {
+		s.log.Error("failed to get queue state", slog.Any("error", err))
+		return
+	}
+
+	if state.Paused {
+		s.processingLock.Pause()
+		s.log.Info("command queue is paused, resume it to process the queued commands")
+	}
+}
+
+func (s *Service) MoveQueuedCommand(ctx context.Context, params command.MoveQueuedCommandParams) (command.Command, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Command{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cmd, err := s.commandRepository.MoveQueuedCommand(ctx, params.CommandID, params.Position, time.Now())
+	if err != nil {
+		return command.Command{}, fmt.Errorf("move queued command: %w", err)
+	}
+
+	s.log.Info("queued command moved",
+		slog.Int64("command_id", cmd.ID),
+		slog.Int("position", params.Position),
+	)
+
+	return cmd, nil
+}
+
+func (s *Service) UpdateQueuedCommand(ctx context.Context, params command.UpdateQueuedCommandParams) (command.Command, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Command{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cmd, err := s.commandRepository.UpdateQueuedCommandInputs(ctx, params.CommandID, params.Inputs, time.Now())
+	if err != nil {
+		return command.Command{}, fmt.Errorf("update queued command inputs: %w", err)
+	}
+
+	return cmd, nil
+}
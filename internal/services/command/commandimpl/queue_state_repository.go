@@ -0,0 +1,61 @@
+package commandimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+)
+
+type queueStateRepository struct {
+	db      db.DB
+	queries *sqlc.Queries
+}
+
+func NewQueueStateRepository(db db.DB, queries *sqlc.Queries) command.QueueStateRepository {
+	return &queueStateRepository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+func (r queueStateRepository) GetQueueState(ctx context.Context) (command.QueueState, error) {
+	row, err := r.queries.CommandQueueStateGet(ctx, r.db)
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("queries get command queue state: %w", err)
+	}
+
+	return r.convertRowToQueueState(row)
+}
+
+func (r queueStateRepository) UpdateQueueState(ctx context.Context, state command.QueueState) (command.QueueState, error) {
+	var paused int64
+	if state.Paused {
+		paused = 1
+	}
+
+	row, err := r.queries.CommandQueueStateUpdate(ctx, r.db, sqlc.CommandQueueStateUpdateParams{
+		Paused:    paused,
+		UpdatedAt: state.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("queries update command queue state: %w", err)
+	}
+
+	return r.convertRowToQueueState(row)
+}
+
+func (queueStateRepository) convertRowToQueueState(row sqlc.CommandQueueState) (command.QueueState, error) {
+	updatedAt, err := time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	return command.QueueState{
+		Paused:    row.Paused == 1,
+		UpdatedAt: updatedAt,
+	}, nil
+}
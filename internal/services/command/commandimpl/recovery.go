@@ -0,0 +1,147 @@
+package commandimpl
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+const interruptedCommandError = "command was interrupted by a restart"
+
+func (s *Service) RecoverPendingCommands(ctx context.Context) error {
+	policy := s.recoveryCfg.Policy
+	if policy == "" || policy == config.RecoveryPolicyCancelAll {
+		return s.cancelQueuedAndProcessingCommands(ctx)
+	}
+
+	s.log.Info("recovering pending commands", slog.String("policy", policy.String()))
+
+	return s.processingLock.WithLock(func() error {
+		cmds, err := s.commandRepository.ListInterruptedCommands(ctx)
+		if err != nil {
+			return fmt.Errorf("list interrupted commands: %w", err)
+		}
+
+		for _, cmd := range cmds {
+			if err := s.recoverInterruptedCommand(ctx, policy, cmd); err != nil {
+				return fmt.Errorf("recover command %d: %w", cmd.ID, err)
+			}
+		}
+
+		// Nothing was running when the previous run stopped, so the robot was not left mid-motion.
+		if len(cmds) == 0 {
+			return nil
+		}
+
+		// The queue is resumed only if the robot is safe to move,
+		// otherwise it stays paused until an operator resumes it.
+		if err := s.executorService.EnterSafeState(ctx); err != nil {
+			s.log.Error("failed to enter safe state, pausing the command queue", slog.Any("error", err))
+			if _, err := s.PauseQueue(ctx); err != nil {
+				return fmt.Errorf("pause queue: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// recoverInterruptedCommand finishes or queues again a command that was PROCESSING or CANCELING
+// when the previous run stopped. A command that was being canceled is canceled.
+func (s *Service) recoverInterruptedCommand(ctx context.Context, policy config.RecoveryPolicy, cmd command.Command) error {
+	log := s.log.With(slog.Int64("command_id", cmd.ID), slog.String("command_type", cmd.Type.String()))
+	now := time.Now()
+
+	switch {
+	case cmd.Status == command.StatusCanceling:
+		cmd, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+			ID:             cmd.ID,
+			Status:         command.StatusCanceled,
+			SetStatus:      true,
+			CompletedAt:    ptr.New(now),
+			SetCompletedAt: true,
+			UpdatedAt:      now,
+		})
+		if err != nil {
+			return fmt.Errorf("update command status: %w", err)
+		}
+		log.Info("interrupted command canceled")
+		s.publisher.Publish(events.CommandCanceledTopic, eventbus.NewMessage(events.CommandCanceledEvent{Command: cmd}))
+
+	case policy == config.RecoveryPolicyRerunIdempotent && command.IsIdempotent(cmd.Inputs):
+		if _, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+			ID:           cmd.ID,
+			Status:       command.StatusQueued,
+			SetStatus:    true,
+			StartedAt:    nil,
+			SetStartedAt: true,
+			UpdatedAt:    now,
+		}); err != nil {
+			return fmt.Errorf("requeue command: %w", err)
+		}
+		log.Info("interrupted command queued again")
+
+	default:
+		cmd, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+			ID:             cmd.ID,
+			Status:         command.StatusFailed,
+			SetStatus:      true,
+			Error:          ptr.New(interruptedCommandError),
+			SetError:       true,
+			CompletedAt:    ptr.New(now),
+			SetCompletedAt: true,
+			UpdatedAt:      now,
+		})
+		if err != nil {
+			return fmt.Errorf("update command status: %w", err)
+		}
+		log.Info("interrupted command failed")
+		s.publisher.Publish(events.CommandFailedTopic, eventbus.NewMessage(events.CommandFailedEvent{Command: cmd}))
+	}
+
+	if cmd.MissionID != nil {
+		return s.recoverInterruptedMission(ctx, *cmd.MissionID)
+	}
+
+	return nil
+}
+
+// recoverInterruptedMission finishes the mission if none of its steps is left in the queue.
+// Otherwise the mission is resumed from its next queued step by RunNextExecutableCommand.
+func (s *Service) recoverInterruptedMission(ctx context.Context, missionID int64) error {
+	mission, err := s.missionRepository.GetMissionByID(ctx, missionID)
+	if err != nil {
+		return fmt.Errorf("get mission: %w", err)
+	}
+	if mission.IsFinished() {
+		return nil
+	}
+
+	for _, step := range mission.Steps {
+		if step.Status == command.StatusQueued {
+			return nil
+		}
+	}
+
+	s.log.Info("interrupted mission failed", slog.Int64("mission_id", mission.ID))
+	return s.finishMission(ctx, mission.ID, command.MissionStatusFailed, ptr.New("mission was interrupted by a restart"))
+}
+
+func (s *Service) cancelQueuedAndProcessingCommands(ctx context.Context) error {
+	if err := s.commandRepository.CancelPendingCommands(ctx); err != nil {
+		return fmt.Errorf("cancel queued and processing commands: %w", err)
+	}
+
+	if err := s.missionRepository.CancelPendingMissions(ctx); err != nil {
+		return fmt.Errorf("cancel queued and processing missions: %w", err)
+	}
+
+	return nil
+}
@@ -1,9 +1,12 @@
 package commandimpl
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -16,12 +19,15 @@ import (
 	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
+// maxFailureStats is the number of the most frequent errors returned by GetCommandStats.
+const maxFailureStats = 20
+
 type repository struct {
-	db      db.DB
+	db      db.Provider
 	queries *sqlc.Queries
 }
 
-func NewCommandRepository(db db.DB, queries *sqlc.Queries) command.Repository {
+func NewCommandRepository(db db.Provider, queries *sqlc.Queries) command.Repository {
 	return &repository{
 		db:      db,
 		queries: queries,
@@ -38,14 +44,7 @@ func (r repository) ListCommands(ctx context.Context, params command.ListCommand
 	for _, s := range params.Sorts {
 		query = s.Attach(query)
 	}
-
-	statuses := []string{}
-	for _, s := range params.Statuses {
-		statuses = append(statuses, s.String())
-	}
-	if len(statuses) > 0 {
-		query = query.Where(sq.Eq{"status": statuses})
-	}
+	query = attachCommandFilter(query, params.CommandFilter)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -55,10 +54,7 @@ func (r repository) ListCommands(ctx context.Context, params command.ListCommand
 	countQuery := sq.
 		Select("COUNT(*)").
 		From("commands")
-
-	if len(statuses) > 0 {
-		countQuery = countQuery.Where(sq.Eq{"status": statuses})
-	}
+	countQuery = attachCommandFilter(countQuery, params.CommandFilter)
 
 	countSQL, countArgs, err := countQuery.ToSql()
 	if err != nil {
@@ -75,33 +71,8 @@ func (r repository) ListCommands(ctx context.Context, params command.ListCommand
 		}
 		defer rows.Close()
 
-		for rows.Next() {
-			var row sqlc.Command
-			if err := rows.Scan(
-				&row.ID,
-				&row.Type,
-				&row.Status,
-				&row.Source,
-				&row.Inputs,
-				&row.Error,
-				&row.CompletedAt,
-				&row.CreatedAt,
-				&row.UpdatedAt,
-				&row.StartedAt,
-				&row.Outputs,
-				&row.RequestID,
-			); err != nil {
-				return fmt.Errorf("scan command: %w", err)
-			}
-
-			cmd, err := r.convertRowToCommand(row)
-			if err != nil {
-				return fmt.Errorf("convert row to command: %w", err)
-			}
-			ret.Items = append(ret.Items, cmd)
-		}
-
-		return nil
+		ret.Items, err = r.scanCommands(rows)
+		return err
 	})
 
 	g.Go(func() error {
@@ -118,8 +89,309 @@ func (r repository) ListCommands(ctx context.Context, params command.ListCommand
 	return ret, nil
 }
 
-func (r repository) GetNextExecutableCommand(ctx context.Context) (command.Command, error) {
-	row, err := r.queries.CommandGetNextExecutable(ctx, r.db)
+func (r repository) ListCommandsAfter(ctx context.Context, filter command.CommandFilter, afterID int64, limit int) ([]command.Command, error) {
+	query := sq.
+		Select("*").
+		From("commands").
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(uint64(limit))
+	query = attachCommandFilter(query, filter)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query commands: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanCommands(rows)
+}
+
+func (r repository) GetCommandStats(ctx context.Context, filter command.CommandFilter) (command.CommandStats, error) {
+	var stats command.CommandStats
+
+	statusQuery := sq.
+		Select("status", "COUNT(*)").
+		From("commands").
+		GroupBy("status")
+	if err := r.queryStats(ctx, attachCommandFilter(statusQuery, filter), func(rows *sql.Rows) error {
+		var status string
+		var count int64
+		if err := rows.Scan(&status, &count); err != nil {
+			return err
+		}
+
+		stats.Total += count
+		switch command.Status(status) {
+		case command.StatusSucceeded:
+			stats.Succeeded = count
+		case command.StatusFailed:
+			stats.Failed = count
+		case command.StatusTimedOut:
+			stats.TimedOut = count
+		case command.StatusCanceled:
+			stats.Canceled = count
+		}
+		return nil
+	}); err != nil {
+		return command.CommandStats{}, fmt.Errorf("query status stats: %w", err)
+	}
+
+	// The durations are computed by SQLite, the times are stored as RFC 3339 strings.
+	typeQuery := sq.
+		Select(
+			"type",
+			"COUNT(*)",
+			"COUNT(*) FILTER (WHERE status = 'SUCCEEDED')",
+			"COALESCE(AVG((julianday(completed_at) - julianday(started_at)) * 86400000) FILTER (WHERE status = 'SUCCEEDED' AND started_at IS NOT NULL AND completed_at IS NOT NULL), 0)",
+		).
+		From("commands").
+		GroupBy("type").
+		OrderBy("type")
+	if err := r.queryStats(ctx, attachCommandFilter(typeQuery, filter), func(rows *sql.Rows) error {
+		var typeStats command.CommandTypeStats
+		var meanDurationMs float64
+		if err := rows.Scan(&typeStats.Type, &typeStats.Total, &typeStats.Succeeded, &meanDurationMs); err != nil {
+			return err
+		}
+
+		typeStats.MeanDuration = msToDuration(meanDurationMs)
+		stats.Types = append(stats.Types, typeStats)
+		return nil
+	}); err != nil {
+		return command.CommandStats{}, fmt.Errorf("query type stats: %w", err)
+	}
+
+	// SQLite has no percentile function, the percentiles are computed from the sorted durations
+	durationQuery := sq.
+		Select("type", "(julianday(completed_at) - julianday(started_at)) * 86400000 AS duration_ms").
+		From("commands").
+		Where(sq.Eq{"status": command.StatusSucceeded.String()}).
+		Where(sq.NotEq{"started_at": nil, "completed_at": nil}).
+		OrderBy("type", "duration_ms")
+	durations := map[command.CommandType][]time.Duration{}
+	if err := r.queryStats(ctx, attachCommandFilter(durationQuery, filter), func(rows *sql.Rows) error {
+		var cmdType command.CommandType
+		var durationMs float64
+		if err := rows.Scan(&cmdType, &durationMs); err != nil {
+			return err
+		}
+
+		durations[cmdType] = append(durations[cmdType], msToDuration(durationMs))
+		return nil
+	}); err != nil {
+		return command.CommandStats{}, fmt.Errorf("query duration stats: %w", err)
+	}
+	for i, typeStats := range stats.Types {
+		stats.Types[i].P50Duration = percentile(durations[typeStats.Type], 50)
+		stats.Types[i].P95Duration = percentile(durations[typeStats.Type], 95)
+	}
+
+	sourceQuery := sq.
+		Select(
+			"source",
+			"COUNT(*)",
+			"COUNT(*) FILTER (WHERE status = 'SUCCEEDED')",
+		).
+		From("commands").
+		GroupBy("source").
+		OrderBy("source")
+	if err := r.queryStats(ctx, attachCommandFilter(sourceQuery, filter), func(rows *sql.Rows) error {
+		var sourceStats command.SourceStats
+		if err := rows.Scan(&sourceStats.Source, &sourceStats.Total, &sourceStats.Succeeded); err != nil {
+			return err
+		}
+
+		stats.Sources = append(stats.Sources, sourceStats)
+		return nil
+	}); err != nil {
+		return command.CommandStats{}, fmt.Errorf("query source stats: %w", err)
+	}
+
+	moveQuery := sq.
+		Select(
+			"type",
+			"status",
+			"inputs",
+			"(julianday(completed_at) - julianday(started_at)) * 86400000",
+		).
+		From("commands").
+		Where(sq.Eq{"type": []string{
+			command.CommandTypeMoveTo.String(),
+			command.CommandTypeMoveForward.String(),
+			command.CommandTypeMoveBackward.String(),
+			command.CommandTypeScanLocation.String(),
+		}}).
+		Where(sq.NotEq{"started_at": nil}).
+		OrderBy("julianday(started_at)", "id")
+	var moves []move
+	if err := r.queryStats(ctx, attachCommandFilter(moveQuery, command.CommandFilter{
+		CreatedFrom:   filter.CreatedFrom,
+		CreatedTo:     filter.CreatedTo,
+		CompletedFrom: filter.CompletedFrom,
+		CompletedTo:   filter.CompletedTo,
+	}), func(rows *sql.Rows) error {
+		var m move
+		var inputs string
+		var durationMs sql.NullFloat64
+		if err := rows.Scan(&m.Type, &m.Status, &inputs, &durationMs); err != nil {
+			return err
+		}
+
+		if m.Type == command.CommandTypeMoveTo {
+			var moveToInputs command.MoveToInputs
+			if err := json.Unmarshal([]byte(inputs), &moveToInputs); err != nil {
+				return fmt.Errorf("unmarshal move to inputs: %w", err)
+			}
+			m.Location = moveToInputs.Location
+		}
+		if durationMs.Valid {
+			m.Duration = ptr.New(msToDuration(durationMs.Float64))
+		}
+
+		moves = append(moves, m)
+		return nil
+	}); err != nil {
+		return command.CommandStats{}, fmt.Errorf("query route stats: %w", err)
+	}
+	stats.Routes = routeStats(moves)
+
+	failureQuery := sq.
+		Select("error", "COUNT(*)").
+		From("commands").
+		Where(sq.Eq{"status": []string{command.StatusFailed.String(), command.StatusTimedOut.String()}}).
+		Where(sq.NotEq{"error": nil}).
+		GroupBy("error").
+		OrderBy("COUNT(*) DESC", "error").
+		Limit(maxFailureStats)
+	if err := r.queryStats(ctx, attachCommandFilter(failureQuery, filter), func(rows *sql.Rows) error {
+		var failure command.FailureStats
+		if err := rows.Scan(&failure.Error, &failure.Count); err != nil {
+			return err
+		}
+
+		stats.Failures = append(stats.Failures, failure)
+		return nil
+	}); err != nil {
+		return command.CommandStats{}, fmt.Errorf("query failure stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// queryStats runs the query and calls scan for each row.
+func (r repository) queryStats(ctx context.Context, query sq.SelectBuilder, scan func(*sql.Rows) error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r repository) scanCommands(rows *sql.Rows) ([]command.Command, error) {
+	var cmds []command.Command
+	for rows.Next() {
+		var row sqlc.Command
+		if err := rows.Scan(
+			&row.ID,
+			&row.Type,
+			&row.Status,
+			&row.Source,
+			&row.Inputs,
+			&row.Error,
+			&row.CompletedAt,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+			&row.StartedAt,
+			&row.Outputs,
+			&row.RequestID,
+			&row.MissionID,
+			&row.RetryPolicy,
+			&row.Priority,
+			&row.NotBefore,
+			&row.QueuePosition,
+		); err != nil {
+			return nil, fmt.Errorf("scan command: %w", err)
+		}
+
+		cmd, err := r.convertRowToCommand(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert row to command: %w", err)
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	return cmds, rows.Err()
+}
+
+// attachCommandFilter adds the conditions of the filter to the query.
+// The times are compared with julianday, since they are stored as RFC 3339 strings
+// with the offset of the local time zone.
+func attachCommandFilter(query sq.SelectBuilder, filter command.CommandFilter) sq.SelectBuilder {
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, s.String())
+		}
+		query = query.Where(sq.Eq{"status": statuses})
+	}
+
+	if len(filter.Types) > 0 {
+		types := make([]string, 0, len(filter.Types))
+		for _, t := range filter.Types {
+			types = append(types, t.String())
+		}
+		query = query.Where(sq.Eq{"type": types})
+	}
+
+	if len(filter.Sources) > 0 {
+		sources := make([]string, 0, len(filter.Sources))
+		for _, s := range filter.Sources {
+			sources = append(sources, s.String())
+		}
+		query = query.Where(sq.Eq{"source": sources})
+	}
+
+	if filter.RequestID != nil {
+		query = query.Where(sq.Eq{"request_id": *filter.RequestID})
+	}
+
+	if filter.CreatedFrom != nil {
+		query = query.Where("julianday(created_at) >= julianday(?)", filter.CreatedFrom.Format(time.RFC3339Nano))
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("julianday(created_at) < julianday(?)", filter.CreatedTo.Format(time.RFC3339Nano))
+	}
+	if filter.CompletedFrom != nil {
+		query = query.Where("julianday(completed_at) >= julianday(?)", filter.CompletedFrom.Format(time.RFC3339Nano))
+	}
+	if filter.CompletedTo != nil {
+		query = query.Where("julianday(completed_at) < julianday(?)", filter.CompletedTo.Format(time.RFC3339Nano))
+	}
+
+	return query
+}
+
+func (r repository) GetNextExecutableCommand(ctx context.Context, now time.Time) (command.Command, error) {
+	row, err := r.queries.CommandGetNextExecutable(ctx, r.db, formatNotBefore(now))
 	if err != nil {
 		if db.IsNoRowsError(err) {
 			return command.Command{}, command.ErrNoNextExecutableCommand
@@ -151,7 +423,55 @@ func (r repository) GetCommandByID(ctx context.Context, id int64) (command.Comma
 	return r.convertRowToCommand(row)
 }
 
+func (r repository) GetCommandByRequestID(ctx context.Context, requestID string) (command.Command, error) {
+	row, err := r.queries.CommandGetByRequestID(ctx, r.db, &requestID)
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return command.Command{}, command.ErrCommandNotFound
+		}
+		return command.Command{}, fmt.Errorf("failed to get command by request id: %w", err)
+	}
+	return r.convertRowToCommand(row)
+}
+
 func (r repository) CreateCommand(ctx context.Context, commandArg command.Command) (command.Command, error) {
+	return r.createCommand(ctx, r.db, commandArg)
+}
+
+func (r repository) CreateCommands(ctx context.Context, commands []command.Command) ([]command.Command, error) {
+	ret := make([]command.Command, len(commands))
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		for i, cmd := range commands {
+			if cmd.RequestID != nil {
+				row, err := r.queries.CommandGetByRequestID(ctx, tx, cmd.RequestID)
+				if err == nil {
+					ret[i], err = r.convertRowToCommand(row)
+					if err != nil {
+						return fmt.Errorf("convert row to command: %w", err)
+					}
+					continue
+				}
+				if !db.IsNoRowsError(err) {
+					return fmt.Errorf("queries get command by request id: %w", err)
+				}
+			}
+
+			var err error
+			ret[i], err = r.createCommand(ctx, tx, cmd)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create commands in tx: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r repository) createCommand(ctx context.Context, dbtx db.DB, commandArg command.Command) (command.Command, error) {
 	inputsBytes, err := json.Marshal(commandArg.Inputs)
 	if err != nil {
 		return command.Command{}, fmt.Errorf("failed to marshal inputs: %w", err)
@@ -167,7 +487,21 @@ func (r repository) CreateCommand(ctx context.Context, commandArg command.Comman
 		startedAt = ptr.New(commandArg.StartedAt.Format(time.RFC3339Nano))
 	}
 
-	row, err := r.queries.CommandCreate(ctx, r.db, sqlc.CommandCreateParams{
+	var retryPolicy *string
+	if commandArg.RetryPolicy != nil {
+		retryPolicyBytes, err := json.Marshal(commandArg.RetryPolicy)
+		if err != nil {
+			return command.Command{}, fmt.Errorf("failed to marshal retry policy: %w", err)
+		}
+		retryPolicy = ptr.New(string(retryPolicyBytes))
+	}
+
+	var notBefore *string
+	if commandArg.NotBefore != nil {
+		notBefore = ptr.New(formatNotBefore(*commandArg.NotBefore))
+	}
+
+	row, err := r.queries.CommandCreate(ctx, dbtx, sqlc.CommandCreateParams{
 		Type:        commandArg.Type.String(),
 		Status:      commandArg.Status.String(),
 		Source:      commandArg.Source.String(),
@@ -178,17 +512,21 @@ func (r repository) CreateCommand(ctx context.Context, commandArg command.Comman
 		CreatedAt:   commandArg.CreatedAt.Format(time.RFC3339Nano),
 		UpdatedAt:   commandArg.UpdatedAt.Format(time.RFC3339Nano),
 		RequestID:   commandArg.RequestID,
+		RetryPolicy: retryPolicy,
+		Priority:    int64(commandArg.Priority),
+		NotBefore:   notBefore,
 	})
 	if err != nil {
+		if db.IsUniqueViolationError(err, "request_id") {
+			return command.Command{}, command.ErrCommandAlreadyExists
+		}
 		return command.Command{}, fmt.Errorf("queries create command: %w", err)
 	}
 
 	commandArg.ID = row.ID
+	commandArg.QueuePosition = row.QueuePosition
 	commandArg.Outputs, err = command.UnmarshalOutputs(commandArg.Type, []byte(row.Outputs))
 	if err != nil {
-		if db.IsUniqueViolationError(err, "request_id") {
-			return command.Command{}, command.ErrCommandAlreadyExists
-		}
 		return command.Command{}, fmt.Errorf("failed to unmarshal outputs: %w", err)
 	}
 
@@ -236,6 +574,258 @@ func (r repository) UpdateCommand(ctx context.Context, params command.UpdateComm
 	return r.convertRowToCommand(row)
 }
 
+func (r repository) CreateCommandAtHead(ctx context.Context, commandArg command.Command) (command.Command, error) {
+	var ret command.Command
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		queue, err := r.listQueuedCommands(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		ret, err = r.createCommand(ctx, tx, commandArg)
+		if err != nil {
+			return err
+		}
+		if len(queue) == 0 {
+			return nil
+		}
+
+		// The queue is ordered by priority first, the head has the highest priority
+		ret.Priority = max(ret.Priority, queue[0].Priority)
+		ret.QueuePosition = slices.MinFunc(queue, func(a, b command.Command) int {
+			return cmp.Compare(a.QueuePosition, b.QueuePosition)
+		}).QueuePosition - 1
+
+		if err := r.queries.CommandUpdateQueuePosition(ctx, tx, sqlc.CommandUpdateQueuePositionParams{
+			ID:            ret.ID,
+			QueuePosition: ret.QueuePosition,
+			Priority:      int64(ret.Priority),
+			UpdatedAt:     ret.UpdatedAt.Format(time.RFC3339Nano),
+		}); err != nil {
+			return fmt.Errorf("queries update queue position: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return command.Command{}, fmt.Errorf("create command at head in tx: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r repository) InsertCommand(ctx context.Context, commandArg command.Command, anchorID int64, after bool) (command.Command, error) {
+	var ret command.Command
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		queue, err := r.listQueuedCommands(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if _, err := r.findQueuedCommand(ctx, tx, queue, anchorID); err != nil {
+			return fmt.Errorf("find anchor command: %w", err)
+		}
+
+		created, err := r.createCommand(ctx, tx, commandArg)
+		if err != nil {
+			return err
+		}
+
+		queue, err = r.listQueuedCommands(ctx, tx)
+		if err != nil {
+			return err
+		}
+		from := slices.IndexFunc(queue, func(c command.Command) bool { return c.ID == created.ID })
+		rest := slices.Delete(slices.Clone(queue), from, from+1)
+		to := slices.IndexFunc(rest, func(c command.Command) bool { return c.ID == anchorID })
+		if after {
+			to++
+		}
+
+		ret, err = r.placeQueuedCommand(ctx, tx, queue, from, to, commandArg.UpdatedAt)
+		return err
+	})
+	if err != nil {
+		return command.Command{}, fmt.Errorf("insert command in tx: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r repository) MoveQueuedCommand(ctx context.Context, id int64, position int, updatedAt time.Time) (command.Command, error) {
+	var ret command.Command
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		queue, err := r.listQueuedCommands(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		from, err := r.findQueuedCommand(ctx, tx, queue, id)
+		if err != nil {
+			return err
+		}
+
+		to := min(position, len(queue)) - 1
+		ret, err = r.placeQueuedCommand(ctx, tx, queue, from, to, updatedAt)
+		return err
+	})
+	if err != nil {
+		return command.Command{}, fmt.Errorf("move queued command in tx: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r repository) UpdateQueuedCommandInputs(ctx context.Context, id int64, inputs command.Inputs, updatedAt time.Time) (command.Command, error) {
+	inputsBytes, err := json.Marshal(inputs)
+	if err != nil {
+		return command.Command{}, fmt.Errorf("failed to marshal inputs: %w", err)
+	}
+
+	affected, err := r.queries.CommandUpdateQueuedInputs(ctx, r.db, sqlc.CommandUpdateQueuedInputsParams{
+		ID:        id,
+		Type:      inputs.CommandType().String(),
+		Inputs:    string(inputsBytes),
+		UpdatedAt: updatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return command.Command{}, fmt.Errorf("queries update queued command inputs: %w", err)
+	}
+
+	cmd, err := r.GetCommandByID(ctx, id)
+	if err != nil {
+		return command.Command{}, err
+	}
+	if cmd.MissionID != nil {
+		return command.Command{}, command.ErrCommandInMission
+	}
+	if affected == 0 {
+		return command.Command{}, command.ErrCommandNotQueued
+	}
+
+	return cmd, nil
+}
+
+func (r repository) CancelQueuedCommand(ctx context.Context, id int64, canceledAt time.Time) (command.Command, error) {
+	affected, err := r.queries.CommandCancelQueued(ctx, r.db, sqlc.CommandCancelQueuedParams{
+		ID:          id,
+		CompletedAt: ptr.New(canceledAt.Format(time.RFC3339Nano)),
+		UpdatedAt:   canceledAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return command.Command{}, fmt.Errorf("queries cancel queued command: %w", err)
+	}
+
+	cmd, err := r.GetCommandByID(ctx, id)
+	if err != nil {
+		return command.Command{}, err
+	}
+	if affected == 0 {
+		return command.Command{}, command.ErrCommandNotQueued
+	}
+
+	return cmd, nil
+}
+
+// listQueuedCommands returns the QUEUED commands in queue order.
+func (r repository) listQueuedCommands(ctx context.Context, dbtx db.DB) ([]command.Command, error) {
+	rows, err := r.queries.CommandListQueued(ctx, dbtx)
+	if err != nil {
+		return nil, fmt.Errorf("queries list queued commands: %w", err)
+	}
+
+	queue := make([]command.Command, len(rows))
+	for i, row := range rows {
+		queue[i], err = r.convertRowToCommand(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert row to command: %w", err)
+		}
+	}
+
+	return queue, nil
+}
+
+// findQueuedCommand returns the index of the command in the queue.
+// Mission steps can not be placed in the queue, they are executed in the order of their mission.
+func (r repository) findQueuedCommand(ctx context.Context, dbtx db.DB, queue []command.Command, id int64) (int, error) {
+	i := slices.IndexFunc(queue, func(c command.Command) bool { return c.ID == id })
+	if i < 0 {
+		if _, err := r.queries.CommandGetByID(ctx, dbtx, id); err != nil {
+			if db.IsNoRowsError(err) {
+				return 0, command.ErrCommandNotFound
+			}
+			return 0, fmt.Errorf("queries get command by id: %w", err)
+		}
+		return 0, command.ErrCommandNotQueued
+	}
+
+	if queue[i].MissionID != nil {
+		return 0, command.ErrCommandInMission
+	}
+
+	return i, nil
+}
+
+// placeQueuedCommand moves the command at index from of the queue to index to of the rest of the queue.
+// The queue positions are reassigned in the new order. The queue is ordered by priority first,
+// so the command can not be placed before a command of a lower priority or after one of a higher priority.
+func (r repository) placeQueuedCommand(
+	ctx context.Context,
+	dbtx db.DB,
+	queue []command.Command,
+	from, to int,
+	updatedAt time.Time,
+) (command.Command, error) {
+	positions := make([]int64, len(queue))
+	for i, c := range queue {
+		positions[i] = c.QueuePosition
+	}
+	slices.Sort(positions)
+
+	cmd := queue[from]
+	reordered := slices.Insert(slices.Delete(slices.Clone(queue), from, from+1), to, cmd)
+	if to > 0 && reordered[to-1].Priority < cmd.Priority {
+		return command.Command{}, command.ErrPositionCrossesPriority
+	}
+	if to+1 < len(reordered) && reordered[to+1].Priority > cmd.Priority {
+		return command.Command{}, command.ErrPositionCrossesPriority
+	}
+
+	for i, c := range reordered {
+		if c.ID != cmd.ID && c.QueuePosition == positions[i] {
+			continue
+		}
+
+		if err := r.queries.CommandUpdateQueuePosition(ctx, dbtx, sqlc.CommandUpdateQueuePositionParams{
+			ID:            c.ID,
+			QueuePosition: positions[i],
+			Priority:      int64(c.Priority),
+			UpdatedAt:     updatedAt.Format(time.RFC3339Nano),
+		}); err != nil {
+			return command.Command{}, fmt.Errorf("queries update queue position: %w", err)
+		}
+	}
+
+	cmd.QueuePosition = positions[to]
+	cmd.UpdatedAt = updatedAt
+	return cmd, nil
+}
+
+func (r repository) ListInterruptedCommands(ctx context.Context) ([]command.Command, error) {
+	rows, err := r.queries.CommandListInterrupted(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interrupted commands: %w", err)
+	}
+
+	cmds := make([]command.Command, len(rows))
+	for i, row := range rows {
+		cmds[i], err = r.convertRowToCommand(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert row to command: %w", err)
+		}
+	}
+
+	return cmds, nil
+}
+
 func (r repository) CancelPendingCommands(ctx context.Context) error {
 	err := r.queries.CommandCancelByStatusQueuedAndProcessingAndCanceling(ctx, r.db)
 	if err != nil {
@@ -274,12 +864,15 @@ func (r repository) DeleteOldCommands(ctx context.Context, cutoffTime time.Time)
 
 func (repository) convertRowToCommand(row sqlc.Command) (command.Command, error) {
 	ret := command.Command{
-		ID:        row.ID,
-		Type:      command.CommandType(row.Type),
-		Status:    command.Status(row.Status),
-		Source:    command.Source(row.Source),
-		Error:     row.Error,
-		RequestID: row.RequestID,
+		ID:            row.ID,
+		Type:          command.CommandType(row.Type),
+		Status:        command.Status(row.Status),
+		Source:        command.Source(row.Source),
+		Error:         row.Error,
+		RequestID:     row.RequestID,
+		MissionID:     row.MissionID,
+		Priority:      uint8(row.Priority), //nolint:gosec
+		QueuePosition: row.QueuePosition,
 	}
 	var err error
 
@@ -293,6 +886,14 @@ func (repository) convertRowToCommand(row sqlc.Command) (command.Command, error)
 		return command.Command{}, fmt.Errorf("failed to unmarshal outputs: %w", err)
 	}
 
+	if row.RetryPolicy != nil {
+		var retryPolicy command.RetryPolicy
+		if err := json.Unmarshal([]byte(*row.RetryPolicy), &retryPolicy); err != nil {
+			return command.Command{}, fmt.Errorf("failed to unmarshal retry policy: %w", err)
+		}
+		ret.RetryPolicy = &retryPolicy
+	}
+
 	ret.CreatedAt, err = time.Parse(time.RFC3339Nano, row.CreatedAt)
 	if err != nil {
 		return command.Command{}, fmt.Errorf("failed to parse created at: %w", err)
@@ -319,5 +920,19 @@ func (repository) convertRowToCommand(row sqlc.Command) (command.Command, error)
 		ret.CompletedAt = &completedAt
 	}
 
+	if row.NotBefore != nil {
+		notBefore, err := time.Parse(time.RFC3339, *row.NotBefore)
+		if err != nil {
+			return command.Command{}, fmt.Errorf("failed to parse not before: %w", err)
+		}
+		ret.NotBefore = &notBefore
+	}
+
 	return ret, nil
 }
+
+// formatNotBefore formats the time in UTC with a fixed width,
+// so that not_before can be compared as a string in SQLite.
+func formatNotBefore(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -44,6 +44,36 @@ func (r *runningCmdRepository) Update(_ context.Context, cmd command.CancelableC
 	return nil
 }
 
+func (r *runningCmdRepository) MarkCanceling(_ context.Context, cmdID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cmd == nil || r.cmd.ID != cmdID {
+		return command.ErrRunningCommandNotFound
+	}
+	r.cmd.Cancel()
+	return nil
+}
+
+func (r *runningCmdRepository) SetPausedForObstacle(_ context.Context, paused bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cmd == nil {
+		return command.ErrRunningCommandNotFound
+	}
+	r.cmd.PausedForObstacle = paused
+	return nil
+}
+
+func (r *runningCmdRepository) SetProgress(_ context.Context, progress command.Progress) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cmd == nil || r.cmd.ID != progress.CommandID {
+		return command.ErrRunningCommandNotFound
+	}
+	r.cmd.Progress = &progress
+	return nil
+}
+
 func (r *runningCmdRepository) Remove(_ context.Context) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
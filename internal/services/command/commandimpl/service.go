@@ -13,10 +13,12 @@ import (
 	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/paging"
 	"github.com/tbe-team/raybot/pkg/validator"
+	"github.com/tbe-team/raybot/pkg/xerror"
 )
 
 type Service struct {
 	deleteOldCmdCfg config.DeleteOldCommand
+	recoveryCfg     config.Recovery
 
 	log       *slog.Logger
 	validator validator.Validator
@@ -25,6 +27,8 @@ type Service struct {
 
 	runningCmdRepository command.RunningCommandRepository
 	commandRepository    command.Repository
+	missionRepository    command.MissionRepository
+	queueStateRepository command.QueueStateRepository
 
 	processingLock  command.ProcessingLock
 	executorService command.ExecutorService
@@ -32,26 +36,32 @@ type Service struct {
 
 func NewService(
 	deleteOldCmdCfg config.DeleteOldCommand,
+	recoveryCfg config.Recovery,
 	log *slog.Logger,
 	validator validator.Validator,
 	publisher eventbus.Publisher,
 	runningCmdRepository command.RunningCommandRepository,
 	commandRepository command.Repository,
+	missionRepository command.MissionRepository,
+	queueStateRepository command.QueueStateRepository,
 	processingLock command.ProcessingLock,
 	executorService command.ExecutorService,
 ) command.Service {
 	s := &Service{
 		deleteOldCmdCfg:      deleteOldCmdCfg,
+		recoveryCfg:          recoveryCfg,
 		log:                  log.With("service", "command"),
 		validator:            validator,
 		publisher:            publisher,
 		runningCmdRepository: runningCmdRepository,
 		commandRepository:    commandRepository,
+		missionRepository:    missionRepository,
+		queueStateRepository: queueStateRepository,
 		processingLock:       processingLock,
 		executorService:      executorService,
 	}
 
-	go s.cancelQueuedAndProcessingCommands(context.Background())
+	s.restoreQueueState(context.Background())
 
 	return s
 }
@@ -61,11 +71,21 @@ func (s *Service) GetCommandByID(ctx context.Context, params command.GetCommandB
 		return command.Command{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	return s.commandRepository.GetCommandByID(ctx, params.CommandID)
+	cmd, err := s.commandRepository.GetCommandByID(ctx, params.CommandID)
+	if err != nil {
+		return command.Command{}, err
+	}
+
+	return s.withRunningState(ctx, cmd), nil
 }
 
 func (s *Service) GetCurrentProcessingCommand(ctx context.Context) (command.Command, error) {
-	return s.commandRepository.GetCurrentProcessingCommand(ctx)
+	cmd, err := s.commandRepository.GetCurrentProcessingCommand(ctx)
+	if err != nil {
+		return command.Command{}, err
+	}
+
+	return s.withRunningState(ctx, cmd), nil
 }
 
 func (s *Service) ListCommands(ctx context.Context, params command.ListCommandsParams) (paging.List[command.Command], error) {
@@ -82,7 +102,21 @@ func (s *Service) CreateCommand(ctx context.Context, params command.CreateComman
 	}
 
 	cmd := command.NewCommand(params.Source, params.Inputs, params.RequestID)
-	cmd, err := s.commandRepository.CreateCommand(ctx, cmd)
+	cmd.RetryPolicy = params.Retry
+	cmd.Priority = params.Priority
+	cmd.NotBefore = params.NotBefore
+
+	var err error
+	switch {
+	case params.InsertBefore != nil:
+		cmd, err = s.commandRepository.InsertCommand(ctx, cmd, *params.InsertBefore, false)
+	case params.InsertAfter != nil:
+		cmd, err = s.commandRepository.InsertCommand(ctx, cmd, *params.InsertAfter, true)
+	case params.Preempt:
+		cmd, err = s.createPreemptingCommand(ctx, cmd)
+	default:
+		cmd, err = s.commandRepository.CreateCommand(ctx, cmd)
+	}
 	if err != nil {
 		return command.Command{}, fmt.Errorf("create command: %w", err)
 	}
@@ -97,7 +131,151 @@ func (s *Service) CreateCommand(ctx context.Context, params command.CreateComman
 	return cmd, nil
 }
 
+// createPreemptingCommand creates the command at the head of the queue and cancels the current processing command.
+// Both are done with the processing lock held, so that the executor does not pick up another command in between.
+func (s *Service) createPreemptingCommand(ctx context.Context, cmd command.Command) (command.Command, error) {
+	err := s.processingLock.WithLock(func() error {
+		var err error
+		cmd, err = s.commandRepository.CreateCommandAtHead(ctx, cmd)
+		if err != nil {
+			return err
+		}
+
+		s.log.Info("preempting current processing command", slog.Int64("command_id", cmd.ID))
+		if err := s.cancelRunningCommand(ctx); err != nil && !errors.Is(err, command.ErrNoCommandBeingProcessed) {
+			return fmt.Errorf("preempt current processing command: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return command.Command{}, err
+	}
+
+	return cmd, nil
+}
+
+func (s *Service) CreateCommands(ctx context.Context, params command.CreateCommandsParams) ([]command.Command, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	requestIDs := make(map[string]struct{}, len(params.Commands))
+	cmds := make([]command.Command, len(params.Commands))
+	for i, item := range params.Commands {
+		if item.RequestID != nil {
+			if _, ok := requestIDs[*item.RequestID]; ok {
+				return nil, xerror.ValidationFailed(nil, fmt.Sprintf("duplicate request id: %s", *item.RequestID))
+			}
+			requestIDs[*item.RequestID] = struct{}{}
+		}
+
+		cmds[i] = command.NewCommand(params.Source, item.Inputs, item.RequestID)
+		cmds[i].RetryPolicy = item.Retry
+		cmds[i].Priority = item.Priority
+		cmds[i].NotBefore = item.NotBefore
+	}
+
+	cmds, err := s.commandRepository.CreateCommands(ctx, cmds)
+	if err != nil {
+		return nil, fmt.Errorf("create commands: %w", err)
+	}
+
+	// The first command is enough to wake up the executor,
+	// the remaining commands are pulled from the queue.
+	s.publisher.Publish(
+		events.CommandCreatedTopic,
+		eventbus.NewMessage(events.CommandCreatedEvent{
+			CommandID: cmds[0].ID,
+		}),
+	)
+
+	return cmds, nil
+}
+
 func (s *Service) CancelCurrentProcessingCommand(ctx context.Context) error {
+	return s.cancelRunningCommand(ctx)
+}
+
+func (s *Service) CancelCommandByID(ctx context.Context, params command.CancelCommandByIDParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.processingLock.WithLock(func() error {
+		cmd, err := s.commandRepository.GetCommandByID(ctx, params.CommandID)
+		if err != nil {
+			return fmt.Errorf("get command: %w", err)
+		}
+
+		return s.cancelCommand(ctx, cmd)
+	}); err != nil {
+		return fmt.Errorf("cancel command: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) CancelCommandByRequestID(ctx context.Context, params command.CancelCommandByRequestIDParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.processingLock.WithLock(func() error {
+		cmd, err := s.commandRepository.GetCommandByRequestID(ctx, params.RequestID)
+		if err != nil {
+			return fmt.Errorf("get command: %w", err)
+		}
+
+		return s.cancelCommand(ctx, cmd)
+	}); err != nil {
+		return fmt.Errorf("cancel command: %w", err)
+	}
+
+	return nil
+}
+
+// cancelCommand cancels a QUEUED command right away, and a PROCESSING command through the running command repository,
+// the executor moves it from CANCELING to CANCELED once it stops.
+// It must be called with the processing lock held, so that a QUEUED command is not picked up in the meantime.
+func (s *Service) cancelCommand(ctx context.Context, cmd command.Command) error {
+	if cmd.MissionID != nil {
+		return command.ErrCommandInMission
+	}
+
+	switch cmd.Status {
+	case command.StatusQueued:
+		cmd, err := s.commandRepository.CancelQueuedCommand(ctx, cmd.ID, time.Now())
+		if err != nil {
+			return fmt.Errorf("cancel queued command: %w", err)
+		}
+		s.publisher.Publish(events.CommandCanceledTopic, eventbus.NewMessage(events.CommandCanceledEvent{Command: cmd}))
+		return nil
+
+	case command.StatusProcessing:
+		runningCmd, err := s.runningCmdRepository.Get(ctx)
+		if err != nil {
+			if errors.Is(err, command.ErrRunningCommandNotFound) {
+				return command.ErrNoCommandBeingProcessed
+			}
+			return fmt.Errorf("get running command: %w", err)
+		}
+		// The command is PROCESSING but the executor is done with it, its final status is being saved.
+		if runningCmd.ID != cmd.ID {
+			return command.ErrNoCommandBeingProcessed
+		}
+		return s.cancelCancelableCommand(ctx, runningCmd)
+
+	case command.StatusCanceling:
+		return nil
+
+	default:
+		return command.ErrCommandAlreadyFinished
+	}
+}
+
+// cancelRunningCommand cancels the running command through the running command repository.
+// It returns ErrNoCommandBeingProcessed if there is no running command.
+func (s *Service) cancelRunningCommand(ctx context.Context) error {
 	runningCmd, err := s.runningCmdRepository.Get(ctx)
 	if err != nil {
 		if errors.Is(err, command.ErrRunningCommandNotFound) {
@@ -106,6 +284,12 @@ func (s *Service) CancelCurrentProcessingCommand(ctx context.Context) error {
 		return fmt.Errorf("get running command: %w", err)
 	}
 
+	return s.cancelCancelableCommand(ctx, runningCmd)
+}
+
+// cancelCancelableCommand moves the running command to CANCELING and cancels its context.
+// It does nothing if the command is already being canceled.
+func (s *Service) cancelCancelableCommand(ctx context.Context, runningCmd command.CancelableCommand) error {
 	if runningCmd.CanBeCanceled() {
 		if _, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
 			ID:        runningCmd.ID,
@@ -116,9 +300,11 @@ func (s *Service) CancelCurrentProcessingCommand(ctx context.Context) error {
 			return fmt.Errorf("update command status: %w", err)
 		}
 
-		runningCmd.Cancel()
-		if err := s.runningCmdRepository.Update(ctx, runningCmd); err != nil {
-			return fmt.Errorf("update running command: %w", err)
+		// Only the status is changed, the executor may update the progress and the obstacle state
+		// in the meantime. The command may also have finished since it was read.
+		if err := s.runningCmdRepository.MarkCanceling(ctx, runningCmd.ID); err != nil &&
+			!errors.Is(err, command.ErrRunningCommandNotFound) {
+			return fmt.Errorf("mark running command as canceling: %w", err)
 		}
 	}
 
@@ -144,6 +330,10 @@ func (s *Service) CancelActiveCloudCommands(ctx context.Context) error {
 			return fmt.Errorf("cancel queued and processing commands created by cloud: %w", err)
 		}
 
+		if err := s.missionRepository.CancelQueuedAndProcessingMissionsCreatedByCloud(ctx); err != nil {
+			return fmt.Errorf("cancel queued and processing missions created by cloud: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		return fmt.Errorf("cancel active cloud commands: %w", err)
@@ -174,6 +364,10 @@ func (s *Service) CancelAllRunningCommands(ctx context.Context) error {
 			return fmt.Errorf("cancel queued and processing commands: %w", err)
 		}
 
+		if err := s.missionRepository.CancelPendingMissions(ctx); err != nil {
+			return fmt.Errorf("cancel queued and processing missions: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		return fmt.Errorf("with processing lock: %w", err)
@@ -192,17 +386,38 @@ func (s *Service) DeleteCommandByID(ctx context.Context, params command.DeleteCo
 
 func (s *Service) DeleteOldCommands(ctx context.Context) error {
 	cutoffTime := time.Now().Add(-s.deleteOldCmdCfg.Threshold)
-	return s.commandRepository.DeleteOldCommands(ctx, cutoffTime)
+	if err := s.commandRepository.DeleteOldCommands(ctx, cutoffTime); err != nil {
+		return fmt.Errorf("delete old commands: %w", err)
+	}
+
+	if err := s.missionRepository.DeleteOldMissions(ctx, cutoffTime); err != nil {
+		return fmt.Errorf("delete old missions: %w", err)
+	}
+
+	return nil
 }
 
-func (s *Service) cancelQueuedAndProcessingCommands(ctx context.Context) {
-	if err := s.commandRepository.CancelPendingCommands(ctx); err != nil {
-		s.log.Error("failed to cancel queued and processing commands on startup", slog.Any("error", err))
+// withRunningState fills the runtime state of the command if it is the running command.
+func (s *Service) withRunningState(ctx context.Context, cmd command.Command) command.Command {
+	runningCmd, err := s.runningCmdRepository.Get(ctx)
+	if err != nil {
+		return cmd
 	}
+
+	if runningCmd.ID == cmd.ID {
+		cmd.PausedForObstacle = runningCmd.PausedForObstacle
+		cmd.Progress = runningCmd.Progress
+	}
+
+	return cmd
 }
 
 func (s *Service) runNextExecutableCommand(ctx context.Context) error {
-	cmd, err := s.commandRepository.GetNextExecutableCommand(ctx)
+	if s.processingLock.IsPaused() {
+		return nil
+	}
+
+	cmd, err := s.commandRepository.GetNextExecutableCommand(ctx, time.Now())
 	if err != nil {
 		if errors.Is(err, command.ErrNoNextExecutableCommand) {
 			return nil
@@ -210,6 +425,13 @@ func (s *Service) runNextExecutableCommand(ctx context.Context) error {
 		return fmt.Errorf("get next executable command: %w", err)
 	}
 
+	if cmd.MissionID != nil {
+		s.log.Info("found executable mission, executing",
+			slog.Int64("mission_id", *cmd.MissionID),
+		)
+		return s.executeMission(ctx, *cmd.MissionID)
+	}
+
 	s.log.Info("found executable command, executing",
 		slog.Int64("command_id", cmd.ID),
 		slog.String("command_type", cmd.Type.String()),
@@ -223,6 +445,20 @@ func (s *Service) executeCommand(ctx context.Context, cmd command.Command) error
 		return fmt.Errorf("wait for processing lock: %w", err)
 	}
 
+	// The command may have been canceled or preempted while the processing lock was held,
+	// the next executable command is picked up on the next run.
+	next, err := s.commandRepository.GetNextExecutableCommand(ctx, time.Now())
+	if err != nil {
+		if errors.Is(err, command.ErrNoNextExecutableCommand) {
+			return nil
+		}
+		return fmt.Errorf("get next executable command: %w", err)
+	}
+	if next.ID != cmd.ID {
+		return nil
+	}
+	cmd = next
+
 	if err := s.executorService.Execute(ctx, cmd); err != nil {
 		return fmt.Errorf("execute command: %w", err)
 	}
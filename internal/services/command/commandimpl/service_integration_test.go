@@ -2,9 +2,11 @@ package commandimpl
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tbe-team/raybot/internal/config"
@@ -16,6 +18,7 @@ import (
 	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
 	"github.com/tbe-team/raybot/pkg/validator"
 )
 
@@ -24,7 +27,7 @@ func TestIntegrationCommandService(t *testing.T) {
 		t.Skip("skipping integration test")
 	}
 
-	t.Run(`When create CommandService it should cancel all QUEUED and PROCESSING commands`, func(t *testing.T) {
+	t.Run("Recover pending commands should cancel all QUEUED and PROCESSING commands by default", func(t *testing.T) {
 		log := logging.NewNoopLogger()
 		db, err := db.NewTestDB()
 		require.NoError(t, err)
@@ -52,31 +55,936 @@ func TestIntegrationCommandService(t *testing.T) {
 		runningCmdRepository := NewRunningCmdRepository()
 		commandService := NewService(
 			config.DeleteOldCommand{},
+			config.Recovery{},
 			log,
 			validator.New(),
 			eventbus.NewInProcEventBus(log),
 			runningCmdRepository,
 			commandRepository,
+			NewMissionRepository(db, queries),
+			NewQueueStateRepository(db, queries),
 			processinglockimpl.New(),
 			commandmocks.NewFakeExecutorService(t),
 		)
+		require.NoError(t, commandService.RecoverPendingCommands(context.Background()))
 
-		require.Eventually(t, func() bool {
-			cmd1, err = commandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
-				CommandID: cmd1.ID,
+		cmd1, err = commandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
+			CommandID: cmd1.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, cmd1.Status)
+
+		cmd2, err = commandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
+			CommandID: cmd2.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, cmd2.Status)
+	})
+
+	t.Run("Recover pending commands should resume the queue and fail or rerun the interrupted commands", func(t *testing.T) {
+		testCases := []struct {
+			name                string
+			policy              config.RecoveryPolicy
+			idempotentStatus    command.Status
+			nonIdempotentStatus command.Status
+		}{
+			{
+				name:                "fail interrupted",
+				policy:              config.RecoveryPolicyFailInterrupted,
+				idempotentStatus:    command.StatusFailed,
+				nonIdempotentStatus: command.StatusFailed,
+			},
+			{
+				name:                "rerun idempotent",
+				policy:              config.RecoveryPolicyRerunIdempotent,
+				idempotentStatus:    command.StatusQueued,
+				nonIdempotentStatus: command.StatusFailed,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				log := logging.NewNoopLogger()
+				db, err := db.NewTestDB()
+				require.NoError(t, err)
+				defer func() {
+					require.NoError(t, db.Close())
+				}()
+				require.NoError(t, db.AutoMigrate())
+				queries := sqlc.New()
+				commandRepository := NewCommandRepository(db, queries)
+				ctx := context.Background()
+
+				queued, err := commandRepository.CreateCommand(ctx, command.Command{
+					Status: command.StatusQueued,
+					Type:   command.CommandTypeStopMovement,
+					Inputs: &command.StopMovementInputs{},
+				})
+				require.NoError(t, err)
+				idempotent, err := commandRepository.CreateCommand(ctx, command.Command{
+					Status:    command.StatusProcessing,
+					Type:      command.CommandTypeCargoOpen,
+					Inputs:    &command.CargoOpenInputs{MotorSpeed: 50},
+					StartedAt: ptr.New(time.Now()),
+				})
+				require.NoError(t, err)
+				nonIdempotent, err := commandRepository.CreateCommand(ctx, command.Command{
+					Status: command.StatusProcessing,
+					Type:   command.CommandTypeMoveForward,
+					Inputs: &command.MoveForwardInputs{MotorSpeed: 50},
+				})
+				require.NoError(t, err)
+				canceling, err := commandRepository.CreateCommand(ctx, command.Command{
+					Status: command.StatusCanceling,
+					Type:   command.CommandTypeCargoClose,
+					Inputs: &command.CargoCloseInputs{MotorSpeed: 50},
+				})
+				require.NoError(t, err)
+
+				executorService := commandmocks.NewFakeExecutorService(t)
+				executorService.EXPECT().EnterSafeState(mock.Anything).Return(nil)
+				commandService := NewService(
+					config.DeleteOldCommand{},
+					config.Recovery{Policy: tc.policy},
+					log,
+					validator.New(),
+					eventbus.NewNoopEventBus(),
+					NewRunningCmdRepository(),
+					commandRepository,
+					NewMissionRepository(db, queries),
+					NewQueueStateRepository(db, queries),
+					processinglockimpl.New(),
+					executorService,
+				)
+				require.NoError(t, commandService.RecoverPendingCommands(ctx))
+
+				assertStatus := func(id int64, expected command.Status) command.Command {
+					cmd, err := commandRepository.GetCommandByID(ctx, id)
+					require.NoError(t, err)
+					require.Equal(t, expected, cmd.Status)
+					return cmd
+				}
+				assertStatus(queued.ID, command.StatusQueued)
+				assertStatus(canceling.ID, command.StatusCanceled)
+				failed := assertStatus(nonIdempotent.ID, tc.nonIdempotentStatus)
+				require.NotNil(t, failed.Error)
+				cmd := assertStatus(idempotent.ID, tc.idempotentStatus)
+				if tc.idempotentStatus == command.StatusQueued {
+					require.Nil(t, cmd.StartedAt)
+				}
+
+				state, err := commandService.GetQueueState(ctx)
+				require.NoError(t, err)
+				require.False(t, state.Paused)
+			})
+		}
+	})
+
+	t.Run("Recover pending commands should pause the queue if the robot can not enter the safe state", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		require.NoError(t, db.AutoMigrate())
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		ctx := context.Background()
+
+		queued, err := commandRepository.CreateCommand(ctx, command.Command{
+			Status: command.StatusQueued,
+			Type:   command.CommandTypeStopMovement,
+			Inputs: &command.StopMovementInputs{},
+		})
+		require.NoError(t, err)
+		_, err = commandRepository.CreateCommand(ctx, command.Command{
+			Status: command.StatusProcessing,
+			Type:   command.CommandTypeMoveForward,
+			Inputs: &command.MoveForwardInputs{MotorSpeed: 50},
+		})
+		require.NoError(t, err)
+
+		executorService := commandmocks.NewFakeExecutorService(t)
+		executorService.EXPECT().EnterSafeState(mock.Anything).Return(errors.New("lift is not at the safe position"))
+		processingLock := processinglockimpl.New()
+		commandService := NewService(
+			config.DeleteOldCommand{},
+			config.Recovery{Policy: config.RecoveryPolicyFailInterrupted},
+			log,
+			validator.New(),
+			eventbus.NewNoopEventBus(),
+			NewRunningCmdRepository(),
+			commandRepository,
+			NewMissionRepository(db, queries),
+			NewQueueStateRepository(db, queries),
+			processingLock,
+			executorService,
+		)
+		require.NoError(t, commandService.RecoverPendingCommands(ctx))
+		require.True(t, processingLock.IsPaused())
+
+		state, err := commandService.GetQueueState(ctx)
+		require.NoError(t, err)
+		require.True(t, state.Paused)
+
+		cmd, err := commandRepository.GetCommandByID(ctx, queued.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusQueued, cmd.Status)
+	})
+
+	t.Run("Recover pending commands should not enter the safe state if no command was interrupted", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		require.NoError(t, db.AutoMigrate())
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		ctx := context.Background()
+
+		queued, err := commandRepository.CreateCommand(ctx, command.Command{
+			Status: command.StatusQueued,
+			Type:   command.CommandTypeStopMovement,
+			Inputs: &command.StopMovementInputs{},
+		})
+		require.NoError(t, err)
+
+		// EnterSafeState is not expected, the fake fails the test if it is called
+		executorService := commandmocks.NewFakeExecutorService(t)
+		processingLock := processinglockimpl.New()
+		commandService := NewService(
+			config.DeleteOldCommand{},
+			config.Recovery{Policy: config.RecoveryPolicyFailInterrupted},
+			log,
+			validator.New(),
+			eventbus.NewNoopEventBus(),
+			NewRunningCmdRepository(),
+			commandRepository,
+			NewMissionRepository(db, queries),
+			NewQueueStateRepository(db, queries),
+			processingLock,
+			executorService,
+		)
+		require.NoError(t, commandService.RecoverPendingCommands(ctx))
+		require.False(t, processingLock.IsPaused())
+
+		state, err := commandService.GetQueueState(ctx)
+		require.NoError(t, err)
+		require.False(t, state.Paused)
+
+		cmd, err := commandRepository.GetCommandByID(ctx, queued.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusQueued, cmd.Status)
+	})
+
+	t.Run(`Get current processing command should return the command in PROCESSING status`, func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		runningCmdRepository := NewRunningCmdRepository()
+		commandService := Service{
+			deleteOldCmdCfg:      config.DeleteOldCommand{},
+			log:                  log,
+			validator:            validator.New(),
+			publisher:            eventbus.NewInProcEventBus(log),
+			runningCmdRepository: runningCmdRepository,
+			commandRepository:    commandRepository,
+			missionRepository:    NewMissionRepository(db, queries),
+			processingLock:       processinglockimpl.New(),
+			executorService:      commandmocks.NewFakeExecutorService(t),
+		}
+
+		cmd1, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Status: command.StatusProcessing,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		cmd, err := commandService.GetCurrentProcessingCommand(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, cmd1.ID, cmd.ID)
+		require.Equal(t, command.StatusProcessing, cmd.Status)
+	})
+
+	t.Run(`List commands should return list of commands`, func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		runningCmdRepository := NewRunningCmdRepository()
+		commandService := Service{
+			deleteOldCmdCfg:      config.DeleteOldCommand{},
+			log:                  log,
+			validator:            validator.New(),
+			publisher:            eventbus.NewInProcEventBus(log),
+			runningCmdRepository: runningCmdRepository,
+			commandRepository:    commandRepository,
+			missionRepository:    NewMissionRepository(db, queries),
+			processingLock:       processinglockimpl.New(),
+		}
+
+		cmd1, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Status: command.StatusProcessing,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		cmd2, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Status: command.StatusQueued,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		commands, err := commandService.ListCommands(context.Background(), command.ListCommandsParams{
+			PagingParams: paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		})
+		require.NoError(t, err)
+
+		var ids []int64
+		for _, cmd := range commands.Items {
+			ids = append(ids, cmd.ID)
+		}
+		require.Contains(t, ids, cmd1.ID)
+		require.Contains(t, ids, cmd2.ID)
+	})
+
+	t.Run("List, export and stats should filter the commands by time range, type, source and request ID", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		commandService := Service{
+			log:               log,
+			validator:         validator.New(),
+			commandRepository: commandRepository,
+		}
+
+		day := time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+		// The times are stored with the offset of the local time zone,
+		// the filter must compare the instants.
+		local := time.FixedZone("UTC+7", 7*60*60)
+		newFinishedCommand := func(cmdType command.CommandType, source command.Source, status command.Status, createdAt time.Time, duration time.Duration, cmdErr *string) command.Command {
+			return command.Command{
+				Type:        cmdType,
+				Status:      status,
+				Source:      source,
+				Inputs:      &command.StopMovementInputs{},
+				Error:       cmdErr,
+				CreatedAt:   createdAt.In(local),
+				UpdatedAt:   createdAt.In(local),
+				StartedAt:   ptr.New(createdAt.In(local)),
+				CompletedAt: ptr.New(createdAt.Add(duration).In(local)),
+			}
+		}
+
+		cmds, err := commandRepository.CreateCommands(context.Background(), []command.Command{
+			// The day before
+			newFinishedCommand(command.CommandTypeMoveTo, command.SourceApp, command.StatusSucceeded, day.Add(-time.Hour), 10*time.Second, nil),
+			newFinishedCommand(command.CommandTypeMoveTo, command.SourceApp, command.StatusSucceeded, day.Add(time.Hour), 10*time.Second, nil),
+			newFinishedCommand(command.CommandTypeMoveTo, command.SourceCloud, command.StatusSucceeded, day.Add(2*time.Hour), 20*time.Second, nil),
+			newFinishedCommand(command.CommandTypeMoveTo, command.SourceCloud, command.StatusFailed, day.Add(3*time.Hour), time.Second, ptr.New("obstacle")),
+			newFinishedCommand(command.CommandTypeCargoLift, command.SourceCloud, command.StatusTimedOut, day.Add(4*time.Hour), time.Minute, ptr.New("obstacle")),
+			newFinishedCommand(command.CommandTypeCargoLift, command.SourceCloud, command.StatusCanceled, day.Add(5*time.Hour), time.Second, nil),
+			// The day after
+			newFinishedCommand(command.CommandTypeCargoLift, command.SourceCloud, command.StatusFailed, day.Add(25*time.Hour), time.Second, ptr.New("motor")),
+		})
+		require.NoError(t, err)
+		reqCmd, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Type:      command.CommandTypeStopMovement,
+			Status:    command.StatusQueued,
+			Source:    command.SourceCloud,
+			Inputs:    &command.StopMovementInputs{},
+			RequestID: ptr.New("req-1"),
+			CreatedAt: day.Add(6 * time.Hour),
+			UpdatedAt: day.Add(6 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		sameDay := command.CommandFilter{
+			CreatedFrom: ptr.New(day),
+			CreatedTo:   ptr.New(day.Add(24 * time.Hour)),
+		}
+
+		list, err := commandService.ListCommands(context.Background(), command.ListCommandsParams{
+			CommandFilter: command.CommandFilter{
+				Types:       []command.CommandType{command.CommandTypeMoveTo},
+				Sources:     []command.Source{command.SourceCloud},
+				CreatedFrom: sameDay.CreatedFrom,
+				CreatedTo:   sameDay.CreatedTo,
+			},
+			PagingParams: paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, list.TotalItems)
+		require.Equal(t, cmds[2].ID, list.Items[0].ID)
+		require.Equal(t, cmds[3].ID, list.Items[1].ID)
+
+		list, err = commandService.ListCommands(context.Background(), command.ListCommandsParams{
+			CommandFilter: command.CommandFilter{RequestID: ptr.New("req-1")},
+			PagingParams:  paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		require.Equal(t, reqCmd.ID, list.Items[0].ID)
+
+		var exportedIDs []int64
+		err = commandService.ExportCommands(context.Background(), command.ExportCommandsParams{
+			CommandFilter: command.CommandFilter{
+				CompletedFrom: sameDay.CreatedFrom,
+				CompletedTo:   sameDay.CreatedTo,
+			},
+		}, func(cmd command.Command) error {
+			exportedIDs = append(exportedIDs, cmd.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int64{cmds[1].ID, cmds[2].ID, cmds[3].ID, cmds[4].ID, cmds[5].ID}, exportedIDs)
+
+		stats, err := commandService.GetCommandStats(context.Background(), command.GetCommandStatsParams{
+			CommandFilter: sameDay,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 6, stats.Total)
+		require.EqualValues(t, 2, stats.Succeeded)
+		require.EqualValues(t, 1, stats.Failed)
+		require.EqualValues(t, 1, stats.TimedOut)
+		require.EqualValues(t, 1, stats.Canceled)
+		require.InDelta(t, 0.5, stats.SuccessRate(), 0.001)
+		require.Equal(t, []command.FailureStats{{Error: "obstacle", Count: 2}}, stats.Failures)
+
+		require.Len(t, stats.Types, 3)
+		require.Equal(t, command.CommandTypeCargoLift, stats.Types[0].Type)
+		require.EqualValues(t, 2, stats.Types[0].Total)
+		require.Zero(t, stats.Types[0].MeanDuration)
+		require.Equal(t, command.CommandTypeMoveTo, stats.Types[1].Type)
+		require.EqualValues(t, 3, stats.Types[1].Total)
+		require.EqualValues(t, 2, stats.Types[1].Succeeded)
+		require.InDelta(t, (15 * time.Second).Seconds(), stats.Types[1].MeanDuration.Seconds(), 0.01)
+		require.InDelta(t, (10 * time.Second).Seconds(), stats.Types[1].P50Duration.Seconds(), 0.01)
+		require.InDelta(t, (20 * time.Second).Seconds(), stats.Types[1].P95Duration.Seconds(), 0.01)
+
+		require.Equal(t, []command.SourceStats{
+			{Source: command.SourceApp, Total: 1, Succeeded: 1},
+			{Source: command.SourceCloud, Total: 5, Succeeded: 1},
+		}, stats.Sources)
+	})
+
+	t.Run("Get command stats should compute the routes from the location of the preceding MOVE_TO", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+		commandService := Service{
+			log:               logging.NewNoopLogger(),
+			validator:         validator.New(),
+			commandRepository: commandRepository,
+		}
+
+		day := time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+		newMove := func(inputs command.Inputs, status command.Status, startedAt time.Time, duration time.Duration) command.Command {
+			return command.Command{
+				Type:        inputs.CommandType(),
+				Status:      status,
+				Source:      command.SourceCloud,
+				Inputs:      inputs,
+				CreatedAt:   startedAt,
+				UpdatedAt:   startedAt,
+				StartedAt:   ptr.New(startedAt),
+				CompletedAt: ptr.New(startedAt.Add(duration)),
+			}
+		}
+		moveTo := func(location string, status command.Status, startedAt time.Time, duration time.Duration) command.Command {
+			return newMove(&command.MoveToInputs{Location: location, MotorSpeed: 50}, status, startedAt, duration)
+		}
+
+		_, err = commandRepository.CreateCommands(context.Background(), []command.Command{
+			moveTo("A", command.StatusSucceeded, day.Add(time.Hour), time.Minute),
+			moveTo("B", command.StatusSucceeded, day.Add(2*time.Hour), 40*time.Second),
+			moveTo("A", command.StatusSucceeded, day.Add(3*time.Hour), 50*time.Second),
+			moveTo("B", command.StatusSucceeded, day.Add(4*time.Hour), 60*time.Second),
+			// The location is unknown after a failed move or a manual move
+			moveTo("C", command.StatusFailed, day.Add(5*time.Hour), 5*time.Second),
+			moveTo("A", command.StatusSucceeded, day.Add(6*time.Hour), 30*time.Second),
+			newMove(&command.MoveForwardInputs{MotorSpeed: 50}, command.StatusSucceeded, day.Add(7*time.Hour), time.Second),
+			moveTo("B", command.StatusSucceeded, day.Add(8*time.Hour), 30*time.Second),
+		})
+		require.NoError(t, err)
+
+		stats, err := commandService.GetCommandStats(context.Background(), command.GetCommandStatsParams{
+			CommandFilter: command.CommandFilter{
+				// The manual move still breaks the route, the type filter does not apply to the routes
+				Types:       []command.CommandType{command.CommandTypeMoveTo},
+				CreatedFrom: ptr.New(day),
+				CreatedTo:   ptr.New(day.Add(24 * time.Hour)),
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, stats.Routes, 2)
+		require.Equal(t, "A", stats.Routes[0].From)
+		require.Equal(t, "B", stats.Routes[0].To)
+		require.EqualValues(t, 2, stats.Routes[0].Count)
+		require.InDelta(t, (50 * time.Second).Seconds(), stats.Routes[0].MeanDuration.Seconds(), 0.01)
+		require.InDelta(t, (40 * time.Second).Seconds(), stats.Routes[0].P50Duration.Seconds(), 0.01)
+		require.InDelta(t, (60 * time.Second).Seconds(), stats.Routes[0].P95Duration.Seconds(), 0.01)
+		require.Equal(t, "B", stats.Routes[1].From)
+		require.Equal(t, "A", stats.Routes[1].To)
+		require.EqualValues(t, 1, stats.Routes[1].Count)
+	})
+
+	t.Run("Export commands should read the commands in batches", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+		commandService := Service{
+			log:               logging.NewNoopLogger(),
+			validator:         validator.New(),
+			commandRepository: commandRepository,
+		}
+
+		cmds := make([]command.Command, exportBatchSize*2+1)
+		for i := range cmds {
+			cmds[i] = command.Command{
+				Type:   command.CommandTypeStopMovement,
+				Status: command.StatusSucceeded,
+				Source: command.SourceApp,
+				Inputs: &command.StopMovementInputs{},
+			}
+		}
+		_, err = commandRepository.CreateCommands(context.Background(), cmds)
+		require.NoError(t, err)
+
+		var count int
+		lastID := int64(0)
+		err = commandService.ExportCommands(context.Background(), command.ExportCommandsParams{}, func(cmd command.Command) error {
+			require.Greater(t, cmd.ID, lastID)
+			lastID = cmd.ID
+			count++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(cmds), count)
+	})
+
+	t.Run("Paused queue should not run the next command and should stay paused after a restart", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		newService := func(executorService command.ExecutorService) *Service {
+			return &Service{
+				log:                  log,
+				validator:            validator.New(),
+				publisher:            eventbus.NewNoopEventBus(),
+				runningCmdRepository: NewRunningCmdRepository(),
+				commandRepository:    NewCommandRepository(db, queries),
+				missionRepository:    NewMissionRepository(db, queries),
+				queueStateRepository: NewQueueStateRepository(db, queries),
+				processingLock:       processinglockimpl.New(),
+				executorService:      executorService,
+			}
+		}
+
+		commandService := newService(commandmocks.NewFakeExecutorService(t))
+		state, err := commandService.PauseQueue(context.Background())
+		require.NoError(t, err)
+		require.True(t, state.Paused)
+
+		// Restart with a new processing lock, the executor must not be called.
+		commandService = newService(commandmocks.NewFakeExecutorService(t))
+		commandService.restoreQueueState(context.Background())
+		require.True(t, commandService.processingLock.IsPaused())
+
+		state, err = commandService.GetQueueState(context.Background())
+		require.NoError(t, err)
+		require.True(t, state.Paused)
+
+		cmd, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceApp,
+			Inputs: &command.StopMovementInputs{},
+		})
+		require.NoError(t, err)
+		require.NoError(t, commandService.RunNextExecutableCommand(context.Background()))
+
+		executorService := commandmocks.NewFakeExecutorService(t)
+		executorService.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(c command.Command) bool {
+			return c.ID == cmd.ID
+		})).Return(nil).Once()
+		commandService.executorService = executorService
+
+		state, err = commandService.ResumeQueue(context.Background())
+		require.NoError(t, err)
+		require.False(t, state.Paused)
+		require.False(t, commandService.processingLock.IsPaused())
+		require.NoError(t, commandService.RunNextExecutableCommand(context.Background()))
+	})
+
+	t.Run("Get next executable command should return the queued command with the highest priority", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+
+		now := time.Now()
+		newCommand := func(status command.Status, priority uint8, createdAt time.Time) command.Command {
+			cmd, err := commandRepository.CreateCommand(context.Background(), command.Command{
+				Type:      command.CommandTypeStopMovement,
+				Status:    status,
+				Source:    command.SourceApp,
+				Inputs:    &command.StopMovementInputs{},
+				Priority:  priority,
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
 			})
 			require.NoError(t, err)
-			return cmd1.Status == command.StatusCanceled
-		}, 500*time.Millisecond, 10*time.Millisecond)
+			return cmd
+		}
+
+		newCommand(command.StatusQueued, 0, now.Add(-3*time.Second))
+		newCommand(command.StatusSucceeded, 50, now.Add(-3*time.Second))
+		urgent := newCommand(command.StatusQueued, 10, now.Add(-time.Second))
+		newCommand(command.StatusQueued, 10, now)
+
+		cmd, err := commandRepository.GetNextExecutableCommand(context.Background(), now)
+		require.NoError(t, err)
+		require.Equal(t, urgent.ID, cmd.ID)
+		require.Equal(t, uint8(10), cmd.Priority)
+	})
+
+	t.Run("Get next executable command should skip the command until not before is reached", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+
+		now := time.Now()
+		notBefore := now.Add(time.Hour).Truncate(time.Second)
+		deferred, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Type:      command.CommandTypeStopMovement,
+			Status:    command.StatusQueued,
+			Source:    command.SourceApp,
+			Inputs:    &command.StopMovementInputs{},
+			Priority:  100,
+			NotBefore: &notBefore,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		require.NoError(t, err)
+
+		_, err = commandRepository.GetNextExecutableCommand(context.Background(), now)
+		require.ErrorIs(t, err, command.ErrNoNextExecutableCommand)
+
+		cmd, err := commandRepository.GetNextExecutableCommand(context.Background(), notBefore)
+		require.NoError(t, err)
+		require.Equal(t, deferred.ID, cmd.ID)
+		require.NotNil(t, cmd.NotBefore)
+		require.True(t, notBefore.Equal(*cmd.NotBefore))
+	})
+
+	t.Run("Create command should persist the retry policy", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		commandService := Service{
+			log:                  log,
+			validator:            validator.New(),
+			publisher:            eventbus.NewInProcEventBus(log),
+			runningCmdRepository: NewRunningCmdRepository(),
+			commandRepository:    commandRepository,
+			missionRepository:    NewMissionRepository(db, queries),
+			processingLock:       processinglockimpl.New(),
+		}
+
+		retryPolicy := command.RetryPolicy{
+			MaxAttempts:      3,
+			InitialBackoffMs: 100,
+			MaxBackoffMs:     1000,
+		}
+		cmd, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceApp,
+			Inputs: &command.StopMovementInputs{},
+			Retry:  &retryPolicy,
+		})
+		require.NoError(t, err)
+
+		cmd, err = commandRepository.GetCommandByID(context.Background(), cmd.ID)
+		require.NoError(t, err)
+		require.NotNil(t, cmd.RetryPolicy)
+		require.Equal(t, retryPolicy, *cmd.RetryPolicy)
+
+		commands, err := commandService.ListCommands(context.Background(), command.ListCommandsParams{
+			PagingParams: paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		})
+		require.NoError(t, err)
+		require.Len(t, commands.Items, 1)
+		require.Equal(t, &retryPolicy, commands.Items[0].RetryPolicy)
+	})
+
+	t.Run("Create command should store the request ID and reject a duplicate", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+
+		cmd, err := commandRepository.CreateCommand(context.Background(),
+			command.NewCommand(command.SourceCloud, &command.StopMovementInputs{}, ptr.New("req-1")))
+		require.NoError(t, err)
+
+		cmd, err = commandRepository.GetCommandByID(context.Background(), cmd.ID)
+		require.NoError(t, err)
+		require.Equal(t, ptr.New("req-1"), cmd.RequestID)
+
+		_, err = commandRepository.CreateCommand(context.Background(),
+			command.NewCommand(command.SourceCloud, &command.StopMovementInputs{}, ptr.New("req-1")))
+		require.ErrorIs(t, err, command.ErrCommandAlreadyExists)
+	})
+
+	t.Run("Create commands should create the commands in order and reuse the command of an existing request ID", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		commandService := Service{
+			log:               log,
+			validator:         validator.New(),
+			publisher:         eventbus.NewInProcEventBus(log),
+			commandRepository: commandRepository,
+		}
+
+		existing, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:    command.SourceCloud,
+			Inputs:    &command.WaitInputs{DurationMs: 100},
+			RequestID: ptr.New("req-2"),
+		})
+		require.NoError(t, err)
+
+		cmds, err := commandService.CreateCommands(context.Background(), command.CreateCommandsParams{
+			Source: command.SourceCloud,
+			Commands: []command.CreateCommandsItem{
+				{Inputs: &command.StopMovementInputs{}, RequestID: ptr.New("req-1")},
+				{Inputs: &command.WaitInputs{DurationMs: 100}, RequestID: ptr.New("req-2")},
+				{Inputs: &command.CargoOpenInputs{MotorSpeed: 50}, Priority: 10},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, cmds, 3)
+		require.Equal(t, command.CommandTypeStopMovement, cmds[0].Type)
+		require.Equal(t, existing.ID, cmds[1].ID)
+		require.Equal(t, command.CommandTypeCargoOpen, cmds[2].Type)
+		require.Equal(t, uint8(10), cmds[2].Priority)
+		require.Less(t, cmds[0].ID, cmds[2].ID)
+
+		commands, err := commandService.ListCommands(context.Background(), command.ListCommandsParams{
+			PagingParams: paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		})
+		require.NoError(t, err)
+		require.Len(t, commands.Items, 3)
+	})
+
+	t.Run("Create commands should not create any command if one of them fails", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+
+		_, err = commandRepository.CreateCommands(context.Background(), []command.Command{
+			command.NewCommand(command.SourceApp, &command.StopMovementInputs{}, nil),
+			// The outputs of an unregistered command type can not be decoded
+			command.NewCommand(command.SourceApp, unregisteredInputs{}, nil),
+		})
+		require.Error(t, err)
+
+		commands, err := commandRepository.ListCommands(context.Background(), command.ListCommandsParams{
+			PagingParams: paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		})
+		require.NoError(t, err)
+		require.Empty(t, commands.Items)
+	})
+
+	t.Run("Move and insert should reorder the queue", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+		commandService := Service{
+			log:               log,
+			validator:         validator.New(),
+			publisher:         eventbus.NewInProcEventBus(log),
+			commandRepository: commandRepository,
+		}
+
+		create := func(params command.CreateCommandParams) int64 {
+			params.Source = command.SourceApp
+			if params.Inputs == nil {
+				params.Inputs = &command.StopMovementInputs{}
+			}
+			cmd, err := commandService.CreateCommand(context.Background(), params)
+			require.NoError(t, err)
+			return cmd.ID
+		}
+		queueOrder := func() []int64 {
+			queue, err := commandRepository.(*repository).listQueuedCommands(context.Background(), db)
+			require.NoError(t, err)
+			ids := make([]int64, len(queue))
+			for i, c := range queue {
+				ids[i] = c.ID
+			}
+			return ids
+		}
 
-		cmd2, err = commandService.GetCommandByID(context.Background(), command.GetCommandByIDParams{
-			CommandID: cmd2.ID,
+		a := create(command.CreateCommandParams{})
+		b := create(command.CreateCommandParams{})
+		c := create(command.CreateCommandParams{})
+		d := create(command.CreateCommandParams{Priority: 5})
+		require.Equal(t, []int64{d, a, b, c}, queueOrder())
+
+		// The queue is ordered by priority first, c can not move ahead of d
+		_, err = commandService.MoveQueuedCommand(context.Background(), command.MoveQueuedCommandParams{
+			CommandID: c,
+			Position:  1,
+		})
+		require.ErrorIs(t, err, command.ErrPositionCrossesPriority)
+		require.Equal(t, []int64{d, a, b, c}, queueOrder())
+
+		moved, err := commandService.MoveQueuedCommand(context.Background(), command.MoveQueuedCommandParams{
+			CommandID: c,
+			Position:  2,
 		})
 		require.NoError(t, err)
-		require.Equal(t, command.StatusCanceled, cmd2.Status)
+		require.Equal(t, uint8(0), moved.Priority)
+		require.Equal(t, []int64{d, c, a, b}, queueOrder())
+
+		next, err := commandRepository.GetNextExecutableCommand(context.Background(), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, d, next.ID)
+
+		e := create(command.CreateCommandParams{InsertAfter: &a})
+		require.Equal(t, []int64{d, c, a, e, b}, queueOrder())
+
+		f := create(command.CreateCommandParams{InsertBefore: &c, Priority: 1})
+		require.Equal(t, []int64{d, f, c, a, e, b}, queueOrder())
+
+		_, err = commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:       command.SourceApp,
+			Inputs:       &command.StopMovementInputs{},
+			InsertBefore: &d,
+			Priority:     1,
+		})
+		require.ErrorIs(t, err, command.ErrPositionCrossesPriority)
+		require.Equal(t, []int64{d, f, c, a, e, b}, queueOrder())
+
+		_, err = commandService.MoveQueuedCommand(context.Background(), command.MoveQueuedCommandParams{
+			CommandID: d,
+			Position:  100,
+		})
+		require.ErrorIs(t, err, command.ErrPositionCrossesPriority)
+
+		_, err = commandService.MoveQueuedCommand(context.Background(), command.MoveQueuedCommandParams{
+			CommandID: a,
+			Position:  100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int64{d, f, c, e, b, a}, queueOrder())
+
+		_, err = commandService.MoveQueuedCommand(context.Background(), command.MoveQueuedCommandParams{
+			CommandID: 100,
+			Position:  1,
+		})
+		require.ErrorIs(t, err, command.ErrCommandNotFound)
+
+		_, err = commandRepository.UpdateCommand(context.Background(), command.UpdateCommandParams{
+			ID:        b,
+			Status:    command.StatusSucceeded,
+			SetStatus: true,
+			UpdatedAt: time.Now(),
+		})
+		require.NoError(t, err)
+		_, err = commandService.MoveQueuedCommand(context.Background(), command.MoveQueuedCommandParams{
+			CommandID: b,
+			Position:  1,
+		})
+		require.ErrorIs(t, err, command.ErrCommandNotQueued)
+
+		_, err = commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:      command.SourceApp,
+			Inputs:      &command.StopMovementInputs{},
+			InsertAfter: &b,
+		})
+		require.ErrorIs(t, err, command.ErrCommandNotQueued)
+		require.Equal(t, []int64{d, f, c, e, a}, queueOrder())
 	})
 
-	t.Run(`Get current processing command should return the command in PROCESSING status`, func(t *testing.T) {
+	t.Run("Create command with preempt should queue the command at the head and cancel the running command", func(t *testing.T) {
 		log := logging.NewNoopLogger()
 		db, err := db.NewTestDB()
 		require.NoError(t, err)
@@ -85,33 +993,55 @@ func TestIntegrationCommandService(t *testing.T) {
 		}()
 		err = db.AutoMigrate()
 		require.NoError(t, err)
-		queries := sqlc.New()
-		commandRepository := NewCommandRepository(db, queries)
+		commandRepository := NewCommandRepository(db, sqlc.New())
 		runningCmdRepository := NewRunningCmdRepository()
 		commandService := Service{
-			deleteOldCmdCfg:      config.DeleteOldCommand{},
 			log:                  log,
 			validator:            validator.New(),
 			publisher:            eventbus.NewInProcEventBus(log),
 			runningCmdRepository: runningCmdRepository,
 			commandRepository:    commandRepository,
 			processingLock:       processinglockimpl.New(),
-			executorService:      commandmocks.NewFakeExecutorService(t),
 		}
 
-		cmd1, err := commandRepository.CreateCommand(context.Background(), command.Command{
-			Status: command.StatusProcessing,
-			Type:   command.CommandTypeStopMovement,
+		create := func(params command.CreateCommandParams) command.Command {
+			params.Source = command.SourceApp
+			params.Inputs = &command.StopMovementInputs{}
+			cmd, err := commandService.CreateCommand(context.Background(), params)
+			require.NoError(t, err)
+			return cmd
+		}
+
+		running := create(command.CreateCommandParams{})
+		running, err = commandRepository.UpdateCommand(context.Background(), command.UpdateCommandParams{
+			ID:        running.ID,
+			Status:    command.StatusProcessing,
+			SetStatus: true,
+			UpdatedAt: time.Now(),
 		})
 		require.NoError(t, err)
+		runningCmd := command.NewCancelableCommand(context.Background(), running)
+		require.NoError(t, runningCmdRepository.Add(context.Background(), runningCmd))
 
-		cmd, err := commandService.GetCurrentProcessingCommand(context.Background())
+		create(command.CreateCommandParams{Priority: 10})
+		create(command.CreateCommandParams{Priority: 50})
+
+		preempting := create(command.CreateCommandParams{Priority: 5, Preempt: true})
+		require.Equal(t, uint8(50), preempting.Priority)
+
+		next, err := commandRepository.GetNextExecutableCommand(context.Background(), time.Now())
 		require.NoError(t, err)
-		require.Equal(t, cmd1.ID, cmd.ID)
-		require.Equal(t, command.StatusProcessing, cmd.Status)
+		require.Equal(t, preempting.ID, next.ID)
+		require.Equal(t, uint8(50), next.Priority)
+		require.Equal(t, preempting.QueuePosition, next.QueuePosition)
+
+		running, err = commandRepository.GetCommandByID(context.Background(), running.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceling, running.Status)
+		require.Error(t, runningCmd.Context().Err())
 	})
 
-	t.Run(`List commands should return list of commands`, func(t *testing.T) {
+	t.Run("Update queued command should replace the inputs only while the command is QUEUED and not a mission step", func(t *testing.T) {
 		log := logging.NewNoopLogger()
 		db, err := db.NewTestDB()
 		require.NoError(t, err)
@@ -122,40 +1052,58 @@ func TestIntegrationCommandService(t *testing.T) {
 		require.NoError(t, err)
 		queries := sqlc.New()
 		commandRepository := NewCommandRepository(db, queries)
-		runningCmdRepository := NewRunningCmdRepository()
 		commandService := Service{
-			deleteOldCmdCfg:      config.DeleteOldCommand{},
-			log:                  log,
-			validator:            validator.New(),
-			publisher:            eventbus.NewInProcEventBus(log),
-			runningCmdRepository: runningCmdRepository,
-			commandRepository:    commandRepository,
-			processingLock:       processinglockimpl.New(),
+			log:               log,
+			validator:         validator.New(),
+			publisher:         eventbus.NewInProcEventBus(log),
+			commandRepository: commandRepository,
+			missionRepository: NewMissionRepository(db, queries),
 		}
 
-		cmd1, err := commandRepository.CreateCommand(context.Background(), command.Command{
-			Status: command.StatusProcessing,
-			Type:   command.CommandTypeStopMovement,
+		cmd, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceApp,
+			Inputs: &command.MoveToInputs{Location: "A", MotorSpeed: 50},
 		})
 		require.NoError(t, err)
 
-		cmd2, err := commandRepository.CreateCommand(context.Background(), command.Command{
-			Status: command.StatusQueued,
-			Type:   command.CommandTypeStopMovement,
+		updated, err := commandService.UpdateQueuedCommand(context.Background(), command.UpdateQueuedCommandParams{
+			CommandID: cmd.ID,
+			Inputs:    &command.MoveToInputs{Location: "B", MotorSpeed: 80},
 		})
 		require.NoError(t, err)
+		require.Equal(t, cmd.ID, updated.ID)
+		require.Equal(t, &command.MoveToInputs{Location: "B", MotorSpeed: 80}, updated.Inputs)
 
-		commands, err := commandService.ListCommands(context.Background(), command.ListCommandsParams{
-			PagingParams: paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		_, err = commandRepository.UpdateCommand(context.Background(), command.UpdateCommandParams{
+			ID:        cmd.ID,
+			Status:    command.StatusProcessing,
+			SetStatus: true,
+			UpdatedAt: time.Now(),
 		})
 		require.NoError(t, err)
 
-		var ids []int64
-		for _, cmd := range commands.Items {
-			ids = append(ids, cmd.ID)
-		}
-		require.Contains(t, ids, cmd1.ID)
-		require.Contains(t, ids, cmd2.ID)
+		_, err = commandService.UpdateQueuedCommand(context.Background(), command.UpdateQueuedCommandParams{
+			CommandID: cmd.ID,
+			Inputs:    &command.MoveToInputs{Location: "C", MotorSpeed: 80},
+		})
+		require.ErrorIs(t, err, command.ErrCommandNotQueued)
+
+		mission, err := commandService.CreateMission(context.Background(), command.CreateMissionParams{
+			Source:    command.SourceApp,
+			Steps:     []command.Inputs{&command.MoveToInputs{Location: "A", MotorSpeed: 50}},
+			OnFailure: command.OnFailureAbort,
+		})
+		require.NoError(t, err)
+
+		_, err = commandService.UpdateQueuedCommand(context.Background(), command.UpdateQueuedCommandParams{
+			CommandID: mission.Steps[0].ID,
+			Inputs:    &command.MoveToInputs{Location: "C", MotorSpeed: 80},
+		})
+		require.ErrorIs(t, err, command.ErrCommandInMission)
+
+		step, err := commandRepository.GetCommandByID(context.Background(), mission.Steps[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, &command.MoveToInputs{Location: "A", MotorSpeed: 50}, step.Inputs)
 	})
 
 	t.Run("Delete command by id should not delete command with status PROCESSING", func(t *testing.T) {
@@ -174,6 +1122,7 @@ func TestIntegrationCommandService(t *testing.T) {
 			validator:         validator.New(),
 			publisher:         eventbus.NewInProcEventBus(log),
 			commandRepository: commandRepository,
+			missionRepository: NewMissionRepository(db, queries),
 		}
 
 		cmd, err := commandRepository.CreateCommand(context.Background(), command.Command{
@@ -205,6 +1154,7 @@ func TestIntegrationCommandService(t *testing.T) {
 			},
 			validator:         validator.New(),
 			commandRepository: commandRepository,
+			missionRepository: NewMissionRepository(db, queries),
 		}
 
 		cmd1, err := commandRepository.CreateCommand(context.Background(), command.Command{
@@ -262,6 +1212,7 @@ func TestIntegrationCommandService(t *testing.T) {
 			},
 			validator:            validator.New(),
 			commandRepository:    commandRepository,
+			missionRepository:    NewMissionRepository(db, queries),
 			runningCmdRepository: runningCmdRepository,
 			processingLock:       processinglockimpl.New(),
 		}
@@ -311,6 +1262,7 @@ func TestIntegrationCommandService(t *testing.T) {
 		commandService := Service{
 			validator:            validator.New(),
 			commandRepository:    commandRepository,
+			missionRepository:    NewMissionRepository(db, queries),
 			runningCmdRepository: runningCmdRepository,
 			processingLock:       processinglockimpl.New(),
 		}
@@ -365,4 +1317,305 @@ func TestIntegrationCommandService(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, command.StatusQueued, cmd4.Status)
 	})
+	t.Run("Run next executable command should execute all steps of the mission and retry the failed step", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		missionRepository := NewMissionRepository(db, queries)
+		executorService := commandmocks.NewFakeExecutorService(t)
+		commandService := Service{
+			log:                  log,
+			validator:            validator.New(),
+			publisher:            eventbus.NewInProcEventBus(log),
+			commandRepository:    commandRepository,
+			missionRepository:    missionRepository,
+			runningCmdRepository: NewRunningCmdRepository(),
+			processingLock:       processinglockimpl.New(),
+			executorService:      executorService,
+		}
+
+		mission, err := commandService.CreateMission(context.Background(), command.CreateMissionParams{
+			Source: command.SourceApp,
+			Steps: []command.Inputs{
+				&command.StopMovementInputs{},
+				&command.WaitInputs{DurationMs: 10},
+				&command.StopMovementInputs{},
+			},
+			OnFailure:  command.OnFailureRetry,
+			MaxRetries: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, mission.Steps, 3)
+
+		// The second step fails on the first attempt and succeeds on the retry.
+		attempts := map[int64]int{}
+		executorService.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, cmd command.Command) error {
+			attempts[cmd.ID]++
+			status := command.StatusSucceeded
+			if cmd.ID == mission.Steps[1].ID && attempts[cmd.ID] == 1 {
+				status = command.StatusFailed
+			}
+			_, err := commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+				ID:        cmd.ID,
+				Status:    status,
+				SetStatus: true,
+				UpdatedAt: time.Now(),
+			})
+			return err
+		}).Times(4)
+
+		err = commandService.RunNextExecutableCommand(context.Background())
+		require.NoError(t, err)
+
+		mission, err = commandService.GetMissionByID(context.Background(), command.GetMissionByIDParams{
+			MissionID: mission.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, command.MissionStatusSucceeded, mission.Status)
+		require.NotNil(t, mission.StartedAt)
+		require.NotNil(t, mission.CompletedAt)
+		for _, step := range mission.Steps {
+			require.Equal(t, command.StatusSucceeded, step.Status)
+		}
+		require.Equal(t, 2, attempts[mission.Steps[1].ID])
+	})
+
+	t.Run("Run next executable command should abort the mission when a step fails a condition with abort mission", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		missionRepository := NewMissionRepository(db, queries)
+		executorService := commandmocks.NewFakeExecutorService(t)
+		commandService := Service{
+			log:                  log,
+			validator:            validator.New(),
+			publisher:            eventbus.NewInProcEventBus(log),
+			commandRepository:    commandRepository,
+			missionRepository:    missionRepository,
+			runningCmdRepository: NewRunningCmdRepository(),
+			processingLock:       processinglockimpl.New(),
+			executorService:      executorService,
+		}
+
+		condition := command.Condition{Type: command.ConditionTypeCargoHasItem}
+		mission, err := commandService.CreateMission(context.Background(), command.CreateMissionParams{
+			Source: command.SourceApp,
+			Steps: []command.Inputs{
+				&command.StopMovementInputs{},
+				&command.AssertInputs{
+					CommonInputs: command.CommonInputs{AbortMission: true},
+					Conditions:   []command.Condition{condition},
+				},
+				&command.StopMovementInputs{},
+			},
+			OnFailure:  command.OnFailureRetry,
+			MaxRetries: 2,
+		})
+		require.NoError(t, err)
+
+		// The assert step fails, it is neither retried nor skipped.
+		executorService.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, cmd command.Command) error {
+			params := command.UpdateCommandParams{
+				ID:        cmd.ID,
+				Status:    command.StatusSucceeded,
+				SetStatus: true,
+				UpdatedAt: time.Now(),
+			}
+			if cmd.ID == mission.Steps[1].ID {
+				condErr := &command.ConditionFailedError{
+					FailedCondition: command.FailedCondition{Condition: condition, Actual: "no item"},
+				}
+				params.Status = command.StatusFailed
+				params.Error = ptr.New(condErr.Error())
+				params.SetError = true
+				params.Outputs = &command.AssertOutputs{
+					CommonOutputs: command.CommonOutputs{FailedCondition: &condErr.FailedCondition},
+				}
+				params.SetOutputs = true
+			}
+			_, err := commandRepository.UpdateCommand(ctx, params)
+			return err
+		}).Times(2)
+
+		err = commandService.RunNextExecutableCommand(context.Background())
+		require.NoError(t, err)
+
+		mission, err = commandService.GetMissionByID(context.Background(), command.GetMissionByIDParams{
+			MissionID: mission.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, command.MissionStatusFailed, mission.Status)
+		require.NotNil(t, mission.Error)
+		require.Contains(t, *mission.Error, "aborted the mission")
+		require.Equal(t, command.StatusSucceeded, mission.Steps[0].Status)
+		require.Equal(t, command.StatusFailed, mission.Steps[1].Status)
+		require.Equal(t, &condition, &mission.Steps[1].Outputs.Common().FailedCondition.Condition)
+		require.Equal(t, command.StatusCanceled, mission.Steps[2].Status)
+	})
+
+	t.Run("Cancel command by ID and by request ID should cancel QUEUED and PROCESSING commands", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		runningCmdRepository := NewRunningCmdRepository()
+		commandRepository := NewCommandRepository(db, queries)
+		commandService := Service{
+			log:                  log,
+			validator:            validator.New(),
+			publisher:            eventbus.NewInProcEventBus(log),
+			commandRepository:    commandRepository,
+			missionRepository:    NewMissionRepository(db, queries),
+			runningCmdRepository: runningCmdRepository,
+			processingLock:       processinglockimpl.New(),
+		}
+
+		queued, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Source: command.SourceCloud,
+			Status: command.StatusQueued,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		queuedWithRequestID, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Source:    command.SourceCloud,
+			Status:    command.StatusQueued,
+			Type:      command.CommandTypeStopMovement,
+			RequestID: ptr.New("cancel-1"),
+		})
+		require.NoError(t, err)
+
+		processing, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Source: command.SourceCloud,
+			Status: command.StatusProcessing,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+		runningCmd := command.NewCancelableCommand(context.Background(), processing)
+		require.NoError(t, runningCmdRepository.Add(context.Background(), runningCmd))
+
+		succeeded, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Source: command.SourceCloud,
+			Status: command.StatusSucceeded,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		mission, err := commandService.CreateMission(context.Background(), command.CreateMissionParams{
+			Source:    command.SourceCloud,
+			Steps:     []command.Inputs{&command.StopMovementInputs{}},
+			OnFailure: command.OnFailureAbort,
+		})
+		require.NoError(t, err)
+
+		err = commandService.CancelCommandByID(context.Background(), command.CancelCommandByIDParams{CommandID: queued.ID})
+		require.NoError(t, err)
+		queued, err = commandRepository.GetCommandByID(context.Background(), queued.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, queued.Status)
+		require.NotNil(t, queued.CompletedAt)
+
+		err = commandService.CancelCommandByRequestID(context.Background(), command.CancelCommandByRequestIDParams{RequestID: "cancel-1"})
+		require.NoError(t, err)
+		queuedWithRequestID, err = commandRepository.GetCommandByID(context.Background(), queuedWithRequestID.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, queuedWithRequestID.Status)
+
+		// The executor moves the command from CANCELING to CANCELED once it stops
+		err = commandService.CancelCommandByID(context.Background(), command.CancelCommandByIDParams{CommandID: processing.ID})
+		require.NoError(t, err)
+		processing, err = commandRepository.GetCommandByID(context.Background(), processing.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceling, processing.Status)
+		require.Error(t, runningCmd.Context().Err())
+
+		err = commandService.CancelCommandByID(context.Background(), command.CancelCommandByIDParams{CommandID: processing.ID})
+		require.NoError(t, err)
+
+		err = commandService.CancelCommandByID(context.Background(), command.CancelCommandByIDParams{CommandID: succeeded.ID})
+		require.ErrorIs(t, err, command.ErrCommandAlreadyFinished)
+
+		err = commandService.CancelCommandByID(context.Background(), command.CancelCommandByIDParams{CommandID: mission.Steps[0].ID})
+		require.ErrorIs(t, err, command.ErrCommandInMission)
+
+		err = commandService.CancelCommandByRequestID(context.Background(), command.CancelCommandByRequestIDParams{RequestID: "unknown"})
+		require.ErrorIs(t, err, command.ErrCommandNotFound)
+	})
+
+	t.Run("Cancel mission should cancel all queued steps and finish the mission", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandService := Service{
+			log:                  log,
+			validator:            validator.New(),
+			publisher:            eventbus.NewInProcEventBus(log),
+			commandRepository:    NewCommandRepository(db, queries),
+			missionRepository:    NewMissionRepository(db, queries),
+			runningCmdRepository: NewRunningCmdRepository(),
+			processingLock:       processinglockimpl.New(),
+		}
+
+		mission, err := commandService.CreateMission(context.Background(), command.CreateMissionParams{
+			Source: command.SourceApp,
+			Steps: []command.Inputs{
+				&command.StopMovementInputs{},
+				&command.StopMovementInputs{},
+			},
+			OnFailure: command.OnFailureAbort,
+		})
+		require.NoError(t, err)
+
+		err = commandService.CancelMissionByID(context.Background(), command.CancelMissionByIDParams{
+			MissionID: mission.ID,
+		})
+		require.NoError(t, err)
+
+		mission, err = commandService.GetMissionByID(context.Background(), command.GetMissionByIDParams{
+			MissionID: mission.ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, command.MissionStatusCanceled, mission.Status)
+		for _, step := range mission.Steps {
+			require.Equal(t, command.StatusCanceled, step.Status)
+		}
+
+		err = commandService.CancelMissionByID(context.Background(), command.CancelMissionByIDParams{
+			MissionID: mission.ID,
+		})
+		require.ErrorIs(t, err, command.ErrMissionAlreadyFinished)
+	})
+}
+
+type unregisteredInputs struct {
+	command.CommonInputs
+}
+
+func (unregisteredInputs) CommandType() command.CommandType {
+	return "UNREGISTERED"
 }
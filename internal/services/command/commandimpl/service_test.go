@@ -9,9 +9,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
 	"github.com/tbe-team/raybot/internal/services/command"
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	"github.com/tbe-team/raybot/internal/services/command/processinglockimpl"
 	eventbusmocks "github.com/tbe-team/raybot/pkg/eventbus/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
 	"github.com/tbe-team/raybot/pkg/validator"
 )
 
@@ -35,7 +38,133 @@ func TestService_CreateCommand(t *testing.T) {
 		require.NotNil(t, command)
 	})
 
+	t.Run("Should cancel the current processing command when preempt is set", func(t *testing.T) {
+		publisher := eventbusmocks.NewFakePublisher(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		runningCommandRepository := NewRunningCmdRepository()
+		commandService := Service{
+			log:                  logging.NewNoopLogger(),
+			validator:            validator.New(),
+			publisher:            publisher,
+			runningCmdRepository: runningCommandRepository,
+			commandRepository:    commandRepository,
+			processingLock:       processinglockimpl.New(),
+		}
+
+		cancelableCommand := command.NewCancelableCommand(context.Background(), command.Command{
+			ID:     1,
+			Status: command.StatusProcessing,
+		})
+		require.NoError(t, runningCommandRepository.Add(context.Background(), cancelableCommand))
+		commandRepository.EXPECT().CreateCommandAtHead(mock.Anything, mock.MatchedBy(func(cmd command.Command) bool {
+			return cmd.Priority == 100
+		})).Return(command.Command{ID: 2, Priority: 100}, nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(func(params command.UpdateCommandParams) bool {
+			return params.ID == 1 && params.Status == command.StatusCanceling
+		})).Return(command.Command{}, nil)
+		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
+
+		cmd, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:   command.SourceApp,
+			Inputs:   command.StopMovementInputs{},
+			Priority: 100,
+			Preempt:  true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), cmd.ID)
+
+		select {
+		case <-cancelableCommand.Context().Done():
+		case <-time.After(10 * time.Millisecond):
+			require.Fail(t, "command should be canceled")
+		}
+	})
+
+	t.Run("Should create the command when preempt is set and no command is being processed", func(t *testing.T) {
+		publisher := eventbusmocks.NewFakePublisher(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		commandService := Service{
+			log:                  logging.NewNoopLogger(),
+			validator:            validator.New(),
+			publisher:            publisher,
+			runningCmdRepository: runningCommandRepository,
+			commandRepository:    commandRepository,
+			processingLock:       processinglockimpl.New(),
+		}
+
+		commandRepository.EXPECT().CreateCommandAtHead(mock.Anything, mock.Anything).Return(command.Command{ID: 1}, nil)
+		runningCommandRepository.EXPECT().Get(mock.Anything).Return(command.CancelableCommand{}, command.ErrRunningCommandNotFound)
+		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:  command.SourceApp,
+			Inputs:  command.StopMovementInputs{},
+			Preempt: true,
+		})
+		require.NoError(t, err)
+	})
+
 	t.Run("Create command validation", func(t *testing.T) {
+		t.Run("Should return validation error when priority is out of range", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source:   command.SourceApp,
+				Inputs:   command.StopMovementInputs{},
+				Priority: 101,
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when preempt is set with not before", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source:    command.SourceApp,
+				Inputs:    command.StopMovementInputs{},
+				Preempt:   true,
+				NotBefore: ptr.New(time.Now().Add(time.Hour)),
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when insert before is set with insert after", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source:       command.SourceApp,
+				Inputs:       command.StopMovementInputs{},
+				InsertBefore: ptr.New(int64(1)),
+				InsertAfter:  ptr.New(int64(2)),
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when insert after is set with preempt", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source:      command.SourceApp,
+				Inputs:      command.StopMovementInputs{},
+				InsertAfter: ptr.New(int64(1)),
+				Preempt:     true,
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when a precondition is invalid", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source: command.SourceApp,
+				Inputs: command.StopMovementInputs{
+					CommonInputs: command.CommonInputs{
+						Preconditions: []command.Condition{{Type: command.ConditionTypeAtLocation}},
+					},
+				},
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when assert has no conditions", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source: command.SourceApp,
+				Inputs: command.AssertInputs{},
+			})
+			require.Error(t, err)
+		})
+
 		t.Run("Should return validation error when source is empty", func(t *testing.T) {
 			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
 				Source: "",
@@ -54,9 +183,74 @@ func TestService_CreateCommand(t *testing.T) {
 	})
 }
 
+func TestService_CreateCommands(t *testing.T) {
+	t.Run("Should create the commands and publish a single created event", func(t *testing.T) {
+		publisher := eventbusmocks.NewFakePublisher(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		commandService := Service{
+			validator:         validator.New(),
+			publisher:         publisher,
+			commandRepository: commandRepository,
+		}
+
+		commandRepository.EXPECT().CreateCommands(mock.Anything, mock.MatchedBy(func(cmds []command.Command) bool {
+			return len(cmds) == 2 &&
+				*cmds[0].RequestID == "req-1" &&
+				cmds[1].Type == command.CommandTypeWait && cmds[1].Priority == 5
+		})).Return([]command.Command{{ID: 1}, {ID: 2}}, nil)
+		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
+
+		cmds, err := commandService.CreateCommands(context.Background(), command.CreateCommandsParams{
+			Source: command.SourceApp,
+			Commands: []command.CreateCommandsItem{
+				{Inputs: command.StopMovementInputs{}, RequestID: ptr.New("req-1")},
+				{Inputs: command.WaitInputs{DurationMs: 100}, Priority: 5},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []command.Command{{ID: 1}, {ID: 2}}, cmds)
+	})
+
+	t.Run("Create commands validation", func(t *testing.T) {
+		commandService := Service{
+			validator:         validator.New(),
+			commandRepository: commandmocks.NewFakeRepository(t),
+		}
+
+		t.Run("Should return validation error when there is no command", func(t *testing.T) {
+			_, err := commandService.CreateCommands(context.Background(), command.CreateCommandsParams{
+				Source: command.SourceApp,
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when a command is invalid", func(t *testing.T) {
+			_, err := commandService.CreateCommands(context.Background(), command.CreateCommandsParams{
+				Source: command.SourceApp,
+				Commands: []command.CreateCommandsItem{
+					{Inputs: command.StopMovementInputs{}},
+					{Inputs: command.AssertInputs{}},
+				},
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when a request ID is duplicated", func(t *testing.T) {
+			_, err := commandService.CreateCommands(context.Background(), command.CreateCommandsParams{
+				Source: command.SourceApp,
+				Commands: []command.CreateCommandsItem{
+					{Inputs: command.StopMovementInputs{}, RequestID: ptr.New("req-1")},
+					{Inputs: command.StopMovementInputs{}, RequestID: ptr.New("req-1")},
+				},
+			})
+			require.Error(t, err)
+		})
+	})
+}
+
 func TestService_CancelCurrentProcessingCommand(t *testing.T) {
 	t.Run("Cancel current processing command successfully", func(t *testing.T) {
-		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		runningCommandRepository := NewRunningCmdRepository()
 		commandRepository := commandmocks.NewFakeRepository(t)
 		commandService := Service{
 			runningCmdRepository: runningCommandRepository,
@@ -66,9 +260,8 @@ func TestService_CancelCurrentProcessingCommand(t *testing.T) {
 		cancelableCommand := command.NewCancelableCommand(context.Background(), command.Command{
 			Status: command.StatusProcessing,
 		})
-		runningCommandRepository.EXPECT().Get(mock.Anything).Return(cancelableCommand, nil)
+		require.NoError(t, runningCommandRepository.Add(context.Background(), cancelableCommand))
 		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.Anything).Return(command.Command{}, nil)
-		runningCommandRepository.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
 
 		err := commandService.CancelCurrentProcessingCommand(context.Background())
 		require.NoError(t, err)
@@ -80,6 +273,37 @@ func TestService_CancelCurrentProcessingCommand(t *testing.T) {
 		}
 	})
 
+	t.Run("Should keep the progress and the obstacle state written while canceling", func(t *testing.T) {
+		ctx := context.Background()
+		runningCommandRepository := NewRunningCmdRepository()
+		commandRepository := commandmocks.NewFakeRepository(t)
+		commandService := Service{
+			runningCmdRepository: runningCommandRepository,
+			commandRepository:    commandRepository,
+		}
+
+		cancelableCommand := command.NewCancelableCommand(ctx, command.Command{
+			ID:     1,
+			Status: command.StatusProcessing,
+		})
+		require.NoError(t, runningCommandRepository.Add(ctx, cancelableCommand))
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, _ command.UpdateCommandParams) {
+				// The executor reports while the command is being canceled.
+				require.NoError(t, runningCommandRepository.SetProgress(ctx, command.Progress{CommandID: 1}))
+				require.NoError(t, runningCommandRepository.SetPausedForObstacle(ctx, true))
+			}).
+			Return(command.Command{}, nil)
+
+		require.NoError(t, commandService.CancelCurrentProcessingCommand(ctx))
+
+		runningCmd, err := runningCommandRepository.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceling, runningCmd.Status)
+		require.NotNil(t, runningCmd.Progress)
+		require.True(t, runningCmd.PausedForObstacle)
+	})
+
 	t.Run("Should return error no command being processed", func(t *testing.T) {
 		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
 		commandService := Service{
@@ -0,0 +1,83 @@
+package commandimpl
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+// move is a started command that moves the robot.
+type move struct {
+	Type   command.CommandType
+	Status command.Status
+	// Location is the target location of MOVE_TO, empty for the other types.
+	Location string
+	// Duration is nil until the command completes.
+	Duration *time.Duration
+}
+
+type route struct {
+	from string
+	to   string
+}
+
+// routeStats returns the travel times of the SUCCEEDED MOVE_TO commands of the moves in start order.
+// A MOVE_TO starts from the target of the move before it, if that move is a SUCCEEDED MOVE_TO.
+// After any other move the location is unknown, and the travel time of the next MOVE_TO is not counted.
+func routeStats(moves []move) []command.RouteStats {
+	byRoute := map[route][]time.Duration{}
+	location := ""
+	for _, m := range moves {
+		succeeded := m.Type == command.CommandTypeMoveTo && m.Status == command.StatusSucceeded && m.Duration != nil
+		if succeeded && location != "" && location != m.Location {
+			r := route{from: location, to: m.Location}
+			byRoute[r] = append(byRoute[r], *m.Duration)
+		}
+
+		location = ""
+		if succeeded {
+			location = m.Location
+		}
+	}
+
+	routes := slices.SortedFunc(maps.Keys(byRoute), func(a, b route) int {
+		return cmp.Or(cmp.Compare(a.from, b.from), cmp.Compare(a.to, b.to))
+	})
+
+	ret := make([]command.RouteStats, 0, len(routes))
+	for _, r := range routes {
+		durations := byRoute[r]
+		slices.Sort(durations)
+
+		var sum time.Duration
+		for _, d := range durations {
+			sum += d
+		}
+
+		ret = append(ret, command.RouteStats{
+			From:         r.from,
+			To:           r.to,
+			Count:        int64(len(durations)),
+			MeanDuration: sum / time.Duration(len(durations)),
+			P50Duration:  percentile(durations, 50),
+			P95Duration:  percentile(durations, 95),
+		})
+	}
+	return ret
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations, 0 if there is none.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+func msToDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
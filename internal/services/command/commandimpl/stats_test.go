@@ -0,0 +1,30 @@
+package commandimpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	t.Run("Should return zero without durations", func(t *testing.T) {
+		require.Zero(t, percentile(nil, 50))
+	})
+
+	t.Run("Should compute the nearest-rank percentiles", func(t *testing.T) {
+		durations := make([]time.Duration, 0, 20)
+		for i := 1; i <= 20; i++ {
+			durations = append(durations, time.Duration(i)*time.Second)
+		}
+
+		require.Equal(t, 10*time.Second, percentile(durations, 50))
+		require.Equal(t, 19*time.Second, percentile(durations, 95))
+	})
+
+	t.Run("Should return the single duration for every percentile", func(t *testing.T) {
+		durations := []time.Duration{3 * time.Second}
+		require.Equal(t, 3*time.Second, percentile(durations, 50))
+		require.Equal(t, 3*time.Second, percentile(durations, 95))
+	})
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"fmt"
+)
+
+// ConditionType is the robot state checked by a condition.
+type ConditionType string
+
+func (c ConditionType) Validate() error {
+	switch c {
+	case ConditionTypeCargoHasItem, ConditionTypeCargoEmpty,
+		ConditionTypeCargoDoorClosed, ConditionTypeCargoDoorOpen,
+		ConditionTypeBatteryAtLeast, ConditionTypeAtLocation:
+		return nil
+	}
+	return fmt.Errorf("invalid condition type: %s", c)
+}
+
+func (c ConditionType) String() string {
+	return string(c)
+}
+
+const (
+	ConditionTypeCargoHasItem    ConditionType = "CARGO_HAS_ITEM"
+	ConditionTypeCargoEmpty      ConditionType = "CARGO_EMPTY"
+	ConditionTypeCargoDoorClosed ConditionType = "CARGO_DOOR_CLOSED"
+	ConditionTypeCargoDoorOpen   ConditionType = "CARGO_DOOR_OPEN"
+	ConditionTypeBatteryAtLeast  ConditionType = "BATTERY_AT_LEAST"
+	ConditionTypeAtLocation      ConditionType = "AT_LOCATION"
+)
+
+// Condition is a check of the robot state.
+// It is used as a precondition of a command and by the ASSERT command.
+type Condition struct {
+	Type ConditionType `json:"type" validate:"enum"`
+
+	// BatteryPercent is the minimum battery percent of BATTERY_AT_LEAST.
+	BatteryPercent uint8 `json:"battery_percent,omitempty" validate:"max=100"`
+	// Location is the location or the station alias of AT_LOCATION.
+	Location string `json:"location,omitempty" validate:"required_if=Type AT_LOCATION"`
+}
+
+func (c Condition) String() string {
+	switch c.Type {
+	case ConditionTypeBatteryAtLeast:
+		return fmt.Sprintf("%s %d%%", c.Type, c.BatteryPercent)
+	case ConditionTypeAtLocation:
+		return fmt.Sprintf("%s %s", c.Type, c.Location)
+	default:
+		return c.Type.String()
+	}
+}
+
+// FailedCondition is the structured reason of a command failed by a condition.
+type FailedCondition struct {
+	Condition Condition `json:"condition"`
+	// Actual is the observed state, e.g. the battery percent or the current location.
+	Actual string `json:"actual"`
+	// Precondition reports whether the condition is a precondition of the command,
+	// false if it is checked by an ASSERT command.
+	Precondition bool `json:"precondition"`
+}
+
+// ConditionFailedError is returned when a condition is not met.
+type ConditionFailedError struct {
+	FailedCondition FailedCondition
+}
+
+func (e *ConditionFailedError) Error() string {
+	kind := "condition"
+	if e.FailedCondition.Precondition {
+		kind = "precondition"
+	}
+	return fmt.Sprintf("%s %s not met: actual %s", kind, e.FailedCondition.Condition, e.FailedCondition.Actual)
+}
@@ -0,0 +1,30 @@
+package executor
+
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+type assertExecutor struct {
+	conditionChecker conditionChecker
+}
+
+func newAssertExecutor(conditionChecker conditionChecker) CommandExecutor[command.AssertInputs, command.AssertOutputs] {
+	return assertExecutor{
+		conditionChecker: conditionChecker,
+	}
+}
+
+func (e assertExecutor) Execute(ctx context.Context, inputs command.AssertInputs) (command.AssertOutputs, error) {
+	return command.AssertOutputs{}, e.conditionChecker.check(ctx, inputs.Conditions, false)
+}
+
+func (e assertExecutor) OnCancel(_ context.Context) error {
+	return nil
+}
+
+func (assertExecutor) Plan(_ context.Context, sim *simulation, inputs command.AssertInputs) (command.PlanStep, error) {
+	conditions, err := sim.checkConditions(inputs.Conditions, false)
+	return command.PlanStep{Conditions: conditions}, err
+}
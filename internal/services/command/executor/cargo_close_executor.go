@@ -91,3 +91,8 @@ func (e cargoCloseExecutor) trackingCargoDoorUntilClosed(ctx context.Context) {
 	case <-ctx.Done():
 	}
 }
+
+func (cargoCloseExecutor) Plan(_ context.Context, sim *simulation, _ command.CargoCloseInputs) (command.PlanStep, error) {
+	sim.cargo.IsOpen = false
+	return command.PlanStep{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
 	"sync"
 
 	"github.com/tbe-team/raybot/internal/events"
@@ -12,6 +13,7 @@ import (
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
 	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
 type cargoLiftExecutor struct {
@@ -97,6 +99,8 @@ func (e cargoLiftExecutor) trackingLiftPositionUntilReached(ctx context.Context,
 		slog.Int64("target_position", int64(liftPosition)),
 		slog.Int("required_stable_read_count", requiredStableReadCount))
 
+	// lastPosition reports the progress only when the position changes
+	lastPosition := uint16(math.MaxUint16)
 	doneCh := make(chan struct{}, 1)
 	e.subscriber.Subscribe(ctx, events.DistanceSensorUpdatedTopic, func(msg *eventbus.Message) {
 		ev, ok := msg.Payload.(events.UpdateDistanceSensorEvent)
@@ -105,6 +109,14 @@ func (e cargoLiftExecutor) trackingLiftPositionUntilReached(ctx context.Context,
 			return
 		}
 
+		if ev.DownDistance != lastPosition {
+			lastPosition = ev.DownDistance
+			command.ReportProgress(ctx, command.Progress{
+				CurrentPosition: ptr.New(ev.DownDistance),
+				TargetPosition:  ptr.New(liftPosition),
+			})
+		}
+
 		if e.isLiftPositionReached(ev.DownDistance, liftPosition) {
 			stableReadCount++
 			e.log.Info("lift position reached",
@@ -148,3 +160,7 @@ func (e cargoLiftExecutor) getRequiredStableReadCount(ctx context.Context) int {
 	}
 	return int(commandCfg.CargoLift.StableReadCount)
 }
+
+func (cargoLiftExecutor) Plan(_ context.Context, _ *simulation, inputs command.CargoLiftInputs) (command.PlanStep, error) {
+	return command.PlanStep{LiftPosition: &inputs.Position}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
 	"sync"
 
 	"github.com/tbe-team/raybot/internal/config"
@@ -12,6 +13,7 @@ import (
 	configservice "github.com/tbe-team/raybot/internal/services/config"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
 	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
 type cargoLowerExecutor struct {
@@ -98,6 +100,8 @@ func (e cargoLowerExecutor) trackingLowerPositionUntilReached(ctx context.Contex
 		slog.Int64("lower_position", int64(lowerPosition)),
 		slog.Int("required_stable_read_count", requiredStableReadCount))
 
+	// lastPosition reports the progress only when the position changes
+	lastPosition := uint16(math.MaxUint16)
 	doneCh := make(chan struct{}, 1)
 	e.subscriber.Subscribe(ctx, events.DistanceSensorUpdatedTopic, func(msg *eventbus.Message) {
 		ev, ok := msg.Payload.(events.UpdateDistanceSensorEvent)
@@ -106,6 +110,14 @@ func (e cargoLowerExecutor) trackingLowerPositionUntilReached(ctx context.Contex
 			return
 		}
 
+		if ev.DownDistance != lastPosition {
+			lastPosition = ev.DownDistance
+			command.ReportProgress(ctx, command.Progress{
+				CurrentPosition: ptr.New(ev.DownDistance),
+				TargetPosition:  ptr.New(lowerPosition),
+			})
+		}
+
 		if e.isLowerPositionReached(ev.DownDistance, lowerPosition) {
 			stableReadCount++
 			e.log.Info("lower position reached",
@@ -230,3 +242,7 @@ func (e cargoLowerExecutor) getObstacleTracking(ctx context.Context) (config.Obs
 	}
 	return commandCfg.CargoLower.BottomObstacleTracking, nil
 }
+
+func (cargoLowerExecutor) Plan(_ context.Context, _ *simulation, inputs command.CargoLowerInputs) (command.PlanStep, error) {
+	return command.PlanStep{LiftPosition: &inputs.Position}, nil
+}
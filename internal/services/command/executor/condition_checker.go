@@ -0,0 +1,138 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/tbe-team/raybot/internal/services/battery"
+	"github.com/tbe-team/raybot/internal/services/cargo"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+)
+
+// conditionChecker checks the conditions of the preconditions and the ASSERT command
+// against the current robot state.
+type conditionChecker struct {
+	cargoService    cargo.Service
+	batteryService  battery.Service
+	locationService location.Service
+	railMapService  railmap.Service
+}
+
+func newConditionChecker(
+	cargoService cargo.Service,
+	batteryService battery.Service,
+	locationService location.Service,
+	railMapService railmap.Service,
+) conditionChecker {
+	return conditionChecker{
+		cargoService:    cargoService,
+		batteryService:  batteryService,
+		locationService: locationService,
+		railMapService:  railMapService,
+	}
+}
+
+// check returns a *command.ConditionFailedError for the first condition that is not met.
+func (c conditionChecker) check(ctx context.Context, conditions []command.Condition, precondition bool) error {
+	for _, cond := range conditions {
+		ok, actual, err := c.checkCondition(ctx, cond)
+		if err != nil {
+			return fmt.Errorf("check condition %s: %w", cond, err)
+		}
+		if !ok {
+			return &command.ConditionFailedError{
+				FailedCondition: command.FailedCondition{
+					Condition:    cond,
+					Actual:       actual,
+					Precondition: precondition,
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkCondition reports whether the condition is met and the observed state.
+func (c conditionChecker) checkCondition(ctx context.Context, cond command.Condition) (bool, string, error) {
+	var state conditionState
+	switch cond.Type {
+	case command.ConditionTypeCargoHasItem, command.ConditionTypeCargoEmpty,
+		command.ConditionTypeCargoDoorClosed, command.ConditionTypeCargoDoorOpen:
+		cargoState, err := c.cargoService.GetCargo(ctx)
+		if err != nil {
+			return false, "", fmt.Errorf("get cargo: %w", err)
+		}
+		state.cargo = cargoState
+
+	case command.ConditionTypeBatteryAtLeast:
+		batteryState, err := c.batteryService.GetBatteryState(ctx)
+		if err != nil {
+			return false, "", fmt.Errorf("get battery state: %w", err)
+		}
+		state.batteryPercent = batteryState.Percent
+
+	case command.ConditionTypeAtLocation:
+		loc, err := c.locationService.GetLocation(ctx)
+		if err != nil {
+			return false, "", fmt.Errorf("get location: %w", err)
+		}
+		state.location = loc.CurrentLocation
+
+		railMap, err := c.railMapService.GetRailMap(ctx)
+		if err != nil {
+			return false, "", fmt.Errorf("get rail map: %w", err)
+		}
+		state.railMap = railMap
+
+	default:
+		return false, "", fmt.Errorf("invalid condition type: %s", cond.Type)
+	}
+
+	ok, actual := state.check(cond)
+	return ok, actual, nil
+}
+
+// conditionState is the robot state checked by the conditions.
+// A dry run checks the conditions against its simulated state.
+type conditionState struct {
+	cargo          cargo.Cargo
+	batteryPercent uint8
+	location       string
+	railMap        railmap.RailMap
+}
+
+// check reports whether the condition is met and the observed state.
+func (s conditionState) check(cond command.Condition) (bool, string) {
+	switch cond.Type {
+	case command.ConditionTypeCargoHasItem, command.ConditionTypeCargoEmpty:
+		actual := "no item"
+		if s.cargo.HasItem {
+			actual = "has item"
+		}
+		return s.cargo.HasItem == (cond.Type == command.ConditionTypeCargoHasItem), actual
+
+	case command.ConditionTypeCargoDoorClosed, command.ConditionTypeCargoDoorOpen:
+		actual := "closed"
+		if s.cargo.IsOpen {
+			actual = "open"
+		}
+		return s.cargo.IsOpen == (cond.Type == command.ConditionTypeCargoDoorOpen), actual
+
+	case command.ConditionTypeBatteryAtLeast:
+		return s.batteryPercent >= cond.BatteryPercent, strconv.Itoa(int(s.batteryPercent)) + "%"
+
+	case command.ConditionTypeAtLocation:
+		target := cond.Location
+		if location, ok := s.railMap.ResolveLocation(target); ok {
+			target = location
+		}
+		return s.location == target, s.location
+
+	default:
+		return false, ""
+	}
+}
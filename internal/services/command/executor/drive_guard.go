@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
+)
+
+// driveGuard keeps the drive obstacle tracking running after MOVE_FORWARD and MOVE_BACKWARD
+// return, for as long as the drive motor runs.
+// The commands that take over the drive motor stop it.
+type driveGuard struct {
+	driveMotorService    drivemotor.Service
+	driveObstacleTracker driveObstacleTracker
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+func newDriveGuard(driveMotorService drivemotor.Service, driveObstacleTracker driveObstacleTracker) *driveGuard {
+	return &driveGuard{
+		driveMotorService: driveMotorService,
+		// The tracking outlives the command, so there is no running command to report to
+		driveObstacleTracker: driveObstacleTracker.detached(),
+	}
+}
+
+// start replaces the ongoing tracking with the tracking of the direction.
+// The drive motor is stopped when an obstacle enters the range and resume runs it again once cleared.
+func (g *driveGuard) start(ctx context.Context, direction command.MoveDirection, resume func(context.Context) error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.stopLocked()
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	doneCh := make(chan struct{})
+	g.cancel = cancel
+	g.doneCh = doneCh
+
+	go func() {
+		defer close(doneCh)
+		g.driveObstacleTracker.tracking(ctx, direction, g.driveMotorService.Stop, resume)
+	}()
+}
+
+// stop stops the ongoing tracking and waits until it returns.
+func (g *driveGuard) stop() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.stopLocked()
+}
+
+func (g *driveGuard) stopLocked() {
+	if g.cancel == nil {
+		return
+	}
+	g.cancel()
+	<-g.doneCh
+	g.cancel = nil
+}
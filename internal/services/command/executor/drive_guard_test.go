@@ -0,0 +1,157 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	"github.com/tbe-team/raybot/internal/services/distancesensor"
+	distancesensormocks "github.com/tbe-team/raybot/internal/services/distancesensor/mocks"
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestDriveGuard(t *testing.T) {
+	moveCfg := config.Command{
+		Move: config.Move{
+			EnableObstacleTracking: true,
+			FrontObstacleTracking:  config.ObstacleTracking{EnterDistance: 20, ExitDistance: 30},
+			BackObstacleTracking:   config.ObstacleTracking{EnterDistance: 40, ExitDistance: 50},
+		},
+	}
+
+	newGuard := func(t *testing.T, driveMotorService drivemotor.Service) (*driveGuard, eventbus.EventBus) {
+		log := logging.NewNoopLogger()
+		bus := eventbus.NewInProcEventBus(log)
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(moveCfg, nil)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		distanceSensorService.EXPECT().GetDistanceSensorState(mock.Anything).Return(distancesensor.DistanceSensorState{
+			FrontDistance: 100,
+			BackDistance:  100,
+		}, nil).Maybe()
+		tracker := newDriveObstacleTracker(
+			log,
+			bus,
+			configService,
+			distanceSensorService,
+			// The tracking outlives the command, nothing is reported to the running command
+			commandmocks.NewFakeRunningCommandRepository(t),
+		)
+
+		return newDriveGuard(driveMotorService, tracker), bus
+	}
+
+	// publishUntil publishes the distance until the signal is received.
+	publishUntil := func(t *testing.T, bus eventbus.EventBus, ev events.UpdateDistanceSensorEvent, signalCh <-chan struct{}) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(ev))
+			select {
+			case <-signalCh:
+				return true
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("Should stop the drive motor while an obstacle is in range after MOVE_FORWARD returns", func(t *testing.T) {
+		stoppedCh := make(chan struct{}, 1)
+		resumedCh := make(chan struct{}, 1)
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).Return(nil).Once()
+		driveMotorService.EXPECT().Stop(mock.Anything).RunAndReturn(func(context.Context) error {
+			stoppedCh <- struct{}{}
+			return nil
+		}).Once()
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).RunAndReturn(func(context.Context, drivemotor.MoveForwardParams) error {
+			resumedCh <- struct{}{}
+			return nil
+		}).Once()
+		guard, bus := newGuard(t, driveMotorService)
+		defer guard.stop()
+
+		e := newMoveForwardExecutor(driveMotorService, guard.driveObstacleTracker, guard)
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err := e.Execute(ctx, command.MoveForwardInputs{MotorSpeed: 50})
+		require.NoError(t, err)
+		// The command is done, the tracking keeps running
+		cancel()
+
+		publishUntil(t, bus, events.UpdateDistanceSensorEvent{FrontDistance: 10}, stoppedCh)
+
+		// Between the enter and exit distance, the obstacle is still present
+		bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(events.UpdateDistanceSensorEvent{FrontDistance: 25}))
+		select {
+		case <-resumedCh:
+			t.Fatal("should not restart the drive motor before the obstacle is cleared")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		publishUntil(t, bus, events.UpdateDistanceSensorEvent{FrontDistance: 35}, resumedCh)
+	})
+
+	t.Run("Should track the back distance after MOVE_BACKWARD returns", func(t *testing.T) {
+		stoppedCh := make(chan struct{}, 1)
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		driveMotorService.EXPECT().MoveBackward(mock.Anything, drivemotor.MoveBackwardParams{Speed: 50}).Return(nil).Once()
+		driveMotorService.EXPECT().Stop(mock.Anything).RunAndReturn(func(context.Context) error {
+			stoppedCh <- struct{}{}
+			return nil
+		}).Once()
+		guard, bus := newGuard(t, driveMotorService)
+		defer guard.stop()
+
+		e := newMoveBackwardExecutor(driveMotorService, guard.driveObstacleTracker, guard)
+		_, err := e.Execute(context.Background(), command.MoveBackwardInputs{MotorSpeed: 50})
+		require.NoError(t, err)
+
+		publishUntil(t, bus, events.UpdateDistanceSensorEvent{FrontDistance: 100, BackDistance: 30}, stoppedCh)
+	})
+
+	t.Run("Should stop the tracking on STOP_MOVEMENT", func(t *testing.T) {
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).Return(nil).Once()
+		// Only the stop of STOP_MOVEMENT itself
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(nil).Once()
+		guard, bus := newGuard(t, driveMotorService)
+
+		_, err := newMoveForwardExecutor(driveMotorService, guard.driveObstacleTracker, guard).
+			Execute(context.Background(), command.MoveForwardInputs{MotorSpeed: 50})
+		require.NoError(t, err)
+
+		_, err = newStopMovementExecutor(driveMotorService, guard).
+			Execute(context.Background(), command.StopMovementInputs{})
+		require.NoError(t, err)
+
+		bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(events.UpdateDistanceSensorEvent{FrontDistance: 10}))
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	t.Run("Should stop the tracking when MOVE_FORWARD is canceled", func(t *testing.T) {
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).Return(nil).Once()
+		// Only the stop of the cancel hook
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(nil).Once()
+		guard, bus := newGuard(t, driveMotorService)
+
+		e := newMoveForwardExecutor(driveMotorService, guard.driveObstacleTracker, guard)
+		_, err := e.Execute(context.Background(), command.MoveForwardInputs{MotorSpeed: 50})
+		require.NoError(t, err)
+		require.NoError(t, e.OnCancel(context.Background()))
+
+		bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(events.UpdateDistanceSensorEvent{FrontDistance: 10}))
+		time.Sleep(50 * time.Millisecond)
+	})
+}
@@ -0,0 +1,205 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/internal/services/distancesensor"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// driveObstacleTracker tracks the front or back distance for the direction of travel.
+// It uses the same hysteresis as the cargo lower bottom obstacle tracking:
+// the obstacle is present when distance <= EnterDistance and cleared when distance >= ExitDistance.
+type driveObstacleTracker struct {
+	log                      *slog.Logger
+	subscriber               eventbus.Subscriber
+	configService            configservice.Service
+	distanceSensorService    distancesensor.Service
+	runningCommandRepository command.RunningCommandRepository
+}
+
+func newDriveObstacleTracker(
+	log *slog.Logger,
+	subscriber eventbus.Subscriber,
+	configService configservice.Service,
+	distanceSensorService distancesensor.Service,
+	runningCommandRepository command.RunningCommandRepository,
+) driveObstacleTracker {
+	return driveObstacleTracker{
+		log:                      log,
+		subscriber:               subscriber,
+		configService:            configService,
+		distanceSensorService:    distanceSensorService,
+		runningCommandRepository: runningCommandRepository,
+	}
+}
+
+// waitUntilPathClear blocks while an obstacle is detected in the direction of travel.
+// It returns immediately if the obstacle tracking is disabled or the path is clear.
+func (t driveObstacleTracker) waitUntilPathClear(ctx context.Context, direction command.MoveDirection) error {
+	obstacleTracking, ok := t.getObstacleTracking(ctx, direction)
+	if !ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	distanceCh := t.subscribeDistance(ctx, direction)
+
+	state, err := t.distanceSensorService.GetDistanceSensorState(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get distance sensor state: %w", err)
+	}
+
+	distance := t.pickDistance(state.FrontDistance, state.BackDistance, direction)
+	if distance > obstacleTracking.EnterDistance {
+		return nil
+	}
+
+	t.log.Info("obstacle detected, waiting for the path to clear",
+		slog.String("direction", direction.String()),
+		slog.Uint64("distance", uint64(distance)))
+	t.setPausedForObstacle(ctx, true)
+	defer t.setPausedForObstacle(ctx, false)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case distance := <-distanceCh:
+			if distance >= obstacleTracking.ExitDistance {
+				t.log.Info("obstacle cleared", slog.Uint64("distance", uint64(distance)))
+				return nil
+			}
+		}
+	}
+}
+
+// tracking calls pause if an obstacle is detected in the direction of travel
+// and resume once the obstacle is cleared.
+// Cancel the context to stop the tracking.
+func (t driveObstacleTracker) tracking(
+	ctx context.Context,
+	direction command.MoveDirection,
+	pause func(context.Context) error,
+	resume func(context.Context) error,
+) {
+	obstacleTracking, ok := t.getObstacleTracking(ctx, direction)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		t.log.Info("stop tracking drive obstacle", slog.String("direction", direction.String()))
+		cancel()
+	}()
+
+	t.log.Info("start tracking drive obstacle", slog.String("direction", direction.String()))
+	distanceCh := t.subscribeDistance(ctx, direction)
+
+	isMotorRunning := true
+
+	for {
+		select {
+		case <-ctx.Done():
+			if !isMotorRunning {
+				t.setPausedForObstacle(context.WithoutCancel(ctx), false)
+			}
+			return
+
+		case distance := <-distanceCh:
+			// If the distance is less than the enter distance, we stop the motor
+			if distance <= obstacleTracking.EnterDistance && isMotorRunning {
+				t.log.Info("obstacle detected, stopping drive motor", slog.Uint64("distance", uint64(distance)))
+				if err := pause(ctx); err != nil {
+					t.log.Error("failed to stop drive motor", slog.Any("error", err))
+				}
+
+				isMotorRunning = false
+				t.setPausedForObstacle(ctx, true)
+				continue
+			}
+
+			// If the distance is greater than the exit distance, we run motor again
+			if distance >= obstacleTracking.ExitDistance && !isMotorRunning {
+				t.log.Info("obstacle cleared, running drive motor again", slog.Uint64("distance", uint64(distance)))
+				if err := resume(ctx); err != nil {
+					t.log.Error("failed to run drive motor", slog.Any("error", err))
+				}
+
+				isMotorRunning = true
+				t.setPausedForObstacle(ctx, false)
+			}
+		}
+	}
+}
+
+func (t driveObstacleTracker) subscribeDistance(ctx context.Context, direction command.MoveDirection) <-chan uint16 {
+	distanceCh := make(chan uint16, 1)
+	t.subscriber.Subscribe(ctx, events.DistanceSensorUpdatedTopic, func(msg *eventbus.Message) {
+		ev, ok := msg.Payload.(events.UpdateDistanceSensorEvent)
+		if !ok {
+			t.log.Error("invalid event", slog.Any("event", msg.Payload))
+			return
+		}
+
+		distance := t.pickDistance(ev.FrontDistance, ev.BackDistance, direction)
+		select {
+		case distanceCh <- distance:
+		default:
+			t.log.Error("dropped message from distance channel", slog.Uint64("distance", uint64(distance)))
+		}
+	})
+
+	return distanceCh
+}
+
+func (driveObstacleTracker) pickDistance(front, back uint16, direction command.MoveDirection) uint16 {
+	if direction == command.MoveDirectionBackward {
+		return back
+	}
+	return front
+}
+
+// detached returns a copy of the tracker that does not report the paused state to the running command.
+func (t driveObstacleTracker) detached() driveObstacleTracker {
+	t.runningCommandRepository = nil
+	return t
+}
+
+func (t driveObstacleTracker) setPausedForObstacle(ctx context.Context, paused bool) {
+	if t.runningCommandRepository == nil {
+		return
+	}
+	if err := t.runningCommandRepository.SetPausedForObstacle(ctx, paused); err != nil {
+		t.log.Error("failed to set paused for obstacle", slog.Any("error", err))
+	}
+}
+
+// getObstacleTracking returns the obstacle tracking config for the direction of travel,
+// ok is false if the obstacle tracking is disabled.
+func (t driveObstacleTracker) getObstacleTracking(ctx context.Context, direction command.MoveDirection) (config.ObstacleTracking, bool) {
+	commandCfg, err := t.configService.GetCommandConfig(ctx)
+	if err != nil {
+		t.log.Error("failed to get command config", slog.Any("error", err))
+		return config.ObstacleTracking{}, false
+	}
+
+	if !commandCfg.Move.EnableObstacleTracking {
+		return config.ObstacleTracking{}, false
+	}
+
+	if direction == command.MoveDirectionBackward {
+		return commandCfg.Move.BackObstacleTracking, true
+	}
+	return commandCfg.Move.FrontObstacleTracking, true
+}
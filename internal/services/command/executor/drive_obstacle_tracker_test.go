@@ -0,0 +1,147 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	"github.com/tbe-team/raybot/internal/services/distancesensor"
+	distancesensormocks "github.com/tbe-team/raybot/internal/services/distancesensor/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestDriveObstacleTracker_WaitUntilPathClear(t *testing.T) {
+	moveCfg := config.Command{
+		Move: config.Move{
+			EnableObstacleTracking: true,
+			FrontObstacleTracking:  config.ObstacleTracking{EnterDistance: 20, ExitDistance: 30},
+			BackObstacleTracking:   config.ObstacleTracking{EnterDistance: 40, ExitDistance: 50},
+		},
+	}
+
+	t.Run("Should return immediately if obstacle tracking is disabled", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil)
+		tracker := newDriveObstacleTracker(
+			log,
+			eventbus.NewInProcEventBus(log),
+			configService,
+			distancesensormocks.NewFakeService(t),
+			commandmocks.NewFakeRunningCommandRepository(t),
+		)
+
+		err := tracker.waitUntilPathClear(context.Background(), command.MoveDirectionForward)
+		require.NoError(t, err)
+	})
+
+	t.Run("Should return immediately if the path is clear", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(moveCfg, nil)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		distanceSensorService.EXPECT().GetDistanceSensorState(mock.Anything).Return(distancesensor.DistanceSensorState{
+			FrontDistance: 10,
+			BackDistance:  100,
+		}, nil)
+		tracker := newDriveObstacleTracker(
+			log,
+			eventbus.NewInProcEventBus(log),
+			configService,
+			distanceSensorService,
+			commandmocks.NewFakeRunningCommandRepository(t),
+		)
+
+		err := tracker.waitUntilPathClear(context.Background(), command.MoveDirectionBackward)
+		require.NoError(t, err)
+	})
+
+	t.Run("Should wait until the distance is above the exit distance", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		bus := eventbus.NewInProcEventBus(log)
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(moveCfg, nil)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		distanceSensorService.EXPECT().GetDistanceSensorState(mock.Anything).Return(distancesensor.DistanceSensorState{
+			FrontDistance: 10,
+		}, nil)
+		pausedCh := make(chan struct{})
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		runningCommandRepository.EXPECT().SetPausedForObstacle(mock.Anything, true).RunAndReturn(func(context.Context, bool) error {
+			close(pausedCh)
+			return nil
+		}).Once()
+		runningCommandRepository.EXPECT().SetPausedForObstacle(mock.Anything, false).Return(nil).Once()
+		tracker := newDriveObstacleTracker(
+			log,
+			bus,
+			configService,
+			distanceSensorService,
+			runningCommandRepository,
+		)
+
+		doneCh := make(chan error, 1)
+		go func() {
+			doneCh <- tracker.waitUntilPathClear(context.Background(), command.MoveDirectionForward)
+		}()
+
+		<-pausedCh
+
+		// Between the enter and exit distance, the obstacle is still present.
+		bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(events.UpdateDistanceSensorEvent{
+			FrontDistance: 25,
+		}))
+		select {
+		case <-doneCh:
+			t.Fatal("should not return before the obstacle is cleared")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		require.Eventually(t, func() bool {
+			bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(events.UpdateDistanceSensorEvent{
+				FrontDistance: 35,
+			}))
+			select {
+			case err := <-doneCh:
+				require.NoError(t, err)
+				return true
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("Should return context error if canceled while waiting", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(moveCfg, nil)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		distanceSensorService.EXPECT().GetDistanceSensorState(mock.Anything).Return(distancesensor.DistanceSensorState{
+			BackDistance: 40,
+		}, nil)
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		runningCommandRepository.EXPECT().SetPausedForObstacle(mock.Anything, mock.Anything).Return(nil).Twice()
+		tracker := newDriveObstacleTracker(
+			log,
+			eventbus.NewInProcEventBus(log),
+			configService,
+			distanceSensorService,
+			runningCommandRepository,
+		)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := tracker.waitUntilPathClear(ctx, command.MoveDirectionBackward)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
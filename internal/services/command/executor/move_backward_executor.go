@@ -9,30 +9,55 @@ import (
 )
 
 type moveBackwardExecutor struct {
-	driveMotorService drivemotor.Service
+	driveMotorService    drivemotor.Service
+	driveObstacleTracker driveObstacleTracker
+	driveGuard           *driveGuard
 }
 
 func newMoveBackwardExecutor(
 	driveMotorService drivemotor.Service,
+	driveObstacleTracker driveObstacleTracker,
+	driveGuard *driveGuard,
 ) CommandExecutor[command.MoveBackwardInputs, command.MoveBackwardOutputs] {
 	return moveBackwardExecutor{
-		driveMotorService: driveMotorService,
+		driveMotorService:    driveMotorService,
+		driveObstacleTracker: driveObstacleTracker,
+		driveGuard:           driveGuard,
 	}
 }
 
+// Execute starts the drive motor and returns.
+// The obstacle tracking keeps running in the background until the drive motor is taken over.
 func (e moveBackwardExecutor) Execute(ctx context.Context, inputs command.MoveBackwardInputs) (command.MoveBackwardOutputs, error) {
-	if err := e.driveMotorService.MoveBackward(ctx, drivemotor.MoveBackwardParams{
-		Speed: inputs.MotorSpeed,
-	}); err != nil {
+	e.driveGuard.stop()
+
+	if err := e.driveObstacleTracker.waitUntilPathClear(ctx, command.MoveDirectionBackward); err != nil {
+		return command.MoveBackwardOutputs{}, fmt.Errorf("failed to wait until path clear: %w", err)
+	}
+
+	move := func(ctx context.Context) error {
+		return e.driveMotorService.MoveBackward(ctx, drivemotor.MoveBackwardParams{
+			Speed: inputs.MotorSpeed,
+		})
+	}
+	if err := move(ctx); err != nil {
 		return command.MoveBackwardOutputs{}, fmt.Errorf("failed to move backward: %w", err)
 	}
+	e.driveGuard.start(ctx, command.MoveDirectionBackward, move)
 
 	return command.MoveBackwardOutputs{}, nil
 }
 
 func (e moveBackwardExecutor) OnCancel(ctx context.Context) error {
+	e.driveGuard.stop()
 	if err := e.driveMotorService.Stop(ctx); err != nil {
 		return fmt.Errorf("failed to stop drive motor: %w", err)
 	}
 	return nil
 }
+
+// Plan leaves the simulated location unknown, the robot stops only when the command is stopped.
+func (moveBackwardExecutor) Plan(_ context.Context, sim *simulation, _ command.MoveBackwardInputs) (command.PlanStep, error) {
+	sim.location = ""
+	return command.PlanStep{}, nil
+}
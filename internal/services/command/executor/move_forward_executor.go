@@ -9,30 +9,55 @@ import (
 )
 
 type moveForwardExecutor struct {
-	driveMotorService drivemotor.Service
+	driveMotorService    drivemotor.Service
+	driveObstacleTracker driveObstacleTracker
+	driveGuard           *driveGuard
 }
 
 func newMoveForwardExecutor(
 	driveMotorService drivemotor.Service,
+	driveObstacleTracker driveObstacleTracker,
+	driveGuard *driveGuard,
 ) CommandExecutor[command.MoveForwardInputs, command.MoveForwardOutputs] {
 	return moveForwardExecutor{
-		driveMotorService: driveMotorService,
+		driveMotorService:    driveMotorService,
+		driveObstacleTracker: driveObstacleTracker,
+		driveGuard:           driveGuard,
 	}
 }
 
+// Execute starts the drive motor and returns.
+// The obstacle tracking keeps running in the background until the drive motor is taken over.
 func (e moveForwardExecutor) Execute(ctx context.Context, inputs command.MoveForwardInputs) (command.MoveForwardOutputs, error) {
-	if err := e.driveMotorService.MoveForward(ctx, drivemotor.MoveForwardParams{
-		Speed: inputs.MotorSpeed,
-	}); err != nil {
+	e.driveGuard.stop()
+
+	if err := e.driveObstacleTracker.waitUntilPathClear(ctx, command.MoveDirectionForward); err != nil {
+		return command.MoveForwardOutputs{}, fmt.Errorf("failed to wait until path clear: %w", err)
+	}
+
+	move := func(ctx context.Context) error {
+		return e.driveMotorService.MoveForward(ctx, drivemotor.MoveForwardParams{
+			Speed: inputs.MotorSpeed,
+		})
+	}
+	if err := move(ctx); err != nil {
 		return command.MoveForwardOutputs{}, fmt.Errorf("failed to move forward: %w", err)
 	}
+	e.driveGuard.start(ctx, command.MoveDirectionForward, move)
 
 	return command.MoveForwardOutputs{}, nil
 }
 
 func (e moveForwardExecutor) OnCancel(ctx context.Context) error {
+	e.driveGuard.stop()
 	if err := e.driveMotorService.Stop(ctx); err != nil {
 		return fmt.Errorf("failed to stop drive motor: %w", err)
 	}
 	return nil
 }
+
+// Plan leaves the simulated location unknown, the robot stops only when the command is stopped.
+func (moveForwardExecutor) Plan(_ context.Context, sim *simulation, _ command.MoveForwardInputs) (command.PlanStep, error) {
+	sim.location = ""
+	return command.PlanStep{}, nil
+}
@@ -2,60 +2,135 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/internal/services/railmap"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
 type moveToExecutor struct {
-	log               *slog.Logger
-	subscriber        eventbus.Subscriber
-	driveMotorService drivemotor.Service
+	log                  *slog.Logger
+	subscriber           eventbus.Subscriber
+	configService        configservice.Service
+	driveMotorService    drivemotor.Service
+	locationService      location.Service
+	railMapService       railmap.Service
+	driveObstacleTracker driveObstacleTracker
+	driveGuard           *driveGuard
 }
 
 func newMoveToExecutor(
 	log *slog.Logger,
 	subscriber eventbus.Subscriber,
+	configService configservice.Service,
 	driveMotorService drivemotor.Service,
+	locationService location.Service,
+	railMapService railmap.Service,
+	driveObstacleTracker driveObstacleTracker,
+	driveGuard *driveGuard,
 ) CommandExecutor[command.MoveToInputs, command.MoveToOutputs] {
 	return moveToExecutor{
-		log:               log,
-		subscriber:        subscriber,
-		driveMotorService: driveMotorService,
+		log:                  log,
+		subscriber:           subscriber,
+		configService:        configService,
+		driveMotorService:    driveMotorService,
+		locationService:      locationService,
+		railMapService:       railMapService,
+		driveObstacleTracker: driveObstacleTracker,
+		driveGuard:           driveGuard,
 	}
 }
 
+// moveToProfile is the speed profile of a MOVE_TO command.
+type moveToProfile struct {
+	speed             uint8
+	accelerationRamp  time.Duration
+	approachSpeed     uint8
+	decelerationRamp  time.Duration
+	approachLocations []string
+}
+
 func (e moveToExecutor) Execute(ctx context.Context, inputs command.MoveToInputs) (command.MoveToOutputs, error) {
+	// MOVE_TO tracks the obstacles itself
+	e.driveGuard.stop()
+
+	railMap, err := e.railMapService.GetRailMap(ctx)
+	if err != nil {
+		if inputs.Direction == "" {
+			return command.MoveToOutputs{}, fmt.Errorf("failed to get rail map: %w", err)
+		}
+		e.log.Error("failed to get rail map", slog.Any("error", err))
+	}
+
+	inputs, err = e.resolveRoute(ctx, railMap, inputs)
+	if errors.Is(err, railmap.ErrAlreadyAtLocation) {
+		e.log.Info("already at the target location", slog.String("location", inputs.Location))
+		return command.MoveToOutputs{}, nil
+	}
+	if err != nil {
+		return command.MoveToOutputs{}, err
+	}
+
+	if inputs.Direction != command.MoveDirectionForward && inputs.Direction != command.MoveDirectionBackward {
+		return command.MoveToOutputs{}, fmt.Errorf("invalid move direction: %s", inputs.Direction)
+	}
+
+	profile := e.getProfile(ctx, inputs, railMap)
+	driver := newMoveToDriver(e.driveMotorService, inputs.Direction)
+
 	wg := sync.WaitGroup{}
 
+	obstacleCtx, cancelObstacleTracking := context.WithCancel(ctx)
+	defer cancelObstacleTracking()
+
+	approachCh := make(chan struct{}, 1)
+
+	wg.Add(1)
+	go func() {
+		defer func() {
+			wg.Done()
+			cancelObstacleTracking()
+		}()
+		e.trackingLocationUntilReached(ctx, inputs.Location, profile.approachLocations, approachCh)
+	}()
+
+	if err := e.driveObstacleTracker.waitUntilPathClear(ctx, inputs.Direction); err != nil {
+		return command.MoveToOutputs{}, fmt.Errorf("failed to wait until path clear: %w", err)
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		e.trackingLocationUntilReached(ctx, inputs.Location)
+		e.driveObstacleTracker.tracking(obstacleCtx, inputs.Direction, driver.pause, func(ctx context.Context) error {
+			return driver.resume(ctx, profile.accelerationRamp)
+		})
 	}()
 
-	switch inputs.Direction {
-	case command.MoveDirectionForward:
-		if err := e.driveMotorService.MoveForward(ctx, drivemotor.MoveForwardParams{
-			Speed: inputs.MotorSpeed,
-		}); err != nil {
-			return command.MoveToOutputs{}, fmt.Errorf("failed to move forward: %w", err)
-		}
+	// The speed changes are bound to the location tracking,
+	// reaching the target location interrupts the ongoing ramp.
+	if err := driver.setSpeed(obstacleCtx, profile.speed, profile.accelerationRamp); err != nil && ctx.Err() == nil && obstacleCtx.Err() == nil {
+		return command.MoveToOutputs{}, err
+	}
 
-	case command.MoveDirectionBackward:
-		if err := e.driveMotorService.MoveBackward(ctx, drivemotor.MoveBackwardParams{
-			Speed: inputs.MotorSpeed,
-		}); err != nil {
-			return command.MoveToOutputs{}, fmt.Errorf("failed to move backward: %w", err)
+	select {
+	case <-approachCh:
+		e.log.Info("approaching target location, slowing down",
+			slog.String("location", inputs.Location),
+			slog.Uint64("approach_speed", uint64(profile.approachSpeed)))
+		if err := driver.setSpeed(obstacleCtx, profile.approachSpeed, profile.decelerationRamp); err != nil && ctx.Err() == nil && obstacleCtx.Err() == nil {
+			return command.MoveToOutputs{}, err
 		}
-
-	default:
-		return command.MoveToOutputs{}, fmt.Errorf("invalid move direction: %s", inputs.Direction)
+	case <-obstacleCtx.Done():
 	}
 
 	wg.Wait()
@@ -74,15 +149,26 @@ func (e moveToExecutor) OnCancel(ctx context.Context) error {
 	return nil
 }
 
-func (e moveToExecutor) trackingLocationUntilReached(ctx context.Context, location string) {
+// trackingLocationUntilReached tracks the location until the target location is reached.
+// It notifies approachCh once when one of the approach locations is reached.
+func (e moveToExecutor) trackingLocationUntilReached(
+	ctx context.Context,
+	location string,
+	approachLocations []string,
+	approachCh chan<- struct{},
+) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() {
 		e.log.Info("stop tracking location", slog.String("location", location))
 		cancel()
 	}()
 
+	passedLocations := []string{}
 	doneCh := make(chan struct{})
-	e.log.Info("start tracking location", slog.String("target_location", location))
+	once := sync.Once{}
+	e.log.Info("start tracking location",
+		slog.String("target_location", location),
+		slog.Any("approach_locations", approachLocations))
 	e.subscriber.Subscribe(ctx, events.LocationUpdatedTopic, func(msg *eventbus.Message) {
 		ev, ok := msg.Payload.(events.LocationUpdatedEvent)
 		if !ok {
@@ -90,9 +176,24 @@ func (e moveToExecutor) trackingLocationUntilReached(ctx context.Context, locati
 			return
 		}
 
+		if len(passedLocations) == 0 || passedLocations[len(passedLocations)-1] != ev.Location {
+			passedLocations = append(passedLocations, ev.Location)
+			command.ReportProgress(ctx, command.Progress{
+				PassedLocations: slices.Clone(passedLocations),
+			})
+		}
+
 		if ev.Location == location {
 			e.log.Info("location reached", slog.String("location", ev.Location))
-			close(doneCh)
+			once.Do(func() { close(doneCh) })
+			return
+		}
+
+		if slices.Contains(approachLocations, ev.Location) {
+			select {
+			case approachCh <- struct{}{}:
+			default:
+			}
 		}
 	})
 
@@ -101,3 +202,210 @@ func (e moveToExecutor) trackingLocationUntilReached(ctx context.Context, locati
 	case <-ctx.Done():
 	}
 }
+
+// resolveRoute resolves the station alias of the target location
+// and infers the direction of the shortest path from the current location if none is given.
+func (e moveToExecutor) resolveRoute(
+	ctx context.Context,
+	railMap railmap.RailMap,
+	inputs command.MoveToInputs,
+) (command.MoveToInputs, error) {
+	if location, ok := railMap.ResolveLocation(inputs.Location); ok {
+		inputs.Location = location
+	}
+
+	if inputs.Direction != "" {
+		return inputs, nil
+	}
+
+	current, err := e.locationService.GetLocation(ctx)
+	if err != nil {
+		return inputs, fmt.Errorf("failed to get current location: %w", err)
+	}
+
+	direction, err := railMap.Direction(current.CurrentLocation, inputs.Location)
+	if err != nil {
+		return inputs, fmt.Errorf("failed to infer move direction: %w", err)
+	}
+	inputs.Direction = command.MoveDirection(direction)
+
+	e.log.Info("inferred move direction from rail map",
+		slog.String("from", current.CurrentLocation),
+		slog.String("to", inputs.Location),
+		slog.String("direction", inputs.Direction.String()))
+
+	return inputs, nil
+}
+
+// getProfile returns the speed profile of the command.
+// The inputs take precedence over the move config.
+func (e moveToExecutor) getProfile(ctx context.Context, inputs command.MoveToInputs, railMap railmap.RailMap) moveToProfile {
+	profile := moveToProfile{
+		speed: inputs.MotorSpeed,
+	}
+
+	commandCfg, err := e.configService.GetCommandConfig(ctx)
+	if err != nil {
+		e.log.Error("failed to get command config", slog.Any("error", err))
+	} else {
+		profile.accelerationRamp = commandCfg.Move.AccelerationRamp
+		profile.decelerationRamp = commandCfg.Move.DecelerationRamp
+		profile.approachSpeed = commandCfg.Move.ApproachSpeed
+	}
+
+	if inputs.AccelerationRampMs != nil {
+		profile.accelerationRamp = time.Duration(*inputs.AccelerationRampMs) * time.Millisecond
+	}
+	if inputs.DecelerationRampMs != nil {
+		profile.decelerationRamp = time.Duration(*inputs.DecelerationRampMs) * time.Millisecond
+	}
+	if inputs.ApproachSpeed != nil {
+		profile.approachSpeed = *inputs.ApproachSpeed
+	}
+
+	// The slow-down zone is only used if it actually slows the robot down.
+	if profile.approachSpeed == 0 || profile.approachSpeed >= profile.speed {
+		return profile
+	}
+
+	profile.approachLocations = inputs.ApproachLocations
+	if len(profile.approachLocations) == 0 {
+		if location, ok := railMap.LocationBefore(inputs.Location, railmap.Direction(inputs.Direction)); ok {
+			profile.approachLocations = []string{location}
+		}
+	}
+
+	return profile
+}
+
+// moveToDriver serializes the drive motor speed changes of MOVE_TO
+// with the stop and resume of the obstacle tracking.
+type moveToDriver struct {
+	driveMotorService drivemotor.Service
+	direction         command.MoveDirection
+
+	mu         sync.Mutex
+	speed      uint8
+	paused     bool
+	cancelRamp context.CancelFunc
+	rampDoneCh chan struct{}
+}
+
+func newMoveToDriver(driveMotorService drivemotor.Service, direction command.MoveDirection) *moveToDriver {
+	return &moveToDriver{
+		driveMotorService: driveMotorService,
+		direction:         direction,
+	}
+}
+
+// setSpeed ramps the drive motor to the speed, interrupting the ongoing ramp.
+// While paused, the speed is only recorded and applied on resume.
+func (d *moveToDriver) setSpeed(ctx context.Context, speed uint8, ramp time.Duration) error {
+	d.mu.Lock()
+	d.speed = speed
+	if d.paused {
+		d.mu.Unlock()
+		return nil
+	}
+	d.stopRampLocked()
+
+	rampCtx, cancel := context.WithCancel(ctx)
+	rampDoneCh := make(chan struct{})
+	d.cancelRamp = cancel
+	d.rampDoneCh = rampDoneCh
+	d.mu.Unlock()
+
+	defer func() {
+		cancel()
+		close(rampDoneCh)
+	}()
+
+	err := d.move(rampCtx, speed, ramp)
+	// The ramp is interrupted by a pause or a new speed, which is not an error.
+	if err != nil && rampCtx.Err() != nil && ctx.Err() == nil {
+		return nil
+	}
+	return err
+}
+
+// pause interrupts the ongoing ramp and stops the drive motor.
+func (d *moveToDriver) pause(ctx context.Context) error {
+	d.mu.Lock()
+	d.paused = true
+	d.stopRampLocked()
+	d.mu.Unlock()
+
+	if err := d.driveMotorService.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop drive motor: %w", err)
+	}
+	return nil
+}
+
+// resume ramps the drive motor back to the last set speed.
+func (d *moveToDriver) resume(ctx context.Context, ramp time.Duration) error {
+	d.mu.Lock()
+	d.paused = false
+	speed := d.speed
+	d.mu.Unlock()
+
+	return d.setSpeed(ctx, speed, ramp)
+}
+
+// stopRampLocked cancels the ongoing ramp and waits until it returns.
+// It must be called with the lock held.
+func (d *moveToDriver) stopRampLocked() {
+	if d.cancelRamp == nil {
+		return
+	}
+	d.cancelRamp()
+	<-d.rampDoneCh
+	d.cancelRamp = nil
+}
+
+func (d *moveToDriver) move(ctx context.Context, speed uint8, ramp time.Duration) error {
+	switch d.direction {
+	case command.MoveDirectionForward:
+		if err := d.driveMotorService.MoveForward(ctx, drivemotor.MoveForwardParams{
+			Speed: speed,
+			Ramp:  ramp,
+		}); err != nil {
+			return fmt.Errorf("failed to move forward: %w", err)
+		}
+
+	case command.MoveDirectionBackward:
+		if err := d.driveMotorService.MoveBackward(ctx, drivemotor.MoveBackwardParams{
+			Speed: speed,
+			Ramp:  ramp,
+		}); err != nil {
+			return fmt.Errorf("failed to move backward: %w", err)
+		}
+
+	default:
+		return fmt.Errorf("invalid move direction: %s", d.direction)
+	}
+
+	return nil
+}
+
+// Plan resolves the route from the simulated location and moves the simulation to the target location.
+func (e moveToExecutor) Plan(ctx context.Context, sim *simulation, inputs command.MoveToInputs) (command.PlanStep, error) {
+	e.locationService = sim
+	inputs, err := e.resolveRoute(ctx, sim.railMap, inputs)
+	if errors.Is(err, railmap.ErrAlreadyAtLocation) {
+		return command.PlanStep{Location: &inputs.Location}, nil
+	}
+	if err != nil {
+		return command.PlanStep{}, err
+	}
+
+	step := command.PlanStep{
+		Location:  &inputs.Location,
+		Direction: &inputs.Direction,
+	}
+	if path, err := sim.railMap.Path(sim.location, inputs.Location, railmap.Direction(inputs.Direction)); err == nil {
+		step.ExpectedLocations = path
+	}
+	sim.location = inputs.Location
+
+	return step, nil
+}
@@ -0,0 +1,213 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/location"
+	locationmocks "github.com/tbe-team/raybot/internal/services/location/mocks"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	railmapmocks "github.com/tbe-team/raybot/internal/services/railmap/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestMoveToExecutor_GetProfile(t *testing.T) {
+	moveCfg := config.Command{
+		Move: config.Move{
+			AccelerationRamp: time.Second,
+			DecelerationRamp: 2 * time.Second,
+			ApproachSpeed:    30,
+		},
+	}
+	railMap := railmap.RailMap{
+		Topology: railmap.TopologyLoop,
+		Tags: []railmap.Tag{
+			{Location: "A"},
+			{Location: "B"},
+			{Location: "C"},
+		},
+	}
+
+	newExecutor := func(t *testing.T) moveToExecutor {
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(moveCfg, nil)
+		return newMoveToExecutor(
+			logging.NewNoopLogger(),
+			&eventbus.NoopEventBus{},
+			configService,
+			drivemotormocks.NewFakeService(t),
+			locationmocks.NewFakeService(t),
+			railmapmocks.NewFakeService(t),
+			driveObstacleTracker{},
+			&driveGuard{},
+		).(moveToExecutor)
+	}
+
+	t.Run("Should use the location before the target in the rail map when moving forward", func(t *testing.T) {
+		e := newExecutor(t)
+
+		profile := e.getProfile(context.Background(), command.MoveToInputs{
+			Location:   "A",
+			Direction:  command.MoveDirectionForward,
+			MotorSpeed: 100,
+		}, railMap)
+		require.Equal(t, moveToProfile{
+			speed:             100,
+			accelerationRamp:  time.Second,
+			approachSpeed:     30,
+			decelerationRamp:  2 * time.Second,
+			approachLocations: []string{"C"},
+		}, profile)
+	})
+
+	t.Run("Should use the location after the target in the rail map when moving backward", func(t *testing.T) {
+		e := newExecutor(t)
+
+		profile := e.getProfile(context.Background(), command.MoveToInputs{
+			Location:   "B",
+			Direction:  command.MoveDirectionBackward,
+			MotorSpeed: 100,
+		}, railMap)
+		require.Equal(t, []string{"C"}, profile.approachLocations)
+	})
+
+	t.Run("Should prefer the inputs over the config", func(t *testing.T) {
+		e := newExecutor(t)
+
+		profile := e.getProfile(context.Background(), command.MoveToInputs{
+			Location:           "B",
+			Direction:          command.MoveDirectionForward,
+			MotorSpeed:         80,
+			ApproachLocations:  []string{"X", "Y"},
+			ApproachSpeed:      ptr.New(uint8(20)),
+			AccelerationRampMs: ptr.New(int64(0)),
+			DecelerationRampMs: ptr.New(int64(500)),
+		}, railMap)
+		require.Equal(t, moveToProfile{
+			speed:             80,
+			accelerationRamp:  0,
+			approachSpeed:     20,
+			decelerationRamp:  500 * time.Millisecond,
+			approachLocations: []string{"X", "Y"},
+		}, profile)
+	})
+
+	t.Run("Should not use the slow-down zone if the approach speed is not slower", func(t *testing.T) {
+		e := newExecutor(t)
+
+		profile := e.getProfile(context.Background(), command.MoveToInputs{
+			Location:   "B",
+			Direction:  command.MoveDirectionForward,
+			MotorSpeed: 30,
+		}, railMap)
+		require.Empty(t, profile.approachLocations)
+	})
+}
+
+func TestMoveToExecutor_ResolveRoute(t *testing.T) {
+	railMap := railmap.RailMap{
+		Topology: railmap.TopologyLoop,
+		Tags: []railmap.Tag{
+			{Location: "A", DistanceToNext: ptr.New(uint32(100))},
+			{Location: "B", DistanceToNext: ptr.New(uint32(100)), Alias: ptr.New("dock-A")},
+			{Location: "C", DistanceToNext: ptr.New(uint32(100))},
+			{Location: "D", DistanceToNext: ptr.New(uint32(100))},
+		},
+	}
+
+	newExecutor := func(t *testing.T, locationService location.Service) moveToExecutor {
+		return newMoveToExecutor(
+			logging.NewNoopLogger(),
+			&eventbus.NoopEventBus{},
+			configmocks.NewFakeService(t),
+			drivemotormocks.NewFakeService(t),
+			locationService,
+			railmapmocks.NewFakeService(t),
+			driveObstacleTracker{},
+			&driveGuard{},
+		).(moveToExecutor)
+	}
+
+	t.Run("Should resolve the station alias and infer the shortest direction", func(t *testing.T) {
+		locationService := locationmocks.NewFakeService(t)
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "C"}, nil)
+		e := newExecutor(t, locationService)
+
+		inputs, err := e.resolveRoute(context.Background(), railMap, command.MoveToInputs{
+			Location:   "dock-A",
+			MotorSpeed: 100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "B", inputs.Location)
+		require.Equal(t, command.MoveDirectionBackward, inputs.Direction)
+	})
+
+	t.Run("Should keep the given direction", func(t *testing.T) {
+		e := newExecutor(t, locationmocks.NewFakeService(t))
+
+		inputs, err := e.resolveRoute(context.Background(), railMap, command.MoveToInputs{
+			Location:   "D",
+			Direction:  command.MoveDirectionForward,
+			MotorSpeed: 100,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "D", inputs.Location)
+		require.Equal(t, command.MoveDirectionForward, inputs.Direction)
+	})
+
+	t.Run("Should fail if the target is not in the rail map", func(t *testing.T) {
+		locationService := locationmocks.NewFakeService(t)
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "A"}, nil)
+		e := newExecutor(t, locationService)
+
+		_, err := e.resolveRoute(context.Background(), railMap, command.MoveToInputs{
+			Location:   "X",
+			MotorSpeed: 100,
+		})
+		require.ErrorIs(t, err, railmap.ErrLocationNotFound)
+	})
+}
+
+func TestMoveToExecutor_Execute(t *testing.T) {
+	t.Run("Should succeed without driving if already at the target location", func(t *testing.T) {
+		railMapService := railmapmocks.NewFakeService(t)
+		railMapService.EXPECT().GetRailMap(mock.Anything).Return(railmap.RailMap{
+			Topology: railmap.TopologyLinear,
+			Tags: []railmap.Tag{
+				{Location: "A"},
+				{Location: "B", Alias: ptr.New("dock-A")},
+			},
+		}, nil)
+		locationService := locationmocks.NewFakeService(t)
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "B"}, nil)
+		// The drive motor is never called
+		driveMotorService := drivemotormocks.NewFakeService(t)
+
+		e := newMoveToExecutor(
+			logging.NewNoopLogger(),
+			&eventbus.NoopEventBus{},
+			configmocks.NewFakeService(t),
+			driveMotorService,
+			locationService,
+			railMapService,
+			driveObstacleTracker{},
+			&driveGuard{},
+		)
+
+		_, err := e.Execute(context.Background(), command.MoveToInputs{
+			Location:   "dock-A",
+			MotorSpeed: 100,
+		})
+		require.NoError(t, err)
+	})
+}
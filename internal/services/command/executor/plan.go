@@ -0,0 +1,119 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/dashboarddata"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+// planner is implemented by the executors that know the outcome of a command without executing it.
+// Plan returns the expected outcome of the command and applies its effects to the simulation.
+// The executors that do not implement it are planned without any effect on the robot state.
+type planner[I command.Inputs] interface {
+	Plan(ctx context.Context, sim *simulation, inputs I) (command.PlanStep, error)
+}
+
+// simulation is the robot state of a dry run.
+// It starts from the current robot state and each planned command applies its expected effects.
+// It is the location service of the planners, so that they resolve the routes from the simulated location.
+type simulation struct {
+	conditionState
+}
+
+var _ location.Service = (*simulation)(nil)
+
+func newSimulation(state dashboarddata.RobotState, railMap railmap.RailMap) *simulation {
+	return &simulation{
+		conditionState: conditionState{
+			cargo:          state.Cargo,
+			batteryPercent: state.Battery.Percent,
+			location:       state.Location.CurrentLocation,
+			railMap:        railMap,
+		},
+	}
+}
+
+func (s *simulation) GetLocation(_ context.Context) (location.Location, error) {
+	return location.Location{CurrentLocation: s.location}, nil
+}
+
+func (s *simulation) UpdateLocation(_ context.Context, params location.UpdateLocationParams) error {
+	s.location = params.CurrentLocation
+	return nil
+}
+
+// checkConditions checks the conditions against the simulated state.
+// It returns a *command.ConditionFailedError for the first condition that is not met.
+func (s *simulation) checkConditions(conditions []command.Condition, precondition bool) ([]command.ConditionResult, error) {
+	results := make([]command.ConditionResult, 0, len(conditions))
+	var condErr error
+	for _, cond := range conditions {
+		met, actual := s.check(cond)
+		results = append(results, command.ConditionResult{
+			Condition:    cond,
+			Met:          met,
+			Actual:       actual,
+			Precondition: precondition,
+		})
+		if !met && condErr == nil {
+			condErr = &command.ConditionFailedError{
+				FailedCondition: command.FailedCondition{
+					Condition:    cond,
+					Actual:       actual,
+					Precondition: precondition,
+				},
+			}
+		}
+	}
+
+	return results, condErr
+}
+
+func (s *service) Plan(ctx context.Context, cmds []command.Command) (command.Plan, error) {
+	state, err := s.robotStateService.GetRobotState(ctx)
+	if err != nil {
+		return command.Plan{}, fmt.Errorf("failed to get robot state: %w", err)
+	}
+
+	railMap, err := s.railMapService.GetRailMap(ctx)
+	if err != nil {
+		return command.Plan{}, fmt.Errorf("failed to get rail map: %w", err)
+	}
+
+	sim := newSimulation(state, railMap)
+	plan := command.Plan{Steps: make([]command.PlanStep, 0, len(cmds))}
+	for _, cmd := range cmds {
+		plan.Steps = append(plan.Steps, s.planCommand(ctx, sim, cmd))
+	}
+
+	return plan, nil
+}
+
+// planCommand checks the preconditions of the command against the simulation and plans it if they are met.
+// The effects of a command expected to fail are not applied to the simulation.
+func (s *service) planCommand(ctx context.Context, sim *simulation, cmd command.Command) command.PlanStep {
+	preconditions, err := sim.checkConditions(cmd.Inputs.Common().Preconditions, true)
+	if err != nil {
+		return command.PlanStep{
+			Type:       cmd.Type,
+			Inputs:     cmd.Inputs,
+			Conditions: preconditions,
+			Error:      ptr.New(err.Error()),
+		}
+	}
+
+	step, err := s.plan(ctx, sim, cmd)
+	step.Type = cmd.Type
+	step.Inputs = cmd.Inputs
+	step.Conditions = append(preconditions, step.Conditions...)
+	if err != nil {
+		step.Error = ptr.New(err.Error())
+	}
+
+	return step
+}
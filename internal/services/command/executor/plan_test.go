@@ -0,0 +1,144 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	batterymocks "github.com/tbe-team/raybot/internal/services/battery/mocks"
+	"github.com/tbe-team/raybot/internal/services/cargo"
+	cargomocks "github.com/tbe-team/raybot/internal/services/cargo/mocks"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	"github.com/tbe-team/raybot/internal/services/dashboarddata"
+	dashboarddatamocks "github.com/tbe-team/raybot/internal/services/dashboarddata/mocks"
+	distancesensormocks "github.com/tbe-team/raybot/internal/services/distancesensor/mocks"
+	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
+	liftmotormocks "github.com/tbe-team/raybot/internal/services/liftmotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/location"
+	locationmocks "github.com/tbe-team/raybot/internal/services/location/mocks"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	railmapmocks "github.com/tbe-team/raybot/internal/services/railmap/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestService_Plan(t *testing.T) {
+	newPlanService := func(t *testing.T, state dashboarddata.RobotState) command.ExecutorService {
+		robotStateService := dashboarddatamocks.NewFakeService(t)
+		robotStateService.EXPECT().GetRobotState(mock.Anything).Return(state, nil)
+		railMapService := railmapmocks.NewFakeService(t)
+		railMapService.EXPECT().GetRailMap(mock.Anything).Return(railmap.RailMap{
+			Topology: railmap.TopologyLinear,
+			Tags: []railmap.Tag{
+				{Location: "loc-1"},
+				{Location: "loc-2"},
+				{Location: "loc-3", Alias: ptr.New("dock-A")},
+			},
+		}, nil)
+
+		// The motor and sensor services have no expectations, planning must not use them.
+		s, err := NewService(
+			logging.NewNoopLogger(),
+			&eventbus.NoopEventBus{},
+			&eventbus.NoopEventBus{},
+			configmocks.NewFakeService(t),
+			drivemotormocks.NewFakeService(t),
+			liftmotormocks.NewFakeService(t),
+			cargomocks.NewFakeService(t),
+			batterymocks.NewFakeService(t),
+			distancesensormocks.NewFakeService(t),
+			locationmocks.NewFakeService(t),
+			railMapService,
+			robotStateService,
+			commandmocks.NewFakeRunningCommandRepository(t),
+			commandmocks.NewFakeRepository(t),
+		)
+		require.NoError(t, err)
+		return s
+	}
+
+	t.Run("Should resolve the route of MOVE_TO from the location expected after the previous steps", func(t *testing.T) {
+		s := newPlanService(t, dashboarddata.RobotState{
+			Location: location.Location{CurrentLocation: "loc-1"},
+		})
+
+		plan, err := s.Plan(context.Background(), []command.Command{
+			command.NewCommand(command.SourceApp, &command.MoveToInputs{Location: "dock-A", MotorSpeed: 50}, nil),
+			command.NewCommand(command.SourceApp, &command.MoveToInputs{Location: "loc-2", MotorSpeed: 50}, nil),
+		})
+		require.NoError(t, err)
+		require.True(t, plan.Executable())
+		require.Len(t, plan.Steps, 2)
+
+		require.Equal(t, "loc-3", *plan.Steps[0].Location)
+		require.Equal(t, command.MoveDirectionForward, *plan.Steps[0].Direction)
+		require.Equal(t, []string{"loc-2", "loc-3"}, plan.Steps[0].ExpectedLocations)
+
+		require.Equal(t, command.MoveDirectionBackward, *plan.Steps[1].Direction)
+		require.Equal(t, []string{"loc-2"}, plan.Steps[1].ExpectedLocations)
+	})
+
+	t.Run("Should check the conditions against the state expected after the previous steps", func(t *testing.T) {
+		s := newPlanService(t, dashboarddata.RobotState{
+			Cargo:   cargo.Cargo{IsOpen: false},
+			Battery: battery.BatteryState{Percent: 80},
+		})
+
+		plan, err := s.Plan(context.Background(), []command.Command{
+			command.NewCommand(command.SourceApp, &command.CargoOpenInputs{}, nil),
+			command.NewCommand(command.SourceApp, &command.AssertInputs{
+				Conditions: []command.Condition{{Type: command.ConditionTypeCargoDoorOpen}},
+			}, nil),
+			command.NewCommand(command.SourceApp, &command.CargoLiftInputs{Position: 30, MotorSpeed: 50}, nil),
+		})
+		require.NoError(t, err)
+		require.True(t, plan.Executable())
+
+		require.Len(t, plan.Steps[1].Conditions, 1)
+		require.True(t, plan.Steps[1].Conditions[0].Met)
+		require.Equal(t, "open", plan.Steps[1].Conditions[0].Actual)
+		require.Equal(t, uint16(30), *plan.Steps[2].LiftPosition)
+	})
+
+	t.Run("Should report the precondition that is expected to fail", func(t *testing.T) {
+		s := newPlanService(t, dashboarddata.RobotState{
+			Battery: battery.BatteryState{Percent: 20},
+		})
+
+		plan, err := s.Plan(context.Background(), []command.Command{
+			command.NewCommand(command.SourceApp, &command.CargoOpenInputs{
+				CommonInputs: command.CommonInputs{
+					Preconditions: []command.Condition{{Type: command.ConditionTypeBatteryAtLeast, BatteryPercent: 30}},
+				},
+			}, nil),
+		})
+		require.NoError(t, err)
+		require.False(t, plan.Executable())
+
+		step := plan.Steps[0]
+		require.NotNil(t, step.Error)
+		require.Len(t, step.Conditions, 1)
+		require.False(t, step.Conditions[0].Met)
+		require.True(t, step.Conditions[0].Precondition)
+		require.Equal(t, "20%", step.Conditions[0].Actual)
+	})
+
+	t.Run("Should fail the MOVE_TO step if the location is not in the rail map", func(t *testing.T) {
+		s := newPlanService(t, dashboarddata.RobotState{
+			Location: location.Location{CurrentLocation: "loc-1"},
+		})
+
+		plan, err := s.Plan(context.Background(), []command.Command{
+			command.NewCommand(command.SourceApp, &command.MoveToInputs{Location: "unknown", MotorSpeed: 50}, nil),
+		})
+		require.NoError(t, err)
+		require.False(t, plan.Executable())
+		require.Contains(t, *plan.Steps[0].Error, "failed to infer move direction")
+	})
+}
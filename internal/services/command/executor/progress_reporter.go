@@ -0,0 +1,51 @@
+package executor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// progressReporter keeps the latest progress on the running command
+// and publishes it on the command progress topic.
+type progressReporter struct {
+	log                      *slog.Logger
+	publisher                eventbus.Publisher
+	runningCommandRepository command.RunningCommandRepository
+	cmd                      command.Command
+}
+
+func newProgressReporter(
+	log *slog.Logger,
+	publisher eventbus.Publisher,
+	runningCommandRepository command.RunningCommandRepository,
+	cmd command.Command,
+) command.ProgressReporter {
+	return progressReporter{
+		log:                      log,
+		publisher:                publisher,
+		runningCommandRepository: runningCommandRepository,
+		cmd:                      cmd,
+	}
+}
+
+func (r progressReporter) ReportProgress(ctx context.Context, progress command.Progress) {
+	progress.CommandID = r.cmd.ID
+	progress.CommandType = r.cmd.Type
+	progress.UpdatedAt = time.Now()
+
+	if err := r.runningCommandRepository.SetProgress(ctx, progress); err != nil {
+		r.log.Error("failed to set command progress",
+			slog.Int64("command_id", r.cmd.ID),
+			slog.Any("error", err))
+	}
+
+	r.publisher.Publish(
+		events.CommandProgressTopic,
+		eventbus.NewMessage(events.CommandProgressEvent{Progress: progress}),
+	)
+}
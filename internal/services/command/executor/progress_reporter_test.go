@@ -0,0 +1,70 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	eventbusmocks "github.com/tbe-team/raybot/pkg/eventbus/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestProgressReporter(t *testing.T) {
+	t.Run("Should keep the progress on the running command and publish it", func(t *testing.T) {
+		cmd := command.Command{ID: 1, Type: command.CommandTypeWait}
+
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		runningCommandRepository.EXPECT().SetProgress(mock.Anything, mock.MatchedBy(
+			func(p command.Progress) bool {
+				return p.CommandID == cmd.ID &&
+					p.CommandType == cmd.Type &&
+					*p.WaitedMs == 1000 &&
+					!p.UpdatedAt.IsZero()
+			},
+		)).Return(nil)
+
+		publisher := eventbusmocks.NewFakePublisher(t)
+		publisher.EXPECT().Publish(events.CommandProgressTopic, mock.MatchedBy(
+			func(msg *eventbus.Message) bool {
+				ev, ok := msg.Payload.(events.CommandProgressEvent)
+				return ok && ev.Progress.CommandID == cmd.ID && *ev.Progress.WaitedMs == 1000
+			},
+		)).Return()
+
+		reporter := newProgressReporter(logging.NewNoopLogger(), publisher, runningCommandRepository, cmd)
+		ctx := command.WithProgressReporter(context.Background(), reporter)
+
+		command.ReportProgress(ctx, command.Progress{WaitedMs: ptr.New(int64(1000))})
+	})
+
+	t.Run("Should not report the progress if the context has no reporter", func(_ *testing.T) {
+		command.ReportProgress(context.Background(), command.Progress{WaitedMs: ptr.New(int64(1000))})
+	})
+}
+
+func TestWaitExecutor_Progress(t *testing.T) {
+	reporter := &recordingProgressReporter{}
+	ctx := command.WithProgressReporter(context.Background(), reporter)
+
+	e := waitExecutor{progressInterval: 20 * time.Millisecond}
+	_, err := e.Execute(ctx, command.WaitInputs{DurationMs: 50})
+	require.NoError(t, err)
+	require.Len(t, reporter.progresses, 2)
+	require.GreaterOrEqual(t, *reporter.progresses[1].WaitedMs, int64(40))
+}
+
+type recordingProgressReporter struct {
+	progresses []command.Progress
+}
+
+func (r *recordingProgressReporter) ReportProgress(_ context.Context, progress command.Progress) {
+	r.progresses = append(r.progresses, progress)
+}
@@ -0,0 +1,141 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+// Registry holds the executor of every command type.
+type Registry struct {
+	executors map[command.CommandType]registeredExecutor
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		executors: map[command.CommandType]registeredExecutor{},
+	}
+}
+
+// Register registers the command type of the inputs I and the outputs O with its executor.
+// The command type is also registered in the command package, so that it can be validated,
+// stored and decoded like the built-in types. OnCancel of the executor is the cancel hook
+// of the command type, it is invoked if the command is canceled, timed out or retried.
+func Register[I command.Inputs, O command.Outputs](r *Registry, e CommandExecutor[I, O], opts ...ExecutorOption) error {
+	def, err := command.NewTypeDefinition[I, O]()
+	if err != nil {
+		return fmt.Errorf("new type definition: %w", err)
+	}
+
+	if _, ok := r.executors[def.Type]; ok {
+		return fmt.Errorf("executor of command type %s is already registered", def.Type)
+	}
+
+	if err := command.RegisterType(def); err != nil {
+		return fmt.Errorf("register command type: %w", err)
+	}
+
+	te := typedExecutor[I, O]{executor: e}
+	for _, opt := range opts {
+		opt(&te.executorOptions)
+	}
+
+	r.executors[def.Type] = te
+	return nil
+}
+
+func (r *Registry) get(cmdType command.CommandType) (registeredExecutor, bool) {
+	e, ok := r.executors[cmdType]
+	return e, ok
+}
+
+// Option configures the registry of the executor service,
+// e.g. to register the executors of site-specific command types.
+type Option func(r *Registry) error
+
+// WithExecutor registers the executor of a custom command type, see Register.
+func WithExecutor[I command.Inputs, O command.Outputs](e CommandExecutor[I, O], opts ...ExecutorOption) Option {
+	return func(r *Registry) error {
+		return Register(r, e, opts...)
+	}
+}
+
+// DefaultTimeoutFunc returns the default timeout of a command type from the command timeout config.
+// A zero timeout means the command has no timeout.
+type DefaultTimeoutFunc func(cfg config.CommandTimeout) time.Duration
+
+// ExecutorOption configures a registered executor.
+type ExecutorOption func(o *executorOptions)
+
+// WithDefaultTimeout sets the timeout of the commands whose inputs have no timeout.
+func WithDefaultTimeout(f DefaultTimeoutFunc) ExecutorOption {
+	return func(o *executorOptions) {
+		o.defaultTimeout = f
+	}
+}
+
+type executorOptions struct {
+	defaultTimeout DefaultTimeoutFunc
+}
+
+// timeout returns the default timeout of the command type, zero if it has none.
+func (o executorOptions) timeout(cfg config.CommandTimeout) time.Duration {
+	if o.defaultTimeout == nil {
+		return 0
+	}
+	return o.defaultTimeout(cfg)
+}
+
+// registeredExecutor is a CommandExecutor with the inputs and outputs types erased.
+type registeredExecutor interface {
+	execute(ctx context.Context, inputs command.Inputs) (command.Outputs, error)
+	plan(ctx context.Context, sim *simulation, inputs command.Inputs) (command.PlanStep, error)
+	timeout(cfg config.CommandTimeout) time.Duration
+	Cancelable
+}
+
+type typedExecutor[I command.Inputs, O command.Outputs] struct {
+	executor CommandExecutor[I, O]
+	executorOptions
+}
+
+func (e typedExecutor[I, O]) execute(ctx context.Context, inputs command.Inputs) (command.Outputs, error) {
+	i, err := e.typedInputs(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.executor.Execute(ctx, i)
+}
+
+func (e typedExecutor[I, O]) plan(ctx context.Context, sim *simulation, inputs command.Inputs) (command.PlanStep, error) {
+	i, err := e.typedInputs(inputs)
+	if err != nil {
+		return command.PlanStep{}, err
+	}
+
+	p, ok := e.executor.(planner[I])
+	if !ok {
+		return command.PlanStep{}, nil
+	}
+	return p.Plan(ctx, sim, i)
+}
+
+func (typedExecutor[I, O]) typedInputs(inputs command.Inputs) (I, error) {
+	var i I
+	switch v := any(inputs).(type) {
+	case *I:
+		return *v, nil
+	case I:
+		return v, nil
+	default:
+		return i, fmt.Errorf("invalid %s inputs: %v", i.CommandType(), inputs)
+	}
+}
+
+func (e typedExecutor[I, O]) OnCancel(ctx context.Context) error {
+	return e.executor.OnCancel(ctx)
+}
@@ -0,0 +1,160 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+const commandTypeRingBell command.CommandType = "RING_BELL"
+
+type ringBellInputs struct {
+	command.CommonInputs
+	Times int `json:"times" validate:"min=1"`
+}
+
+func (ringBellInputs) CommandType() command.CommandType {
+	return commandTypeRingBell
+}
+
+type ringBellOutputs struct {
+	command.CommonOutputs
+	Rang int `json:"rang"`
+}
+
+func (ringBellOutputs) CommandType() command.CommandType {
+	return commandTypeRingBell
+}
+
+type ringBellExecutor struct {
+	canceled int
+}
+
+func (e *ringBellExecutor) Execute(_ context.Context, inputs ringBellInputs) (ringBellOutputs, error) {
+	return ringBellOutputs{Rang: inputs.Times}, nil
+}
+
+func (e *ringBellExecutor) OnCancel(_ context.Context) error {
+	e.canceled++
+	return nil
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("Should register the command type and its executor", func(t *testing.T) {
+		r := NewRegistry()
+		require.NoError(t, Register(r, &ringBellExecutor{}))
+
+		def, ok := command.LookupType(commandTypeRingBell)
+		require.True(t, ok)
+		require.NoError(t, commandTypeRingBell.Validate())
+
+		inputs, err := command.UnmarshalInputs(commandTypeRingBell, []byte(`{"times":3}`))
+		require.NoError(t, err)
+		require.Equal(t, &ringBellInputs{Times: 3}, inputs)
+		require.IsType(t, &ringBellOutputs{}, def.NewOutputs())
+
+		_, ok = r.get(commandTypeRingBell)
+		require.True(t, ok)
+	})
+
+	t.Run("Should fail to register a second executor for the same command type", func(t *testing.T) {
+		r := NewRegistry()
+		require.NoError(t, Register(r, &ringBellExecutor{}))
+
+		err := Register(r, &ringBellExecutor{})
+		require.ErrorContains(t, err, "already registered")
+	})
+
+	t.Run("Should fail to register a built-in command type with other inputs", func(t *testing.T) {
+		r := NewRegistry()
+		err := Register[waitLikeInputs, command.WaitOutputs](r, newFakeExecutor[waitLikeInputs, command.WaitOutputs](nil))
+		require.ErrorContains(t, err, "register command type")
+	})
+}
+
+type waitLikeInputs struct {
+	command.CommonInputs
+}
+
+func (waitLikeInputs) CommandType() command.CommandType {
+	return command.CommandTypeWait
+}
+
+func TestService_CustomExecutor(t *testing.T) {
+	e := &ringBellExecutor{}
+	s := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+	require.NoError(t, WithExecutor[ringBellInputs, ringBellOutputs](e)(s.registry))
+
+	cmd := command.Command{
+		ID:     1,
+		Type:   commandTypeRingBell,
+		Inputs: &ringBellInputs{Times: 2},
+	}
+
+	outputs, err := s.route(context.Background(), cmd)
+	require.NoError(t, err)
+	require.Equal(t, ringBellOutputs{Rang: 2}, outputs)
+
+	require.NoError(t, s.runCancelHook(context.Background(), cmd))
+	require.Equal(t, 1, e.canceled)
+}
+
+func TestService_DefaultTimeout(t *testing.T) {
+	newService := func(t *testing.T, opts ...ExecutorOption) *service {
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{
+			Timeout: config.CommandTimeout{Wait: 3 * time.Second},
+		}, nil).Maybe()
+
+		s := newTestService(logging.NewNoopLogger(), configService, nil, nil, nil)
+		s.registry = NewRegistry()
+		require.NoError(t, WithExecutor[ringBellInputs, ringBellOutputs](&ringBellExecutor{}, opts...)(s.registry))
+		return s
+	}
+
+	t.Run("Should use the default timeout registered with the executor", func(t *testing.T) {
+		s := newService(t, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration {
+			return c.Wait * 2
+		}))
+
+		timeout := s.getTimeout(context.Background(), command.Command{
+			Type:   commandTypeRingBell,
+			Inputs: &ringBellInputs{Times: 1},
+		})
+		require.Equal(t, 6*time.Second, timeout)
+	})
+
+	t.Run("Should prefer the timeout of the inputs", func(t *testing.T) {
+		s := newService(t, WithDefaultTimeout(func(config.CommandTimeout) time.Duration {
+			return time.Minute
+		}))
+
+		timeout := s.getTimeout(context.Background(), command.Command{
+			Type: commandTypeRingBell,
+			Inputs: &ringBellInputs{
+				CommonInputs: command.CommonInputs{TimeoutMs: ptr.New(int64(500))},
+				Times:        1,
+			},
+		})
+		require.Equal(t, 500*time.Millisecond, timeout)
+	})
+
+	t.Run("Should have no timeout without a default timeout", func(t *testing.T) {
+		s := newService(t)
+
+		timeout := s.getTimeout(context.Background(), command.Command{
+			Type:   commandTypeRingBell,
+			Inputs: &ringBellInputs{Times: 1},
+		})
+		require.Zero(t, timeout)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/tbe-team/raybot/internal/logging"
 	"github.com/tbe-team/raybot/internal/services/command"
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
 )
 
 func TestService_route(t *testing.T) {
@@ -214,6 +215,7 @@ func TestService_route(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			s := newTestService(
 				logging.NewNoopLogger(),
+				configmocks.NewFakeService(t),
 				commandmocks.NewFakeRunningCommandRepository(t),
 				commandmocks.NewFakeRepository(t),
 				tc.expectedErr,
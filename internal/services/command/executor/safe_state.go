@@ -0,0 +1,80 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// safeStateReadTimeout is the time to wait for a fresh bottom distance reading.
+const safeStateReadTimeout = 5 * time.Second
+
+func (s *service) EnterSafeState(ctx context.Context) error {
+	s.driveGuard.stop()
+	if err := s.driveMotorService.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop drive motor: %w", err)
+	}
+
+	if err := s.liftMotorService.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop lift motor: %w", err)
+	}
+
+	commandCfg, err := s.configService.GetCommandConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get command config: %w", err)
+	}
+
+	safePosition := commandCfg.Recovery.SafeLiftPosition
+	if safePosition == 0 {
+		s.log.Info("safe state entered, lift position check is disabled")
+		return nil
+	}
+
+	position, err := s.readLiftPosition(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Same tolerance as CARGO_LIFT
+	if position > safePosition+safePosition*10/100 {
+		return fmt.Errorf("lift position %d cm has not reached the safe position %d cm", position, safePosition)
+	}
+
+	s.log.Info("safe state entered",
+		slog.Int64("lift_position", int64(position)),
+		slog.Int64("safe_lift_position", int64(safePosition)),
+	)
+	return nil
+}
+
+// readLiftPosition waits for the next bottom distance reading,
+// the stored distance sensor state may be left from before the restart.
+func (s *service) readLiftPosition(ctx context.Context) (uint16, error) {
+	ctx, cancel := context.WithTimeout(ctx, safeStateReadTimeout)
+	defer cancel()
+
+	positionCh := make(chan uint16, 1)
+	s.subscriber.Subscribe(ctx, events.DistanceSensorUpdatedTopic, func(msg *eventbus.Message) {
+		ev, ok := msg.Payload.(events.UpdateDistanceSensorEvent)
+		if !ok {
+			s.log.Error("invalid event", slog.Any("event", msg.Payload))
+			return
+		}
+
+		select {
+		case positionCh <- ev.DownDistance:
+		default:
+		}
+	})
+
+	select {
+	case position := <-positionCh:
+		return position, nil
+	case <-ctx.Done():
+		return 0, fmt.Errorf("failed to read lift position: %w", ctx.Err())
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	"github.com/tbe-team/raybot/internal/services/railmap"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
@@ -17,21 +18,29 @@ type scanLocationExecutor struct {
 	log               *slog.Logger
 	subscriber        eventbus.Subscriber
 	driveMotorService drivemotor.Service
+	railMapService    railmap.Service
+	driveGuard        *driveGuard
 }
 
 func newScanLocationExecutor(
 	log *slog.Logger,
 	subscriber eventbus.Subscriber,
 	driveMotorService drivemotor.Service,
+	railMapService railmap.Service,
+	driveGuard *driveGuard,
 ) CommandExecutor[command.ScanLocationInputs, command.ScanLocationOutputs] {
 	return scanLocationExecutor{
 		log:               log,
 		subscriber:        subscriber,
 		driveMotorService: driveMotorService,
+		railMapService:    railMapService,
+		driveGuard:        driveGuard,
 	}
 }
 
 func (e scanLocationExecutor) Execute(ctx context.Context, _ command.ScanLocationInputs) (command.ScanLocationOutputs, error) {
+	e.driveGuard.stop()
+
 	outputs := command.ScanLocationOutputs{
 		Locations: []command.Location{},
 	}
@@ -56,6 +65,10 @@ func (e scanLocationExecutor) Execute(ctx context.Context, _ command.ScanLocatio
 		return outputs, fmt.Errorf("failed to stop drive motor: %w", err)
 	}
 
+	if ctx.Err() == nil {
+		e.syncRailMap(ctx, outputs.Locations)
+	}
+
 	return outputs, nil
 }
 
@@ -66,6 +79,25 @@ func (e scanLocationExecutor) OnCancel(ctx context.Context) error {
 	return nil
 }
 
+// syncRailMap replaces the tags of the rail map with the scanned locations.
+// The scan itself succeeded, so a failure is only logged.
+func (e scanLocationExecutor) syncRailMap(ctx context.Context, locs []command.Location) {
+	if len(locs) == 0 {
+		return
+	}
+
+	locations := make([]string, len(locs))
+	for i, loc := range locs {
+		locations[i] = loc.Location
+	}
+
+	if _, err := e.railMapService.SyncFromScan(ctx, railmap.SyncFromScanParams{
+		Locations: locations,
+	}); err != nil {
+		e.log.Error("failed to sync rail map from scan", slog.Any("error", err))
+	}
+}
+
 func (e scanLocationExecutor) recordLocationsUntilLoopedBack(ctx context.Context) []command.Location {
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() {
@@ -95,6 +127,14 @@ func (e scanLocationExecutor) recordLocationsUntilLoopedBack(ctx context.Context
 			Location:  ev.Location,
 			ScannedAt: time.Now(),
 		})
+
+		passedLocations := make([]string, len(locs))
+		for i, l := range locs {
+			passedLocations[i] = l.Location
+		}
+		command.ReportProgress(ctx, command.Progress{
+			PassedLocations: passedLocations,
+		})
 	})
 
 	select {
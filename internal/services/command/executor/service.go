@@ -7,12 +7,19 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/cargo"
 	"github.com/tbe-team/raybot/internal/services/command"
-	"github.com/tbe-team/raybot/internal/services/config"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/internal/services/dashboarddata"
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/pkg/backoff"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/ptr"
 )
@@ -34,41 +41,61 @@ type CommandExecutor[I command.Inputs, O command.Outputs] interface {
 
 type service struct {
 	log                      *slog.Logger
+	publisher                eventbus.Publisher
+	subscriber               eventbus.Subscriber
+	configService            configservice.Service
+	driveMotorService        drivemotor.Service
+	liftMotorService         liftmotor.Service
+	robotStateService        dashboarddata.Service
+	railMapService           railmap.Service
 	runningCommandRepository command.RunningCommandRepository
 	commandRepository        command.Repository
+	conditionChecker         conditionChecker
+	driveGuard               *driveGuard
 
-	stopMovementExecutor CommandExecutor[command.StopMovementInputs, command.StopMovementOutputs]
-	moveToExecutor       CommandExecutor[command.MoveToInputs, command.MoveToOutputs]
-	moveForwardExecutor  CommandExecutor[command.MoveForwardInputs, command.MoveForwardOutputs]
-	moveBackwardExecutor CommandExecutor[command.MoveBackwardInputs, command.MoveBackwardOutputs]
-
-	cargoOpenExecutor    CommandExecutor[command.CargoOpenInputs, command.CargoOpenOutputs]
-	cargoCloseExecutor   CommandExecutor[command.CargoCloseInputs, command.CargoCloseOutputs]
-	cargoLiftExecutor    CommandExecutor[command.CargoLiftInputs, command.CargoLiftOutputs]
-	cargoLowerExecutor   CommandExecutor[command.CargoLowerInputs, command.CargoLowerOutputs]
-	cargoCheckQRExecutor CommandExecutor[command.CargoCheckQRInputs, command.CargoCheckQROutputs]
-
-	scanLocationExecutor CommandExecutor[command.ScanLocationInputs, command.ScanLocationOutputs]
-	waitExecutor         CommandExecutor[command.WaitInputs, command.WaitOutputs]
-
-	cancelableMap map[command.CommandType]Cancelable
+	registry *Registry
 }
 
 func NewService(
 	log *slog.Logger,
+	publisher eventbus.Publisher,
 	subscriber eventbus.Subscriber,
-	configService config.Service,
+	configService configservice.Service,
 	driveMotorService drivemotor.Service,
 	liftMotorService liftmotor.Service,
 	cargoService cargo.Service,
+	batteryService battery.Service,
 	distanceSensorService distancesensor.Service,
+	locationService location.Service,
+	railMapService railmap.Service,
+	robotStateService dashboarddata.Service,
 	runningCommandRepository command.RunningCommandRepository,
 	commandRepository command.Repository,
-) command.ExecutorService {
-	stopMovementExecutor := newStopMovementExecutor(driveMotorService)
-	moveBackwardExecutor := newMoveBackwardExecutor(driveMotorService)
-	moveForwardExecutor := newMoveForwardExecutor(driveMotorService)
-	moveToExecutor := newMoveToExecutor(log, subscriber, driveMotorService)
+	opts ...Option,
+) (command.ExecutorService, error) {
+	driveObstacleTracker := newDriveObstacleTracker(
+		log,
+		subscriber,
+		configService,
+		distanceSensorService,
+		runningCommandRepository,
+	)
+
+	driveGuard := newDriveGuard(driveMotorService, driveObstacleTracker)
+
+	stopMovementExecutor := newStopMovementExecutor(driveMotorService, driveGuard)
+	moveBackwardExecutor := newMoveBackwardExecutor(driveMotorService, driveObstacleTracker, driveGuard)
+	moveForwardExecutor := newMoveForwardExecutor(driveMotorService, driveObstacleTracker, driveGuard)
+	moveToExecutor := newMoveToExecutor(
+		log,
+		subscriber,
+		configService,
+		driveMotorService,
+		locationService,
+		railMapService,
+		driveObstacleTracker,
+		driveGuard,
+	)
 
 	cargoOpenExecutor := newCargoOpenExecutor(log, subscriber, cargoService)
 	cargoCloseExecutor := newCargoCloseExecutor(log, subscriber, cargoService)
@@ -76,44 +103,50 @@ func NewService(
 	cargoLowerExecutor := newCargoLowerExecutor(log, subscriber, configService, liftMotorService)
 	cargoCheckQRExecutor := newCargoCheckQRExecutor(log, subscriber)
 
-	scanLocationExecutor := newScanLocationExecutor(log, subscriber, driveMotorService)
+	scanLocationExecutor := newScanLocationExecutor(log, subscriber, driveMotorService, railMapService, driveGuard)
 	waitExecutor := newWaitExecutor()
 
+	conditionChecker := newConditionChecker(cargoService, batteryService, locationService, railMapService)
+	assertExecutor := newAssertExecutor(conditionChecker)
+
+	registry := NewRegistry()
+	builtins := []Option{
+		WithExecutor(stopMovementExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.StopMovement })),
+		WithExecutor(moveBackwardExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.MoveBackward })),
+		WithExecutor(moveForwardExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.MoveForward })),
+		WithExecutor(moveToExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.MoveTo })),
+
+		WithExecutor(cargoOpenExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.CargoOpen })),
+		WithExecutor(cargoCloseExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.CargoClose })),
+		WithExecutor(cargoLiftExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.CargoLift })),
+		WithExecutor(cargoLowerExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.CargoLower })),
+		WithExecutor(cargoCheckQRExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.CargoCheckQR })),
+
+		WithExecutor(scanLocationExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.ScanLocation })),
+		WithExecutor(waitExecutor, WithDefaultTimeout(func(c config.CommandTimeout) time.Duration { return c.Wait })),
+		WithExecutor(assertExecutor),
+	}
+	for _, opt := range append(builtins, opts...) {
+		if err := opt(registry); err != nil {
+			return nil, fmt.Errorf("failed to register executor: %w", err)
+		}
+	}
+
 	return &service{
 		log:                      log,
+		publisher:                publisher,
+		subscriber:               subscriber,
+		configService:            configService,
+		driveMotorService:        driveMotorService,
+		liftMotorService:         liftMotorService,
+		robotStateService:        robotStateService,
+		railMapService:           railMapService,
 		runningCommandRepository: runningCommandRepository,
 		commandRepository:        commandRepository,
-
-		stopMovementExecutor: stopMovementExecutor,
-		moveBackwardExecutor: moveBackwardExecutor,
-		moveForwardExecutor:  moveForwardExecutor,
-		moveToExecutor:       moveToExecutor,
-
-		cargoOpenExecutor:    cargoOpenExecutor,
-		cargoCloseExecutor:   cargoCloseExecutor,
-		cargoLiftExecutor:    cargoLiftExecutor,
-		cargoLowerExecutor:   cargoLowerExecutor,
-		cargoCheckQRExecutor: cargoCheckQRExecutor,
-
-		scanLocationExecutor: scanLocationExecutor,
-		waitExecutor:         waitExecutor,
-
-		cancelableMap: map[command.CommandType]Cancelable{
-			command.CommandTypeStopMovement: stopMovementExecutor,
-			command.CommandTypeMoveBackward: moveBackwardExecutor,
-			command.CommandTypeMoveForward:  moveForwardExecutor,
-			command.CommandTypeMoveTo:       moveToExecutor,
-
-			command.CommandTypeCargoOpen:    cargoOpenExecutor,
-			command.CommandTypeCargoClose:   cargoCloseExecutor,
-			command.CommandTypeCargoLift:    cargoLiftExecutor,
-			command.CommandTypeCargoLower:   cargoLowerExecutor,
-			command.CommandTypeCargoCheckQR: cargoCheckQRExecutor,
-
-			command.CommandTypeScanLocation: scanLocationExecutor,
-			command.CommandTypeWait:         waitExecutor,
-		},
-	}
+		conditionChecker:         conditionChecker,
+		driveGuard:               driveGuard,
+		registry:                 registry,
+	}, nil
 }
 
 func (s *service) Execute(ctx context.Context, cmd command.Command) error {
@@ -125,8 +158,11 @@ func (s *service) Execute(ctx context.Context, cmd command.Command) error {
 	case errors.Is(err, context.Canceled):
 		return s.handleCancel(ctx, cmd.ID, outputs)
 
+	case errors.Is(err, context.DeadlineExceeded):
+		return s.handleTimeout(ctx, cmd.ID, outputs)
+
 	default:
-		return s.handleFailure(ctx, cmd.ID, err)
+		return s.handleFailure(ctx, cmd.ID, outputs, err)
 	}
 }
 
@@ -146,6 +182,7 @@ func (s *service) execute(ctx context.Context, cmd command.Command) (command.Out
 	if err != nil {
 		return nil, fmt.Errorf("failed to update command status: %w", err)
 	}
+	s.publisher.Publish(events.CommandStartedTopic, eventbus.NewMessage(events.CommandStartedEvent{Command: cmd}))
 
 	runningCmd := command.NewCancelableCommand(ctx, cmd)
 	defer func() {
@@ -158,8 +195,17 @@ func (s *service) execute(ctx context.Context, cmd command.Command) (command.Out
 		return nil, fmt.Errorf("failed to add running command: %w", err)
 	}
 
-	cmdCtx := runningCmd.Context()
-	out, err := s.route(cmdCtx, cmd)
+	cmdCtx := command.WithProgressReporter(
+		runningCmd.Context(),
+		newProgressReporter(s.log, s.publisher, s.runningCommandRepository, cmd),
+	)
+	if timeout := s.getTimeout(ctx, cmd); timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		cmdCtx, cancelTimeout = context.WithTimeout(cmdCtx, timeout)
+		defer cancelTimeout()
+	}
+
+	out, err := s.checkPreconditionsAndRoute(cmdCtx, cmd)
 
 	select {
 	case <-cmdCtx.Done():
@@ -167,6 +213,15 @@ func (s *service) execute(ctx context.Context, cmd command.Command) (command.Out
 		if err != nil {
 			return out, err
 		}
+
+		if errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+			out, err = command.WithCommonOutputs(cmd.Type, out, command.CommonOutputs{
+				ElapsedMs: ptr.New(time.Since(now).Milliseconds()),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to set elapsed time: %w", err)
+			}
+		}
 		return out, cmdCtx.Err()
 
 	default:
@@ -174,8 +229,117 @@ func (s *service) execute(ctx context.Context, cmd command.Command) (command.Out
 	}
 }
 
+// checkPreconditionsAndRoute checks the preconditions of the command and routes it if they are met.
+// The condition that is not met, either a precondition or an asserted condition, is added to the outputs.
+func (s *service) checkPreconditionsAndRoute(ctx context.Context, cmd command.Command) (command.Outputs, error) {
+	var out command.Outputs
+	err := s.conditionChecker.check(ctx, cmd.Inputs.Common().Preconditions, true)
+	if err == nil {
+		out, err = s.routeWithRetry(ctx, cmd)
+	}
+
+	var condErr *command.ConditionFailedError
+	if errors.As(err, &condErr) {
+		out, err := command.WithCommonOutputs(cmd.Type, out, command.CommonOutputs{
+			FailedCondition: &condErr.FailedCondition,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to set failed condition: %w", err)
+		}
+		return out, condErr
+	}
+
+	return out, err
+}
+
+// routeWithRetry routes the command and executes it again while it fails with a retryable error,
+// up to the max attempts of the retry policy.
+// The cancel hook runs before each retry to bring the hardware back to a safe state.
+// The attempt history is added to the outputs.
+func (s *service) routeWithRetry(ctx context.Context, cmd command.Command) (command.Outputs, error) {
+	if cmd.RetryPolicy == nil {
+		return s.route(ctx, cmd)
+	}
+
+	b := cmd.RetryPolicy.Backoff()
+	maxAttempts := int(cmd.RetryPolicy.MaxAttempts)
+	attempts := make([]command.Attempt, 0, maxAttempts)
+
+	withAttempts := func(out command.Outputs, execErr error) (command.Outputs, error) {
+		out, err := command.WithCommonOutputs(cmd.Type, out, command.CommonOutputs{
+			Attempts: attempts,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to set attempts: %w", err)
+		}
+		return out, execErr
+	}
+
+	for attempt := 1; ; attempt++ {
+		startedAt := time.Now()
+		out, err := s.route(ctx, cmd)
+		a := command.Attempt{
+			Attempt:     attempt,
+			StartedAt:   startedAt,
+			CompletedAt: time.Now(),
+		}
+		if err != nil {
+			a.Error = ptr.New(err.Error())
+		}
+		attempts = append(attempts, a)
+
+		if err == nil || !retryable(ctx, err) || attempt >= maxAttempts {
+			return withAttempts(out, err)
+		}
+
+		delay := b.Delay(attempt)
+		s.log.Warn("command execution failed, retrying",
+			slog.Int64("command_id", cmd.ID),
+			slog.Int("attempt", attempt),
+			slog.Int("max_attempts", maxAttempts),
+			slog.Duration("backoff", delay),
+			slog.Any("error", err))
+
+		if err := s.runCancelHook(ctx, cmd); err != nil {
+			s.log.Error("failed to run cancel hook before retrying", slog.Any("error", err))
+		}
+
+		if err := backoff.Wait(ctx, delay); err != nil {
+			return withAttempts(out, err)
+		}
+	}
+}
+
+// retryable reports whether the failed execution may succeed on the next attempt.
+// A condition that is not met and a done context fail the same way on every attempt.
+func retryable(ctx context.Context, err error) bool {
+	var condErr *command.ConditionFailedError
+	return ctx.Err() == nil && !errors.As(err, &condErr)
+}
+
+// getTimeout returns the timeout from the command inputs if set,
+// otherwise the default timeout registered with the executor of the command type.
+func (s *service) getTimeout(ctx context.Context, cmd command.Command) time.Duration {
+	if timeout := cmd.Inputs.Common().Timeout(); timeout > 0 {
+		return timeout
+	}
+
+	cfg, err := s.configService.GetCommandConfig(ctx)
+	if err != nil {
+		s.log.Error("failed to get command config", slog.Any("error", err))
+		return 0
+	}
+
+	e, ok := s.registry.get(cmd.Type)
+	if !ok {
+		return 0
+	}
+
+	return e.timeout(cfg.Timeout)
+}
+
 func (s *service) runCancelHook(ctx context.Context, cmd command.Command) error {
-	c, ok := s.cancelableMap[cmd.Type]
+	c, ok := s.registry.get(cmd.Type)
 	if !ok {
 		s.log.Error("cancelable executor not found", slog.Any("command_type", cmd.Type))
 		return nil
@@ -192,7 +356,7 @@ func (s *service) handleSuccess(ctx context.Context, id int64, outputs command.O
 	log.Info("command executed successfully")
 
 	now := time.Now()
-	_, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+	cmd, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
 		ID:             id,
 		Status:         command.StatusSucceeded,
 		SetStatus:      true,
@@ -202,10 +366,10 @@ func (s *service) handleSuccess(ctx context.Context, id int64, outputs command.O
 		SetCompletedAt: true,
 		UpdatedAt:      now,
 	})
-
 	if err != nil {
 		return fmt.Errorf("failed to update command status: %w", err)
 	}
+	s.publisher.Publish(events.CommandSucceededTopic, eventbus.NewMessage(events.CommandSucceededEvent{Command: cmd}))
 
 	return nil
 }
@@ -215,7 +379,7 @@ func (s *service) handleCancel(ctx context.Context, id int64, outputs command.Ou
 	log.Info("command cancelled")
 
 	now := time.Now()
-	_, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+	cmd, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
 		ID:             id,
 		Status:         command.StatusCanceled,
 		SetStatus:      true,
@@ -228,19 +392,48 @@ func (s *service) handleCancel(ctx context.Context, id int64, outputs command.Ou
 	if err != nil {
 		return fmt.Errorf("failed to update command status: %w", err)
 	}
+	s.publisher.Publish(events.CommandCanceledTopic, eventbus.NewMessage(events.CommandCanceledEvent{Command: cmd}))
 
 	return nil
 }
 
-func (s *service) handleFailure(ctx context.Context, id int64, execErr error) error {
+func (s *service) handleTimeout(ctx context.Context, id int64, outputs command.Outputs) error {
+	log := s.log.With(slog.Int64("command_id", id), slog.Any("outputs", outputs))
+	log.Warn("command timed out")
+
+	now := time.Now()
+	cmd, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+		ID:             id,
+		Status:         command.StatusTimedOut,
+		SetStatus:      true,
+		Outputs:        outputs,
+		SetOutputs:     true,
+		CompletedAt:    ptr.New(now),
+		SetCompletedAt: true,
+		UpdatedAt:      now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update command status: %w", err)
+	}
+	s.publisher.Publish(events.CommandFailedTopic, eventbus.NewMessage(events.CommandFailedEvent{Command: cmd}))
+
+	return nil
+}
+
+// handleFailure marks the command as FAILED.
+// The outputs are only stored if they hold the attempt history of a retried command
+// or the condition that was not met.
+func (s *service) handleFailure(ctx context.Context, id int64, outputs command.Outputs, execErr error) error {
 	log := s.log.With(slog.Int64("command_id", id), slog.Any("exec_error", execErr))
 	log.Error("command execution failed")
 
 	now := time.Now()
-	_, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+	cmd, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
 		ID:             id,
 		Status:         command.StatusFailed,
 		SetStatus:      true,
+		Outputs:        outputs,
+		SetOutputs:     outputs != nil && (len(outputs.Common().Attempts) > 0 || outputs.Common().FailedCondition != nil),
 		Error:          ptr.New(execErr.Error()),
 		SetError:       true,
 		CompletedAt:    ptr.New(now),
@@ -250,6 +443,7 @@ func (s *service) handleFailure(ctx context.Context, id int64, execErr error) er
 	if err != nil {
 		return fmt.Errorf("failed to update command status: %w", err)
 	}
+	s.publisher.Publish(events.CommandFailedTopic, eventbus.NewMessage(events.CommandFailedEvent{Command: cmd}))
 
 	return nil
 }
@@ -5,33 +5,54 @@ import (
 	"errors"
 	"log/slog"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	batterymocks "github.com/tbe-team/raybot/internal/services/battery/mocks"
+	"github.com/tbe-team/raybot/internal/services/cargo"
 	cargomocks "github.com/tbe-team/raybot/internal/services/cargo/mocks"
 	"github.com/tbe-team/raybot/internal/services/command"
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configsvc "github.com/tbe-team/raybot/internal/services/config"
 	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	dashboarddatamocks "github.com/tbe-team/raybot/internal/services/dashboarddata/mocks"
 	distancesensormocks "github.com/tbe-team/raybot/internal/services/distancesensor/mocks"
 	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
 	liftmotormocks "github.com/tbe-team/raybot/internal/services/liftmotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/location"
+	locationmocks "github.com/tbe-team/raybot/internal/services/location/mocks"
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	railmapmocks "github.com/tbe-team/raybot/internal/services/railmap/mocks"
 	"github.com/tbe-team/raybot/pkg/eventbus"
+	eventbusmocks "github.com/tbe-team/raybot/pkg/eventbus/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
 func TestService_NewService(t *testing.T) {
-	service := NewService(
+	service, err := NewService(
 		logging.NewNoopLogger(),
 		&eventbus.NoopEventBus{},
+		&eventbus.NoopEventBus{},
 		configmocks.NewFakeService(t),
 		drivemotormocks.NewFakeService(t),
 		liftmotormocks.NewFakeService(t),
 		cargomocks.NewFakeService(t),
+		batterymocks.NewFakeService(t),
 		distancesensormocks.NewFakeService(t),
+		locationmocks.NewFakeService(t),
+		railmapmocks.NewFakeService(t),
+		dashboarddatamocks.NewFakeService(t),
 		commandmocks.NewFakeRunningCommandRepository(t),
 		commandmocks.NewFakeRepository(t),
 	)
+	require.NoError(t, err)
 	require.NotNil(t, service)
 }
 
@@ -40,7 +61,9 @@ func TestService_Execute(t *testing.T) {
 		log := logging.NewNoopLogger()
 		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
 		commandRepository := commandmocks.NewFakeRepository(t)
-		service := newTestService(log, runningCommandRepository, commandRepository, nil)
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil)
+		service := newTestService(log, configService, runningCommandRepository, commandRepository, nil)
 
 		cmdID := int64(1)
 
@@ -88,7 +111,9 @@ func TestService_Execute(t *testing.T) {
 		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
 		commandRepository := commandmocks.NewFakeRepository(t)
 		execErr := errors.New("exec error")
-		service := newTestService(log, runningCommandRepository, commandRepository, execErr)
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil)
+		service := newTestService(log, configService, runningCommandRepository, commandRepository, execErr)
 
 		cmdID := int64(1)
 
@@ -136,7 +161,9 @@ func TestService_Execute(t *testing.T) {
 		log := logging.NewNoopLogger()
 		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
 		commandRepository := commandmocks.NewFakeRepository(t)
-		service := newTestService(log, runningCommandRepository, commandRepository, context.Canceled)
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil)
+		service := newTestService(log, configService, runningCommandRepository, commandRepository, context.Canceled)
 
 		cmdID := int64(1)
 
@@ -177,63 +204,420 @@ func TestService_Execute(t *testing.T) {
 		})
 		require.NoError(t, err)
 	})
+
+	t.Run("Should handle command has timed out and update status to TIMED_OUT successfully", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		service := newTestService(log, configmocks.NewFakeService(t), runningCommandRepository, commandRepository, nil)
+		setExecutor(service, blockingFakeExecutor[command.WaitInputs, command.WaitOutputs]{})
+
+		cmdID := int64(1)
+		inputs := &command.WaitInputs{
+			CommonInputs: command.CommonInputs{TimeoutMs: ptr.New(int64(10))},
+			DurationMs:   1000,
+		}
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID &&
+					params.Status == command.StatusProcessing
+			},
+		)).Return(command.Command{
+			ID:     cmdID,
+			Type:   command.CommandTypeWait,
+			Inputs: inputs,
+		}, nil)
+
+		runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+		runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID &&
+					params.Status == command.StatusTimedOut &&
+					params.SetStatus &&
+					params.SetOutputs &&
+					params.Outputs != nil &&
+					params.Outputs.Common().ElapsedMs != nil &&
+					*params.Outputs.Common().ElapsedMs >= 10 &&
+					params.CompletedAt != nil &&
+					params.SetCompletedAt &&
+					!params.UpdatedAt.IsZero()
+			},
+		)).Return(command.Command{}, nil)
+
+		err := service.Execute(context.Background(), command.Command{
+			ID:     cmdID,
+			Type:   command.CommandTypeWait,
+			Inputs: inputs,
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestService_routeWithRetry(t *testing.T) {
+	retryPolicy := &command.RetryPolicy{
+		MaxAttempts:      3,
+		InitialBackoffMs: 1,
+		MaxBackoffMs:     2,
+	}
+
+	t.Run("Should retry until the execution succeeds", func(t *testing.T) {
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		waitExecutor := &flakyFakeExecutor[command.WaitInputs, command.WaitOutputs]{failures: 2}
+		setExecutor(service, waitExecutor)
+
+		outputs, err := service.routeWithRetry(context.Background(), command.Command{
+			ID:          1,
+			Type:        command.CommandTypeWait,
+			Inputs:      &command.WaitInputs{},
+			RetryPolicy: retryPolicy,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, waitExecutor.calls)
+		require.Equal(t, 2, waitExecutor.canceled)
+
+		attempts := outputs.Common().Attempts
+		require.Len(t, attempts, 3)
+		require.NotNil(t, attempts[0].Error)
+		require.NotNil(t, attempts[1].Error)
+		require.Nil(t, attempts[2].Error)
+		require.Equal(t, 3, attempts[2].Attempt)
+	})
+
+	t.Run("Should return the last error once the max attempts is reached", func(t *testing.T) {
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		waitExecutor := &flakyFakeExecutor[command.WaitInputs, command.WaitOutputs]{failures: 5}
+		setExecutor(service, waitExecutor)
+
+		outputs, err := service.routeWithRetry(context.Background(), command.Command{
+			ID:          1,
+			Type:        command.CommandTypeWait,
+			Inputs:      &command.WaitInputs{},
+			RetryPolicy: retryPolicy,
+		})
+		require.ErrorIs(t, err, errFlakyExecutor)
+		require.Equal(t, 3, waitExecutor.calls)
+		require.Len(t, outputs.Common().Attempts, 3)
+	})
+
+	t.Run("Should not retry if the command has no retry policy", func(t *testing.T) {
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		waitExecutor := &flakyFakeExecutor[command.WaitInputs, command.WaitOutputs]{failures: 1}
+		setExecutor(service, waitExecutor)
+
+		outputs, err := service.routeWithRetry(context.Background(), command.Command{
+			ID:     1,
+			Type:   command.CommandTypeWait,
+			Inputs: &command.WaitInputs{},
+		})
+		require.ErrorIs(t, err, errFlakyExecutor)
+		require.Equal(t, 1, waitExecutor.calls)
+		require.Empty(t, outputs.Common().Attempts)
+	})
+
+	t.Run("Should not retry if the context is canceled", func(t *testing.T) {
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		waitExecutor := &blockingFakeExecutor[command.WaitInputs, command.WaitOutputs]{}
+		setExecutor(service, waitExecutor)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		outputs, err := service.routeWithRetry(ctx, command.Command{
+			ID:          1,
+			Type:        command.CommandTypeWait,
+			Inputs:      &command.WaitInputs{},
+			RetryPolicy: retryPolicy,
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, outputs.Common().Attempts, 1)
+	})
+
+	t.Run("Should attempt a failed assert only once", func(t *testing.T) {
+		locationService := locationmocks.NewFakeService(t)
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "A"}, nil).Once()
+		railMapService := railmapmocks.NewFakeService(t)
+		railMapService.EXPECT().GetRailMap(mock.Anything).Return(railmap.RailMap{}, nil).Once()
+
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		service.conditionChecker = conditionChecker{locationService: locationService, railMapService: railMapService}
+		setExecutor(service, newAssertExecutor(service.conditionChecker))
+
+		outputs, err := service.routeWithRetry(context.Background(), command.Command{
+			ID:   1,
+			Type: command.CommandTypeAssert,
+			Inputs: &command.AssertInputs{Conditions: []command.Condition{
+				{Type: command.ConditionTypeAtLocation, Location: "B"},
+			}},
+			RetryPolicy: retryPolicy,
+		})
+
+		var condErr *command.ConditionFailedError
+		require.ErrorAs(t, err, &condErr)
+		require.Len(t, outputs.Common().Attempts, 1)
+	})
+}
+
+func TestService_checkPreconditionsAndRoute(t *testing.T) {
+	t.Run("Should not execute the command if a precondition is not met", func(t *testing.T) {
+		cargoService := cargomocks.NewFakeService(t)
+		cargoService.EXPECT().GetCargo(mock.Anything).Return(cargo.Cargo{HasItem: false}, nil)
+
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		service.conditionChecker = conditionChecker{cargoService: cargoService}
+		waitExecutor := &flakyFakeExecutor[command.WaitInputs, command.WaitOutputs]{}
+		setExecutor(service, waitExecutor)
+
+		condition := command.Condition{Type: command.ConditionTypeCargoHasItem}
+		outputs, err := service.checkPreconditionsAndRoute(context.Background(), command.Command{
+			ID:   1,
+			Type: command.CommandTypeWait,
+			Inputs: &command.WaitInputs{
+				CommonInputs: command.CommonInputs{Preconditions: []command.Condition{condition}},
+			},
+		})
+
+		var condErr *command.ConditionFailedError
+		require.ErrorAs(t, err, &condErr)
+		require.Equal(t, 0, waitExecutor.calls)
+		require.Equal(t, &command.FailedCondition{
+			Condition:    condition,
+			Actual:       "no item",
+			Precondition: true,
+		}, outputs.Common().FailedCondition)
+	})
+
+	t.Run("Should execute the command if all preconditions are met", func(t *testing.T) {
+		batteryService := batterymocks.NewFakeService(t)
+		batteryService.EXPECT().GetBatteryState(mock.Anything).Return(battery.BatteryState{Percent: 80}, nil)
+
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		service.conditionChecker = conditionChecker{batteryService: batteryService}
+		waitExecutor := &flakyFakeExecutor[command.WaitInputs, command.WaitOutputs]{}
+		setExecutor(service, waitExecutor)
+
+		outputs, err := service.checkPreconditionsAndRoute(context.Background(), command.Command{
+			ID:   1,
+			Type: command.CommandTypeWait,
+			Inputs: &command.WaitInputs{
+				CommonInputs: command.CommonInputs{Preconditions: []command.Condition{
+					{Type: command.ConditionTypeBatteryAtLeast, BatteryPercent: 50},
+				}},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, waitExecutor.calls)
+		require.Nil(t, outputs.Common().FailedCondition)
+	})
+
+	t.Run("Should add the failed condition of the assert command to the outputs", func(t *testing.T) {
+		locationService := locationmocks.NewFakeService(t)
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "A"}, nil)
+		railMapService := railmapmocks.NewFakeService(t)
+		railMapService.EXPECT().GetRailMap(mock.Anything).Return(railmap.RailMap{
+			Tags: []railmap.Tag{{Location: "A"}, {Location: "B", Alias: ptr.New("dock")}},
+		}, nil)
+
+		service := newTestService(logging.NewNoopLogger(), nil, nil, nil, nil)
+		service.conditionChecker = conditionChecker{locationService: locationService, railMapService: railMapService}
+		setExecutor(service, newAssertExecutor(service.conditionChecker))
+
+		condition := command.Condition{Type: command.ConditionTypeAtLocation, Location: "dock"}
+		outputs, err := service.checkPreconditionsAndRoute(context.Background(), command.Command{
+			ID:     1,
+			Type:   command.CommandTypeAssert,
+			Inputs: &command.AssertInputs{Conditions: []command.Condition{condition}},
+		})
+
+		var condErr *command.ConditionFailedError
+		require.ErrorAs(t, err, &condErr)
+		require.Equal(t, &command.FailedCondition{
+			Condition: condition,
+			Actual:    "A",
+		}, outputs.Common().FailedCondition)
+	})
+}
+
+func TestService_Execute_LifecycleEvents(t *testing.T) {
+	testCases := []struct {
+		name          string
+		execErr       error
+		expectedTopic string
+		expectedEvent func(cmd command.Command) any
+	}{
+		{
+			name:          "Should publish the started and succeeded events",
+			expectedTopic: events.CommandSucceededTopic,
+			expectedEvent: func(cmd command.Command) any { return events.CommandSucceededEvent{Command: cmd} },
+		},
+		{
+			name:          "Should publish the started and failed events",
+			execErr:       errors.New("exec error"),
+			expectedTopic: events.CommandFailedTopic,
+			expectedEvent: func(cmd command.Command) any { return events.CommandFailedEvent{Command: cmd} },
+		},
+		{
+			name:          "Should publish the started and canceled events",
+			execErr:       context.Canceled,
+			expectedTopic: events.CommandCanceledTopic,
+			expectedEvent: func(cmd command.Command) any { return events.CommandCanceledEvent{Command: cmd} },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+			commandRepository := commandmocks.NewFakeRepository(t)
+			configService := configmocks.NewFakeService(t)
+			configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil)
+			publisher := eventbusmocks.NewFakePublisher(t)
+			service := newTestService(logging.NewNoopLogger(), configService, runningCommandRepository, commandRepository, tc.execErr)
+			service.publisher = publisher
+
+			processingCmd := command.Command{
+				ID:     1,
+				Type:   command.CommandTypeStopMovement,
+				Status: command.StatusProcessing,
+				Inputs: &command.StopMovementInputs{},
+			}
+			completedCmd := processingCmd
+			completedCmd.Status = command.StatusSucceeded
+
+			commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+				func(params command.UpdateCommandParams) bool {
+					return params.Status == command.StatusProcessing
+				},
+			)).Return(processingCmd, nil).Once()
+			commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.Anything).Return(completedCmd, nil).Once()
+			runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+			runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+
+			publisher.EXPECT().Publish(events.CommandStartedTopic, mock.MatchedBy(func(msg *eventbus.Message) bool {
+				return assert.ObjectsAreEqual(events.CommandStartedEvent{Command: processingCmd}, msg.Payload)
+			})).Return().Once()
+			publisher.EXPECT().Publish(tc.expectedTopic, mock.MatchedBy(func(msg *eventbus.Message) bool {
+				return assert.ObjectsAreEqual(tc.expectedEvent(completedCmd), msg.Payload)
+			})).Return().Once()
+
+			err := service.Execute(context.Background(), processingCmd)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_EnterSafeState(t *testing.T) {
+	newSafeStateService := func(t *testing.T, safeLiftPosition uint16, bus *eventbus.InProcEventBus) *service {
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(nil)
+		liftMotorService := liftmotormocks.NewFakeService(t)
+		liftMotorService.EXPECT().Stop(mock.Anything).Return(nil)
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{
+			Recovery: config.Recovery{SafeLiftPosition: safeLiftPosition},
+		}, nil)
+
+		return &service{
+			log:               logging.NewNoopLogger(),
+			subscriber:        bus,
+			configService:     configService,
+			driveMotorService: driveMotorService,
+			liftMotorService:  liftMotorService,
+			driveGuard:        &driveGuard{},
+		}
+	}
+
+	// publishDownDistance publishes the reading until the test ends,
+	// the service subscribes after the motors are stopped.
+	publishDownDistance := func(t *testing.T, bus *eventbus.InProcEventBus, downDistance uint16) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		go func() {
+			for ctx.Err() == nil {
+				bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(events.UpdateDistanceSensorEvent{
+					DownDistance: downDistance,
+				}))
+				time.Sleep(5 * time.Millisecond)
+			}
+		}()
+	}
+
+	t.Run("Should stop the motors and skip the lift check if the safe position is not set", func(t *testing.T) {
+		bus := eventbus.NewInProcEventBus(logging.NewNoopLogger())
+		s := newSafeStateService(t, 0, bus)
+
+		require.NoError(t, s.EnterSafeState(context.Background()))
+	})
+
+	t.Run("Should succeed if the lift has reached the safe position", func(t *testing.T) {
+		bus := eventbus.NewInProcEventBus(logging.NewNoopLogger())
+		s := newSafeStateService(t, 20, bus)
+		publishDownDistance(t, bus, 21)
+
+		require.NoError(t, s.EnterSafeState(context.Background()))
+	})
+
+	t.Run("Should fail if the lift has not reached the safe position", func(t *testing.T) {
+		bus := eventbus.NewInProcEventBus(logging.NewNoopLogger())
+		s := newSafeStateService(t, 20, bus)
+		publishDownDistance(t, bus, 80)
+
+		err := s.EnterSafeState(context.Background())
+		require.ErrorContains(t, err, "has not reached the safe position")
+	})
+
+	t.Run("Should fail if the drive motor can not be stopped", func(t *testing.T) {
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(errors.New("not connected"))
+		s := &service{
+			log:               logging.NewNoopLogger(),
+			driveMotorService: driveMotorService,
+			driveGuard:        &driveGuard{},
+		}
+
+		err := s.EnterSafeState(context.Background())
+		require.ErrorContains(t, err, "failed to stop drive motor")
+	})
 }
 
 func newTestService(
 	log *slog.Logger,
+	configService configsvc.Service,
 	runningCommandRepository command.RunningCommandRepository,
 	commandRepository command.Repository,
 	expectedReturnErr error,
 ) *service {
-	stopMovementExecutor := newFakeExecutor[command.StopMovementInputs, command.StopMovementOutputs](expectedReturnErr)
-	moveBackwardExecutor := newFakeExecutor[command.MoveBackwardInputs, command.MoveBackwardOutputs](expectedReturnErr)
-	moveForwardExecutor := newFakeExecutor[command.MoveForwardInputs, command.MoveForwardOutputs](expectedReturnErr)
-	moveToExecutor := newFakeExecutor[command.MoveToInputs, command.MoveToOutputs](expectedReturnErr)
-
-	cargoOpenExecutor := newFakeExecutor[command.CargoOpenInputs, command.CargoOpenOutputs](expectedReturnErr)
-	cargoCloseExecutor := newFakeExecutor[command.CargoCloseInputs, command.CargoCloseOutputs](expectedReturnErr)
-	cargoLiftExecutor := newFakeExecutor[command.CargoLiftInputs, command.CargoLiftOutputs](expectedReturnErr)
-	cargoLowerExecutor := newFakeExecutor[command.CargoLowerInputs, command.CargoLowerOutputs](expectedReturnErr)
-	cargoCheckQRExecutor := newFakeExecutor[command.CargoCheckQRInputs, command.CargoCheckQROutputs](expectedReturnErr)
-
-	scanLocationExecutor := newFakeExecutor[command.ScanLocationInputs, command.ScanLocationOutputs](expectedReturnErr)
-	waitExecutor := newFakeExecutor[command.WaitInputs, command.WaitOutputs](expectedReturnErr)
-
-	return &service{
+	s := &service{
 		log:                      log,
+		configService:            configService,
 		runningCommandRepository: runningCommandRepository,
 		commandRepository:        commandRepository,
-
-		stopMovementExecutor: stopMovementExecutor,
-		moveBackwardExecutor: moveBackwardExecutor,
-		moveForwardExecutor:  moveForwardExecutor,
-		moveToExecutor:       moveToExecutor,
-
-		cargoOpenExecutor:    cargoOpenExecutor,
-		cargoCloseExecutor:   cargoCloseExecutor,
-		cargoLiftExecutor:    cargoLiftExecutor,
-		cargoLowerExecutor:   cargoLowerExecutor,
-		cargoCheckQRExecutor: cargoCheckQRExecutor,
-
-		scanLocationExecutor: scanLocationExecutor,
-		waitExecutor:         waitExecutor,
-
-		cancelableMap: map[command.CommandType]Cancelable{
-			command.CommandTypeStopMovement: stopMovementExecutor,
-			command.CommandTypeMoveBackward: moveBackwardExecutor,
-			command.CommandTypeMoveForward:  moveForwardExecutor,
-			command.CommandTypeMoveTo:       moveToExecutor,
-
-			command.CommandTypeCargoOpen:    cargoOpenExecutor,
-			command.CommandTypeCargoClose:   cargoCloseExecutor,
-			command.CommandTypeCargoLift:    cargoLiftExecutor,
-			command.CommandTypeCargoLower:   cargoLowerExecutor,
-			command.CommandTypeCargoCheckQR: cargoCheckQRExecutor,
-
-			command.CommandTypeScanLocation: scanLocationExecutor,
-			command.CommandTypeWait:         waitExecutor,
-		},
+		publisher:                &eventbus.NoopEventBus{},
+		registry:                 NewRegistry(),
 	}
+
+	setExecutor(s, newFakeExecutor[command.StopMovementInputs, command.StopMovementOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.MoveBackwardInputs, command.MoveBackwardOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.MoveForwardInputs, command.MoveForwardOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.MoveToInputs, command.MoveToOutputs](expectedReturnErr))
+
+	setExecutor(s, newFakeExecutor[command.CargoOpenInputs, command.CargoOpenOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.CargoCloseInputs, command.CargoCloseOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.CargoLiftInputs, command.CargoLiftOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.CargoLowerInputs, command.CargoLowerOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.CargoCheckQRInputs, command.CargoCheckQROutputs](expectedReturnErr))
+
+	setExecutor(s, newFakeExecutor[command.ScanLocationInputs, command.ScanLocationOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.WaitInputs, command.WaitOutputs](expectedReturnErr))
+	setExecutor(s, newFakeExecutor[command.AssertInputs, command.AssertOutputs](expectedReturnErr))
+
+	return s
+}
+
+// setExecutor sets the executor of a command type, replacing the registered one.
+func setExecutor[I command.Inputs, O command.Outputs](s *service, e CommandExecutor[I, O]) {
+	var inputs I
+	s.registry.executors[inputs.CommandType()] = typedExecutor[I, O]{executor: e}
 }
 
 type fakeExecutor[I command.Inputs, O command.Outputs] struct {
@@ -254,3 +638,39 @@ func (e fakeExecutor[I, O]) Execute(_ context.Context, _ I) (O, error) {
 func (e fakeExecutor[I, O]) OnCancel(_ context.Context) error {
 	return nil
 }
+
+var errFlakyExecutor = errors.New("flaky executor error")
+
+// flakyFakeExecutor fails the first failures executions.
+type flakyFakeExecutor[I command.Inputs, O command.Outputs] struct {
+	failures int
+	calls    int
+	canceled int
+}
+
+func (e *flakyFakeExecutor[I, O]) Execute(_ context.Context, _ I) (O, error) {
+	var zero O
+	e.calls++
+	if e.calls <= e.failures {
+		return zero, errFlakyExecutor
+	}
+	return zero, nil
+}
+
+func (e *flakyFakeExecutor[I, O]) OnCancel(_ context.Context) error {
+	e.canceled++
+	return nil
+}
+
+// blockingFakeExecutor blocks until the context is done.
+type blockingFakeExecutor[I command.Inputs, O command.Outputs] struct{}
+
+func (blockingFakeExecutor[I, O]) Execute(ctx context.Context, _ I) (O, error) {
+	var zero O
+	<-ctx.Done()
+	return zero, ctx.Err()
+}
+
+func (blockingFakeExecutor[I, O]) OnCancel(_ context.Context) error {
+	return nil
+}
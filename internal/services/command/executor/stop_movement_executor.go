@@ -10,17 +10,21 @@ import (
 
 type stopMovementExecutor struct {
 	driveMotorService drivemotor.Service
+	driveGuard        *driveGuard
 }
 
 func newStopMovementExecutor(
 	driveMotorService drivemotor.Service,
+	driveGuard *driveGuard,
 ) CommandExecutor[command.StopMovementInputs, command.StopMovementOutputs] {
 	return stopMovementExecutor{
 		driveMotorService: driveMotorService,
+		driveGuard:        driveGuard,
 	}
 }
 
 func (e stopMovementExecutor) Execute(ctx context.Context, _ command.StopMovementInputs) (command.StopMovementOutputs, error) {
+	e.driveGuard.stop()
 	if err := e.driveMotorService.Stop(ctx); err != nil {
 		return command.StopMovementOutputs{}, fmt.Errorf("failed to stop drive motor: %w", err)
 	}
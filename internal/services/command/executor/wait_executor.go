@@ -5,20 +5,38 @@ import (
 	"time"
 
 	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
-type waitExecutor struct{}
+type waitExecutor struct {
+	// progressInterval is the interval of the progress reports.
+	progressInterval time.Duration
+}
 
 func newWaitExecutor() CommandExecutor[command.WaitInputs, command.WaitOutputs] {
-	return waitExecutor{}
+	return waitExecutor{
+		progressInterval: time.Second,
+	}
 }
 
 func (e waitExecutor) Execute(ctx context.Context, inputs command.WaitInputs) (command.WaitOutputs, error) {
-	select {
-	case <-time.After(time.Duration(inputs.DurationMs) * time.Millisecond):
-		return command.WaitOutputs{}, nil
-	case <-ctx.Done():
-		return command.WaitOutputs{}, ctx.Err()
+	startedAt := time.Now()
+	timer := time.NewTimer(time.Duration(inputs.DurationMs) * time.Millisecond)
+	defer timer.Stop()
+	ticker := time.NewTicker(e.progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			return command.WaitOutputs{}, nil
+		case <-ticker.C:
+			command.ReportProgress(ctx, command.Progress{
+				WaitedMs: ptr.New(time.Since(startedAt).Milliseconds()),
+			})
+		case <-ctx.Done():
+			return command.WaitOutputs{}, ctx.Err()
+		}
 	}
 }
 
@@ -3,6 +3,7 @@ package command
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 var (
@@ -17,37 +18,87 @@ var (
 	_ Inputs = (*CargoCheckQRInputs)(nil)
 	_ Inputs = (*ScanLocationInputs)(nil)
 	_ Inputs = (*WaitInputs)(nil)
+	_ Inputs = (*AssertInputs)(nil)
 )
 
+// Inputs are the inputs of a command type.
+// They are implemented by embedding CommonInputs and defining CommandType.
 type Inputs interface {
 	isInputs()
 	CommandType() CommandType
+	Common() CommonInputs
 }
 
-type StopMovementInputs struct{}
+// IdempotentInputs are the inputs of a command type that reaches the same end state
+// when it is executed again after being interrupted, e.g. MOVE_TO a location.
+// See config.RecoveryPolicyRerunIdempotent.
+type IdempotentInputs interface {
+	Inputs
+	Idempotent()
+}
+
+// IsIdempotent reports whether the inputs are IdempotentInputs.
+func IsIdempotent(inputs Inputs) bool {
+	_, ok := inputs.(IdempotentInputs)
+	return ok
+}
+
+// CommonInputs holds the inputs shared by every command type.
+type CommonInputs struct {
+	// TimeoutMs overrides the default execution timeout configured for the command type.
+	TimeoutMs *int64 `json:"timeout_ms,omitempty" validate:"omitempty,min=1"`
+
+	// Preconditions are checked before the command is executed,
+	// the command fails without being executed if one of them is not met.
+	Preconditions []Condition `json:"preconditions,omitempty" validate:"omitempty,dive"`
+	// AbortMission aborts the rest of the mission if a precondition or an asserted condition
+	// is not met, whatever the on failure policy of the mission is.
+	AbortMission bool `json:"abort_mission,omitempty"`
+}
+
+func (c CommonInputs) Common() CommonInputs {
+	return c
+}
+
+func (CommonInputs) isInputs() {}
+
+// Timeout returns the execution timeout of the command, or zero if not set.
+func (c CommonInputs) Timeout() time.Duration {
+	if c.TimeoutMs == nil {
+		return 0
+	}
+	return time.Duration(*c.TimeoutMs) * time.Millisecond
+}
+
+type StopMovementInputs struct {
+	CommonInputs
+}
 
 func (StopMovementInputs) CommandType() CommandType {
 	return CommandTypeStopMovement
 }
-func (StopMovementInputs) isInputs() {}
+
+func (StopMovementInputs) Idempotent() {}
 
 type MoveForwardInputs struct {
+	CommonInputs
+
 	MotorSpeed uint8 `json:"motor_speed" validate:"required,max=100"`
 }
 
 func (MoveForwardInputs) CommandType() CommandType {
 	return CommandTypeMoveForward
 }
-func (MoveForwardInputs) isInputs() {}
 
 type MoveBackwardInputs struct {
+	CommonInputs
+
 	MotorSpeed uint8 `json:"motor_speed" validate:"required,max=100"`
 }
 
 func (MoveBackwardInputs) CommandType() CommandType {
 	return CommandTypeMoveBackward
 }
-func (MoveBackwardInputs) isInputs() {}
 
 type MoveDirection string
 
@@ -68,35 +119,59 @@ const (
 )
 
 type MoveToInputs struct {
-	Location   string        `json:"location" validate:"required"`
-	Direction  MoveDirection `json:"direction" validate:"required,enum"`
+	CommonInputs
+
+	// Location is the target location or the station alias of a rail map tag.
+	Location string `json:"location" validate:"required"`
+	// Direction is the direction of travel.
+	// If empty, the direction of the shortest path in the rail map is used.
+	Direction  MoveDirection `json:"direction" validate:"omitempty,enum"`
 	MotorSpeed uint8         `json:"motor_speed" validate:"required,max=100"`
+
+	// ApproachLocations are the locations where the robot starts slowing down to ApproachSpeed.
+	// If empty, the location before the target in the rail map is used.
+	ApproachLocations []string `json:"approach_locations,omitempty" validate:"omitempty,dive,required"`
+	// ApproachSpeed overrides the approach speed of the move config.
+	ApproachSpeed *uint8 `json:"approach_speed,omitempty" validate:"omitempty,max=100"`
+	// AccelerationRampMs overrides the acceleration ramp of the move config.
+	AccelerationRampMs *int64 `json:"acceleration_ramp_ms,omitempty" validate:"omitempty,min=0"`
+	// DecelerationRampMs overrides the deceleration ramp of the move config.
+	DecelerationRampMs *int64 `json:"deceleration_ramp_ms,omitempty" validate:"omitempty,min=0"`
 }
 
 func (MoveToInputs) CommandType() CommandType {
 	return CommandTypeMoveTo
 }
-func (MoveToInputs) isInputs() {}
+
+func (MoveToInputs) Idempotent() {}
 
 type CargoOpenInputs struct {
+	CommonInputs
+
 	MotorSpeed uint8 `json:"motor_speed" validate:"required,max=100"`
 }
 
 func (CargoOpenInputs) CommandType() CommandType {
 	return CommandTypeCargoOpen
 }
-func (CargoOpenInputs) isInputs() {}
+
+func (CargoOpenInputs) Idempotent() {}
 
 type CargoCloseInputs struct {
+	CommonInputs
+
 	MotorSpeed uint8 `json:"motor_speed" validate:"required,max=100"`
 }
 
 func (CargoCloseInputs) CommandType() CommandType {
 	return CommandTypeCargoClose
 }
-func (CargoCloseInputs) isInputs() {}
+
+func (CargoCloseInputs) Idempotent() {}
 
 type CargoLiftInputs struct {
+	CommonInputs
+
 	Position   uint16 `json:"position" validate:"required"`
 	MotorSpeed uint8  `json:"motor_speed" validate:"required,max=100"`
 }
@@ -104,9 +179,12 @@ type CargoLiftInputs struct {
 func (CargoLiftInputs) CommandType() CommandType {
 	return CommandTypeCargoLift
 }
-func (CargoLiftInputs) isInputs() {}
+
+func (CargoLiftInputs) Idempotent() {}
 
 type CargoLowerInputs struct {
+	CommonInputs
+
 	Position   uint16 `json:"position" validate:"required"`
 	MotorSpeed uint8  `json:"motor_speed" validate:"required,max=100"`
 }
@@ -114,117 +192,90 @@ type CargoLowerInputs struct {
 func (CargoLowerInputs) CommandType() CommandType {
 	return CommandTypeCargoLower
 }
-func (CargoLowerInputs) isInputs() {}
+
+func (CargoLowerInputs) Idempotent() {}
 
 type CargoCheckQRInputs struct {
+	CommonInputs
+
 	QRCode string `json:"qr_code" validate:"required"`
 }
 
 func (CargoCheckQRInputs) CommandType() CommandType {
 	return CommandTypeCargoCheckQR
 }
-func (CargoCheckQRInputs) isInputs() {}
 
-type ScanLocationInputs struct{}
+func (CargoCheckQRInputs) Idempotent() {}
+
+type ScanLocationInputs struct {
+	CommonInputs
+}
 
 func (ScanLocationInputs) CommandType() CommandType {
 	return CommandTypeScanLocation
 }
-func (ScanLocationInputs) isInputs() {}
+
+func (ScanLocationInputs) Idempotent() {}
 
 type WaitInputs struct {
+	CommonInputs
+
 	DurationMs int64 `json:"duration_ms" validate:"required"`
 }
 
 func (WaitInputs) CommandType() CommandType {
 	return CommandTypeWait
 }
-func (WaitInputs) isInputs() {}
+
+func (WaitInputs) Idempotent() {}
+
+type AssertInputs struct {
+	CommonInputs
+
+	// Conditions must all be met for the command to succeed.
+	Conditions []Condition `json:"conditions" validate:"required,min=1,dive"`
+}
+
+func (AssertInputs) CommandType() CommandType {
+	return CommandTypeAssert
+}
+
+func (AssertInputs) Idempotent() {}
 
 func UnmarshalInputs(cmdType CommandType, inputsBytes []byte) (Inputs, error) {
-	var inputs Inputs
-
-	switch cmdType {
-	case CommandTypeStopMovement:
-		i := &StopMovementInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal stop movement inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeMoveForward:
-		i := &MoveForwardInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal move forward inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeMoveBackward:
-		i := &MoveBackwardInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal move backward inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeMoveTo:
-		i := &MoveToInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal move to inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeCargoOpen:
-		i := &CargoOpenInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal cargo open inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeCargoClose:
-		i := &CargoCloseInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal cargo close inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeCargoLift:
-		i := &CargoLiftInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal cargo lift inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeCargoLower:
-		i := &CargoLowerInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal cargo lower inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeCargoCheckQR:
-		i := &CargoCheckQRInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal cargo check qr inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeScanLocation:
-		i := &ScanLocationInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal scan location inputs: %w", err)
-		}
-		inputs = i
-
-	case CommandTypeWait:
-		i := &WaitInputs{}
-		if err := json.Unmarshal(inputsBytes, i); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal wait inputs: %w", err)
-		}
-		inputs = i
-
-	default:
+	def, ok := LookupType(cmdType)
+	if !ok {
 		return nil, fmt.Errorf("invalid command type: %s", cmdType)
 	}
 
+	inputs := def.NewInputs()
+	if err := json.Unmarshal(inputsBytes, inputs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s inputs: %w", cmdType, err)
+	}
+
 	return inputs, nil
 }
+
+// WithCommonInputs returns a copy of the inputs with the common fields replaced.
+// Only the non-empty common fields are applied.
+func WithCommonInputs(inputs Inputs, common CommonInputs) (Inputs, error) {
+	inputsBytes, err := json.Marshal(inputs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal inputs: %w", err)
+	}
+
+	i, err := UnmarshalInputs(inputs.CommandType(), inputsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	commonBytes, err := json.Marshal(common)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal common inputs: %w", err)
+	}
+	if err := json.Unmarshal(commonBytes, i); err != nil {
+		return nil, fmt.Errorf("failed to apply common inputs: %w", err)
+	}
+
+	return i, nil
+}
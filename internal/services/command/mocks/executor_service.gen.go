@@ -23,6 +23,52 @@ func (_m *FakeExecutorService) EXPECT() *FakeExecutorService_Expecter {
 	return &FakeExecutorService_Expecter{mock: &_m.Mock}
 }
 
+// EnterSafeState provides a mock function with given fields: ctx
+func (_m *FakeExecutorService) EnterSafeState(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnterSafeState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeExecutorService_EnterSafeState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnterSafeState'
+type FakeExecutorService_EnterSafeState_Call struct {
+	*mock.Call
+}
+
+// EnterSafeState is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeExecutorService_Expecter) EnterSafeState(ctx interface{}) *FakeExecutorService_EnterSafeState_Call {
+	return &FakeExecutorService_EnterSafeState_Call{Call: _e.mock.On("EnterSafeState", ctx)}
+}
+
+func (_c *FakeExecutorService_EnterSafeState_Call) Run(run func(ctx context.Context)) *FakeExecutorService_EnterSafeState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeExecutorService_EnterSafeState_Call) Return(_a0 error) *FakeExecutorService_EnterSafeState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeExecutorService_EnterSafeState_Call) RunAndReturn(run func(context.Context) error) *FakeExecutorService_EnterSafeState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Execute provides a mock function with given fields: ctx, cmd
 func (_m *FakeExecutorService) Execute(ctx context.Context, cmd command.Command) error {
 	ret := _m.Called(ctx, cmd)
@@ -70,6 +116,63 @@ func (_c *FakeExecutorService_Execute_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Plan provides a mock function with given fields: ctx, cmds
+func (_m *FakeExecutorService) Plan(ctx context.Context, cmds []command.Command) (command.Plan, error) {
+	ret := _m.Called(ctx, cmds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plan")
+	}
+
+	var r0 command.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []command.Command) (command.Plan, error)); ok {
+		return rf(ctx, cmds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []command.Command) command.Plan); ok {
+		r0 = rf(ctx, cmds)
+	} else {
+		r0 = ret.Get(0).(command.Plan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []command.Command) error); ok {
+		r1 = rf(ctx, cmds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeExecutorService_Plan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Plan'
+type FakeExecutorService_Plan_Call struct {
+	*mock.Call
+}
+
+// Plan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmds []command.Command
+func (_e *FakeExecutorService_Expecter) Plan(ctx interface{}, cmds interface{}) *FakeExecutorService_Plan_Call {
+	return &FakeExecutorService_Plan_Call{Call: _e.mock.On("Plan", ctx, cmds)}
+}
+
+func (_c *FakeExecutorService_Plan_Call) Run(run func(ctx context.Context, cmds []command.Command)) *FakeExecutorService_Plan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]command.Command))
+	})
+	return _c
+}
+
+func (_c *FakeExecutorService_Plan_Call) Return(_a0 command.Plan, _a1 error) *FakeExecutorService_Plan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeExecutorService_Plan_Call) RunAndReturn(run func(context.Context, []command.Command) (command.Plan, error)) *FakeExecutorService_Plan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFakeExecutorService creates a new instance of FakeExecutorService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFakeExecutorService(t interface {
@@ -21,6 +21,115 @@ func (_m *FakeProcessingLock) EXPECT() *FakeProcessingLock_Expecter {
 	return &FakeProcessingLock_Expecter{mock: &_m.Mock}
 }
 
+// IsPaused provides a mock function with no fields
+func (_m *FakeProcessingLock) IsPaused() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsPaused")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FakeProcessingLock_IsPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPaused'
+type FakeProcessingLock_IsPaused_Call struct {
+	*mock.Call
+}
+
+// IsPaused is a helper method to define mock.On call
+func (_e *FakeProcessingLock_Expecter) IsPaused() *FakeProcessingLock_IsPaused_Call {
+	return &FakeProcessingLock_IsPaused_Call{Call: _e.mock.On("IsPaused")}
+}
+
+func (_c *FakeProcessingLock_IsPaused_Call) Run(run func()) *FakeProcessingLock_IsPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FakeProcessingLock_IsPaused_Call) Return(_a0 bool) *FakeProcessingLock_IsPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeProcessingLock_IsPaused_Call) RunAndReturn(run func() bool) *FakeProcessingLock_IsPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pause provides a mock function with no fields
+func (_m *FakeProcessingLock) Pause() {
+	_m.Called()
+}
+
+// FakeProcessingLock_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type FakeProcessingLock_Pause_Call struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+func (_e *FakeProcessingLock_Expecter) Pause() *FakeProcessingLock_Pause_Call {
+	return &FakeProcessingLock_Pause_Call{Call: _e.mock.On("Pause")}
+}
+
+func (_c *FakeProcessingLock_Pause_Call) Run(run func()) *FakeProcessingLock_Pause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FakeProcessingLock_Pause_Call) Return() *FakeProcessingLock_Pause_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FakeProcessingLock_Pause_Call) RunAndReturn(run func()) *FakeProcessingLock_Pause_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Resume provides a mock function with no fields
+func (_m *FakeProcessingLock) Resume() {
+	_m.Called()
+}
+
+// FakeProcessingLock_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type FakeProcessingLock_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+func (_e *FakeProcessingLock_Expecter) Resume() *FakeProcessingLock_Resume_Call {
+	return &FakeProcessingLock_Resume_Call{Call: _e.mock.On("Resume")}
+}
+
+func (_c *FakeProcessingLock_Resume_Call) Run(run func()) *FakeProcessingLock_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FakeProcessingLock_Resume_Call) Return() *FakeProcessingLock_Resume_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FakeProcessingLock_Resume_Call) RunAndReturn(run func()) *FakeProcessingLock_Resume_Call {
+	_c.Run(run)
+	return _c
+}
+
 // WaitUntilUnlocked provides a mock function with given fields: ctx
 func (_m *FakeProcessingLock) WaitUntilUnlocked(ctx context.Context) error {
 	ret := _m.Called(ctx)
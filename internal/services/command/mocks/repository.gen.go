@@ -119,6 +119,64 @@ func (_c *FakeRepository_CancelQueuedAndProcessingCommandsCreatedByCloud_Call) R
 	return _c
 }
 
+// CancelQueuedCommand provides a mock function with given fields: ctx, id, canceledAt
+func (_m *FakeRepository) CancelQueuedCommand(ctx context.Context, id int64, canceledAt time.Time) (command.Command, error) {
+	ret := _m.Called(ctx, id, canceledAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelQueuedCommand")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (command.Command, error)); ok {
+		return rf(ctx, id, canceledAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) command.Command); ok {
+		r0 = rf(ctx, id, canceledAt)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, id, canceledAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_CancelQueuedCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelQueuedCommand'
+type FakeRepository_CancelQueuedCommand_Call struct {
+	*mock.Call
+}
+
+// CancelQueuedCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - canceledAt time.Time
+func (_e *FakeRepository_Expecter) CancelQueuedCommand(ctx interface{}, id interface{}, canceledAt interface{}) *FakeRepository_CancelQueuedCommand_Call {
+	return &FakeRepository_CancelQueuedCommand_Call{Call: _e.mock.On("CancelQueuedCommand", ctx, id, canceledAt)}
+}
+
+func (_c *FakeRepository_CancelQueuedCommand_Call) Run(run func(ctx context.Context, id int64, canceledAt time.Time)) *FakeRepository_CancelQueuedCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_CancelQueuedCommand_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_CancelQueuedCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_CancelQueuedCommand_Call) RunAndReturn(run func(context.Context, int64, time.Time) (command.Command, error)) *FakeRepository_CancelQueuedCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCommand provides a mock function with given fields: ctx, _a1
 func (_m *FakeRepository) CreateCommand(ctx context.Context, _a1 command.Command) (command.Command, error) {
 	ret := _m.Called(ctx, _a1)
@@ -176,6 +234,122 @@ func (_c *FakeRepository_CreateCommand_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// CreateCommandAtHead provides a mock function with given fields: ctx, _a1
+func (_m *FakeRepository) CreateCommandAtHead(ctx context.Context, _a1 command.Command) (command.Command, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCommandAtHead")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.Command) (command.Command, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.Command) command.Command); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.Command) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_CreateCommandAtHead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommandAtHead'
+type FakeRepository_CreateCommandAtHead_Call struct {
+	*mock.Call
+}
+
+// CreateCommandAtHead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 command.Command
+func (_e *FakeRepository_Expecter) CreateCommandAtHead(ctx interface{}, _a1 interface{}) *FakeRepository_CreateCommandAtHead_Call {
+	return &FakeRepository_CreateCommandAtHead_Call{Call: _e.mock.On("CreateCommandAtHead", ctx, _a1)}
+}
+
+func (_c *FakeRepository_CreateCommandAtHead_Call) Run(run func(ctx context.Context, _a1 command.Command)) *FakeRepository_CreateCommandAtHead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.Command))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_CreateCommandAtHead_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_CreateCommandAtHead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_CreateCommandAtHead_Call) RunAndReturn(run func(context.Context, command.Command) (command.Command, error)) *FakeRepository_CreateCommandAtHead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCommands provides a mock function with given fields: ctx, commands
+func (_m *FakeRepository) CreateCommands(ctx context.Context, commands []command.Command) ([]command.Command, error) {
+	ret := _m.Called(ctx, commands)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCommands")
+	}
+
+	var r0 []command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []command.Command) ([]command.Command, error)); ok {
+		return rf(ctx, commands)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []command.Command) []command.Command); ok {
+		r0 = rf(ctx, commands)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]command.Command)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []command.Command) error); ok {
+		r1 = rf(ctx, commands)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_CreateCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommands'
+type FakeRepository_CreateCommands_Call struct {
+	*mock.Call
+}
+
+// CreateCommands is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commands []command.Command
+func (_e *FakeRepository_Expecter) CreateCommands(ctx interface{}, commands interface{}) *FakeRepository_CreateCommands_Call {
+	return &FakeRepository_CreateCommands_Call{Call: _e.mock.On("CreateCommands", ctx, commands)}
+}
+
+func (_c *FakeRepository_CreateCommands_Call) Run(run func(ctx context.Context, commands []command.Command)) *FakeRepository_CreateCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]command.Command))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_CreateCommands_Call) Return(_a0 []command.Command, _a1 error) *FakeRepository_CreateCommands_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_CreateCommands_Call) RunAndReturn(run func(context.Context, []command.Command) ([]command.Command, error)) *FakeRepository_CreateCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteCommandByID provides a mock function with given fields: ctx, id
 func (_m *FakeRepository) DeleteCommandByID(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -327,6 +501,120 @@ func (_c *FakeRepository_GetCommandByID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetCommandByRequestID provides a mock function with given fields: ctx, requestID
+func (_m *FakeRepository) GetCommandByRequestID(ctx context.Context, requestID string) (command.Command, error) {
+	ret := _m.Called(ctx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommandByRequestID")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (command.Command, error)); ok {
+		return rf(ctx, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) command.Command); ok {
+		r0 = rf(ctx, requestID)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_GetCommandByRequestID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommandByRequestID'
+type FakeRepository_GetCommandByRequestID_Call struct {
+	*mock.Call
+}
+
+// GetCommandByRequestID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestID string
+func (_e *FakeRepository_Expecter) GetCommandByRequestID(ctx interface{}, requestID interface{}) *FakeRepository_GetCommandByRequestID_Call {
+	return &FakeRepository_GetCommandByRequestID_Call{Call: _e.mock.On("GetCommandByRequestID", ctx, requestID)}
+}
+
+func (_c *FakeRepository_GetCommandByRequestID_Call) Run(run func(ctx context.Context, requestID string)) *FakeRepository_GetCommandByRequestID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_GetCommandByRequestID_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_GetCommandByRequestID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_GetCommandByRequestID_Call) RunAndReturn(run func(context.Context, string) (command.Command, error)) *FakeRepository_GetCommandByRequestID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCommandStats provides a mock function with given fields: ctx, filter
+func (_m *FakeRepository) GetCommandStats(ctx context.Context, filter command.CommandFilter) (command.CommandStats, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommandStats")
+	}
+
+	var r0 command.CommandStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CommandFilter) (command.CommandStats, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.CommandFilter) command.CommandStats); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(command.CommandStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.CommandFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_GetCommandStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommandStats'
+type FakeRepository_GetCommandStats_Call struct {
+	*mock.Call
+}
+
+// GetCommandStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter command.CommandFilter
+func (_e *FakeRepository_Expecter) GetCommandStats(ctx interface{}, filter interface{}) *FakeRepository_GetCommandStats_Call {
+	return &FakeRepository_GetCommandStats_Call{Call: _e.mock.On("GetCommandStats", ctx, filter)}
+}
+
+func (_c *FakeRepository_GetCommandStats_Call) Run(run func(ctx context.Context, filter command.CommandFilter)) *FakeRepository_GetCommandStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CommandFilter))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_GetCommandStats_Call) Return(_a0 command.CommandStats, _a1 error) *FakeRepository_GetCommandStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_GetCommandStats_Call) RunAndReturn(run func(context.Context, command.CommandFilter) (command.CommandStats, error)) *FakeRepository_GetCommandStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCurrentProcessingCommand provides a mock function with given fields: ctx
 func (_m *FakeRepository) GetCurrentProcessingCommand(ctx context.Context) (command.Command, error) {
 	ret := _m.Called(ctx)
@@ -383,9 +671,9 @@ func (_c *FakeRepository_GetCurrentProcessingCommand_Call) RunAndReturn(run func
 	return _c
 }
 
-// GetNextExecutableCommand provides a mock function with given fields: ctx
-func (_m *FakeRepository) GetNextExecutableCommand(ctx context.Context) (command.Command, error) {
-	ret := _m.Called(ctx)
+// GetNextExecutableCommand provides a mock function with given fields: ctx, now
+func (_m *FakeRepository) GetNextExecutableCommand(ctx context.Context, now time.Time) (command.Command, error) {
+	ret := _m.Called(ctx, now)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNextExecutableCommand")
@@ -393,17 +681,17 @@ func (_m *FakeRepository) GetNextExecutableCommand(ctx context.Context) (command
 
 	var r0 command.Command
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (command.Command, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (command.Command, error)); ok {
+		return rf(ctx, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) command.Command); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) command.Command); ok {
+		r0 = rf(ctx, now)
 	} else {
 		r0 = ret.Get(0).(command.Command)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -418,13 +706,14 @@ type FakeRepository_GetNextExecutableCommand_Call struct {
 
 // GetNextExecutableCommand is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *FakeRepository_Expecter) GetNextExecutableCommand(ctx interface{}) *FakeRepository_GetNextExecutableCommand_Call {
-	return &FakeRepository_GetNextExecutableCommand_Call{Call: _e.mock.On("GetNextExecutableCommand", ctx)}
+//   - now time.Time
+func (_e *FakeRepository_Expecter) GetNextExecutableCommand(ctx interface{}, now interface{}) *FakeRepository_GetNextExecutableCommand_Call {
+	return &FakeRepository_GetNextExecutableCommand_Call{Call: _e.mock.On("GetNextExecutableCommand", ctx, now)}
 }
 
-func (_c *FakeRepository_GetNextExecutableCommand_Call) Run(run func(ctx context.Context)) *FakeRepository_GetNextExecutableCommand_Call {
+func (_c *FakeRepository_GetNextExecutableCommand_Call) Run(run func(ctx context.Context, now time.Time)) *FakeRepository_GetNextExecutableCommand_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(time.Time))
 	})
 	return _c
 }
@@ -434,7 +723,66 @@ func (_c *FakeRepository_GetNextExecutableCommand_Call) Return(_a0 command.Comma
 	return _c
 }
 
-func (_c *FakeRepository_GetNextExecutableCommand_Call) RunAndReturn(run func(context.Context) (command.Command, error)) *FakeRepository_GetNextExecutableCommand_Call {
+func (_c *FakeRepository_GetNextExecutableCommand_Call) RunAndReturn(run func(context.Context, time.Time) (command.Command, error)) *FakeRepository_GetNextExecutableCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertCommand provides a mock function with given fields: ctx, _a1, anchorID, after
+func (_m *FakeRepository) InsertCommand(ctx context.Context, _a1 command.Command, anchorID int64, after bool) (command.Command, error) {
+	ret := _m.Called(ctx, _a1, anchorID, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertCommand")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.Command, int64, bool) (command.Command, error)); ok {
+		return rf(ctx, _a1, anchorID, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.Command, int64, bool) command.Command); ok {
+		r0 = rf(ctx, _a1, anchorID, after)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.Command, int64, bool) error); ok {
+		r1 = rf(ctx, _a1, anchorID, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_InsertCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCommand'
+type FakeRepository_InsertCommand_Call struct {
+	*mock.Call
+}
+
+// InsertCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 command.Command
+//   - anchorID int64
+//   - after bool
+func (_e *FakeRepository_Expecter) InsertCommand(ctx interface{}, _a1 interface{}, anchorID interface{}, after interface{}) *FakeRepository_InsertCommand_Call {
+	return &FakeRepository_InsertCommand_Call{Call: _e.mock.On("InsertCommand", ctx, _a1, anchorID, after)}
+}
+
+func (_c *FakeRepository_InsertCommand_Call) Run(run func(ctx context.Context, _a1 command.Command, anchorID int64, after bool)) *FakeRepository_InsertCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.Command), args[2].(int64), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_InsertCommand_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_InsertCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_InsertCommand_Call) RunAndReturn(run func(context.Context, command.Command, int64, bool) (command.Command, error)) *FakeRepository_InsertCommand_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -496,6 +844,184 @@ func (_c *FakeRepository_ListCommands_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ListCommandsAfter provides a mock function with given fields: ctx, filter, afterID, limit
+func (_m *FakeRepository) ListCommandsAfter(ctx context.Context, filter command.CommandFilter, afterID int64, limit int) ([]command.Command, error) {
+	ret := _m.Called(ctx, filter, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommandsAfter")
+	}
+
+	var r0 []command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CommandFilter, int64, int) ([]command.Command, error)); ok {
+		return rf(ctx, filter, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.CommandFilter, int64, int) []command.Command); ok {
+		r0 = rf(ctx, filter, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]command.Command)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.CommandFilter, int64, int) error); ok {
+		r1 = rf(ctx, filter, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_ListCommandsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCommandsAfter'
+type FakeRepository_ListCommandsAfter_Call struct {
+	*mock.Call
+}
+
+// ListCommandsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter command.CommandFilter
+//   - afterID int64
+//   - limit int
+func (_e *FakeRepository_Expecter) ListCommandsAfter(ctx interface{}, filter interface{}, afterID interface{}, limit interface{}) *FakeRepository_ListCommandsAfter_Call {
+	return &FakeRepository_ListCommandsAfter_Call{Call: _e.mock.On("ListCommandsAfter", ctx, filter, afterID, limit)}
+}
+
+func (_c *FakeRepository_ListCommandsAfter_Call) Run(run func(ctx context.Context, filter command.CommandFilter, afterID int64, limit int)) *FakeRepository_ListCommandsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CommandFilter), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_ListCommandsAfter_Call) Return(_a0 []command.Command, _a1 error) *FakeRepository_ListCommandsAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_ListCommandsAfter_Call) RunAndReturn(run func(context.Context, command.CommandFilter, int64, int) ([]command.Command, error)) *FakeRepository_ListCommandsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInterruptedCommands provides a mock function with given fields: ctx
+func (_m *FakeRepository) ListInterruptedCommands(ctx context.Context) ([]command.Command, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInterruptedCommands")
+	}
+
+	var r0 []command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]command.Command, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []command.Command); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]command.Command)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_ListInterruptedCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInterruptedCommands'
+type FakeRepository_ListInterruptedCommands_Call struct {
+	*mock.Call
+}
+
+// ListInterruptedCommands is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeRepository_Expecter) ListInterruptedCommands(ctx interface{}) *FakeRepository_ListInterruptedCommands_Call {
+	return &FakeRepository_ListInterruptedCommands_Call{Call: _e.mock.On("ListInterruptedCommands", ctx)}
+}
+
+func (_c *FakeRepository_ListInterruptedCommands_Call) Run(run func(ctx context.Context)) *FakeRepository_ListInterruptedCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_ListInterruptedCommands_Call) Return(_a0 []command.Command, _a1 error) *FakeRepository_ListInterruptedCommands_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_ListInterruptedCommands_Call) RunAndReturn(run func(context.Context) ([]command.Command, error)) *FakeRepository_ListInterruptedCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveQueuedCommand provides a mock function with given fields: ctx, id, position, updatedAt
+func (_m *FakeRepository) MoveQueuedCommand(ctx context.Context, id int64, position int, updatedAt time.Time) (command.Command, error) {
+	ret := _m.Called(ctx, id, position, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveQueuedCommand")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, time.Time) (command.Command, error)); ok {
+		return rf(ctx, id, position, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, time.Time) command.Command); ok {
+		r0 = rf(ctx, id, position, updatedAt)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, time.Time) error); ok {
+		r1 = rf(ctx, id, position, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_MoveQueuedCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveQueuedCommand'
+type FakeRepository_MoveQueuedCommand_Call struct {
+	*mock.Call
+}
+
+// MoveQueuedCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - position int
+//   - updatedAt time.Time
+func (_e *FakeRepository_Expecter) MoveQueuedCommand(ctx interface{}, id interface{}, position interface{}, updatedAt interface{}) *FakeRepository_MoveQueuedCommand_Call {
+	return &FakeRepository_MoveQueuedCommand_Call{Call: _e.mock.On("MoveQueuedCommand", ctx, id, position, updatedAt)}
+}
+
+func (_c *FakeRepository_MoveQueuedCommand_Call) Run(run func(ctx context.Context, id int64, position int, updatedAt time.Time)) *FakeRepository_MoveQueuedCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_MoveQueuedCommand_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_MoveQueuedCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_MoveQueuedCommand_Call) RunAndReturn(run func(context.Context, int64, int, time.Time) (command.Command, error)) *FakeRepository_MoveQueuedCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCommand provides a mock function with given fields: ctx, params
 func (_m *FakeRepository) UpdateCommand(ctx context.Context, params command.UpdateCommandParams) (command.Command, error) {
 	ret := _m.Called(ctx, params)
@@ -553,6 +1079,65 @@ func (_c *FakeRepository_UpdateCommand_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateQueuedCommandInputs provides a mock function with given fields: ctx, id, inputs, updatedAt
+func (_m *FakeRepository) UpdateQueuedCommandInputs(ctx context.Context, id int64, inputs command.Inputs, updatedAt time.Time) (command.Command, error) {
+	ret := _m.Called(ctx, id, inputs, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQueuedCommandInputs")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, command.Inputs, time.Time) (command.Command, error)); ok {
+		return rf(ctx, id, inputs, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, command.Inputs, time.Time) command.Command); ok {
+		r0 = rf(ctx, id, inputs, updatedAt)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, command.Inputs, time.Time) error); ok {
+		r1 = rf(ctx, id, inputs, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_UpdateQueuedCommandInputs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQueuedCommandInputs'
+type FakeRepository_UpdateQueuedCommandInputs_Call struct {
+	*mock.Call
+}
+
+// UpdateQueuedCommandInputs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - inputs command.Inputs
+//   - updatedAt time.Time
+func (_e *FakeRepository_Expecter) UpdateQueuedCommandInputs(ctx interface{}, id interface{}, inputs interface{}, updatedAt interface{}) *FakeRepository_UpdateQueuedCommandInputs_Call {
+	return &FakeRepository_UpdateQueuedCommandInputs_Call{Call: _e.mock.On("UpdateQueuedCommandInputs", ctx, id, inputs, updatedAt)}
+}
+
+func (_c *FakeRepository_UpdateQueuedCommandInputs_Call) Run(run func(ctx context.Context, id int64, inputs command.Inputs, updatedAt time.Time)) *FakeRepository_UpdateQueuedCommandInputs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(command.Inputs), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_UpdateQueuedCommandInputs_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_UpdateQueuedCommandInputs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_UpdateQueuedCommandInputs_Call) RunAndReturn(run func(context.Context, int64, command.Inputs, time.Time) (command.Command, error)) *FakeRepository_UpdateQueuedCommandInputs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFakeRepository creates a new instance of FakeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFakeRepository(t interface {
@@ -126,6 +126,53 @@ func (_c *FakeRunningCommandRepository_Get_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// MarkCanceling provides a mock function with given fields: ctx, cmdID
+func (_m *FakeRunningCommandRepository) MarkCanceling(ctx context.Context, cmdID int64) error {
+	ret := _m.Called(ctx, cmdID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCanceling")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, cmdID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeRunningCommandRepository_MarkCanceling_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCanceling'
+type FakeRunningCommandRepository_MarkCanceling_Call struct {
+	*mock.Call
+}
+
+// MarkCanceling is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmdID int64
+func (_e *FakeRunningCommandRepository_Expecter) MarkCanceling(ctx interface{}, cmdID interface{}) *FakeRunningCommandRepository_MarkCanceling_Call {
+	return &FakeRunningCommandRepository_MarkCanceling_Call{Call: _e.mock.On("MarkCanceling", ctx, cmdID)}
+}
+
+func (_c *FakeRunningCommandRepository_MarkCanceling_Call) Run(run func(ctx context.Context, cmdID int64)) *FakeRunningCommandRepository_MarkCanceling_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_MarkCanceling_Call) Return(_a0 error) *FakeRunningCommandRepository_MarkCanceling_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_MarkCanceling_Call) RunAndReturn(run func(context.Context, int64) error) *FakeRunningCommandRepository_MarkCanceling_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Remove provides a mock function with given fields: ctx
 func (_m *FakeRunningCommandRepository) Remove(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -172,6 +219,100 @@ func (_c *FakeRunningCommandRepository_Remove_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// SetPausedForObstacle provides a mock function with given fields: ctx, paused
+func (_m *FakeRunningCommandRepository) SetPausedForObstacle(ctx context.Context, paused bool) error {
+	ret := _m.Called(ctx, paused)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPausedForObstacle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) error); ok {
+		r0 = rf(ctx, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeRunningCommandRepository_SetPausedForObstacle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPausedForObstacle'
+type FakeRunningCommandRepository_SetPausedForObstacle_Call struct {
+	*mock.Call
+}
+
+// SetPausedForObstacle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paused bool
+func (_e *FakeRunningCommandRepository_Expecter) SetPausedForObstacle(ctx interface{}, paused interface{}) *FakeRunningCommandRepository_SetPausedForObstacle_Call {
+	return &FakeRunningCommandRepository_SetPausedForObstacle_Call{Call: _e.mock.On("SetPausedForObstacle", ctx, paused)}
+}
+
+func (_c *FakeRunningCommandRepository_SetPausedForObstacle_Call) Run(run func(ctx context.Context, paused bool)) *FakeRunningCommandRepository_SetPausedForObstacle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_SetPausedForObstacle_Call) Return(_a0 error) *FakeRunningCommandRepository_SetPausedForObstacle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_SetPausedForObstacle_Call) RunAndReturn(run func(context.Context, bool) error) *FakeRunningCommandRepository_SetPausedForObstacle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProgress provides a mock function with given fields: ctx, progress
+func (_m *FakeRunningCommandRepository) SetProgress(ctx context.Context, progress command.Progress) error {
+	ret := _m.Called(ctx, progress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.Progress) error); ok {
+		r0 = rf(ctx, progress)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeRunningCommandRepository_SetProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProgress'
+type FakeRunningCommandRepository_SetProgress_Call struct {
+	*mock.Call
+}
+
+// SetProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - progress command.Progress
+func (_e *FakeRunningCommandRepository_Expecter) SetProgress(ctx interface{}, progress interface{}) *FakeRunningCommandRepository_SetProgress_Call {
+	return &FakeRunningCommandRepository_SetProgress_Call{Call: _e.mock.On("SetProgress", ctx, progress)}
+}
+
+func (_c *FakeRunningCommandRepository_SetProgress_Call) Run(run func(ctx context.Context, progress command.Progress)) *FakeRunningCommandRepository_SetProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.Progress))
+	})
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_SetProgress_Call) Return(_a0 error) *FakeRunningCommandRepository_SetProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_SetProgress_Call) RunAndReturn(run func(context.Context, command.Progress) error) *FakeRunningCommandRepository_SetProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, cmd
 func (_m *FakeRunningCommandRepository) Update(ctx context.Context, cmd command.CancelableCommand) error {
 	ret := _m.Called(ctx, cmd)
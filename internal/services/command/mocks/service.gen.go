@@ -117,6 +117,100 @@ func (_c *FakeService_CancelAllRunningCommands_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// CancelCommandByID provides a mock function with given fields: ctx, params
+func (_m *FakeService) CancelCommandByID(ctx context.Context, params command.CancelCommandByIDParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelCommandByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CancelCommandByIDParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_CancelCommandByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCommandByID'
+type FakeService_CancelCommandByID_Call struct {
+	*mock.Call
+}
+
+// CancelCommandByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CancelCommandByIDParams
+func (_e *FakeService_Expecter) CancelCommandByID(ctx interface{}, params interface{}) *FakeService_CancelCommandByID_Call {
+	return &FakeService_CancelCommandByID_Call{Call: _e.mock.On("CancelCommandByID", ctx, params)}
+}
+
+func (_c *FakeService_CancelCommandByID_Call) Run(run func(ctx context.Context, params command.CancelCommandByIDParams)) *FakeService_CancelCommandByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CancelCommandByIDParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CancelCommandByID_Call) Return(_a0 error) *FakeService_CancelCommandByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_CancelCommandByID_Call) RunAndReturn(run func(context.Context, command.CancelCommandByIDParams) error) *FakeService_CancelCommandByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelCommandByRequestID provides a mock function with given fields: ctx, params
+func (_m *FakeService) CancelCommandByRequestID(ctx context.Context, params command.CancelCommandByRequestIDParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelCommandByRequestID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CancelCommandByRequestIDParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_CancelCommandByRequestID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCommandByRequestID'
+type FakeService_CancelCommandByRequestID_Call struct {
+	*mock.Call
+}
+
+// CancelCommandByRequestID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CancelCommandByRequestIDParams
+func (_e *FakeService_Expecter) CancelCommandByRequestID(ctx interface{}, params interface{}) *FakeService_CancelCommandByRequestID_Call {
+	return &FakeService_CancelCommandByRequestID_Call{Call: _e.mock.On("CancelCommandByRequestID", ctx, params)}
+}
+
+func (_c *FakeService_CancelCommandByRequestID_Call) Run(run func(ctx context.Context, params command.CancelCommandByRequestIDParams)) *FakeService_CancelCommandByRequestID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CancelCommandByRequestIDParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CancelCommandByRequestID_Call) Return(_a0 error) *FakeService_CancelCommandByRequestID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_CancelCommandByRequestID_Call) RunAndReturn(run func(context.Context, command.CancelCommandByRequestIDParams) error) *FakeService_CancelCommandByRequestID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelCurrentProcessingCommand provides a mock function with given fields: ctx
 func (_m *FakeService) CancelCurrentProcessingCommand(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -163,6 +257,53 @@ func (_c *FakeService_CancelCurrentProcessingCommand_Call) RunAndReturn(run func
 	return _c
 }
 
+// CancelMissionByID provides a mock function with given fields: ctx, params
+func (_m *FakeService) CancelMissionByID(ctx context.Context, params command.CancelMissionByIDParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelMissionByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CancelMissionByIDParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_CancelMissionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelMissionByID'
+type FakeService_CancelMissionByID_Call struct {
+	*mock.Call
+}
+
+// CancelMissionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CancelMissionByIDParams
+func (_e *FakeService_Expecter) CancelMissionByID(ctx interface{}, params interface{}) *FakeService_CancelMissionByID_Call {
+	return &FakeService_CancelMissionByID_Call{Call: _e.mock.On("CancelMissionByID", ctx, params)}
+}
+
+func (_c *FakeService_CancelMissionByID_Call) Run(run func(ctx context.Context, params command.CancelMissionByIDParams)) *FakeService_CancelMissionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CancelMissionByIDParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CancelMissionByID_Call) Return(_a0 error) *FakeService_CancelMissionByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_CancelMissionByID_Call) RunAndReturn(run func(context.Context, command.CancelMissionByIDParams) error) *FakeService_CancelMissionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCommand provides a mock function with given fields: ctx, params
 func (_m *FakeService) CreateCommand(ctx context.Context, params command.CreateCommandParams) (command.Command, error) {
 	ret := _m.Called(ctx, params)
@@ -220,6 +361,122 @@ func (_c *FakeService_CreateCommand_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreateCommands provides a mock function with given fields: ctx, params
+func (_m *FakeService) CreateCommands(ctx context.Context, params command.CreateCommandsParams) ([]command.Command, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCommands")
+	}
+
+	var r0 []command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateCommandsParams) ([]command.Command, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateCommandsParams) []command.Command); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]command.Command)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.CreateCommandsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_CreateCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCommands'
+type FakeService_CreateCommands_Call struct {
+	*mock.Call
+}
+
+// CreateCommands is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CreateCommandsParams
+func (_e *FakeService_Expecter) CreateCommands(ctx interface{}, params interface{}) *FakeService_CreateCommands_Call {
+	return &FakeService_CreateCommands_Call{Call: _e.mock.On("CreateCommands", ctx, params)}
+}
+
+func (_c *FakeService_CreateCommands_Call) Run(run func(ctx context.Context, params command.CreateCommandsParams)) *FakeService_CreateCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CreateCommandsParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CreateCommands_Call) Return(_a0 []command.Command, _a1 error) *FakeService_CreateCommands_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_CreateCommands_Call) RunAndReturn(run func(context.Context, command.CreateCommandsParams) ([]command.Command, error)) *FakeService_CreateCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMission provides a mock function with given fields: ctx, params
+func (_m *FakeService) CreateMission(ctx context.Context, params command.CreateMissionParams) (command.Mission, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMission")
+	}
+
+	var r0 command.Mission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateMissionParams) (command.Mission, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateMissionParams) command.Mission); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.Mission)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.CreateMissionParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_CreateMission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMission'
+type FakeService_CreateMission_Call struct {
+	*mock.Call
+}
+
+// CreateMission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CreateMissionParams
+func (_e *FakeService_Expecter) CreateMission(ctx interface{}, params interface{}) *FakeService_CreateMission_Call {
+	return &FakeService_CreateMission_Call{Call: _e.mock.On("CreateMission", ctx, params)}
+}
+
+func (_c *FakeService_CreateMission_Call) Run(run func(ctx context.Context, params command.CreateMissionParams)) *FakeService_CreateMission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CreateMissionParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CreateMission_Call) Return(_a0 command.Mission, _a1 error) *FakeService_CreateMission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_CreateMission_Call) RunAndReturn(run func(context.Context, command.CreateMissionParams) (command.Mission, error)) *FakeService_CreateMission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteCommandByID provides a mock function with given fields: ctx, params
 func (_m *FakeService) DeleteCommandByID(ctx context.Context, params command.DeleteCommandByIDParams) error {
 	ret := _m.Called(ctx, params)
@@ -313,6 +570,54 @@ func (_c *FakeService_DeleteOldCommands_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ExportCommands provides a mock function with given fields: ctx, params, fn
+func (_m *FakeService) ExportCommands(ctx context.Context, params command.ExportCommandsParams, fn func(command.Command) error) error {
+	ret := _m.Called(ctx, params, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCommands")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.ExportCommandsParams, func(command.Command) error) error); ok {
+		r0 = rf(ctx, params, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_ExportCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCommands'
+type FakeService_ExportCommands_Call struct {
+	*mock.Call
+}
+
+// ExportCommands is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.ExportCommandsParams
+//   - fn func(command.Command) error
+func (_e *FakeService_Expecter) ExportCommands(ctx interface{}, params interface{}, fn interface{}) *FakeService_ExportCommands_Call {
+	return &FakeService_ExportCommands_Call{Call: _e.mock.On("ExportCommands", ctx, params, fn)}
+}
+
+func (_c *FakeService_ExportCommands_Call) Run(run func(ctx context.Context, params command.ExportCommandsParams, fn func(command.Command) error)) *FakeService_ExportCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.ExportCommandsParams), args[2].(func(command.Command) error))
+	})
+	return _c
+}
+
+func (_c *FakeService_ExportCommands_Call) Return(_a0 error) *FakeService_ExportCommands_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_ExportCommands_Call) RunAndReturn(run func(context.Context, command.ExportCommandsParams, func(command.Command) error) error) *FakeService_ExportCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCommandByID provides a mock function with given fields: ctx, params
 func (_m *FakeService) GetCommandByID(ctx context.Context, params command.GetCommandByIDParams) (command.Command, error) {
 	ret := _m.Called(ctx, params)
@@ -370,6 +675,63 @@ func (_c *FakeService_GetCommandByID_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetCommandStats provides a mock function with given fields: ctx, params
+func (_m *FakeService) GetCommandStats(ctx context.Context, params command.GetCommandStatsParams) (command.CommandStats, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommandStats")
+	}
+
+	var r0 command.CommandStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.GetCommandStatsParams) (command.CommandStats, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.GetCommandStatsParams) command.CommandStats); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.CommandStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.GetCommandStatsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetCommandStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommandStats'
+type FakeService_GetCommandStats_Call struct {
+	*mock.Call
+}
+
+// GetCommandStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.GetCommandStatsParams
+func (_e *FakeService_Expecter) GetCommandStats(ctx interface{}, params interface{}) *FakeService_GetCommandStats_Call {
+	return &FakeService_GetCommandStats_Call{Call: _e.mock.On("GetCommandStats", ctx, params)}
+}
+
+func (_c *FakeService_GetCommandStats_Call) Run(run func(ctx context.Context, params command.GetCommandStatsParams)) *FakeService_GetCommandStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.GetCommandStatsParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetCommandStats_Call) Return(_a0 command.CommandStats, _a1 error) *FakeService_GetCommandStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetCommandStats_Call) RunAndReturn(run func(context.Context, command.GetCommandStatsParams) (command.CommandStats, error)) *FakeService_GetCommandStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCurrentProcessingCommand provides a mock function with given fields: ctx
 func (_m *FakeService) GetCurrentProcessingCommand(ctx context.Context) (command.Command, error) {
 	ret := _m.Called(ctx)
@@ -426,26 +788,26 @@ func (_c *FakeService_GetCurrentProcessingCommand_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// ListCommands provides a mock function with given fields: ctx, params
-func (_m *FakeService) ListCommands(ctx context.Context, params command.ListCommandsParams) (paging.List[command.Command], error) {
+// GetMissionByID provides a mock function with given fields: ctx, params
+func (_m *FakeService) GetMissionByID(ctx context.Context, params command.GetMissionByIDParams) (command.Mission, error) {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListCommands")
+		panic("no return value specified for GetMissionByID")
 	}
 
-	var r0 paging.List[command.Command]
+	var r0 command.Mission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, command.ListCommandsParams) (paging.List[command.Command], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, command.GetMissionByIDParams) (command.Mission, error)); ok {
 		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, command.ListCommandsParams) paging.List[command.Command]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, command.GetMissionByIDParams) command.Mission); ok {
 		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Get(0).(paging.List[command.Command])
+		r0 = ret.Get(0).(command.Mission)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, command.ListCommandsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, command.GetMissionByIDParams) error); ok {
 		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -454,7 +816,120 @@ func (_m *FakeService) ListCommands(ctx context.Context, params command.ListComm
 	return r0, r1
 }
 
-// FakeService_ListCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCommands'
+// FakeService_GetMissionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMissionByID'
+type FakeService_GetMissionByID_Call struct {
+	*mock.Call
+}
+
+// GetMissionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.GetMissionByIDParams
+func (_e *FakeService_Expecter) GetMissionByID(ctx interface{}, params interface{}) *FakeService_GetMissionByID_Call {
+	return &FakeService_GetMissionByID_Call{Call: _e.mock.On("GetMissionByID", ctx, params)}
+}
+
+func (_c *FakeService_GetMissionByID_Call) Run(run func(ctx context.Context, params command.GetMissionByIDParams)) *FakeService_GetMissionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.GetMissionByIDParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetMissionByID_Call) Return(_a0 command.Mission, _a1 error) *FakeService_GetMissionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetMissionByID_Call) RunAndReturn(run func(context.Context, command.GetMissionByIDParams) (command.Mission, error)) *FakeService_GetMissionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueState provides a mock function with given fields: ctx
+func (_m *FakeService) GetQueueState(ctx context.Context) (command.QueueState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueState")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (command.QueueState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) command.QueueState); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetQueueState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueState'
+type FakeService_GetQueueState_Call struct {
+	*mock.Call
+}
+
+// GetQueueState is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) GetQueueState(ctx interface{}) *FakeService_GetQueueState_Call {
+	return &FakeService_GetQueueState_Call{Call: _e.mock.On("GetQueueState", ctx)}
+}
+
+func (_c *FakeService_GetQueueState_Call) Run(run func(ctx context.Context)) *FakeService_GetQueueState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetQueueState_Call) Return(_a0 command.QueueState, _a1 error) *FakeService_GetQueueState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetQueueState_Call) RunAndReturn(run func(context.Context) (command.QueueState, error)) *FakeService_GetQueueState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCommands provides a mock function with given fields: ctx, params
+func (_m *FakeService) ListCommands(ctx context.Context, params command.ListCommandsParams) (paging.List[command.Command], error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommands")
+	}
+
+	var r0 paging.List[command.Command]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.ListCommandsParams) (paging.List[command.Command], error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.ListCommandsParams) paging.List[command.Command]); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(paging.List[command.Command])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.ListCommandsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_ListCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCommands'
 type FakeService_ListCommands_Call struct {
 	*mock.Call
 }
@@ -483,6 +958,392 @@ func (_c *FakeService_ListCommands_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListMissions provides a mock function with given fields: ctx, params
+func (_m *FakeService) ListMissions(ctx context.Context, params command.ListMissionsParams) (paging.List[command.Mission], error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMissions")
+	}
+
+	var r0 paging.List[command.Mission]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.ListMissionsParams) (paging.List[command.Mission], error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.ListMissionsParams) paging.List[command.Mission]); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(paging.List[command.Mission])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.ListMissionsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_ListMissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMissions'
+type FakeService_ListMissions_Call struct {
+	*mock.Call
+}
+
+// ListMissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.ListMissionsParams
+func (_e *FakeService_Expecter) ListMissions(ctx interface{}, params interface{}) *FakeService_ListMissions_Call {
+	return &FakeService_ListMissions_Call{Call: _e.mock.On("ListMissions", ctx, params)}
+}
+
+func (_c *FakeService_ListMissions_Call) Run(run func(ctx context.Context, params command.ListMissionsParams)) *FakeService_ListMissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.ListMissionsParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_ListMissions_Call) Return(_a0 paging.List[command.Mission], _a1 error) *FakeService_ListMissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_ListMissions_Call) RunAndReturn(run func(context.Context, command.ListMissionsParams) (paging.List[command.Mission], error)) *FakeService_ListMissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveQueuedCommand provides a mock function with given fields: ctx, params
+func (_m *FakeService) MoveQueuedCommand(ctx context.Context, params command.MoveQueuedCommandParams) (command.Command, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveQueuedCommand")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.MoveQueuedCommandParams) (command.Command, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.MoveQueuedCommandParams) command.Command); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.MoveQueuedCommandParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_MoveQueuedCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveQueuedCommand'
+type FakeService_MoveQueuedCommand_Call struct {
+	*mock.Call
+}
+
+// MoveQueuedCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.MoveQueuedCommandParams
+func (_e *FakeService_Expecter) MoveQueuedCommand(ctx interface{}, params interface{}) *FakeService_MoveQueuedCommand_Call {
+	return &FakeService_MoveQueuedCommand_Call{Call: _e.mock.On("MoveQueuedCommand", ctx, params)}
+}
+
+func (_c *FakeService_MoveQueuedCommand_Call) Run(run func(ctx context.Context, params command.MoveQueuedCommandParams)) *FakeService_MoveQueuedCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.MoveQueuedCommandParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_MoveQueuedCommand_Call) Return(_a0 command.Command, _a1 error) *FakeService_MoveQueuedCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_MoveQueuedCommand_Call) RunAndReturn(run func(context.Context, command.MoveQueuedCommandParams) (command.Command, error)) *FakeService_MoveQueuedCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseQueue provides a mock function with given fields: ctx
+func (_m *FakeService) PauseQueue(ctx context.Context) (command.QueueState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseQueue")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (command.QueueState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) command.QueueState); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_PauseQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseQueue'
+type FakeService_PauseQueue_Call struct {
+	*mock.Call
+}
+
+// PauseQueue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) PauseQueue(ctx interface{}) *FakeService_PauseQueue_Call {
+	return &FakeService_PauseQueue_Call{Call: _e.mock.On("PauseQueue", ctx)}
+}
+
+func (_c *FakeService_PauseQueue_Call) Run(run func(ctx context.Context)) *FakeService_PauseQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_PauseQueue_Call) Return(_a0 command.QueueState, _a1 error) *FakeService_PauseQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_PauseQueue_Call) RunAndReturn(run func(context.Context) (command.QueueState, error)) *FakeService_PauseQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanCommand provides a mock function with given fields: ctx, params
+func (_m *FakeService) PlanCommand(ctx context.Context, params command.CreateCommandParams) (command.Plan, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanCommand")
+	}
+
+	var r0 command.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateCommandParams) (command.Plan, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateCommandParams) command.Plan); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.Plan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.CreateCommandParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_PlanCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanCommand'
+type FakeService_PlanCommand_Call struct {
+	*mock.Call
+}
+
+// PlanCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CreateCommandParams
+func (_e *FakeService_Expecter) PlanCommand(ctx interface{}, params interface{}) *FakeService_PlanCommand_Call {
+	return &FakeService_PlanCommand_Call{Call: _e.mock.On("PlanCommand", ctx, params)}
+}
+
+func (_c *FakeService_PlanCommand_Call) Run(run func(ctx context.Context, params command.CreateCommandParams)) *FakeService_PlanCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CreateCommandParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_PlanCommand_Call) Return(_a0 command.Plan, _a1 error) *FakeService_PlanCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_PlanCommand_Call) RunAndReturn(run func(context.Context, command.CreateCommandParams) (command.Plan, error)) *FakeService_PlanCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanMission provides a mock function with given fields: ctx, params
+func (_m *FakeService) PlanMission(ctx context.Context, params command.CreateMissionParams) (command.Plan, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanMission")
+	}
+
+	var r0 command.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateMissionParams) (command.Plan, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.CreateMissionParams) command.Plan); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.Plan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.CreateMissionParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_PlanMission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanMission'
+type FakeService_PlanMission_Call struct {
+	*mock.Call
+}
+
+// PlanMission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CreateMissionParams
+func (_e *FakeService_Expecter) PlanMission(ctx interface{}, params interface{}) *FakeService_PlanMission_Call {
+	return &FakeService_PlanMission_Call{Call: _e.mock.On("PlanMission", ctx, params)}
+}
+
+func (_c *FakeService_PlanMission_Call) Run(run func(ctx context.Context, params command.CreateMissionParams)) *FakeService_PlanMission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CreateMissionParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_PlanMission_Call) Return(_a0 command.Plan, _a1 error) *FakeService_PlanMission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_PlanMission_Call) RunAndReturn(run func(context.Context, command.CreateMissionParams) (command.Plan, error)) *FakeService_PlanMission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoverPendingCommands provides a mock function with given fields: ctx
+func (_m *FakeService) RecoverPendingCommands(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverPendingCommands")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_RecoverPendingCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverPendingCommands'
+type FakeService_RecoverPendingCommands_Call struct {
+	*mock.Call
+}
+
+// RecoverPendingCommands is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) RecoverPendingCommands(ctx interface{}) *FakeService_RecoverPendingCommands_Call {
+	return &FakeService_RecoverPendingCommands_Call{Call: _e.mock.On("RecoverPendingCommands", ctx)}
+}
+
+func (_c *FakeService_RecoverPendingCommands_Call) Run(run func(ctx context.Context)) *FakeService_RecoverPendingCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_RecoverPendingCommands_Call) Return(_a0 error) *FakeService_RecoverPendingCommands_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_RecoverPendingCommands_Call) RunAndReturn(run func(context.Context) error) *FakeService_RecoverPendingCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeQueue provides a mock function with given fields: ctx
+func (_m *FakeService) ResumeQueue(ctx context.Context) (command.QueueState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeQueue")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (command.QueueState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) command.QueueState); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_ResumeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeQueue'
+type FakeService_ResumeQueue_Call struct {
+	*mock.Call
+}
+
+// ResumeQueue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) ResumeQueue(ctx interface{}) *FakeService_ResumeQueue_Call {
+	return &FakeService_ResumeQueue_Call{Call: _e.mock.On("ResumeQueue", ctx)}
+}
+
+func (_c *FakeService_ResumeQueue_Call) Run(run func(ctx context.Context)) *FakeService_ResumeQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_ResumeQueue_Call) Return(_a0 command.QueueState, _a1 error) *FakeService_ResumeQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_ResumeQueue_Call) RunAndReturn(run func(context.Context) (command.QueueState, error)) *FakeService_ResumeQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunNextExecutableCommand provides a mock function with given fields: ctx
 func (_m *FakeService) RunNextExecutableCommand(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -529,6 +1390,63 @@ func (_c *FakeService_RunNextExecutableCommand_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// UpdateQueuedCommand provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateQueuedCommand(ctx context.Context, params command.UpdateQueuedCommandParams) (command.Command, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQueuedCommand")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.UpdateQueuedCommandParams) (command.Command, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.UpdateQueuedCommandParams) command.Command); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.UpdateQueuedCommandParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_UpdateQueuedCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQueuedCommand'
+type FakeService_UpdateQueuedCommand_Call struct {
+	*mock.Call
+}
+
+// UpdateQueuedCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.UpdateQueuedCommandParams
+func (_e *FakeService_Expecter) UpdateQueuedCommand(ctx interface{}, params interface{}) *FakeService_UpdateQueuedCommand_Call {
+	return &FakeService_UpdateQueuedCommand_Call{Call: _e.mock.On("UpdateQueuedCommand", ctx, params)}
+}
+
+func (_c *FakeService_UpdateQueuedCommand_Call) Run(run func(ctx context.Context, params command.UpdateQueuedCommandParams)) *FakeService_UpdateQueuedCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.UpdateQueuedCommandParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateQueuedCommand_Call) Return(_a0 command.Command, _a1 error) *FakeService_UpdateQueuedCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_UpdateQueuedCommand_Call) RunAndReturn(run func(context.Context, command.UpdateQueuedCommandParams) (command.Command, error)) *FakeService_UpdateQueuedCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFakeService creates a new instance of FakeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFakeService(t interface {
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/tbe-team/raybot/pkg/backoff"
 )
 
 //nolint:revive
@@ -15,12 +17,9 @@ func (c CommandType) String() string {
 	return string(c)
 }
 
+// Validate reports whether the command type is registered, see RegisterType.
 func (c CommandType) Validate() error {
-	switch c {
-	case CommandTypeStopMovement, CommandTypeMoveForward, CommandTypeMoveBackward,
-		CommandTypeMoveTo, CommandTypeCargoOpen, CommandTypeCargoClose,
-		CommandTypeCargoLift, CommandTypeCargoLower, CommandTypeCargoCheckQR,
-		CommandTypeScanLocation, CommandTypeWait:
+	if _, ok := LookupType(c); ok {
 		return nil
 	}
 	return fmt.Errorf("invalid command type: %s", c)
@@ -40,6 +39,7 @@ const (
 
 	CommandTypeScanLocation CommandType = "SCAN_LOCATION"
 	CommandTypeWait         CommandType = "WAIT"
+	CommandTypeAssert       CommandType = "ASSERT"
 )
 
 type Source string
@@ -65,7 +65,7 @@ type Status string
 
 func (s Status) Validate() error {
 	switch s {
-	case StatusQueued, StatusProcessing, StatusCanceling, StatusSucceeded, StatusFailed, StatusCanceled:
+	case StatusQueued, StatusProcessing, StatusCanceling, StatusSucceeded, StatusFailed, StatusCanceled, StatusTimedOut:
 		return nil
 	}
 	return fmt.Errorf("invalid status: %s", s)
@@ -82,6 +82,7 @@ const (
 	StatusSucceeded  Status = "SUCCEEDED"
 	StatusFailed     Status = "FAILED"
 	StatusCanceled   Status = "CANCELED"
+	StatusTimedOut   Status = "TIMED_OUT"
 )
 
 type Command struct {
@@ -97,12 +98,38 @@ type Command struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	RequestID   *string
+
+	// MissionID is the ID of the mission the command belongs to, nil if it is a standalone command.
+	MissionID *int64
+
+	// RetryPolicy re-runs the executor if the execution fails, nil if the command is not retried.
+	RetryPolicy *RetryPolicy
+
+	// Priority orders the queue, commands with a higher priority are executed first.
+	// Commands with the same priority are executed in creation order.
+	Priority uint8
+
+	// QueuePosition orders the queued commands with the same priority, lower positions are executed first.
+	// It is assigned at creation and changed by moving the command in the queue.
+	QueuePosition int64
+
+	// NotBefore defers the execution of the queued command until the time is reached,
+	// nil if the command can be executed right away. It has a precision of one second.
+	NotBefore *time.Time
+
+	// PausedForObstacle reports whether the drive motor is stopped because of an obstacle
+	// in the direction of travel. It is only set for the running command and is not persisted.
+	PausedForObstacle bool
+
+	// Progress is the latest progress reported by the executor, nil if none is reported yet.
+	// It is only set for the running command and is not persisted.
+	Progress *Progress
 }
 
 func NewCommand(source Source, inputs Inputs, requestID *string) Command {
-	var reqID string
-	if requestID == nil {
-		reqID = uuid.NewString()
+	reqID := uuid.NewString()
+	if requestID != nil {
+		reqID = *requestID
 	}
 
 	now := time.Now()
@@ -117,6 +144,32 @@ func NewCommand(source Source, inputs Inputs, requestID *string) Command {
 	}
 }
 
+// RetryPolicy decides how many times a failed command is executed again before it is marked FAILED.
+// Canceled and timed out commands are not retried, the timeout covers all attempts.
+type RetryPolicy struct {
+	// MaxAttempts is the number of executions including the first one.
+	MaxAttempts uint8 `json:"max_attempts" validate:"min=2,max=10"`
+	// InitialBackoffMs is the delay before the second attempt, it doubles after each attempt.
+	InitialBackoffMs int64 `json:"initial_backoff_ms" validate:"min=0"`
+	// MaxBackoffMs caps the delay between attempts, zero means no cap.
+	MaxBackoffMs int64 `json:"max_backoff_ms" validate:"omitempty,gtefield=InitialBackoffMs"`
+}
+
+// Backoff returns the backoff between attempts.
+func (p RetryPolicy) Backoff() backoff.Exponential {
+	return backoff.Exponential{
+		Initial: time.Duration(p.InitialBackoffMs) * time.Millisecond,
+		Max:     time.Duration(p.MaxBackoffMs) * time.Millisecond,
+	}
+}
+
+// QueueState is the state of the command queue.
+type QueueState struct {
+	// Paused reports whether the queue is held, no new command is pulled until it is resumed.
+	Paused    bool
+	UpdatedAt time.Time
+}
+
 // CancelableCommand is a command that can be canceled.
 type CancelableCommand struct {
 	Command
@@ -147,3 +200,96 @@ func (c *CancelableCommand) CanBeCanceled() bool {
 func (c *CancelableCommand) Context() context.Context {
 	return c.ctx
 }
+
+type MissionStatus string
+
+func (s MissionStatus) Validate() error {
+	switch s {
+	case MissionStatusQueued, MissionStatusProcessing, MissionStatusSucceeded,
+		MissionStatusFailed, MissionStatusCanceled:
+		return nil
+	}
+	return fmt.Errorf("invalid mission status: %s", s)
+}
+
+func (s MissionStatus) String() string {
+	return string(s)
+}
+
+const (
+	MissionStatusQueued     MissionStatus = "QUEUED"
+	MissionStatusProcessing MissionStatus = "PROCESSING"
+	MissionStatusSucceeded  MissionStatus = "SUCCEEDED"
+	MissionStatusFailed     MissionStatus = "FAILED"
+	MissionStatusCanceled   MissionStatus = "CANCELED"
+)
+
+// OnFailurePolicy decides what a mission does when one of its steps fails or times out.
+type OnFailurePolicy string
+
+func (p OnFailurePolicy) Validate() error {
+	switch p {
+	case OnFailureAbort, OnFailureSkip, OnFailureRetry:
+		return nil
+	}
+	return fmt.Errorf("invalid on failure policy: %s", p)
+}
+
+func (p OnFailurePolicy) String() string {
+	return string(p)
+}
+
+const (
+	// OnFailureAbort cancels the remaining steps and fails the mission.
+	OnFailureAbort OnFailurePolicy = "ABORT"
+	// OnFailureSkip continues with the next step, the mission fails once all steps are done.
+	OnFailureSkip OnFailurePolicy = "SKIP"
+	// OnFailureRetry executes the failed step again up to MaxRetries times, then aborts.
+	OnFailureRetry OnFailurePolicy = "RETRY"
+)
+
+// Mission is an ordered list of commands executed one after another
+// without any other command being executed in between.
+type Mission struct {
+	ID          int64
+	Status      MissionStatus
+	Source      Source
+	OnFailure   OnFailurePolicy
+	MaxRetries  uint8
+	Steps       []Command
+	Error       *string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewMission(source Source, steps []Inputs, onFailure OnFailurePolicy, maxRetries uint8) Mission {
+	now := time.Now()
+	mission := Mission{
+		Status:     MissionStatusQueued,
+		Source:     source,
+		OnFailure:  onFailure,
+		MaxRetries: maxRetries,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	for _, inputs := range steps {
+		step := NewCommand(source, inputs, nil)
+		step.CreatedAt = now
+		step.UpdatedAt = now
+		mission.Steps = append(mission.Steps, step)
+	}
+
+	return mission
+}
+
+// IsFinished reports whether the mission reached a terminal status.
+func (m Mission) IsFinished() bool {
+	switch m.Status {
+	case MissionStatusSucceeded, MissionStatusFailed, MissionStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
@@ -18,77 +18,122 @@ var (
 	_ Outputs = (*CargoCheckQROutputs)(nil)
 	_ Outputs = (*ScanLocationOutputs)(nil)
 	_ Outputs = (*WaitOutputs)(nil)
+	_ Outputs = (*AssertOutputs)(nil)
 )
 
+// Outputs are the outputs of a command type.
+// They are implemented by embedding CommonOutputs and defining CommandType.
 type Outputs interface {
 	isOutputs()
 	CommandType() CommandType
+	Common() CommonOutputs
 }
 
-type StopMovementOutputs struct{}
+// CommonOutputs holds the outputs shared by every command type.
+type CommonOutputs struct {
+	// ElapsedMs is the execution time before the command timed out.
+	// Only set when the command status is TIMED_OUT.
+	ElapsedMs *int64 `json:"elapsed_ms,omitempty"`
+
+	// Attempts is the execution history of the command.
+	// Only set when the command has a retry policy.
+	Attempts []Attempt `json:"attempts,omitempty"`
+
+	// FailedCondition is the precondition or the asserted condition that was not met.
+	// Only set when the command failed because of it.
+	FailedCondition *FailedCondition `json:"failed_condition,omitempty"`
+}
+
+// Attempt is a single execution of a command with a retry policy.
+type Attempt struct {
+	Attempt     int       `json:"attempt"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	// Error is the execution error, nil if the attempt succeeded.
+	Error *string `json:"error,omitempty"`
+}
+
+func (c CommonOutputs) Common() CommonOutputs {
+	return c
+}
+
+func (CommonOutputs) isOutputs() {}
+
+type StopMovementOutputs struct {
+	CommonOutputs
+}
 
 func (StopMovementOutputs) CommandType() CommandType {
 	return CommandTypeStopMovement
 }
-func (StopMovementOutputs) isOutputs() {}
 
-type MoveForwardOutputs struct{}
+type MoveForwardOutputs struct {
+	CommonOutputs
+}
 
 func (MoveForwardOutputs) CommandType() CommandType {
 	return CommandTypeMoveForward
 }
-func (MoveForwardOutputs) isOutputs() {}
 
-type MoveBackwardOutputs struct{}
+type MoveBackwardOutputs struct {
+	CommonOutputs
+}
 
 func (MoveBackwardOutputs) CommandType() CommandType {
 	return CommandTypeMoveBackward
 }
-func (MoveBackwardOutputs) isOutputs() {}
 
-type MoveToOutputs struct{}
+type MoveToOutputs struct {
+	CommonOutputs
+}
 
 func (MoveToOutputs) CommandType() CommandType {
 	return CommandTypeMoveTo
 }
-func (MoveToOutputs) isOutputs() {}
 
-type CargoOpenOutputs struct{}
+type CargoOpenOutputs struct {
+	CommonOutputs
+}
 
 func (CargoOpenOutputs) CommandType() CommandType {
 	return CommandTypeCargoOpen
 }
-func (CargoOpenOutputs) isOutputs() {}
 
-type CargoCloseOutputs struct{}
+type CargoCloseOutputs struct {
+	CommonOutputs
+}
 
 func (CargoCloseOutputs) CommandType() CommandType {
 	return CommandTypeCargoClose
 }
-func (CargoCloseOutputs) isOutputs() {}
 
-type CargoLiftOutputs struct{}
+type CargoLiftOutputs struct {
+	CommonOutputs
+}
 
 func (CargoLiftOutputs) CommandType() CommandType {
 	return CommandTypeCargoLift
 }
-func (CargoLiftOutputs) isOutputs() {}
 
-type CargoLowerOutputs struct{}
+type CargoLowerOutputs struct {
+	CommonOutputs
+}
 
 func (CargoLowerOutputs) CommandType() CommandType {
 	return CommandTypeCargoLower
 }
-func (CargoLowerOutputs) isOutputs() {}
 
-type CargoCheckQROutputs struct{}
+type CargoCheckQROutputs struct {
+	CommonOutputs
+}
 
 func (CargoCheckQROutputs) CommandType() CommandType {
 	return CommandTypeCargoCheckQR
 }
-func (CargoCheckQROutputs) isOutputs() {}
 
 type ScanLocationOutputs struct {
+	CommonOutputs
+
 	Locations []Location `json:"locations"`
 }
 
@@ -100,59 +145,61 @@ type Location struct {
 func (ScanLocationOutputs) CommandType() CommandType {
 	return CommandTypeScanLocation
 }
-func (ScanLocationOutputs) isOutputs() {}
 
-type WaitOutputs struct{}
+type WaitOutputs struct {
+	CommonOutputs
+}
 
 func (WaitOutputs) CommandType() CommandType {
 	return CommandTypeWait
 }
-func (WaitOutputs) isOutputs() {}
 
-func UnmarshalOutputs(cmdType CommandType, outputsBytes []byte) (Outputs, error) {
-	var outputs Outputs
-
-	switch cmdType {
-	case CommandTypeStopMovement:
-		outputs = &StopMovementOutputs{}
-
-	case CommandTypeMoveForward:
-		outputs = &MoveForwardOutputs{}
-
-	case CommandTypeMoveBackward:
-		outputs = &MoveBackwardOutputs{}
-
-	case CommandTypeMoveTo:
-		outputs = &MoveToOutputs{}
-
-	case CommandTypeCargoOpen:
-		outputs = &CargoOpenOutputs{}
+type AssertOutputs struct {
+	CommonOutputs
+}
 
-	case CommandTypeCargoClose:
-		outputs = &CargoCloseOutputs{}
+func (AssertOutputs) CommandType() CommandType {
+	return CommandTypeAssert
+}
 
-	case CommandTypeCargoLift:
-		outputs = &CargoLiftOutputs{}
+func UnmarshalOutputs(cmdType CommandType, outputsBytes []byte) (Outputs, error) {
+	def, ok := LookupType(cmdType)
+	if !ok {
+		return nil, fmt.Errorf("unknown command type: %s", cmdType)
+	}
 
-	case CommandTypeCargoLower:
-		outputs = &CargoLowerOutputs{}
+	outputs := def.NewOutputs()
+	if err := json.Unmarshal(outputsBytes, outputs); err != nil {
+		return nil, err
+	}
 
-	case CommandTypeCargoCheckQR:
-		outputs = &CargoCheckQROutputs{}
+	return outputs, nil
+}
 
-	case CommandTypeScanLocation:
-		o := &ScanLocationOutputs{}
-		if err := json.Unmarshal(outputsBytes, o); err != nil {
-			return nil, err
+// WithCommonOutputs returns a copy of the outputs with the common fields replaced.
+// The outputs may be nil, in which case empty outputs of the command type are used.
+func WithCommonOutputs(cmdType CommandType, outputs Outputs, common CommonOutputs) (Outputs, error) {
+	outputsBytes := []byte("{}")
+	if outputs != nil {
+		b, err := json.Marshal(outputs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal outputs: %w", err)
 		}
-		outputs = o
+		outputsBytes = b
+	}
 
-	case CommandTypeWait:
-		outputs = &WaitOutputs{}
+	o, err := UnmarshalOutputs(cmdType, outputsBytes)
+	if err != nil {
+		return nil, err
+	}
 
-	default:
-		return nil, fmt.Errorf("unknown command type: %s", cmdType)
+	commonBytes, err := json.Marshal(common)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal common outputs: %w", err)
+	}
+	if err := json.Unmarshal(commonBytes, o); err != nil {
+		return nil, fmt.Errorf("failed to apply common outputs: %w", err)
 	}
 
-	return outputs, nil
+	return o, nil
 }
@@ -0,0 +1,49 @@
+package command
+
+// Plan is the expected outcome of commands executed in order from the current robot state.
+// It is the result of a dry run, nothing is executed to build it.
+type Plan struct {
+	Steps []PlanStep
+}
+
+// Executable reports whether every step of the plan is expected to succeed.
+func (p Plan) Executable() bool {
+	for _, step := range p.Steps {
+		if step.Error != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// PlanStep is the expected outcome of a command of a plan.
+type PlanStep struct {
+	Type   CommandType
+	Inputs Inputs
+
+	// Location is the resolved target location of MOVE_TO.
+	Location *string
+	// Direction is the resolved direction of travel of MOVE_TO.
+	Direction *MoveDirection
+	// ExpectedLocations are the locations MOVE_TO expects to pass, the target location being the last one.
+	// It is empty if the rail map does not know the current or the target location.
+	ExpectedLocations []string
+	// LiftPosition is the expected lift position of CARGO_LIFT and CARGO_LOWER.
+	LiftPosition *uint16
+
+	// Conditions are the results of the preconditions of the command and of the conditions of ASSERT,
+	// checked against the robot state expected after the previous steps.
+	Conditions []ConditionResult
+
+	// Error is the reason the command is expected to fail.
+	Error *string
+}
+
+// ConditionResult is the result of a condition checked by a dry run.
+type ConditionResult struct {
+	Condition Condition
+	Met       bool
+	// Actual is the expected state, e.g. the battery percent or the current location.
+	Actual       string
+	Precondition bool
+}
@@ -10,6 +10,7 @@ import (
 type processingLock struct {
 	cond   *sync.Cond
 	locked bool
+	paused bool
 }
 
 func New() command.ProcessingLock {
@@ -34,23 +35,43 @@ func (r *processingLock) WithLock(fn func() error) error {
 }
 
 func (r *processingLock) WaitUntilUnlocked(ctx context.Context) error {
-	done := make(chan struct{})
-
-	go func() {
+	// Wake up the waiters once the context is done, so that the wait does not outlive it.
+	stop := context.AfterFunc(ctx, func() {
 		r.cond.L.Lock()
-		defer r.cond.L.Unlock()
+		r.cond.Broadcast()
+		r.cond.L.Unlock()
+	})
+	defer stop()
+
+	r.cond.L.Lock()
+	defer r.cond.L.Unlock()
 
-		for r.locked {
-			r.cond.Wait()
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !r.locked && !r.paused {
+			return nil
 		}
+		r.cond.Wait()
+	}
+}
+
+func (r *processingLock) Pause() {
+	r.cond.L.Lock()
+	r.paused = true
+	r.cond.L.Unlock()
+}
 
-		close(done)
-	}()
+func (r *processingLock) Resume() {
+	r.cond.L.Lock()
+	r.paused = false
+	r.cond.Broadcast()
+	r.cond.L.Unlock()
+}
 
-	select {
-	case <-done:
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
-	}
+func (r *processingLock) IsPaused() bool {
+	r.cond.L.Lock()
+	defer r.cond.L.Unlock()
+	return r.paused
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
 )
 
 func TestProcessingLock(t *testing.T) {
@@ -78,4 +79,52 @@ func TestProcessingLock(t *testing.T) {
 
 		assert.Equal(t, 10, int(counter.Load()))
 	})
+
+	t.Run("WaitUntilUnlocked should block while paused", func(t *testing.T) {
+		l := New()
+		l.Pause()
+		assert.True(t, l.IsPaused())
+
+		waitCh := make(chan struct{})
+		go func() {
+			err := l.WaitUntilUnlocked(context.Background())
+			assert.NoError(t, err)
+			close(waitCh)
+		}()
+
+		// WithLock must not release the paused lock.
+		err := l.WithLock(func() error {
+			return nil
+		})
+		assert.NoError(t, err)
+
+		select {
+		case <-waitCh:
+			t.Fatal("WaitUntilUnlocked did not wait while paused")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		l.Resume()
+		assert.False(t, l.IsPaused())
+
+		select {
+		case <-waitCh:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("WaitUntilUnlocked did not return after resume")
+		}
+	})
+	t.Run("WaitUntilUnlocked should not leak goroutines when the context is canceled while paused", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		l := New()
+		l.Pause()
+
+		for range 10 {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			err := l.WaitUntilUnlocked(ctx)
+			cancel()
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		}
+		assert.True(t, l.IsPaused())
+	})
 }
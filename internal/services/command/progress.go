@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"time"
+)
+
+// Progress is the progress of the running command reported by its executor.
+// Only the fields that apply to the command type are set.
+type Progress struct {
+	CommandID   int64       `json:"command_id"`
+	CommandType CommandType `json:"command_type"`
+
+	// CurrentPosition and TargetPosition are the current and the target cargo position
+	// of CARGO_LIFT and CARGO_LOWER.
+	CurrentPosition *uint16 `json:"current_position,omitempty"`
+	TargetPosition  *uint16 `json:"target_position,omitempty"`
+
+	// PassedLocations are the locations passed so far by MOVE_TO and SCAN_LOCATION.
+	PassedLocations []string `json:"passed_locations,omitempty"`
+
+	// WaitedMs is the time waited so far by WAIT.
+	WaitedMs *int64 `json:"waited_ms,omitempty"`
+
+	// Message is a free-form description of the progress, e.g. of a custom command type.
+	Message *string `json:"message,omitempty"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ProgressReporter reports the progress of the running command.
+type ProgressReporter interface {
+	ReportProgress(ctx context.Context, progress Progress)
+}
+
+type progressReporterKey struct{}
+
+// WithProgressReporter returns a copy of the context that carries the progress reporter.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterKey{}, reporter)
+}
+
+// ReportProgress reports the progress through the reporter of the context.
+// It is a no-op if the context does not carry a reporter.
+func ReportProgress(ctx context.Context, progress Progress) {
+	reporter, ok := ctx.Value(progressReporterKey{}).(ProgressReporter)
+	if !ok {
+		return
+	}
+	reporter.ReportProgress(ctx, progress)
+}
@@ -0,0 +1,128 @@
+package command
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// TypeDefinition describes how the inputs and the outputs of a command type are decoded.
+// The inputs are validated with the validate tags of their struct, as any other params.
+type TypeDefinition struct {
+	Type CommandType
+
+	inputsType  reflect.Type
+	outputsType reflect.Type
+}
+
+// NewTypeDefinition returns the definition of the command type of the inputs I and the outputs O.
+// I and O must be structs that embed CommonInputs and CommonOutputs respectively.
+func NewTypeDefinition[I Inputs, O Outputs]() (TypeDefinition, error) {
+	var (
+		inputs  I
+		outputs O
+	)
+
+	inputsType := reflect.TypeOf(inputs)
+	outputsType := reflect.TypeOf(outputs)
+	if inputsType == nil || inputsType.Kind() != reflect.Struct {
+		return TypeDefinition{}, fmt.Errorf("inputs must be a struct, got %v", inputsType)
+	}
+	if outputsType == nil || outputsType.Kind() != reflect.Struct {
+		return TypeDefinition{}, fmt.Errorf("outputs must be a struct, got %v", outputsType)
+	}
+
+	if inputs.CommandType() == "" {
+		return TypeDefinition{}, fmt.Errorf("command type of %v is empty", inputsType)
+	}
+	if inputs.CommandType() != outputs.CommandType() {
+		return TypeDefinition{}, fmt.Errorf("command type of %v is %s, but %s for %v",
+			inputsType, inputs.CommandType(), outputs.CommandType(), outputsType)
+	}
+
+	return TypeDefinition{
+		Type:        inputs.CommandType(),
+		inputsType:  inputsType,
+		outputsType: outputsType,
+	}, nil
+}
+
+// NewInputs returns a pointer to empty inputs of the command type.
+func (d TypeDefinition) NewInputs() Inputs {
+	return reflect.New(d.inputsType).Interface().(Inputs)
+}
+
+// NewOutputs returns a pointer to empty outputs of the command type.
+func (d TypeDefinition) NewOutputs() Outputs {
+	return reflect.New(d.outputsType).Interface().(Outputs)
+}
+
+var typeRegistry = struct {
+	mu    sync.RWMutex
+	types []CommandType
+	defs  map[CommandType]TypeDefinition
+}{
+	defs: map[CommandType]TypeDefinition{},
+}
+
+// RegisterType registers a command type so that it can be validated, stored and decoded.
+// Registering the same definition again is a no-op,
+// registering another definition for a registered command type fails.
+func RegisterType(def TypeDefinition) error {
+	typeRegistry.mu.Lock()
+	defer typeRegistry.mu.Unlock()
+
+	if existing, ok := typeRegistry.defs[def.Type]; ok {
+		if existing.inputsType != def.inputsType || existing.outputsType != def.outputsType {
+			return fmt.Errorf("command type %s is already registered with %v and %v",
+				def.Type, existing.inputsType, existing.outputsType)
+		}
+		return nil
+	}
+
+	typeRegistry.defs[def.Type] = def
+	typeRegistry.types = append(typeRegistry.types, def.Type)
+	return nil
+}
+
+// LookupType returns the definition of a registered command type.
+func LookupType(cmdType CommandType) (TypeDefinition, bool) {
+	typeRegistry.mu.RLock()
+	defer typeRegistry.mu.RUnlock()
+
+	def, ok := typeRegistry.defs[cmdType]
+	return def, ok
+}
+
+// RegisteredTypes returns the registered command types in registration order.
+func RegisteredTypes() []CommandType {
+	typeRegistry.mu.RLock()
+	defer typeRegistry.mu.RUnlock()
+
+	return append([]CommandType(nil), typeRegistry.types...)
+}
+
+func mustRegisterType[I Inputs, O Outputs]() {
+	def, err := NewTypeDefinition[I, O]()
+	if err != nil {
+		panic(err)
+	}
+	if err := RegisterType(def); err != nil {
+		panic(err)
+	}
+}
+
+func init() {
+	mustRegisterType[StopMovementInputs, StopMovementOutputs]()
+	mustRegisterType[MoveForwardInputs, MoveForwardOutputs]()
+	mustRegisterType[MoveBackwardInputs, MoveBackwardOutputs]()
+	mustRegisterType[MoveToInputs, MoveToOutputs]()
+	mustRegisterType[CargoOpenInputs, CargoOpenOutputs]()
+	mustRegisterType[CargoCloseInputs, CargoCloseOutputs]()
+	mustRegisterType[CargoLiftInputs, CargoLiftOutputs]()
+	mustRegisterType[CargoLowerInputs, CargoLowerOutputs]()
+	mustRegisterType[CargoCheckQRInputs, CargoCheckQROutputs]()
+	mustRegisterType[ScanLocationInputs, ScanLocationOutputs]()
+	mustRegisterType[WaitInputs, WaitOutputs]()
+	mustRegisterType[AssertInputs, AssertOutputs]()
+}
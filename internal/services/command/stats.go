@@ -0,0 +1,67 @@
+package command
+
+import "time"
+
+// CommandStats are the aggregate stats of the commands matching a filter.
+//
+//nolint:revive
+type CommandStats struct {
+	Total     int64
+	Succeeded int64
+	Failed    int64
+	TimedOut  int64
+	Canceled  int64
+	// Types are the stats by command type, ordered by type.
+	Types []CommandTypeStats
+	// Sources are the stats by source, ordered by source.
+	Sources []SourceStats
+	// Routes are the travel times of the SUCCEEDED MOVE_TO commands by route, ordered by route.
+	Routes []RouteStats
+	// Failures are the most frequent errors of the FAILED and TIMED_OUT commands,
+	// ordered by count in descending order.
+	Failures []FailureStats
+}
+
+// SuccessRate returns the ratio of the SUCCEEDED commands to the commands that ran to an end,
+// the canceled commands are not counted. It returns 0 if no command ran to an end.
+func (s CommandStats) SuccessRate() float64 {
+	finished := s.Succeeded + s.Failed + s.TimedOut
+	if finished == 0 {
+		return 0
+	}
+	return float64(s.Succeeded) / float64(finished)
+}
+
+type CommandTypeStats struct {
+	Type      CommandType
+	Total     int64
+	Succeeded int64
+	// MeanDuration is the mean time from the start to the completion of the SUCCEEDED commands.
+	MeanDuration time.Duration
+	// P50Duration and P95Duration are the nearest-rank percentiles of the same times.
+	P50Duration time.Duration
+	P95Duration time.Duration
+}
+
+type SourceStats struct {
+	Source    Source
+	Total     int64
+	Succeeded int64
+}
+
+// RouteStats are the travel times of the SUCCEEDED MOVE_TO commands between two locations.
+// A MOVE_TO starts from the target of the move before it, if that move is a SUCCEEDED MOVE_TO.
+// The locations are the ones of the MOVE_TO inputs, a station alias is not resolved to its tag.
+type RouteStats struct {
+	From         string
+	To           string
+	Count        int64
+	MeanDuration time.Duration
+	P50Duration  time.Duration
+	P95Duration  time.Duration
+}
+
+type FailureStats struct {
+	Error string
+	Count int64
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/cargo"
+	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/led"
@@ -25,6 +26,7 @@ type RobotState struct {
 	CargoDoorMotor   cargo.DoorMotorState
 	AppState         appstate.AppState
 	Leds             led.LedsOutput
+	CommandQueue     command.QueueState
 }
 
 type Service interface {
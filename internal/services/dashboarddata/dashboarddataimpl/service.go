@@ -9,6 +9,7 @@ import (
 	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/cargo"
+	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/dashboarddata"
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
@@ -27,6 +28,7 @@ type service struct {
 	cargoRepo          cargo.Repository
 	appStateRepo       appstate.Repository
 	ledRepo            led.Repository
+	queueStateRepo     command.QueueStateRepository
 }
 
 func NewService(
@@ -39,6 +41,7 @@ func NewService(
 	cargoRepo cargo.Repository,
 	appStateRepo appstate.Repository,
 	ledRepo led.Repository,
+	queueStateRepo command.QueueStateRepository,
 ) dashboarddata.Service {
 	return &service{
 		batteryStateRepo:   batteryStateRepo,
@@ -50,6 +53,7 @@ func NewService(
 		cargoRepo:          cargoRepo,
 		appStateRepo:       appStateRepo,
 		ledRepo:            ledRepo,
+		queueStateRepo:     queueStateRepo,
 	}
 }
 
@@ -123,6 +127,12 @@ func (s *service) GetRobotState(ctx context.Context) (dashboarddata.RobotState,
 		return err
 	})
 
+	g.Go(func() error {
+		var err error
+		ret.CommandQueue, err = s.queueStateRepo.GetQueueState(ctx)
+		return err
+	})
+
 	if err := g.Wait(); err != nil {
 		return dashboarddata.RobotState{}, fmt.Errorf("error group wait: %w", err)
 	}
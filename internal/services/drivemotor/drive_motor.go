@@ -2,6 +2,7 @@ package drivemotor
 
 import (
 	"context"
+	"time"
 
 	"github.com/tbe-team/raybot/internal/hardware/picserial"
 	"github.com/tbe-team/raybot/pkg/xerror"
@@ -22,10 +23,16 @@ type UpdateDriveMotorStateParams struct {
 
 type MoveForwardParams struct {
 	Speed uint8 `validate:"min=0,max=100"`
+	// Ramp is the duration to change from the current speed to Speed.
+	// Zero applies Speed immediately.
+	Ramp time.Duration `validate:"min=0"`
 }
 
 type MoveBackwardParams struct {
 	Speed uint8 `validate:"min=0,max=100"`
+	// Ramp is the duration to change from the current speed to Speed.
+	// Zero applies Speed immediately.
+	Ramp time.Duration `validate:"min=0"`
 }
 
 type Service interface {
@@ -37,10 +44,12 @@ type Service interface {
 	UpdateDriveMotorState(ctx context.Context, params UpdateDriveMotorStateParams) error
 
 	// MoveForward moves the drive motor forward.
+	// If a ramp is set, it blocks until the speed is reached.
 	// This directly sends commands to the hardware.
 	MoveForward(ctx context.Context, params MoveForwardParams) error
 
 	// MoveBackward moves the drive motor backward.
+	// If a ramp is set, it blocks until the speed is reached.
 	// This directly sends commands to the hardware.
 	MoveBackward(ctx context.Context, params MoveBackwardParams) error
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/hardware/controller"
@@ -13,6 +14,9 @@ import (
 	"github.com/tbe-team/raybot/pkg/validator"
 )
 
+// rampStepInterval is the interval between speed changes while ramping.
+const rampStepInterval = 100 * time.Millisecond
+
 type service struct {
 	validator validator.Validator
 	publisher eventbus.Publisher
@@ -65,7 +69,7 @@ func (s service) MoveForward(ctx context.Context, params drivemotor.MoveForwardP
 		return fmt.Errorf("validate params: %w", err)
 	}
 
-	if err := s.driveMotorController.MoveForward(ctx, params.Speed); err != nil {
+	if err := s.ramp(ctx, drivemotor.DirectionForward, params.Speed, params.Ramp, s.driveMotorController.MoveForward); err != nil {
 		if errors.Is(err, picserial.ErrPICSerialNotConnected) {
 			return drivemotor.ErrCanNotControlDriveMotor
 		}
@@ -80,7 +84,7 @@ func (s service) MoveBackward(ctx context.Context, params drivemotor.MoveBackwar
 		return fmt.Errorf("validate params: %w", err)
 	}
 
-	if err := s.driveMotorController.MoveBackward(ctx, params.Speed); err != nil {
+	if err := s.ramp(ctx, drivemotor.DirectionBackward, params.Speed, params.Ramp, s.driveMotorController.MoveBackward); err != nil {
 		if errors.Is(err, picserial.ErrPICSerialNotConnected) {
 			return drivemotor.ErrCanNotControlDriveMotor
 		}
@@ -114,3 +118,50 @@ func (s service) Stop(ctx context.Context) error {
 
 	return nil
 }
+
+// ramp changes the speed linearly from the current speed to the target speed over the ramp duration.
+// The current speed is zero if the motor is not running in the given direction.
+func (s service) ramp(
+	ctx context.Context,
+	direction drivemotor.Direction,
+	target uint8,
+	ramp time.Duration,
+	setSpeed func(ctx context.Context, speed uint8) error,
+) error {
+	steps := int(ramp / rampStepInterval)
+	if steps <= 1 {
+		return setSpeed(ctx, target)
+	}
+
+	state, err := s.driveMotorStateRepo.GetDriveMotorState(ctx)
+	if err != nil {
+		return fmt.Errorf("get drive motor state: %w", err)
+	}
+
+	from := 0
+	if state.IsRunning && state.Direction == direction {
+		from = int(state.Speed)
+	}
+
+	ticker := time.NewTicker(rampStepInterval)
+	defer ticker.Stop()
+
+	for i := 1; i <= steps; i++ {
+		speed := from + (int(target)-from)*i/steps
+		if err := setSpeed(ctx, uint8(speed)); err != nil { //nolint:gosec
+			return err
+		}
+
+		if i == steps {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
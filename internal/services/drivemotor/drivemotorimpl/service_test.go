@@ -0,0 +1,91 @@
+package drivemotorimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+type fakeDriveMotorController struct {
+	forwardSpeeds  []uint8
+	backwardSpeeds []uint8
+}
+
+func (c *fakeDriveMotorController) MoveForward(_ context.Context, speed uint8) error {
+	c.forwardSpeeds = append(c.forwardSpeeds, speed)
+	return nil
+}
+
+func (c *fakeDriveMotorController) MoveBackward(_ context.Context, speed uint8) error {
+	c.backwardSpeeds = append(c.backwardSpeeds, speed)
+	return nil
+}
+
+func (c *fakeDriveMotorController) StopDriveMotor(_ context.Context, _ bool) error {
+	return nil
+}
+
+func TestService_MoveForward(t *testing.T) {
+	t.Run("Should set speed immediately without ramp", func(t *testing.T) {
+		controller := &fakeDriveMotorController{}
+		s := NewService(validator.New(), &eventbus.NoopEventBus{}, NewDriveMotorStateRepository(), controller)
+
+		err := s.MoveForward(context.Background(), drivemotor.MoveForwardParams{Speed: 80})
+		require.NoError(t, err)
+		require.Equal(t, []uint8{80}, controller.forwardSpeeds)
+	})
+
+	t.Run("Should ramp up from stop to the target speed", func(t *testing.T) {
+		controller := &fakeDriveMotorController{}
+		s := NewService(validator.New(), &eventbus.NoopEventBus{}, NewDriveMotorStateRepository(), controller)
+
+		err := s.MoveForward(context.Background(), drivemotor.MoveForwardParams{
+			Speed: 80,
+			Ramp:  4 * rampStepInterval,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint8{20, 40, 60, 80}, controller.forwardSpeeds)
+	})
+
+	t.Run("Should ramp down from the current speed in the same direction", func(t *testing.T) {
+		controller := &fakeDriveMotorController{}
+		stateRepo := NewDriveMotorStateRepository()
+		require.NoError(t, stateRepo.UpdateDriveMotorState(context.Background(), drivemotor.UpdateDriveMotorStateParams{
+			Direction:    drivemotor.DirectionBackward,
+			SetDirection: true,
+			Speed:        100,
+			SetSpeed:     true,
+			IsRunning:    true,
+			SetIsRunning: true,
+		}))
+		s := NewService(validator.New(), &eventbus.NoopEventBus{}, stateRepo, controller)
+
+		err := s.MoveBackward(context.Background(), drivemotor.MoveBackwardParams{
+			Speed: 50,
+			Ramp:  2 * rampStepInterval,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint8{75, 50}, controller.backwardSpeeds)
+	})
+
+	t.Run("Should stop ramping when the context is canceled", func(t *testing.T) {
+		controller := &fakeDriveMotorController{}
+		s := NewService(validator.New(), &eventbus.NoopEventBus{}, NewDriveMotorStateRepository(), controller)
+
+		ctx, cancel := context.WithTimeout(context.Background(), rampStepInterval/2)
+		defer cancel()
+
+		err := s.MoveForward(ctx, drivemotor.MoveForwardParams{
+			Speed: 100,
+			Ramp:  10 * time.Second,
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Len(t, controller.forwardSpeeds, 1)
+	})
+}
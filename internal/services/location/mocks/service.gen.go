@@ -0,0 +1,140 @@
+// Code generated by mockery v2.53.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	location "github.com/tbe-team/raybot/internal/services/location"
+)
+
+// FakeService is an autogenerated mock type for the Service type
+type FakeService struct {
+	mock.Mock
+}
+
+type FakeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FakeService) EXPECT() *FakeService_Expecter {
+	return &FakeService_Expecter{mock: &_m.Mock}
+}
+
+// GetLocation provides a mock function with given fields: ctx
+func (_m *FakeService) GetLocation(ctx context.Context) (location.Location, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocation")
+	}
+
+	var r0 location.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (location.Location, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) location.Location); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(location.Location)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLocation'
+type FakeService_GetLocation_Call struct {
+	*mock.Call
+}
+
+// GetLocation is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) GetLocation(ctx interface{}) *FakeService_GetLocation_Call {
+	return &FakeService_GetLocation_Call{Call: _e.mock.On("GetLocation", ctx)}
+}
+
+func (_c *FakeService_GetLocation_Call) Run(run func(ctx context.Context)) *FakeService_GetLocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetLocation_Call) Return(_a0 location.Location, _a1 error) *FakeService_GetLocation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetLocation_Call) RunAndReturn(run func(context.Context) (location.Location, error)) *FakeService_GetLocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLocation provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateLocation(ctx context.Context, params location.UpdateLocationParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLocation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, location.UpdateLocationParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_UpdateLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLocation'
+type FakeService_UpdateLocation_Call struct {
+	*mock.Call
+}
+
+// UpdateLocation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params location.UpdateLocationParams
+func (_e *FakeService_Expecter) UpdateLocation(ctx interface{}, params interface{}) *FakeService_UpdateLocation_Call {
+	return &FakeService_UpdateLocation_Call{Call: _e.mock.On("UpdateLocation", ctx, params)}
+}
+
+func (_c *FakeService_UpdateLocation_Call) Run(run func(ctx context.Context, params location.UpdateLocationParams)) *FakeService_UpdateLocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(location.UpdateLocationParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateLocation_Call) Return(_a0 error) *FakeService_UpdateLocation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_UpdateLocation_Call) RunAndReturn(run func(context.Context, location.UpdateLocationParams) error) *FakeService_UpdateLocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFakeService creates a new instance of FakeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFakeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FakeService {
+	mock := &FakeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
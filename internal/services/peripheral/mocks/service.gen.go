@@ -22,6 +22,63 @@ func (_m *FakeService) EXPECT() *FakeService_Expecter {
 	return &FakeService_Expecter{mock: &_m.Mock}
 }
 
+// FindUSBSerialPort provides a mock function with given fields: ctx, params
+func (_m *FakeService) FindUSBSerialPort(ctx context.Context, params peripheral.FindUSBSerialPortParams) (peripheral.SerialPort, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUSBSerialPort")
+	}
+
+	var r0 peripheral.SerialPort
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, peripheral.FindUSBSerialPortParams) (peripheral.SerialPort, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, peripheral.FindUSBSerialPortParams) peripheral.SerialPort); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(peripheral.SerialPort)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, peripheral.FindUSBSerialPortParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_FindUSBSerialPort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUSBSerialPort'
+type FakeService_FindUSBSerialPort_Call struct {
+	*mock.Call
+}
+
+// FindUSBSerialPort is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params peripheral.FindUSBSerialPortParams
+func (_e *FakeService_Expecter) FindUSBSerialPort(ctx interface{}, params interface{}) *FakeService_FindUSBSerialPort_Call {
+	return &FakeService_FindUSBSerialPort_Call{Call: _e.mock.On("FindUSBSerialPort", ctx, params)}
+}
+
+func (_c *FakeService_FindUSBSerialPort_Call) Run(run func(ctx context.Context, params peripheral.FindUSBSerialPortParams)) *FakeService_FindUSBSerialPort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(peripheral.FindUSBSerialPortParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_FindUSBSerialPort_Call) Return(_a0 peripheral.SerialPort, _a1 error) *FakeService_FindUSBSerialPort_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_FindUSBSerialPort_Call) RunAndReturn(run func(context.Context, peripheral.FindUSBSerialPortParams) (peripheral.SerialPort, error)) *FakeService_FindUSBSerialPort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAvailableSerialPorts provides a mock function with given fields: ctx
 func (_m *FakeService) ListAvailableSerialPorts(ctx context.Context) ([]peripheral.SerialPort, error) {
 	ret := _m.Called(ctx)
@@ -2,4 +2,10 @@ package peripheral
 
 type SerialPort struct {
 	Port string
+
+	// IsUSB is true for USB serial adapters, the IDs below are set only for them.
+	IsUSB        bool
+	VID          string
+	PID          string
+	SerialNumber string
 }
@@ -1,7 +1,23 @@
 package peripheral
 
-import "context"
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var ErrSerialPortNotFound = xerror.NotFound(nil, "peripheral.serialPortNotFound", "serial port not found")
+
+type FindUSBSerialPortParams struct {
+	// VID, PID and SerialNumber are matched case-insensitively, empty fields match any value.
+	VID          string
+	PID          string
+	SerialNumber string
+}
 
 type Service interface {
 	ListAvailableSerialPorts(ctx context.Context) ([]SerialPort, error)
+	// FindUSBSerialPort returns the USB serial port matching the IDs.
+	// It returns ErrSerialPortNotFound when no port matches.
+	FindUSBSerialPort(ctx context.Context, params FindUSBSerialPortParams) (SerialPort, error)
 }
@@ -0,0 +1,24 @@
+package peripheralimpl
+
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/internal/services/peripheral"
+)
+
+var _ peripheral.Service = (*NoopService)(nil)
+
+// NoopService is a peripheral service without any serial port, e.g. for the capture replay.
+type NoopService struct{}
+
+func NewNoopService() *NoopService {
+	return &NoopService{}
+}
+
+func (s *NoopService) ListAvailableSerialPorts(_ context.Context) ([]peripheral.SerialPort, error) {
+	return nil, nil
+}
+
+func (s *NoopService) FindUSBSerialPort(_ context.Context, _ peripheral.FindUSBSerialPortParams) (peripheral.SerialPort, error) {
+	return peripheral.SerialPort{}, peripheral.ErrSerialPortNotFound
+}
@@ -2,29 +2,62 @@ package peripheralimpl
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
-	libserial "go.bug.st/serial"
+	"go.bug.st/serial/enumerator"
 
 	"github.com/tbe-team/raybot/internal/services/peripheral"
 )
 
 type service struct {
+	listPorts func() ([]*enumerator.PortDetails, error)
 }
 
 func NewService() peripheral.Service {
-	return &service{}
+	return &service{
+		listPorts: enumerator.GetDetailedPortsList,
+	}
 }
 
 func (s service) ListAvailableSerialPorts(_ context.Context) ([]peripheral.SerialPort, error) {
-	ports, err := libserial.GetPortsList()
+	ports, err := s.listPorts()
 	if err != nil {
 		return nil, err
 	}
 
 	serialPorts := make([]peripheral.SerialPort, len(ports))
 	for i, port := range ports {
-		serialPorts[i] = peripheral.SerialPort{Port: port}
+		serialPorts[i] = peripheral.SerialPort{
+			Port:         port.Name,
+			IsUSB:        port.IsUSB,
+			VID:          port.VID,
+			PID:          port.PID,
+			SerialNumber: port.SerialNumber,
+		}
 	}
 
 	return serialPorts, nil
 }
+
+func (s service) FindUSBSerialPort(ctx context.Context, params peripheral.FindUSBSerialPortParams) (peripheral.SerialPort, error) {
+	ports, err := s.ListAvailableSerialPorts(ctx)
+	if err != nil {
+		return peripheral.SerialPort{}, fmt.Errorf("list serial ports: %w", err)
+	}
+
+	for _, port := range ports {
+		if port.IsUSB &&
+			matchUSBID(params.VID, port.VID) &&
+			matchUSBID(params.PID, port.PID) &&
+			matchUSBID(params.SerialNumber, port.SerialNumber) {
+			return port, nil
+		}
+	}
+
+	return peripheral.SerialPort{}, peripheral.ErrSerialPortNotFound
+}
+
+func matchUSBID(want, got string) bool {
+	return want == "" || strings.EqualFold(want, got)
+}
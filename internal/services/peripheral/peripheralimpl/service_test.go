@@ -0,0 +1,50 @@
+package peripheralimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/serial/enumerator"
+
+	"github.com/tbe-team/raybot/internal/services/peripheral"
+)
+
+func TestService_FindUSBSerialPort(t *testing.T) {
+	s := service{
+		listPorts: func() ([]*enumerator.PortDetails, error) {
+			return []*enumerator.PortDetails{
+				{Name: "/dev/ttyS0"},
+				{Name: "/dev/ttyUSB0", IsUSB: true, VID: "1a86", PID: "7523", SerialNumber: "A1"},
+				{Name: "/dev/ttyUSB2", IsUSB: true, VID: "1a86", PID: "7523", SerialNumber: "B2"},
+			}, nil
+		},
+	}
+
+	t.Run("Should find the port by its serial number", func(t *testing.T) {
+		port, err := s.FindUSBSerialPort(context.Background(), peripheral.FindUSBSerialPortParams{
+			VID:          "1A86",
+			PID:          "7523",
+			SerialNumber: "B2",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "/dev/ttyUSB2", port.Port)
+	})
+
+	t.Run("Should return the first port matching the VID and PID", func(t *testing.T) {
+		port, err := s.FindUSBSerialPort(context.Background(), peripheral.FindUSBSerialPortParams{
+			VID: "1a86",
+			PID: "7523",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "/dev/ttyUSB0", port.Port)
+	})
+
+	t.Run("Should return not found when no port matches", func(t *testing.T) {
+		_, err := s.FindUSBSerialPort(context.Background(), peripheral.FindUSBSerialPortParams{
+			VID: "0403",
+		})
+		assert.ErrorIs(t, err, peripheral.ErrSerialPortNotFound)
+	})
+}
@@ -0,0 +1,312 @@
+// Code generated by mockery v2.53.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	railmap "github.com/tbe-team/raybot/internal/services/railmap"
+)
+
+// FakeRepository is an autogenerated mock type for the Repository type
+type FakeRepository struct {
+	mock.Mock
+}
+
+type FakeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FakeRepository) EXPECT() *FakeRepository_Expecter {
+	return &FakeRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: ctx, tag
+func (_m *FakeRepository) CreateTag(ctx context.Context, tag railmap.Tag) (railmap.Tag, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 railmap.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.Tag) (railmap.Tag, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.Tag) railmap.Tag); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Get(0).(railmap.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, railmap.Tag) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type FakeRepository_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag railmap.Tag
+func (_e *FakeRepository_Expecter) CreateTag(ctx interface{}, tag interface{}) *FakeRepository_CreateTag_Call {
+	return &FakeRepository_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, tag)}
+}
+
+func (_c *FakeRepository_CreateTag_Call) Run(run func(ctx context.Context, tag railmap.Tag)) *FakeRepository_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.Tag))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_CreateTag_Call) Return(_a0 railmap.Tag, _a1 error) *FakeRepository_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_CreateTag_Call) RunAndReturn(run func(context.Context, railmap.Tag) (railmap.Tag, error)) *FakeRepository_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, id
+func (_m *FakeRepository) DeleteTag(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeRepository_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type FakeRepository_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *FakeRepository_Expecter) DeleteTag(ctx interface{}, id interface{}) *FakeRepository_DeleteTag_Call {
+	return &FakeRepository_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, id)}
+}
+
+func (_c *FakeRepository_DeleteTag_Call) Run(run func(ctx context.Context, id int64)) *FakeRepository_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_DeleteTag_Call) Return(_a0 error) *FakeRepository_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeRepository_DeleteTag_Call) RunAndReturn(run func(context.Context, int64) error) *FakeRepository_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRailMap provides a mock function with given fields: ctx
+func (_m *FakeRepository) GetRailMap(ctx context.Context) (railmap.RailMap, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRailMap")
+	}
+
+	var r0 railmap.RailMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (railmap.RailMap, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) railmap.RailMap); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(railmap.RailMap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_GetRailMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRailMap'
+type FakeRepository_GetRailMap_Call struct {
+	*mock.Call
+}
+
+// GetRailMap is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeRepository_Expecter) GetRailMap(ctx interface{}) *FakeRepository_GetRailMap_Call {
+	return &FakeRepository_GetRailMap_Call{Call: _e.mock.On("GetRailMap", ctx)}
+}
+
+func (_c *FakeRepository_GetRailMap_Call) Run(run func(ctx context.Context)) *FakeRepository_GetRailMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_GetRailMap_Call) Return(_a0 railmap.RailMap, _a1 error) *FakeRepository_GetRailMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_GetRailMap_Call) RunAndReturn(run func(context.Context) (railmap.RailMap, error)) *FakeRepository_GetRailMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceRailMap provides a mock function with given fields: ctx, topology, tags
+func (_m *FakeRepository) ReplaceRailMap(ctx context.Context, topology railmap.Topology, tags []railmap.Tag) (railmap.RailMap, error) {
+	ret := _m.Called(ctx, topology, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceRailMap")
+	}
+
+	var r0 railmap.RailMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.Topology, []railmap.Tag) (railmap.RailMap, error)); ok {
+		return rf(ctx, topology, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.Topology, []railmap.Tag) railmap.RailMap); ok {
+		r0 = rf(ctx, topology, tags)
+	} else {
+		r0 = ret.Get(0).(railmap.RailMap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, railmap.Topology, []railmap.Tag) error); ok {
+		r1 = rf(ctx, topology, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_ReplaceRailMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceRailMap'
+type FakeRepository_ReplaceRailMap_Call struct {
+	*mock.Call
+}
+
+// ReplaceRailMap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topology railmap.Topology
+//   - tags []railmap.Tag
+func (_e *FakeRepository_Expecter) ReplaceRailMap(ctx interface{}, topology interface{}, tags interface{}) *FakeRepository_ReplaceRailMap_Call {
+	return &FakeRepository_ReplaceRailMap_Call{Call: _e.mock.On("ReplaceRailMap", ctx, topology, tags)}
+}
+
+func (_c *FakeRepository_ReplaceRailMap_Call) Run(run func(ctx context.Context, topology railmap.Topology, tags []railmap.Tag)) *FakeRepository_ReplaceRailMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.Topology), args[2].([]railmap.Tag))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_ReplaceRailMap_Call) Return(_a0 railmap.RailMap, _a1 error) *FakeRepository_ReplaceRailMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_ReplaceRailMap_Call) RunAndReturn(run func(context.Context, railmap.Topology, []railmap.Tag) (railmap.RailMap, error)) *FakeRepository_ReplaceRailMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, params
+func (_m *FakeRepository) UpdateTag(ctx context.Context, params railmap.UpdateTagParams) (railmap.Tag, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 railmap.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.UpdateTagParams) (railmap.Tag, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.UpdateTagParams) railmap.Tag); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(railmap.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, railmap.UpdateTagParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type FakeRepository_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params railmap.UpdateTagParams
+func (_e *FakeRepository_Expecter) UpdateTag(ctx interface{}, params interface{}) *FakeRepository_UpdateTag_Call {
+	return &FakeRepository_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, params)}
+}
+
+func (_c *FakeRepository_UpdateTag_Call) Run(run func(ctx context.Context, params railmap.UpdateTagParams)) *FakeRepository_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.UpdateTagParams))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_UpdateTag_Call) Return(_a0 railmap.Tag, _a1 error) *FakeRepository_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_UpdateTag_Call) RunAndReturn(run func(context.Context, railmap.UpdateTagParams) (railmap.Tag, error)) *FakeRepository_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFakeRepository creates a new instance of FakeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFakeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FakeRepository {
+	mock := &FakeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
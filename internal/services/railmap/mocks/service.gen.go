@@ -0,0 +1,368 @@
+// Code generated by mockery v2.53.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	railmap "github.com/tbe-team/raybot/internal/services/railmap"
+)
+
+// FakeService is an autogenerated mock type for the Service type
+type FakeService struct {
+	mock.Mock
+}
+
+type FakeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FakeService) EXPECT() *FakeService_Expecter {
+	return &FakeService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: ctx, params
+func (_m *FakeService) CreateTag(ctx context.Context, params railmap.CreateTagParams) (railmap.Tag, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 railmap.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.CreateTagParams) (railmap.Tag, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.CreateTagParams) railmap.Tag); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(railmap.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, railmap.CreateTagParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type FakeService_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params railmap.CreateTagParams
+func (_e *FakeService_Expecter) CreateTag(ctx interface{}, params interface{}) *FakeService_CreateTag_Call {
+	return &FakeService_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, params)}
+}
+
+func (_c *FakeService_CreateTag_Call) Run(run func(ctx context.Context, params railmap.CreateTagParams)) *FakeService_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.CreateTagParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CreateTag_Call) Return(_a0 railmap.Tag, _a1 error) *FakeService_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_CreateTag_Call) RunAndReturn(run func(context.Context, railmap.CreateTagParams) (railmap.Tag, error)) *FakeService_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, params
+func (_m *FakeService) DeleteTag(ctx context.Context, params railmap.DeleteTagParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.DeleteTagParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type FakeService_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params railmap.DeleteTagParams
+func (_e *FakeService_Expecter) DeleteTag(ctx interface{}, params interface{}) *FakeService_DeleteTag_Call {
+	return &FakeService_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, params)}
+}
+
+func (_c *FakeService_DeleteTag_Call) Run(run func(ctx context.Context, params railmap.DeleteTagParams)) *FakeService_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.DeleteTagParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_DeleteTag_Call) Return(_a0 error) *FakeService_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_DeleteTag_Call) RunAndReturn(run func(context.Context, railmap.DeleteTagParams) error) *FakeService_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRailMap provides a mock function with given fields: ctx
+func (_m *FakeService) GetRailMap(ctx context.Context) (railmap.RailMap, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRailMap")
+	}
+
+	var r0 railmap.RailMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (railmap.RailMap, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) railmap.RailMap); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(railmap.RailMap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetRailMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRailMap'
+type FakeService_GetRailMap_Call struct {
+	*mock.Call
+}
+
+// GetRailMap is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) GetRailMap(ctx interface{}) *FakeService_GetRailMap_Call {
+	return &FakeService_GetRailMap_Call{Call: _e.mock.On("GetRailMap", ctx)}
+}
+
+func (_c *FakeService_GetRailMap_Call) Run(run func(ctx context.Context)) *FakeService_GetRailMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetRailMap_Call) Return(_a0 railmap.RailMap, _a1 error) *FakeService_GetRailMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetRailMap_Call) RunAndReturn(run func(context.Context) (railmap.RailMap, error)) *FakeService_GetRailMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncFromScan provides a mock function with given fields: ctx, params
+func (_m *FakeService) SyncFromScan(ctx context.Context, params railmap.SyncFromScanParams) (railmap.RailMap, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncFromScan")
+	}
+
+	var r0 railmap.RailMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.SyncFromScanParams) (railmap.RailMap, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.SyncFromScanParams) railmap.RailMap); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(railmap.RailMap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, railmap.SyncFromScanParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_SyncFromScan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncFromScan'
+type FakeService_SyncFromScan_Call struct {
+	*mock.Call
+}
+
+// SyncFromScan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params railmap.SyncFromScanParams
+func (_e *FakeService_Expecter) SyncFromScan(ctx interface{}, params interface{}) *FakeService_SyncFromScan_Call {
+	return &FakeService_SyncFromScan_Call{Call: _e.mock.On("SyncFromScan", ctx, params)}
+}
+
+func (_c *FakeService_SyncFromScan_Call) Run(run func(ctx context.Context, params railmap.SyncFromScanParams)) *FakeService_SyncFromScan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.SyncFromScanParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_SyncFromScan_Call) Return(_a0 railmap.RailMap, _a1 error) *FakeService_SyncFromScan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_SyncFromScan_Call) RunAndReturn(run func(context.Context, railmap.SyncFromScanParams) (railmap.RailMap, error)) *FakeService_SyncFromScan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRailMap provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateRailMap(ctx context.Context, params railmap.UpdateRailMapParams) (railmap.RailMap, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRailMap")
+	}
+
+	var r0 railmap.RailMap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.UpdateRailMapParams) (railmap.RailMap, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.UpdateRailMapParams) railmap.RailMap); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(railmap.RailMap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, railmap.UpdateRailMapParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_UpdateRailMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRailMap'
+type FakeService_UpdateRailMap_Call struct {
+	*mock.Call
+}
+
+// UpdateRailMap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params railmap.UpdateRailMapParams
+func (_e *FakeService_Expecter) UpdateRailMap(ctx interface{}, params interface{}) *FakeService_UpdateRailMap_Call {
+	return &FakeService_UpdateRailMap_Call{Call: _e.mock.On("UpdateRailMap", ctx, params)}
+}
+
+func (_c *FakeService_UpdateRailMap_Call) Run(run func(ctx context.Context, params railmap.UpdateRailMapParams)) *FakeService_UpdateRailMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.UpdateRailMapParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateRailMap_Call) Return(_a0 railmap.RailMap, _a1 error) *FakeService_UpdateRailMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_UpdateRailMap_Call) RunAndReturn(run func(context.Context, railmap.UpdateRailMapParams) (railmap.RailMap, error)) *FakeService_UpdateRailMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateTag(ctx context.Context, params railmap.UpdateTagParams) (railmap.Tag, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 railmap.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.UpdateTagParams) (railmap.Tag, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, railmap.UpdateTagParams) railmap.Tag); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(railmap.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, railmap.UpdateTagParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type FakeService_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params railmap.UpdateTagParams
+func (_e *FakeService_Expecter) UpdateTag(ctx interface{}, params interface{}) *FakeService_UpdateTag_Call {
+	return &FakeService_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, params)}
+}
+
+func (_c *FakeService_UpdateTag_Call) Run(run func(ctx context.Context, params railmap.UpdateTagParams)) *FakeService_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(railmap.UpdateTagParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateTag_Call) Return(_a0 railmap.Tag, _a1 error) *FakeService_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_UpdateTag_Call) RunAndReturn(run func(context.Context, railmap.UpdateTagParams) (railmap.Tag, error)) *FakeService_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFakeService creates a new instance of FakeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFakeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FakeService {
+	mock := &FakeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,192 @@
+package railmap
+
+import (
+	"fmt"
+	"time"
+)
+
+type Topology string
+
+func (t Topology) Validate() error {
+	if t != TopologyLoop && t != TopologyLinear {
+		return fmt.Errorf("invalid topology: %s", t)
+	}
+	return nil
+}
+
+func (t Topology) String() string {
+	return string(t)
+}
+
+const (
+	// TopologyLoop is a closed rail, the tag after the last one is the first one.
+	TopologyLoop Topology = "LOOP"
+	// TopologyLinear is an open rail with two ends.
+	TopologyLinear Topology = "LINEAR"
+)
+
+type Direction string
+
+func (d Direction) String() string {
+	return string(d)
+}
+
+const (
+	DirectionForward  Direction = "FORWARD"
+	DirectionBackward Direction = "BACKWARD"
+)
+
+// RailMap is the layout of the RFID tags along the rail.
+// The tags are ordered in the forward direction of travel.
+type RailMap struct {
+	Topology  Topology
+	Tags      []Tag
+	UpdatedAt time.Time
+}
+
+type Tag struct {
+	ID       int64
+	Location string
+	Position int
+	// DistanceToNext is the distance in centimeters to the next tag in the forward direction.
+	// On a loop, the next tag of the last one is the first one.
+	DistanceToNext *uint32
+	// Alias is the station name of the tag, e.g. "dock-A".
+	Alias     *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IndexOf returns the index of the tag with the location, or -1 if not found.
+func (m RailMap) IndexOf(location string) int {
+	for i, tag := range m.Tags {
+		if tag.Location == location {
+			return i
+		}
+	}
+	return -1
+}
+
+// ResolveLocation returns the location of the tag matching the alias or the location.
+func (m RailMap) ResolveLocation(aliasOrLocation string) (string, bool) {
+	for _, tag := range m.Tags {
+		if tag.Alias != nil && *tag.Alias == aliasOrLocation {
+			return tag.Location, true
+		}
+	}
+	if m.IndexOf(aliasOrLocation) != -1 {
+		return aliasOrLocation, true
+	}
+	return "", false
+}
+
+// Direction returns the direction of the shortest path from one location to another.
+// On a loop, the path length is the sum of the distances between the tags,
+// or the number of tags in between if some distances are unknown.
+// It prefers forward if both directions are the same length.
+// It returns ErrAlreadyAtLocation if both locations are the same.
+func (m RailMap) Direction(from, to string) (Direction, error) {
+	i := m.IndexOf(from)
+	if i == -1 {
+		return "", fmt.Errorf("location %s: %w", from, ErrLocationNotFound)
+	}
+	j := m.IndexOf(to)
+	if j == -1 {
+		return "", fmt.Errorf("location %s: %w", to, ErrLocationNotFound)
+	}
+	if i == j {
+		return "", fmt.Errorf("location %s: %w", to, ErrAlreadyAtLocation)
+	}
+
+	if m.Topology == TopologyLinear {
+		if j < i {
+			return DirectionBackward, nil
+		}
+		return DirectionForward, nil
+	}
+
+	n := len(m.Tags)
+	forwardHops := (j - i + n) % n
+	backwardHops := (i - j + n) % n
+
+	forward, forwardOk := m.pathLength(i, forwardHops)
+	backward, backwardOk := m.pathLength(j, backwardHops)
+	if !forwardOk || !backwardOk {
+		forward, backward = uint64(forwardHops), uint64(backwardHops)
+	}
+
+	if backward < forward {
+		return DirectionBackward, nil
+	}
+	return DirectionForward, nil
+}
+
+// Neighbor returns the location of the tag reached right after the location
+// when travelling in the direction.
+func (m RailMap) Neighbor(location string, direction Direction) (string, bool) {
+	idx := m.IndexOf(location)
+	n := len(m.Tags)
+	if idx == -1 || n < 2 {
+		return "", false
+	}
+
+	next := idx + 1
+	if direction == DirectionBackward {
+		next = idx - 1
+	}
+
+	if m.Topology == TopologyLinear {
+		if next < 0 || next >= n {
+			return "", false
+		}
+		return m.Tags[next].Location, true
+	}
+
+	return m.Tags[(next+n)%n].Location, true
+}
+
+// LocationBefore returns the location of the tag reached right before the location
+// when travelling in the direction.
+func (m RailMap) LocationBefore(location string, direction Direction) (string, bool) {
+	if direction == DirectionBackward {
+		return m.Neighbor(location, DirectionForward)
+	}
+	return m.Neighbor(location, DirectionBackward)
+}
+
+// Path returns the locations passed when travelling from one location to another in the direction,
+// the target location being the last one. The target is not reachable past the end of a linear rail.
+func (m RailMap) Path(from, to string, direction Direction) ([]string, error) {
+	if m.IndexOf(from) == -1 {
+		return nil, fmt.Errorf("location %s: %w", from, ErrLocationNotFound)
+	}
+	if m.IndexOf(to) == -1 {
+		return nil, fmt.Errorf("location %s: %w", to, ErrLocationNotFound)
+	}
+
+	path := []string{}
+	for current := from; current != to; {
+		next, ok := m.Neighbor(current, direction)
+		if !ok || len(path) >= len(m.Tags) {
+			return nil, fmt.Errorf("location %s is not reachable from %s moving %s", to, from, direction)
+		}
+		path = append(path, next)
+		current = next
+	}
+
+	return path, nil
+}
+
+// pathLength sums the distances of the hops in the forward direction starting at the tag index.
+// ok is false if a distance is unknown.
+func (m RailMap) pathLength(start, hops int) (length uint64, ok bool) {
+	n := len(m.Tags)
+	for k := range hops {
+		distance := m.Tags[(start+k)%n].DistanceToNext
+		if distance == nil {
+			return 0, false
+		}
+		length += uint64(*distance)
+	}
+	return length, true
+}
@@ -0,0 +1,157 @@
+package railmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestRailMap_Direction(t *testing.T) {
+	loop := RailMap{
+		Topology: TopologyLoop,
+		Tags: []Tag{
+			{Location: "A", DistanceToNext: ptr.New(uint32(100))},
+			{Location: "B", DistanceToNext: ptr.New(uint32(100))},
+			{Location: "C", DistanceToNext: ptr.New(uint32(500))},
+			{Location: "D", DistanceToNext: ptr.New(uint32(100))},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		railMap  RailMap
+		from     string
+		to       string
+		expected Direction
+	}{
+		{
+			name:     "Should go forward on a loop if the forward path is shorter",
+			railMap:  loop,
+			from:     "A",
+			to:       "C",
+			expected: DirectionForward,
+		},
+		{
+			name:     "Should go backward on a loop if the backward path is shorter",
+			railMap:  loop,
+			from:     "B",
+			to:       "D",
+			expected: DirectionBackward,
+		},
+		{
+			name: "Should count the tags on a loop if a distance is unknown",
+			railMap: RailMap{
+				Topology: TopologyLoop,
+				Tags:     []Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}, {Location: "D"}},
+			},
+			from:     "A",
+			to:       "D",
+			expected: DirectionBackward,
+		},
+		{
+			name: "Should go backward on a linear rail if the target is before",
+			railMap: RailMap{
+				Topology: TopologyLinear,
+				Tags:     []Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}, {Location: "D"}},
+			},
+			from:     "D",
+			to:       "A",
+			expected: DirectionBackward,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			direction, err := tc.railMap.Direction(tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, direction)
+		})
+	}
+
+	t.Run("Should fail if the location is not in the rail map", func(t *testing.T) {
+		_, err := loop.Direction("A", "X")
+		require.ErrorIs(t, err, ErrLocationNotFound)
+	})
+
+	t.Run("Should fail if both locations are the same", func(t *testing.T) {
+		linear := RailMap{
+			Topology: TopologyLinear,
+			Tags:     []Tag{{Location: "A"}, {Location: "B"}},
+		}
+		for _, m := range []RailMap{loop, linear} {
+			_, err := m.Direction("B", "B")
+			require.ErrorIs(t, err, ErrAlreadyAtLocation)
+		}
+	})
+}
+
+func TestRailMap_LocationBefore(t *testing.T) {
+	tags := []Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}}
+
+	t.Run("Should wrap around on a loop", func(t *testing.T) {
+		m := RailMap{Topology: TopologyLoop, Tags: tags}
+
+		location, ok := m.LocationBefore("A", DirectionForward)
+		require.True(t, ok)
+		require.Equal(t, "C", location)
+
+		location, ok = m.LocationBefore("C", DirectionBackward)
+		require.True(t, ok)
+		require.Equal(t, "A", location)
+	})
+
+	t.Run("Should not wrap around on a linear rail", func(t *testing.T) {
+		m := RailMap{Topology: TopologyLinear, Tags: tags}
+
+		_, ok := m.LocationBefore("A", DirectionForward)
+		require.False(t, ok)
+
+		location, ok := m.LocationBefore("A", DirectionBackward)
+		require.True(t, ok)
+		require.Equal(t, "B", location)
+	})
+}
+
+func TestRailMap_Path(t *testing.T) {
+	tags := []Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}, {Location: "D"}}
+
+	t.Run("Should wrap around on a loop", func(t *testing.T) {
+		m := RailMap{Topology: TopologyLoop, Tags: tags}
+
+		path, err := m.Path("C", "B", DirectionForward)
+		require.NoError(t, err)
+		require.Equal(t, []string{"D", "A", "B"}, path)
+
+		path, err = m.Path("B", "D", DirectionBackward)
+		require.NoError(t, err)
+		require.Equal(t, []string{"A", "D"}, path)
+	})
+
+	t.Run("Should return an empty path if the robot is already at the location", func(t *testing.T) {
+		m := RailMap{Topology: TopologyLoop, Tags: tags}
+
+		path, err := m.Path("B", "B", DirectionForward)
+		require.NoError(t, err)
+		require.Empty(t, path)
+	})
+
+	t.Run("Should fail past the end of a linear rail", func(t *testing.T) {
+		m := RailMap{Topology: TopologyLinear, Tags: tags}
+
+		path, err := m.Path("A", "C", DirectionForward)
+		require.NoError(t, err)
+		require.Equal(t, []string{"B", "C"}, path)
+
+		_, err = m.Path("A", "C", DirectionBackward)
+		require.ErrorContains(t, err, "not reachable")
+	})
+
+	t.Run("Should fail if a location is unknown", func(t *testing.T) {
+		m := RailMap{Topology: TopologyLoop, Tags: tags}
+
+		_, err := m.Path("A", "Z", DirectionForward)
+		require.ErrorIs(t, err, ErrLocationNotFound)
+	})
+}
@@ -0,0 +1,72 @@
+package railmap
+
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	ErrTagNotFound            = xerror.NotFound(nil, "railmap.tagNotFound", "tag not found")
+	ErrLocationNotFound       = xerror.BadRequest(nil, "railmap.locationNotFound", "location not found in the rail map")
+	ErrLocationAlreadyExists  = xerror.Conflict(nil, "railmap.locationAlreadyExists", "location already exists in the rail map")
+	ErrAliasAlreadyExists     = xerror.Conflict(nil, "railmap.aliasAlreadyExists", "alias already exists in the rail map")
+	ErrTagPositionOutOfBounds = xerror.BadRequest(nil, "railmap.tagPositionOutOfBounds", "tag position out of bounds")
+	ErrAlreadyAtLocation      = xerror.BadRequest(nil, "railmap.alreadyAtLocation", "already at the location, there is no direction to move")
+)
+
+type UpdateRailMapTag struct {
+	Location       string `validate:"required"`
+	DistanceToNext *uint32
+	Alias          *string `validate:"omitempty,min=1"`
+}
+
+type UpdateRailMapParams struct {
+	Topology Topology           `validate:"enum"`
+	Tags     []UpdateRailMapTag `validate:"dive"`
+}
+
+type CreateTagParams struct {
+	Location string `validate:"required"`
+	// Position is the index to insert the tag at, the tag is appended if nil.
+	Position       *int `validate:"omitempty,min=0"`
+	DistanceToNext *uint32
+	Alias          *string `validate:"omitempty,min=1"`
+}
+
+type UpdateTagParams struct {
+	ID                int64 `validate:"required"`
+	DistanceToNext    *uint32
+	SetDistanceToNext bool
+	Alias             *string `validate:"omitempty,min=1"`
+	SetAlias          bool
+}
+
+type DeleteTagParams struct {
+	ID int64 `validate:"required"`
+}
+
+type SyncFromScanParams struct {
+	// Locations are the locations recorded by SCAN_LOCATION in the forward direction.
+	Locations []string `validate:"required,min=1,dive,required"`
+}
+
+type Service interface {
+	GetRailMap(ctx context.Context) (RailMap, error)
+	// UpdateRailMap replaces the topology and the tags of the rail map.
+	UpdateRailMap(ctx context.Context, params UpdateRailMapParams) (RailMap, error)
+	CreateTag(ctx context.Context, params CreateTagParams) (Tag, error)
+	UpdateTag(ctx context.Context, params UpdateTagParams) (Tag, error)
+	DeleteTag(ctx context.Context, params DeleteTagParams) error
+	// SyncFromScan replaces the tags with the locations recorded by SCAN_LOCATION.
+	// The distances and aliases of the known locations are kept.
+	SyncFromScan(ctx context.Context, params SyncFromScanParams) (RailMap, error)
+}
+
+type Repository interface {
+	GetRailMap(ctx context.Context) (RailMap, error)
+	ReplaceRailMap(ctx context.Context, topology Topology, tags []Tag) (RailMap, error)
+	CreateTag(ctx context.Context, tag Tag) (Tag, error)
+	UpdateTag(ctx context.Context, params UpdateTagParams) (Tag, error)
+	DeleteTag(ctx context.Context, id int64) error
+}
@@ -0,0 +1,232 @@
+package railmapimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+)
+
+type Repository struct {
+	db      db.Provider
+	queries *sqlc.Queries
+}
+
+func NewRepository(db db.Provider, queries *sqlc.Queries) Repository {
+	return Repository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+func (r Repository) GetRailMap(ctx context.Context) (railmap.RailMap, error) {
+	return r.getRailMap(ctx, r.db)
+}
+
+func (r Repository) ReplaceRailMap(ctx context.Context, topology railmap.Topology, tags []railmap.Tag) (railmap.RailMap, error) {
+	var ret railmap.RailMap
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		now := time.Now().Format(time.RFC3339Nano)
+		if err := r.queries.RailMapUpdate(ctx, tx, sqlc.RailMapUpdateParams{
+			Topology:  topology.String(),
+			UpdatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("queries update rail map: %w", err)
+		}
+
+		if err := r.queries.RailMapTagDeleteAll(ctx, tx); err != nil {
+			return fmt.Errorf("queries delete all tags: %w", err)
+		}
+
+		for i, tag := range tags {
+			if _, err := r.queries.RailMapTagCreate(ctx, tx, sqlc.RailMapTagCreateParams{
+				Location:       tag.Location,
+				Position:       int64(i),
+				DistanceToNext: convertDistanceToRow(tag.DistanceToNext),
+				Alias:          tag.Alias,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			}); err != nil {
+				return r.convertTagWriteError(err)
+			}
+		}
+
+		var err error
+		ret, err = r.getRailMap(ctx, tx)
+		return err
+	})
+	if err != nil {
+		return railmap.RailMap{}, fmt.Errorf("replace rail map in tx: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r Repository) CreateTag(ctx context.Context, tag railmap.Tag) (railmap.Tag, error) {
+	var ret railmap.Tag
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		count, err := r.queries.RailMapTagCount(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("queries count tags: %w", err)
+		}
+		if int64(tag.Position) > count {
+			return railmap.ErrTagPositionOutOfBounds
+		}
+
+		if err := r.queries.RailMapTagShiftPositions(ctx, tx, sqlc.RailMapTagShiftPositionsParams{
+			Delta:        1,
+			FromPosition: int64(tag.Position),
+		}); err != nil {
+			return fmt.Errorf("queries shift tag positions: %w", err)
+		}
+
+		row, err := r.queries.RailMapTagCreate(ctx, tx, sqlc.RailMapTagCreateParams{
+			Location:       tag.Location,
+			Position:       int64(tag.Position),
+			DistanceToNext: convertDistanceToRow(tag.DistanceToNext),
+			Alias:          tag.Alias,
+			CreatedAt:      tag.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt:      tag.UpdatedAt.Format(time.RFC3339Nano),
+		})
+		if err != nil {
+			return r.convertTagWriteError(err)
+		}
+
+		ret, err = r.convertRowToTag(row)
+		return err
+	})
+	if err != nil {
+		return railmap.Tag{}, fmt.Errorf("create tag in tx: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r Repository) UpdateTag(ctx context.Context, params railmap.UpdateTagParams) (railmap.Tag, error) {
+	row, err := r.queries.RailMapTagUpdate(ctx, r.db, sqlc.RailMapTagUpdateParams{
+		ID:                params.ID,
+		SetDistanceToNext: params.SetDistanceToNext,
+		DistanceToNext:    convertDistanceToRow(params.DistanceToNext),
+		SetAlias:          params.SetAlias,
+		Alias:             params.Alias,
+		UpdatedAt:         time.Now().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return railmap.Tag{}, railmap.ErrTagNotFound
+		}
+		return railmap.Tag{}, r.convertTagWriteError(err)
+	}
+
+	return r.convertRowToTag(row)
+}
+
+func (r Repository) DeleteTag(ctx context.Context, id int64) error {
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		row, err := r.queries.RailMapTagGet(ctx, tx, id)
+		if err != nil {
+			if db.IsNoRowsError(err) {
+				return railmap.ErrTagNotFound
+			}
+			return fmt.Errorf("queries get tag: %w", err)
+		}
+
+		if err := r.queries.RailMapTagDelete(ctx, tx, id); err != nil {
+			return fmt.Errorf("queries delete tag: %w", err)
+		}
+
+		if err := r.queries.RailMapTagShiftPositions(ctx, tx, sqlc.RailMapTagShiftPositionsParams{
+			Delta:        -1,
+			FromPosition: row.Position + 1,
+		}); err != nil {
+			return fmt.Errorf("queries shift tag positions: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("delete tag in tx: %w", err)
+	}
+
+	return nil
+}
+
+func (r Repository) getRailMap(ctx context.Context, db db.DB) (railmap.RailMap, error) {
+	row, err := r.queries.RailMapGet(ctx, db)
+	if err != nil {
+		return railmap.RailMap{}, fmt.Errorf("queries get rail map: %w", err)
+	}
+
+	updatedAt, err := time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return railmap.RailMap{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	rows, err := r.queries.RailMapTagList(ctx, db)
+	if err != nil {
+		return railmap.RailMap{}, fmt.Errorf("queries list tags: %w", err)
+	}
+
+	tags := make([]railmap.Tag, len(rows))
+	for i, row := range rows {
+		tags[i], err = r.convertRowToTag(row)
+		if err != nil {
+			return railmap.RailMap{}, fmt.Errorf("failed to convert row to tag: %w", err)
+		}
+	}
+
+	return railmap.RailMap{
+		Topology:  railmap.Topology(row.Topology),
+		Tags:      tags,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+func (Repository) convertTagWriteError(err error) error {
+	switch {
+	case db.IsUniqueViolationError(err, "railmap_tags.location"):
+		return railmap.ErrLocationAlreadyExists
+	case db.IsUniqueViolationError(err, "railmap_tags.alias"):
+		return railmap.ErrAliasAlreadyExists
+	default:
+		return fmt.Errorf("queries write tag: %w", err)
+	}
+}
+
+//nolint:gosec
+func (Repository) convertRowToTag(row sqlc.RailmapTag) (railmap.Tag, error) {
+	ret := railmap.Tag{
+		ID:       row.ID,
+		Location: row.Location,
+		Position: int(row.Position),
+		Alias:    row.Alias,
+	}
+	if row.DistanceToNext != nil {
+		distance := uint32(*row.DistanceToNext)
+		ret.DistanceToNext = &distance
+	}
+
+	var err error
+	ret.CreatedAt, err = time.Parse(time.RFC3339Nano, row.CreatedAt)
+	if err != nil {
+		return railmap.Tag{}, fmt.Errorf("failed to parse created at: %w", err)
+	}
+
+	ret.UpdatedAt, err = time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return railmap.Tag{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	return ret, nil
+}
+
+func convertDistanceToRow(distance *uint32) *int64 {
+	if distance == nil {
+		return nil
+	}
+	d := int64(*distance)
+	return &d
+}
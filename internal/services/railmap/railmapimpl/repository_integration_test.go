@@ -0,0 +1,96 @@
+package railmapimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestIntegrationRailMapRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	newRepository := func(t *testing.T) Repository {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, db.Close())
+		})
+		require.NoError(t, db.AutoMigrate())
+		return NewRepository(db, sqlc.New())
+	}
+
+	locations := func(m railmap.RailMap) []string {
+		ret := make([]string, len(m.Tags))
+		for i, tag := range m.Tags {
+			require.Equal(t, i, tag.Position)
+			ret[i] = tag.Location
+		}
+		return ret
+	}
+
+	t.Run("Should shift the positions when inserting and deleting tags", func(t *testing.T) {
+		repo := newRepository(t)
+		ctx := context.Background()
+
+		_, err := repo.ReplaceRailMap(ctx, railmap.TopologyLinear, []railmap.Tag{
+			{Location: "A"},
+			{Location: "C"},
+		})
+		require.NoError(t, err)
+
+		now := time.Now()
+		tag, err := repo.CreateTag(ctx, railmap.Tag{
+			Location:  "B",
+			Position:  1,
+			Alias:     ptr.New("dock-B"),
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		require.NoError(t, err)
+
+		m, err := repo.GetRailMap(ctx)
+		require.NoError(t, err)
+		require.Equal(t, railmap.TopologyLinear, m.Topology)
+		require.Equal(t, []string{"A", "B", "C"}, locations(m))
+
+		require.NoError(t, repo.DeleteTag(ctx, m.Tags[0].ID))
+
+		m, err = repo.GetRailMap(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"B", "C"}, locations(m))
+		require.Equal(t, tag.ID, m.Tags[0].ID)
+	})
+
+	t.Run("Should fail if the location or the alias already exists", func(t *testing.T) {
+		repo := newRepository(t)
+		ctx := context.Background()
+
+		m, err := repo.ReplaceRailMap(ctx, railmap.TopologyLoop, []railmap.Tag{
+			{Location: "A", Alias: ptr.New("dock-A")},
+			{Location: "B"},
+		})
+		require.NoError(t, err)
+
+		_, err = repo.CreateTag(ctx, railmap.Tag{Location: "A", Position: 2})
+		require.ErrorIs(t, err, railmap.ErrLocationAlreadyExists)
+
+		_, err = repo.UpdateTag(ctx, railmap.UpdateTagParams{
+			ID:       m.Tags[1].ID,
+			Alias:    ptr.New("dock-A"),
+			SetAlias: true,
+		})
+		require.ErrorIs(t, err, railmap.ErrAliasAlreadyExists)
+
+		_, err = repo.UpdateTag(ctx, railmap.UpdateTagParams{ID: 100})
+		require.ErrorIs(t, err, railmap.ErrTagNotFound)
+	})
+}
@@ -0,0 +1,120 @@
+package railmapimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+type Service struct {
+	validator   validator.Validator
+	railMapRepo railmap.Repository
+}
+
+func NewService(
+	validator validator.Validator,
+	railMapRepo railmap.Repository,
+) *Service {
+	return &Service{
+		validator:   validator,
+		railMapRepo: railMapRepo,
+	}
+}
+
+func (s Service) GetRailMap(ctx context.Context) (railmap.RailMap, error) {
+	return s.railMapRepo.GetRailMap(ctx)
+}
+
+func (s Service) UpdateRailMap(ctx context.Context, params railmap.UpdateRailMapParams) (railmap.RailMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return railmap.RailMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	tags := make([]railmap.Tag, len(params.Tags))
+	for i, tag := range params.Tags {
+		tags[i] = railmap.Tag{
+			Location:       tag.Location,
+			DistanceToNext: tag.DistanceToNext,
+			Alias:          tag.Alias,
+		}
+	}
+
+	return s.railMapRepo.ReplaceRailMap(ctx, params.Topology, tags)
+}
+
+func (s Service) CreateTag(ctx context.Context, params railmap.CreateTagParams) (railmap.Tag, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return railmap.Tag{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	position := 0
+	if params.Position != nil {
+		position = *params.Position
+	} else {
+		m, err := s.railMapRepo.GetRailMap(ctx)
+		if err != nil {
+			return railmap.Tag{}, fmt.Errorf("get rail map: %w", err)
+		}
+		position = len(m.Tags)
+	}
+
+	now := time.Now()
+	return s.railMapRepo.CreateTag(ctx, railmap.Tag{
+		Location:       params.Location,
+		Position:       position,
+		DistanceToNext: params.DistanceToNext,
+		Alias:          params.Alias,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+}
+
+func (s Service) UpdateTag(ctx context.Context, params railmap.UpdateTagParams) (railmap.Tag, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return railmap.Tag{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.railMapRepo.UpdateTag(ctx, params)
+}
+
+func (s Service) DeleteTag(ctx context.Context, params railmap.DeleteTagParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.railMapRepo.DeleteTag(ctx, params.ID)
+}
+
+func (s Service) SyncFromScan(ctx context.Context, params railmap.SyncFromScanParams) (railmap.RailMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return railmap.RailMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	current, err := s.railMapRepo.GetRailMap(ctx)
+	if err != nil {
+		return railmap.RailMap{}, fmt.Errorf("get rail map: %w", err)
+	}
+
+	// SCAN_LOCATION goes around the loop once, so the scanned rail is always a loop.
+	scanned := railmap.RailMap{Topology: railmap.TopologyLoop}
+	n := len(params.Locations)
+	for i, location := range params.Locations {
+		tag := railmap.Tag{Location: location}
+
+		if idx := current.IndexOf(location); idx != -1 {
+			tag.Alias = current.Tags[idx].Alias
+			// The distance is still valid only if the next tag has not changed.
+			next, ok := current.Neighbor(location, railmap.DirectionForward)
+			if ok && next == params.Locations[(i+1)%n] {
+				tag.DistanceToNext = current.Tags[idx].DistanceToNext
+			}
+		}
+
+		scanned.Tags = append(scanned.Tags, tag)
+	}
+
+	return s.railMapRepo.ReplaceRailMap(ctx, scanned.Topology, scanned.Tags)
+}
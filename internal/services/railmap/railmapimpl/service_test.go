@@ -0,0 +1,47 @@
+package railmapimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/services/railmap"
+	railmapmocks "github.com/tbe-team/raybot/internal/services/railmap/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+func TestService_SyncFromScan(t *testing.T) {
+	t.Run("Should keep the aliases and the distances whose next tag is unchanged", func(t *testing.T) {
+		repo := railmapmocks.NewFakeRepository(t)
+		repo.EXPECT().GetRailMap(mock.Anything).Return(railmap.RailMap{
+			Topology: railmap.TopologyLinear,
+			Tags: []railmap.Tag{
+				{Location: "A", DistanceToNext: ptr.New(uint32(100)), Alias: ptr.New("dock-A")},
+				{Location: "B", DistanceToNext: ptr.New(uint32(200))},
+				{Location: "C", DistanceToNext: ptr.New(uint32(300)), Alias: ptr.New("dock-C")},
+			},
+		}, nil)
+		repo.EXPECT().ReplaceRailMap(mock.Anything, railmap.TopologyLoop, []railmap.Tag{
+			{Location: "A", DistanceToNext: ptr.New(uint32(100)), Alias: ptr.New("dock-A")},
+			{Location: "B"},
+			{Location: "D"},
+			{Location: "C", Alias: ptr.New("dock-C")},
+		}).Return(railmap.RailMap{}, nil)
+		s := NewService(validator.New(), repo)
+
+		_, err := s.SyncFromScan(context.Background(), railmap.SyncFromScanParams{
+			Locations: []string{"A", "B", "D", "C"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should fail if no location is scanned", func(t *testing.T) {
+		s := NewService(validator.New(), railmapmocks.NewFakeRepository(t))
+
+		_, err := s.SyncFromScan(context.Background(), railmap.SyncFromScanParams{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,375 @@
+// Code generated by mockery v2.53.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	paging "github.com/tbe-team/raybot/pkg/paging"
+
+	schedule "github.com/tbe-team/raybot/internal/services/schedule"
+
+	time "time"
+)
+
+// FakeRepository is an autogenerated mock type for the Repository type
+type FakeRepository struct {
+	mock.Mock
+}
+
+type FakeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FakeRepository) EXPECT() *FakeRepository_Expecter {
+	return &FakeRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSchedule provides a mock function with given fields: ctx, _a1
+func (_m *FakeRepository) CreateSchedule(ctx context.Context, _a1 schedule.Schedule) (schedule.Schedule, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchedule")
+	}
+
+	var r0 schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Schedule) (schedule.Schedule, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Schedule) schedule.Schedule); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(schedule.Schedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.Schedule) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_CreateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedule'
+type FakeRepository_CreateSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 schedule.Schedule
+func (_e *FakeRepository_Expecter) CreateSchedule(ctx interface{}, _a1 interface{}) *FakeRepository_CreateSchedule_Call {
+	return &FakeRepository_CreateSchedule_Call{Call: _e.mock.On("CreateSchedule", ctx, _a1)}
+}
+
+func (_c *FakeRepository_CreateSchedule_Call) Run(run func(ctx context.Context, _a1 schedule.Schedule)) *FakeRepository_CreateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.Schedule))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_CreateSchedule_Call) Return(_a0 schedule.Schedule, _a1 error) *FakeRepository_CreateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_CreateSchedule_Call) RunAndReturn(run func(context.Context, schedule.Schedule) (schedule.Schedule, error)) *FakeRepository_CreateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteScheduleByID provides a mock function with given fields: ctx, id
+func (_m *FakeRepository) DeleteScheduleByID(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteScheduleByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeRepository_DeleteScheduleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteScheduleByID'
+type FakeRepository_DeleteScheduleByID_Call struct {
+	*mock.Call
+}
+
+// DeleteScheduleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *FakeRepository_Expecter) DeleteScheduleByID(ctx interface{}, id interface{}) *FakeRepository_DeleteScheduleByID_Call {
+	return &FakeRepository_DeleteScheduleByID_Call{Call: _e.mock.On("DeleteScheduleByID", ctx, id)}
+}
+
+func (_c *FakeRepository_DeleteScheduleByID_Call) Run(run func(ctx context.Context, id int64)) *FakeRepository_DeleteScheduleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_DeleteScheduleByID_Call) Return(_a0 error) *FakeRepository_DeleteScheduleByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeRepository_DeleteScheduleByID_Call) RunAndReturn(run func(context.Context, int64) error) *FakeRepository_DeleteScheduleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScheduleByID provides a mock function with given fields: ctx, id
+func (_m *FakeRepository) GetScheduleByID(ctx context.Context, id int64) (schedule.Schedule, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScheduleByID")
+	}
+
+	var r0 schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (schedule.Schedule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) schedule.Schedule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(schedule.Schedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_GetScheduleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduleByID'
+type FakeRepository_GetScheduleByID_Call struct {
+	*mock.Call
+}
+
+// GetScheduleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *FakeRepository_Expecter) GetScheduleByID(ctx interface{}, id interface{}) *FakeRepository_GetScheduleByID_Call {
+	return &FakeRepository_GetScheduleByID_Call{Call: _e.mock.On("GetScheduleByID", ctx, id)}
+}
+
+func (_c *FakeRepository_GetScheduleByID_Call) Run(run func(ctx context.Context, id int64)) *FakeRepository_GetScheduleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_GetScheduleByID_Call) Return(_a0 schedule.Schedule, _a1 error) *FakeRepository_GetScheduleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_GetScheduleByID_Call) RunAndReturn(run func(context.Context, int64) (schedule.Schedule, error)) *FakeRepository_GetScheduleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueSchedules provides a mock function with given fields: ctx, now
+func (_m *FakeRepository) ListDueSchedules(ctx context.Context, now time.Time) ([]schedule.Schedule, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDueSchedules")
+	}
+
+	var r0 []schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]schedule.Schedule, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []schedule.Schedule); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedule.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_ListDueSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueSchedules'
+type FakeRepository_ListDueSchedules_Call struct {
+	*mock.Call
+}
+
+// ListDueSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *FakeRepository_Expecter) ListDueSchedules(ctx interface{}, now interface{}) *FakeRepository_ListDueSchedules_Call {
+	return &FakeRepository_ListDueSchedules_Call{Call: _e.mock.On("ListDueSchedules", ctx, now)}
+}
+
+func (_c *FakeRepository_ListDueSchedules_Call) Run(run func(ctx context.Context, now time.Time)) *FakeRepository_ListDueSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_ListDueSchedules_Call) Return(_a0 []schedule.Schedule, _a1 error) *FakeRepository_ListDueSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_ListDueSchedules_Call) RunAndReturn(run func(context.Context, time.Time) ([]schedule.Schedule, error)) *FakeRepository_ListDueSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchedules provides a mock function with given fields: ctx, params
+func (_m *FakeRepository) ListSchedules(ctx context.Context, params schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 paging.List[schedule.Schedule]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.ListSchedulesParams) paging.List[schedule.Schedule]); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(paging.List[schedule.Schedule])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.ListSchedulesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_ListSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchedules'
+type FakeRepository_ListSchedules_Call struct {
+	*mock.Call
+}
+
+// ListSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params schedule.ListSchedulesParams
+func (_e *FakeRepository_Expecter) ListSchedules(ctx interface{}, params interface{}) *FakeRepository_ListSchedules_Call {
+	return &FakeRepository_ListSchedules_Call{Call: _e.mock.On("ListSchedules", ctx, params)}
+}
+
+func (_c *FakeRepository_ListSchedules_Call) Run(run func(ctx context.Context, params schedule.ListSchedulesParams)) *FakeRepository_ListSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.ListSchedulesParams))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_ListSchedules_Call) Return(_a0 paging.List[schedule.Schedule], _a1 error) *FakeRepository_ListSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_ListSchedules_Call) RunAndReturn(run func(context.Context, schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error)) *FakeRepository_ListSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchedule provides a mock function with given fields: ctx, _a1
+func (_m *FakeRepository) UpdateSchedule(ctx context.Context, _a1 schedule.Schedule) (schedule.Schedule, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchedule")
+	}
+
+	var r0 schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Schedule) (schedule.Schedule, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Schedule) schedule.Schedule); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(schedule.Schedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.Schedule) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_UpdateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchedule'
+type FakeRepository_UpdateSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 schedule.Schedule
+func (_e *FakeRepository_Expecter) UpdateSchedule(ctx interface{}, _a1 interface{}) *FakeRepository_UpdateSchedule_Call {
+	return &FakeRepository_UpdateSchedule_Call{Call: _e.mock.On("UpdateSchedule", ctx, _a1)}
+}
+
+func (_c *FakeRepository_UpdateSchedule_Call) Run(run func(ctx context.Context, _a1 schedule.Schedule)) *FakeRepository_UpdateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.Schedule))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_UpdateSchedule_Call) Return(_a0 schedule.Schedule, _a1 error) *FakeRepository_UpdateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_UpdateSchedule_Call) RunAndReturn(run func(context.Context, schedule.Schedule) (schedule.Schedule, error)) *FakeRepository_UpdateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFakeRepository creates a new instance of FakeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFakeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FakeRepository {
+	mock := &FakeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
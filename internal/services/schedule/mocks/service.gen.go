@@ -0,0 +1,360 @@
+// Code generated by mockery v2.53.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	paging "github.com/tbe-team/raybot/pkg/paging"
+
+	schedule "github.com/tbe-team/raybot/internal/services/schedule"
+)
+
+// FakeService is an autogenerated mock type for the Service type
+type FakeService struct {
+	mock.Mock
+}
+
+type FakeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FakeService) EXPECT() *FakeService_Expecter {
+	return &FakeService_Expecter{mock: &_m.Mock}
+}
+
+// CreateSchedule provides a mock function with given fields: ctx, params
+func (_m *FakeService) CreateSchedule(ctx context.Context, params schedule.CreateScheduleParams) (schedule.Schedule, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchedule")
+	}
+
+	var r0 schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.CreateScheduleParams) (schedule.Schedule, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.CreateScheduleParams) schedule.Schedule); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(schedule.Schedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.CreateScheduleParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_CreateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedule'
+type FakeService_CreateSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params schedule.CreateScheduleParams
+func (_e *FakeService_Expecter) CreateSchedule(ctx interface{}, params interface{}) *FakeService_CreateSchedule_Call {
+	return &FakeService_CreateSchedule_Call{Call: _e.mock.On("CreateSchedule", ctx, params)}
+}
+
+func (_c *FakeService_CreateSchedule_Call) Run(run func(ctx context.Context, params schedule.CreateScheduleParams)) *FakeService_CreateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.CreateScheduleParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CreateSchedule_Call) Return(_a0 schedule.Schedule, _a1 error) *FakeService_CreateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_CreateSchedule_Call) RunAndReturn(run func(context.Context, schedule.CreateScheduleParams) (schedule.Schedule, error)) *FakeService_CreateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteScheduleByID provides a mock function with given fields: ctx, params
+func (_m *FakeService) DeleteScheduleByID(ctx context.Context, params schedule.DeleteScheduleByIDParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteScheduleByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.DeleteScheduleByIDParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_DeleteScheduleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteScheduleByID'
+type FakeService_DeleteScheduleByID_Call struct {
+	*mock.Call
+}
+
+// DeleteScheduleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params schedule.DeleteScheduleByIDParams
+func (_e *FakeService_Expecter) DeleteScheduleByID(ctx interface{}, params interface{}) *FakeService_DeleteScheduleByID_Call {
+	return &FakeService_DeleteScheduleByID_Call{Call: _e.mock.On("DeleteScheduleByID", ctx, params)}
+}
+
+func (_c *FakeService_DeleteScheduleByID_Call) Run(run func(ctx context.Context, params schedule.DeleteScheduleByIDParams)) *FakeService_DeleteScheduleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.DeleteScheduleByIDParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_DeleteScheduleByID_Call) Return(_a0 error) *FakeService_DeleteScheduleByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_DeleteScheduleByID_Call) RunAndReturn(run func(context.Context, schedule.DeleteScheduleByIDParams) error) *FakeService_DeleteScheduleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScheduleByID provides a mock function with given fields: ctx, params
+func (_m *FakeService) GetScheduleByID(ctx context.Context, params schedule.GetScheduleByIDParams) (schedule.Schedule, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScheduleByID")
+	}
+
+	var r0 schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.GetScheduleByIDParams) (schedule.Schedule, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.GetScheduleByIDParams) schedule.Schedule); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(schedule.Schedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.GetScheduleByIDParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetScheduleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduleByID'
+type FakeService_GetScheduleByID_Call struct {
+	*mock.Call
+}
+
+// GetScheduleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params schedule.GetScheduleByIDParams
+func (_e *FakeService_Expecter) GetScheduleByID(ctx interface{}, params interface{}) *FakeService_GetScheduleByID_Call {
+	return &FakeService_GetScheduleByID_Call{Call: _e.mock.On("GetScheduleByID", ctx, params)}
+}
+
+func (_c *FakeService_GetScheduleByID_Call) Run(run func(ctx context.Context, params schedule.GetScheduleByIDParams)) *FakeService_GetScheduleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.GetScheduleByIDParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetScheduleByID_Call) Return(_a0 schedule.Schedule, _a1 error) *FakeService_GetScheduleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetScheduleByID_Call) RunAndReturn(run func(context.Context, schedule.GetScheduleByIDParams) (schedule.Schedule, error)) *FakeService_GetScheduleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchedules provides a mock function with given fields: ctx, params
+func (_m *FakeService) ListSchedules(ctx context.Context, params schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 paging.List[schedule.Schedule]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.ListSchedulesParams) paging.List[schedule.Schedule]); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(paging.List[schedule.Schedule])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.ListSchedulesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_ListSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchedules'
+type FakeService_ListSchedules_Call struct {
+	*mock.Call
+}
+
+// ListSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params schedule.ListSchedulesParams
+func (_e *FakeService_Expecter) ListSchedules(ctx interface{}, params interface{}) *FakeService_ListSchedules_Call {
+	return &FakeService_ListSchedules_Call{Call: _e.mock.On("ListSchedules", ctx, params)}
+}
+
+func (_c *FakeService_ListSchedules_Call) Run(run func(ctx context.Context, params schedule.ListSchedulesParams)) *FakeService_ListSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.ListSchedulesParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_ListSchedules_Call) Return(_a0 paging.List[schedule.Schedule], _a1 error) *FakeService_ListSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_ListSchedules_Call) RunAndReturn(run func(context.Context, schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error)) *FakeService_ListSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunDueSchedules provides a mock function with given fields: ctx
+func (_m *FakeService) RunDueSchedules(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunDueSchedules")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeService_RunDueSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunDueSchedules'
+type FakeService_RunDueSchedules_Call struct {
+	*mock.Call
+}
+
+// RunDueSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) RunDueSchedules(ctx interface{}) *FakeService_RunDueSchedules_Call {
+	return &FakeService_RunDueSchedules_Call{Call: _e.mock.On("RunDueSchedules", ctx)}
+}
+
+func (_c *FakeService_RunDueSchedules_Call) Run(run func(ctx context.Context)) *FakeService_RunDueSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_RunDueSchedules_Call) Return(_a0 error) *FakeService_RunDueSchedules_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeService_RunDueSchedules_Call) RunAndReturn(run func(context.Context) error) *FakeService_RunDueSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchedule provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateSchedule(ctx context.Context, params schedule.UpdateScheduleParams) (schedule.Schedule, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchedule")
+	}
+
+	var r0 schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.UpdateScheduleParams) (schedule.Schedule, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.UpdateScheduleParams) schedule.Schedule); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(schedule.Schedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.UpdateScheduleParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_UpdateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchedule'
+type FakeService_UpdateSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params schedule.UpdateScheduleParams
+func (_e *FakeService_Expecter) UpdateSchedule(ctx interface{}, params interface{}) *FakeService_UpdateSchedule_Call {
+	return &FakeService_UpdateSchedule_Call{Call: _e.mock.On("UpdateSchedule", ctx, params)}
+}
+
+func (_c *FakeService_UpdateSchedule_Call) Run(run func(ctx context.Context, params schedule.UpdateScheduleParams)) *FakeService_UpdateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.UpdateScheduleParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateSchedule_Call) Return(_a0 schedule.Schedule, _a1 error) *FakeService_UpdateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_UpdateSchedule_Call) RunAndReturn(run func(context.Context, schedule.UpdateScheduleParams) (schedule.Schedule, error)) *FakeService_UpdateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFakeService creates a new instance of FakeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFakeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FakeService {
+	mock := &FakeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
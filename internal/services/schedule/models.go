@@ -0,0 +1,42 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+// Schedule enqueues a command or a mission each time its cron expression matches.
+// Exactly one of Command and Mission is set.
+type Schedule struct {
+	ID   int64
+	Name string
+	Cron string
+	// Timezone is the IANA time zone the cron expression is evaluated in, e.g. "Asia/Ho_Chi_Minh".
+	Timezone string
+	Enabled  bool
+	Command  *CommandTemplate
+	Mission  *MissionTemplate
+
+	// LastRunAt is the last time the schedule enqueued its command or mission, nil if it never ran.
+	LastRunAt *time.Time
+	// NextRunAt is the next time the schedule enqueues its command or mission, nil if it is disabled.
+	NextRunAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CommandTemplate is the command enqueued by a schedule.
+type CommandTemplate struct {
+	Inputs   command.Inputs       `validate:"required"`
+	Retry    *command.RetryPolicy `validate:"omitempty"`
+	Priority uint8                `validate:"max=100"`
+}
+
+// MissionTemplate is the mission enqueued by a schedule.
+type MissionTemplate struct {
+	Steps      []command.Inputs        `validate:"required,min=1,max=100,dive,required"`
+	OnFailure  command.OnFailurePolicy `validate:"enum"`
+	MaxRetries uint8                   `validate:"max=10"`
+}
@@ -0,0 +1,71 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	ErrScheduleNotFound = xerror.NotFound(nil, "schedule.notFound", "schedule not found")
+)
+
+type GetScheduleByIDParams struct {
+	ScheduleID int64 `validate:"required,min=1"`
+}
+
+type ListSchedulesParams struct {
+	PagingParams paging.Params `validate:"required"`
+}
+
+type CreateScheduleParams struct {
+	Name string `validate:"required,max=100"`
+	Cron string `validate:"required"`
+	// Timezone is the IANA time zone the cron expression is evaluated in, UTC if empty.
+	Timezone string
+	Enabled  bool
+	// Command is enqueued when the schedule runs, it can not be used with Mission.
+	Command *CommandTemplate `validate:"required_without=Mission,excluded_with=Mission"`
+	// Mission is enqueued when the schedule runs, it can not be used with Command.
+	Mission *MissionTemplate `validate:"required_without=Command"`
+}
+
+// UpdateScheduleParams replaces the schedule, the next run is computed from the new cron expression.
+type UpdateScheduleParams struct {
+	ScheduleID int64  `validate:"required,min=1"`
+	Name       string `validate:"required,max=100"`
+	Cron       string `validate:"required"`
+	Timezone   string
+	Enabled    bool
+	Command    *CommandTemplate `validate:"required_without=Mission,excluded_with=Mission"`
+	Mission    *MissionTemplate `validate:"required_without=Command"`
+}
+
+type DeleteScheduleByIDParams struct {
+	ScheduleID int64 `validate:"required,min=1"`
+}
+
+type Service interface {
+	GetScheduleByID(ctx context.Context, params GetScheduleByIDParams) (Schedule, error)
+	ListSchedules(ctx context.Context, params ListSchedulesParams) (paging.List[Schedule], error)
+	CreateSchedule(ctx context.Context, params CreateScheduleParams) (Schedule, error)
+	UpdateSchedule(ctx context.Context, params UpdateScheduleParams) (Schedule, error)
+	DeleteScheduleByID(ctx context.Context, params DeleteScheduleByIDParams) error
+
+	// RunDueSchedules enqueues the command or mission of the enabled schedules that are due
+	// and moves them to their next run.
+	// A run missed for longer than a minute, for example while the robot was off, is skipped.
+	RunDueSchedules(ctx context.Context) error
+}
+
+type Repository interface {
+	ListSchedules(ctx context.Context, params ListSchedulesParams) (paging.List[Schedule], error)
+	// ListDueSchedules returns the enabled schedules with a next run at or before now.
+	ListDueSchedules(ctx context.Context, now time.Time) ([]Schedule, error)
+	GetScheduleByID(ctx context.Context, id int64) (Schedule, error)
+	CreateSchedule(ctx context.Context, schedule Schedule) (Schedule, error)
+	UpdateSchedule(ctx context.Context, schedule Schedule) (Schedule, error)
+	DeleteScheduleByID(ctx context.Context, id int64) error
+}
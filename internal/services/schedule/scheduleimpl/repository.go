@@ -0,0 +1,334 @@
+package scheduleimpl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+type Repository struct {
+	db      db.DB
+	queries *sqlc.Queries
+}
+
+func NewRepository(db db.DB, queries *sqlc.Queries) Repository {
+	return Repository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+func (r Repository) ListSchedules(ctx context.Context, params schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error) {
+	var ret paging.List[schedule.Schedule]
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		rows, err := r.queries.ScheduleList(gctx, r.db, sqlc.ScheduleListParams{
+			Limit:  int64(params.PagingParams.Limit()),
+			Offset: int64(params.PagingParams.Offset()),
+		})
+		if err != nil {
+			return fmt.Errorf("queries list schedules: %w", err)
+		}
+
+		ret.Items, err = r.convertRowsToSchedules(rows)
+		return err
+	})
+
+	g.Go(func() error {
+		count, err := r.queries.ScheduleCount(gctx, r.db)
+		if err != nil {
+			return fmt.Errorf("queries count schedules: %w", err)
+		}
+		ret.TotalItems = count
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return paging.List[schedule.Schedule]{}, fmt.Errorf("errgroup wait: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r Repository) ListDueSchedules(ctx context.Context, now time.Time) ([]schedule.Schedule, error) {
+	rows, err := r.queries.ScheduleListDue(ctx, r.db, formatRunAt(now))
+	if err != nil {
+		return nil, fmt.Errorf("queries list due schedules: %w", err)
+	}
+
+	return r.convertRowsToSchedules(rows)
+}
+
+func (r Repository) GetScheduleByID(ctx context.Context, id int64) (schedule.Schedule, error) {
+	row, err := r.queries.ScheduleGetByID(ctx, r.db, id)
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return schedule.Schedule{}, schedule.ErrScheduleNotFound
+		}
+		return schedule.Schedule{}, fmt.Errorf("queries get schedule by id: %w", err)
+	}
+
+	return r.convertRowToSchedule(row)
+}
+
+func (r Repository) CreateSchedule(ctx context.Context, s schedule.Schedule) (schedule.Schedule, error) {
+	commandTemplate, missionTemplate, err := r.marshalTemplates(s)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	row, err := r.queries.ScheduleCreate(ctx, r.db, sqlc.ScheduleCreateParams{
+		Name:            s.Name,
+		Cron:            s.Cron,
+		Timezone:        s.Timezone,
+		Enabled:         boolToInt64(s.Enabled),
+		CommandTemplate: commandTemplate,
+		MissionTemplate: missionTemplate,
+		LastRunAt:       formatOptionalRunAt(s.LastRunAt),
+		NextRunAt:       formatOptionalRunAt(s.NextRunAt),
+		CreatedAt:       s.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:       s.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("queries create schedule: %w", err)
+	}
+
+	return r.convertRowToSchedule(row)
+}
+
+func (r Repository) UpdateSchedule(ctx context.Context, s schedule.Schedule) (schedule.Schedule, error) {
+	commandTemplate, missionTemplate, err := r.marshalTemplates(s)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	row, err := r.queries.ScheduleUpdate(ctx, r.db, sqlc.ScheduleUpdateParams{
+		ID:              s.ID,
+		Name:            s.Name,
+		Cron:            s.Cron,
+		Timezone:        s.Timezone,
+		Enabled:         boolToInt64(s.Enabled),
+		CommandTemplate: commandTemplate,
+		MissionTemplate: missionTemplate,
+		LastRunAt:       formatOptionalRunAt(s.LastRunAt),
+		NextRunAt:       formatOptionalRunAt(s.NextRunAt),
+		UpdatedAt:       s.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return schedule.Schedule{}, schedule.ErrScheduleNotFound
+		}
+		return schedule.Schedule{}, fmt.Errorf("queries update schedule: %w", err)
+	}
+
+	return r.convertRowToSchedule(row)
+}
+
+func (r Repository) DeleteScheduleByID(ctx context.Context, id int64) error {
+	affected, err := r.queries.ScheduleDeleteByID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("queries delete schedule by id: %w", err)
+	}
+	if affected == 0 {
+		return schedule.ErrScheduleNotFound
+	}
+	return nil
+}
+
+// templateStep is the JSON representation of the inputs of a command in a template.
+type templateStep struct {
+	Type   command.CommandType `json:"type"`
+	Inputs json.RawMessage     `json:"inputs"`
+}
+
+type commandTemplateJSON struct {
+	templateStep
+	Retry    *command.RetryPolicy `json:"retry,omitempty"`
+	Priority uint8                `json:"priority"`
+}
+
+type missionTemplateJSON struct {
+	Steps      []templateStep          `json:"steps"`
+	OnFailure  command.OnFailurePolicy `json:"on_failure"`
+	MaxRetries uint8                   `json:"max_retries"`
+}
+
+func (r Repository) marshalTemplates(s schedule.Schedule) (*string, *string, error) {
+	var commandTemplate, missionTemplate *string
+
+	if s.Command != nil {
+		step, err := r.marshalStep(s.Command.Inputs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		b, err := json.Marshal(commandTemplateJSON{
+			templateStep: step,
+			Retry:        s.Command.Retry,
+			Priority:     s.Command.Priority,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal command template: %w", err)
+		}
+		commandTemplate = ptr.New(string(b))
+	}
+
+	if s.Mission != nil {
+		steps := make([]templateStep, len(s.Mission.Steps))
+		for i, inputs := range s.Mission.Steps {
+			step, err := r.marshalStep(inputs)
+			if err != nil {
+				return nil, nil, err
+			}
+			steps[i] = step
+		}
+
+		b, err := json.Marshal(missionTemplateJSON{
+			Steps:      steps,
+			OnFailure:  s.Mission.OnFailure,
+			MaxRetries: s.Mission.MaxRetries,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal mission template: %w", err)
+		}
+		missionTemplate = ptr.New(string(b))
+	}
+
+	return commandTemplate, missionTemplate, nil
+}
+
+func (Repository) marshalStep(inputs command.Inputs) (templateStep, error) {
+	b, err := json.Marshal(inputs)
+	if err != nil {
+		return templateStep{}, fmt.Errorf("failed to marshal inputs: %w", err)
+	}
+	return templateStep{Type: inputs.CommandType(), Inputs: b}, nil
+}
+
+func (r Repository) convertRowsToSchedules(rows []sqlc.Schedule) ([]schedule.Schedule, error) {
+	ret := make([]schedule.Schedule, len(rows))
+	for i, row := range rows {
+		s, err := r.convertRowToSchedule(row)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = s
+	}
+	return ret, nil
+}
+
+func (Repository) convertRowToSchedule(row sqlc.Schedule) (schedule.Schedule, error) {
+	ret := schedule.Schedule{
+		ID:       row.ID,
+		Name:     row.Name,
+		Cron:     row.Cron,
+		Timezone: row.Timezone,
+		Enabled:  row.Enabled == 1,
+	}
+	var err error
+
+	if row.CommandTemplate != nil {
+		var t commandTemplateJSON
+		if err := json.Unmarshal([]byte(*row.CommandTemplate), &t); err != nil {
+			return schedule.Schedule{}, fmt.Errorf("failed to unmarshal command template: %w", err)
+		}
+
+		inputs, err := command.UnmarshalInputs(t.Type, t.Inputs)
+		if err != nil {
+			return schedule.Schedule{}, fmt.Errorf("failed to unmarshal command template inputs: %w", err)
+		}
+
+		ret.Command = &schedule.CommandTemplate{
+			Inputs:   inputs,
+			Retry:    t.Retry,
+			Priority: t.Priority,
+		}
+	}
+
+	if row.MissionTemplate != nil {
+		var t missionTemplateJSON
+		if err := json.Unmarshal([]byte(*row.MissionTemplate), &t); err != nil {
+			return schedule.Schedule{}, fmt.Errorf("failed to unmarshal mission template: %w", err)
+		}
+
+		steps := make([]command.Inputs, len(t.Steps))
+		for i, step := range t.Steps {
+			steps[i], err = command.UnmarshalInputs(step.Type, step.Inputs)
+			if err != nil {
+				return schedule.Schedule{}, fmt.Errorf("failed to unmarshal mission template step %d: %w", i+1, err)
+			}
+		}
+
+		ret.Mission = &schedule.MissionTemplate{
+			Steps:      steps,
+			OnFailure:  t.OnFailure,
+			MaxRetries: t.MaxRetries,
+		}
+	}
+
+	ret.LastRunAt, err = parseOptionalRunAt(row.LastRunAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse last run at: %w", err)
+	}
+
+	ret.NextRunAt, err = parseOptionalRunAt(row.NextRunAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse next run at: %w", err)
+	}
+
+	ret.CreatedAt, err = time.Parse(time.RFC3339Nano, row.CreatedAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse created at: %w", err)
+	}
+
+	ret.UpdatedAt, err = time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	return ret, nil
+}
+
+// formatRunAt formats the time in UTC with a fixed width,
+// so that the run times can be compared as strings in SQLite.
+func formatRunAt(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatOptionalRunAt(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return ptr.New(formatRunAt(*t))
+}
+
+func parseOptionalRunAt(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil, err
+	}
+	t = t.Local()
+	return &t, nil
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
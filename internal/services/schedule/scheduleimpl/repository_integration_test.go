@@ -0,0 +1,138 @@
+package scheduleimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestIntegrationScheduleRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	newRepository := func(t *testing.T) Repository {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, db.Close())
+		})
+		require.NoError(t, db.AutoMigrate())
+		return NewRepository(db, sqlc.New())
+	}
+
+	t.Run("Should persist the command and mission templates", func(t *testing.T) {
+		repo := newRepository(t)
+		ctx := context.Background()
+		now := time.Now()
+
+		commandSchedule, err := repo.CreateSchedule(ctx, schedule.Schedule{
+			Name:     "move to dock",
+			Cron:     "30 8 * * MON-FRI",
+			Timezone: "Asia/Ho_Chi_Minh",
+			Enabled:  true,
+			Command: &schedule.CommandTemplate{
+				Inputs: &command.MoveToInputs{
+					Location:   "dock-A",
+					Direction:  command.MoveDirectionForward,
+					MotorSpeed: 80,
+				},
+				Retry:    &command.RetryPolicy{MaxAttempts: 3, InitialBackoffMs: 100},
+				Priority: 20,
+			},
+			NextRunAt: ptr.New(now.Add(time.Hour)),
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		require.NoError(t, err)
+
+		missionSchedule, err := repo.CreateSchedule(ctx, schedule.Schedule{
+			Name: "delivery loop",
+			Cron: "@every 2h",
+			Mission: &schedule.MissionTemplate{
+				Steps: []command.Inputs{
+					&command.CargoOpenInputs{MotorSpeed: 50},
+					&command.WaitInputs{DurationMs: 1000},
+				},
+				OnFailure:  command.OnFailureRetry,
+				MaxRetries: 2,
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		require.NoError(t, err)
+
+		got, err := repo.GetScheduleByID(ctx, commandSchedule.ID)
+		require.NoError(t, err)
+		require.True(t, got.Enabled)
+		require.Equal(t, "Asia/Ho_Chi_Minh", got.Timezone)
+		require.Nil(t, got.Mission)
+		require.Equal(t, &command.MoveToInputs{
+			Location:   "dock-A",
+			Direction:  command.MoveDirectionForward,
+			MotorSpeed: 80,
+		}, got.Command.Inputs)
+		require.Equal(t, uint8(20), got.Command.Priority)
+		require.Equal(t, uint8(3), got.Command.Retry.MaxAttempts)
+		require.NotNil(t, got.NextRunAt)
+
+		got, err = repo.GetScheduleByID(ctx, missionSchedule.ID)
+		require.NoError(t, err)
+		require.False(t, got.Enabled)
+		require.Nil(t, got.Command)
+		require.Nil(t, got.NextRunAt)
+		require.Equal(t, []command.Inputs{
+			&command.CargoOpenInputs{MotorSpeed: 50},
+			&command.WaitInputs{DurationMs: 1000},
+		}, got.Mission.Steps)
+		require.Equal(t, command.OnFailureRetry, got.Mission.OnFailure)
+		require.Equal(t, uint8(2), got.Mission.MaxRetries)
+
+		list, err := repo.ListSchedules(ctx, schedule.ListSchedulesParams{
+			PagingParams: paging.NewParams(paging.Page(1), paging.PageSize(10)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), list.TotalItems)
+	})
+
+	t.Run("Should list the enabled schedules that are due", func(t *testing.T) {
+		repo := newRepository(t)
+		ctx := context.Background()
+		now := time.Now()
+
+		newSchedule := func(enabled bool, nextRunAt *time.Time) schedule.Schedule {
+			sch, err := repo.CreateSchedule(ctx, schedule.Schedule{
+				Name:      "schedule",
+				Cron:      "@hourly",
+				Enabled:   enabled,
+				Command:   &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+				NextRunAt: nextRunAt,
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+			require.NoError(t, err)
+			return sch
+		}
+
+		due := newSchedule(true, ptr.New(now.Add(-time.Minute)))
+		newSchedule(true, ptr.New(now.Add(time.Minute)))
+		newSchedule(false, nil)
+
+		schedules, err := repo.ListDueSchedules(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+		require.Equal(t, due.ID, schedules[0].ID)
+
+		require.NoError(t, repo.DeleteScheduleByID(ctx, due.ID))
+		require.ErrorIs(t, repo.DeleteScheduleByID(ctx, due.ID), schedule.ErrScheduleNotFound)
+	})
+}
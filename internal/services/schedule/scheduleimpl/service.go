@@ -0,0 +1,241 @@
+package scheduleimpl
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/validator"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+// misfireThreshold is how late a run can be before it is skipped.
+const misfireThreshold = time.Minute
+
+// defaultTimezone is the time zone of the schedules created without one.
+const defaultTimezone = "UTC"
+
+type Service struct {
+	log            *slog.Logger
+	validator      validator.Validator
+	scheduleRepo   schedule.Repository
+	commandService command.Service
+}
+
+func NewService(
+	log *slog.Logger,
+	validator validator.Validator,
+	scheduleRepo schedule.Repository,
+	commandService command.Service,
+) *Service {
+	return &Service{
+		log:            log.With("service", "schedule"),
+		validator:      validator,
+		scheduleRepo:   scheduleRepo,
+		commandService: commandService,
+	}
+}
+
+func (s Service) GetScheduleByID(ctx context.Context, params schedule.GetScheduleByIDParams) (schedule.Schedule, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return schedule.Schedule{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.scheduleRepo.GetScheduleByID(ctx, params.ScheduleID)
+}
+
+func (s Service) ListSchedules(ctx context.Context, params schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[schedule.Schedule]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.scheduleRepo.ListSchedules(ctx, params)
+}
+
+func (s Service) CreateSchedule(ctx context.Context, params schedule.CreateScheduleParams) (schedule.Schedule, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return schedule.Schedule{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cronSchedule, err := parseCron(params.Cron)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	timezone := cmp.Or(params.Timezone, defaultTimezone)
+	loc, err := loadLocation(timezone)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	now := time.Now()
+	sch := schedule.Schedule{
+		Name:      params.Name,
+		Cron:      params.Cron,
+		Timezone:  timezone,
+		Enabled:   params.Enabled,
+		Command:   params.Command,
+		Mission:   params.Mission,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if sch.Enabled {
+		sch.NextRunAt = nextRunAt(cronSchedule, now.In(loc))
+	}
+
+	return s.scheduleRepo.CreateSchedule(ctx, sch)
+}
+
+func (s Service) UpdateSchedule(ctx context.Context, params schedule.UpdateScheduleParams) (schedule.Schedule, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return schedule.Schedule{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cronSchedule, err := parseCron(params.Cron)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	timezone := cmp.Or(params.Timezone, defaultTimezone)
+	loc, err := loadLocation(timezone)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	sch, err := s.scheduleRepo.GetScheduleByID(ctx, params.ScheduleID)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	now := time.Now()
+	sch.Name = params.Name
+	sch.Cron = params.Cron
+	sch.Timezone = timezone
+	sch.Enabled = params.Enabled
+	sch.Command = params.Command
+	sch.Mission = params.Mission
+	sch.NextRunAt = nil
+	sch.UpdatedAt = now
+	if sch.Enabled {
+		sch.NextRunAt = nextRunAt(cronSchedule, now.In(loc))
+	}
+
+	return s.scheduleRepo.UpdateSchedule(ctx, sch)
+}
+
+func (s Service) DeleteScheduleByID(ctx context.Context, params schedule.DeleteScheduleByIDParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.scheduleRepo.DeleteScheduleByID(ctx, params.ScheduleID)
+}
+
+func (s Service) RunDueSchedules(ctx context.Context) error {
+	now := time.Now()
+	schedules, err := s.scheduleRepo.ListDueSchedules(ctx, now)
+	if err != nil {
+		return fmt.Errorf("list due schedules: %w", err)
+	}
+
+	for _, sch := range schedules {
+		if err := s.runSchedule(ctx, sch, now); err != nil {
+			s.log.Error("failed to run schedule",
+				slog.Int64("schedule_id", sch.ID),
+				slog.Any("error", err))
+		}
+	}
+
+	return nil
+}
+
+// runSchedule moves the schedule to its next run, then enqueues its command or mission.
+// The schedule is moved first so that a failing enqueue is not retried on every tick.
+func (s Service) runSchedule(ctx context.Context, sch schedule.Schedule, now time.Time) error {
+	cronSchedule, err := config.ParseCronSchedule(sch.Cron)
+	if err != nil {
+		return fmt.Errorf("parse cron: %w", err)
+	}
+
+	loc, err := time.LoadLocation(sch.Timezone)
+	if err != nil {
+		return fmt.Errorf("load timezone: %w", err)
+	}
+
+	missed := sch.NextRunAt != nil && now.Sub(*sch.NextRunAt) > misfireThreshold
+	if !missed {
+		sch.LastRunAt = &now
+	}
+	sch.NextRunAt = nextRunAt(cronSchedule, now.In(loc))
+	sch.UpdatedAt = now
+
+	if _, err := s.scheduleRepo.UpdateSchedule(ctx, sch); err != nil {
+		return fmt.Errorf("update schedule: %w", err)
+	}
+
+	if missed {
+		s.log.Warn("schedule run missed, skipping",
+			slog.Int64("schedule_id", sch.ID),
+			slog.String("name", sch.Name))
+		return nil
+	}
+
+	s.log.Info("running schedule",
+		slog.Int64("schedule_id", sch.ID),
+		slog.String("name", sch.Name))
+
+	if sch.Command != nil {
+		if _, err := s.commandService.CreateCommand(ctx, command.CreateCommandParams{
+			Source:   command.SourceApp,
+			Inputs:   sch.Command.Inputs,
+			Retry:    sch.Command.Retry,
+			Priority: sch.Command.Priority,
+		}); err != nil {
+			return fmt.Errorf("create command: %w", err)
+		}
+	}
+
+	if sch.Mission != nil {
+		if _, err := s.commandService.CreateMission(ctx, command.CreateMissionParams{
+			Source:     command.SourceApp,
+			Steps:      sch.Mission.Steps,
+			OnFailure:  sch.Mission.OnFailure,
+			MaxRetries: sch.Mission.MaxRetries,
+		}); err != nil {
+			return fmt.Errorf("create mission: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func parseCron(expr string) (config.CronSchedule, error) {
+	cronSchedule, err := config.ParseCronSchedule(expr)
+	if err != nil {
+		return nil, xerror.ValidationFailed(err, fmt.Sprintf("invalid cron expression: %v", err))
+	}
+	return cronSchedule, nil
+}
+
+func loadLocation(timezone string) (*time.Location, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, xerror.ValidationFailed(err, fmt.Sprintf("invalid timezone: %v", err))
+	}
+	return loc, nil
+}
+
+// nextRunAt returns the next run after now, nil if the cron expression does not match anymore.
+func nextRunAt(cronSchedule config.CronSchedule, now time.Time) *time.Time {
+	next := cronSchedule.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
@@ -0,0 +1,198 @@
+package scheduleimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	schedulemocks "github.com/tbe-team/raybot/internal/services/schedule/mocks"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+func TestService_CreateSchedule(t *testing.T) {
+	t.Run("Should compute the next run of an enabled schedule", func(t *testing.T) {
+		repo := schedulemocks.NewFakeRepository(t)
+		repo.EXPECT().CreateSchedule(mock.Anything, mock.MatchedBy(func(sch schedule.Schedule) bool {
+			return sch.Timezone == "UTC" && sch.NextRunAt != nil &&
+				sch.NextRunAt.Hour() == 8 && sch.NextRunAt.Minute() == 30 &&
+				sch.NextRunAt.After(time.Now())
+		})).Return(schedule.Schedule{ID: 1}, nil)
+		s := NewService(logging.NewNoopLogger(), validator.New(), repo, commandmocks.NewFakeService(t))
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:    "morning delivery",
+			Cron:    "30 8 * * *",
+			Enabled: true,
+			Command: &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should compute the next run in the timezone of the schedule", func(t *testing.T) {
+		repo := schedulemocks.NewFakeRepository(t)
+		repo.EXPECT().CreateSchedule(mock.Anything, mock.MatchedBy(func(sch schedule.Schedule) bool {
+			// 08:30 in Asia/Ho_Chi_Minh is 01:30 UTC
+			return sch.Timezone == "Asia/Ho_Chi_Minh" && sch.NextRunAt != nil &&
+				sch.NextRunAt.UTC().Hour() == 1 && sch.NextRunAt.UTC().Minute() == 30
+		})).Return(schedule.Schedule{ID: 1}, nil)
+		s := NewService(logging.NewNoopLogger(), validator.New(), repo, commandmocks.NewFakeService(t))
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:     "morning delivery",
+			Cron:     "30 8 * * *",
+			Timezone: "Asia/Ho_Chi_Minh",
+			Enabled:  true,
+			Command:  &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should not compute the next run of a disabled schedule", func(t *testing.T) {
+		repo := schedulemocks.NewFakeRepository(t)
+		repo.EXPECT().CreateSchedule(mock.Anything, mock.MatchedBy(func(sch schedule.Schedule) bool {
+			return sch.NextRunAt == nil
+		})).Return(schedule.Schedule{ID: 1}, nil)
+		s := NewService(logging.NewNoopLogger(), validator.New(), repo, commandmocks.NewFakeService(t))
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:    "morning delivery",
+			Cron:    "30 8 * * *",
+			Command: &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Create schedule validation", func(t *testing.T) {
+		s := NewService(
+			logging.NewNoopLogger(),
+			validator.New(),
+			schedulemocks.NewFakeRepository(t),
+			commandmocks.NewFakeService(t),
+		)
+
+		t.Run("Should return validation error when the cron expression is invalid", func(t *testing.T) {
+			_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+				Name:    "invalid",
+				Cron:    "61 * * * *",
+				Command: &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when the timezone is unknown", func(t *testing.T) {
+			_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+				Name:     "invalid",
+				Cron:     "@hourly",
+				Timezone: "Mars/Olympus_Mons",
+				Command:  &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when no template is set", func(t *testing.T) {
+			_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+				Name: "empty",
+				Cron: "@hourly",
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when both templates are set", func(t *testing.T) {
+			_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+				Name:    "both",
+				Cron:    "@hourly",
+				Command: &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+				Mission: &schedule.MissionTemplate{
+					Steps:     []command.Inputs{&command.StopMovementInputs{}},
+					OnFailure: command.OnFailureAbort,
+				},
+			})
+			require.Error(t, err)
+		})
+	})
+}
+
+func TestService_RunDueSchedules(t *testing.T) {
+	t.Run("Should enqueue the command and move the schedule to the next run", func(t *testing.T) {
+		due := time.Now().Add(-time.Second)
+		sch := schedule.Schedule{
+			ID:        1,
+			Name:      "delivery",
+			Cron:      "@every 1h",
+			Enabled:   true,
+			Command:   &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}, Priority: 10},
+			NextRunAt: &due,
+		}
+
+		repo := schedulemocks.NewFakeRepository(t)
+		repo.EXPECT().ListDueSchedules(mock.Anything, mock.Anything).Return([]schedule.Schedule{sch}, nil)
+		repo.EXPECT().UpdateSchedule(mock.Anything, mock.MatchedBy(func(s schedule.Schedule) bool {
+			return s.LastRunAt != nil && s.NextRunAt != nil && s.NextRunAt.Sub(*s.LastRunAt) == time.Hour
+		})).Return(schedule.Schedule{}, nil).Once()
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommand(mock.Anything, command.CreateCommandParams{
+			Source:   command.SourceApp,
+			Inputs:   &command.StopMovementInputs{},
+			Priority: 10,
+		}).Return(command.Command{ID: 1}, nil).Once()
+		s := NewService(logging.NewNoopLogger(), validator.New(), repo, commandService)
+
+		require.NoError(t, s.RunDueSchedules(context.Background()))
+	})
+
+	t.Run("Should enqueue the mission", func(t *testing.T) {
+		due := time.Now()
+		sch := schedule.Schedule{
+			ID:      1,
+			Name:    "loop",
+			Cron:    "0 8 * * *",
+			Enabled: true,
+			Mission: &schedule.MissionTemplate{
+				Steps:     []command.Inputs{&command.StopMovementInputs{}, &command.CargoOpenInputs{MotorSpeed: 50}},
+				OnFailure: command.OnFailureAbort,
+			},
+			NextRunAt: &due,
+		}
+
+		repo := schedulemocks.NewFakeRepository(t)
+		repo.EXPECT().ListDueSchedules(mock.Anything, mock.Anything).Return([]schedule.Schedule{sch}, nil)
+		repo.EXPECT().UpdateSchedule(mock.Anything, mock.Anything).Return(schedule.Schedule{}, nil).Once()
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateMission(mock.Anything, command.CreateMissionParams{
+			Source:    command.SourceApp,
+			Steps:     sch.Mission.Steps,
+			OnFailure: command.OnFailureAbort,
+		}).Return(command.Mission{ID: 1}, nil).Once()
+		s := NewService(logging.NewNoopLogger(), validator.New(), repo, commandService)
+
+		require.NoError(t, s.RunDueSchedules(context.Background()))
+	})
+
+	t.Run("Should skip a missed run and only move the schedule to the next run", func(t *testing.T) {
+		due := time.Now().Add(-time.Hour)
+		sch := schedule.Schedule{
+			ID:        1,
+			Name:      "delivery",
+			Cron:      "0 8 * * *",
+			Enabled:   true,
+			Command:   &schedule.CommandTemplate{Inputs: &command.StopMovementInputs{}},
+			NextRunAt: &due,
+		}
+
+		repo := schedulemocks.NewFakeRepository(t)
+		repo.EXPECT().ListDueSchedules(mock.Anything, mock.Anything).Return([]schedule.Schedule{sch}, nil)
+		repo.EXPECT().UpdateSchedule(mock.Anything, mock.MatchedBy(func(s schedule.Schedule) bool {
+			return s.LastRunAt == nil && s.NextRunAt != nil && s.NextRunAt.After(time.Now())
+		})).Return(schedule.Schedule{}, nil).Once()
+		s := NewService(logging.NewNoopLogger(), validator.New(), repo, commandmocks.NewFakeService(t))
+
+		require.NoError(t, s.RunDueSchedules(context.Background()))
+	})
+}
@@ -50,9 +50,8 @@ func (p *SQLiteDB) WithTX(ctx context.Context, fn func(DB) error) (err error) {
 
 	defer func() {
 		if err != nil {
-			rbErr := tx.Rollback()
-			if !errors.Is(rbErr, sql.ErrTxDone) {
-				err = fmt.Errorf("rollback: %w", rbErr)
+			if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+				err = errors.Join(err, fmt.Errorf("rollback: %w", rbErr))
 			}
 		}
 	}()
@@ -68,6 +67,19 @@ func (p *SQLiteDB) WithTX(ctx context.Context, fn func(DB) error) (err error) {
 	return err
 }
 
+// NewInMemoryDB creates a private in-memory SQLite database, e.g. for the capture replay.
+// The database lives in its single connection and is dropped when it is closed.
+func NewInMemoryDB() (*SQLiteDB, error) {
+	db, err := sql.Open("sqlite3", "file::memory:")
+	if err != nil {
+		return nil, fmt.Errorf("open db: %w", err)
+	}
+
+	db.SetMaxOpenConns(1)
+
+	return &SQLiteDB{DB: db}, nil
+}
+
 func NewTestDB() (*SQLiteDB, error) {
 	db, err := sql.Open("sqlite3", "file::memory:?cache=shared")
 	if err != nil {
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE missions (
+	id INTEGER PRIMARY KEY,
+	status TEXT NOT NULL,
+	source TEXT NOT NULL,
+	on_failure TEXT NOT NULL,
+	max_retries INTEGER NOT NULL DEFAULT 0,
+	error TEXT,
+	started_at TEXT,
+	completed_at TEXT,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE INDEX idx_missions_status ON missions(status);
+
+ALTER TABLE commands
+ADD COLUMN mission_id INTEGER REFERENCES missions(id);
+
+CREATE INDEX idx_commands_mission_id ON commands(mission_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_commands_mission_id;
+
+ALTER TABLE commands
+DROP COLUMN mission_id;
+
+DROP INDEX idx_missions_status;
+DROP TABLE missions;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE railmap (
+	id INTEGER PRIMARY KEY CHECK (id = 1),
+	topology TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE TABLE railmap_tags (
+	id INTEGER PRIMARY KEY,
+	location TEXT NOT NULL UNIQUE,
+	position INTEGER NOT NULL,
+	distance_to_next INTEGER,
+	alias TEXT UNIQUE,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE INDEX idx_railmap_tags_position ON railmap_tags(position);
+
+INSERT INTO
+	railmap (id, topology, updated_at)
+VALUES
+	(1, 'LOOP', '2025-01-01T00:00:00Z');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_railmap_tags_position;
+DROP TABLE railmap_tags;
+DROP TABLE railmap;
+-- +goose StatementEnd
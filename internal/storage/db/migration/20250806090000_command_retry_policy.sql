@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE commands
+ADD COLUMN retry_policy TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE commands
+DROP COLUMN retry_policy;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE schedules (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	cron TEXT NOT NULL,
+	enabled INTEGER NOT NULL DEFAULT 1,
+	command_template TEXT,
+	mission_template TEXT,
+	last_run_at TEXT,
+	next_run_at TEXT,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE INDEX idx_schedules_enabled_next_run_at ON schedules(enabled, next_run_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_schedules_enabled_next_run_at;
+DROP TABLE schedules;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE commands
+ADD COLUMN queue_position INTEGER NOT NULL DEFAULT 0;
+
+UPDATE commands
+SET queue_position = id;
+
+DROP INDEX idx_commands_status_priority_created_at;
+
+CREATE INDEX idx_commands_status_priority_queue_position ON commands(status, priority DESC, queue_position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_commands_status_priority_queue_position;
+
+CREATE INDEX idx_commands_status_priority_created_at ON commands(status, priority DESC, created_at);
+
+ALTER TABLE commands
+DROP COLUMN queue_position;
+-- +goose StatementEnd
@@ -6,6 +6,14 @@ FROM
 WHERE
 	id = @id;
 
+-- name: CommandGetByRequestID :one
+SELECT
+	*
+FROM
+	commands
+WHERE
+	request_id = @request_id;
+
 -- name: CommandGetCurrentProcessing :one
 -- It returns the command with the status PROCESSING or CANCELING.
 -- Should be only one command in status PROCESSING or CANCELING.
@@ -19,17 +27,38 @@ LIMIT
 	1;
 
 -- name: CommandGetNextExecutable :one
+-- It returns the queued command with the highest priority,
+-- commands with the same priority are returned in queue position order.
+-- Commands deferred by not_before are skipped until the time is reached.
 SELECT
 	*
 FROM
 	commands
 WHERE
 	status = 'QUEUED'
+	AND (
+		not_before IS NULL
+		OR not_before <= CAST(@now AS TEXT)
+	)
 ORDER BY
-	created_at ASC
+	priority DESC,
+	queue_position ASC
 LIMIT
 	1;
 
+-- name: CommandListQueued :many
+-- It returns the queued commands in queue order.
+SELECT
+	*
+FROM
+	commands
+WHERE
+	status = 'QUEUED'
+ORDER BY
+	priority DESC,
+	queue_position ASC,
+	id ASC;
+
 -- name: CommandCreate :one
 INSERT INTO
 	commands (
@@ -42,7 +71,11 @@ INSERT INTO
 		created_at,
 		updated_at,
 		completed_at,
-		request_id
+		request_id,
+		retry_policy,
+		priority,
+		not_before,
+		queue_position
 	)
 VALUES
 	(
@@ -55,9 +88,19 @@ VALUES
 		@created_at,
 		@updated_at,
 		@completed_at,
-		@request_id
+		@request_id,
+		@retry_policy,
+		@priority,
+		@not_before,
+		(
+			SELECT
+				COALESCE(MAX(queue_position), 0) + 1
+			FROM
+				commands
+		)
 	) RETURNING id,
-	outputs;
+	outputs,
+	queue_position;
 
 -- name: CommandUpdate :one
 UPDATE
@@ -87,6 +130,51 @@ SET
 WHERE
 	id = @id RETURNING *;
 
+-- name: CommandUpdateQueuePosition :exec
+UPDATE
+	commands
+SET
+	queue_position = @queue_position,
+	priority = @priority,
+	updated_at = @updated_at
+WHERE
+	id = @id;
+
+-- name: CommandUpdateQueuedInputs :execrows
+-- It does not update the command if the status is not QUEUED or if it is a mission step.
+UPDATE
+	commands
+SET
+	type = @type,
+	inputs = @inputs,
+	updated_at = @updated_at
+WHERE
+	id = @id
+	AND status = 'QUEUED'
+	AND mission_id IS NULL;
+
+-- name: CommandCancelQueued :execrows
+-- It does not cancel the command if the status is not QUEUED.
+UPDATE
+	commands
+SET
+	status = 'CANCELED',
+	completed_at = @completed_at,
+	updated_at = @updated_at
+WHERE
+	id = @id
+	AND status = 'QUEUED';
+
+-- name: CommandListInterrupted :many
+SELECT
+	*
+FROM
+	commands
+WHERE
+	status IN ('PROCESSING', 'CANCELING')
+ORDER BY
+	id ASC;
+
 -- name: CommandCancelByStatusQueuedAndProcessingAndCanceling :exec
 UPDATE
 	commands
@@ -119,3 +207,20 @@ DELETE FROM
 WHERE
 	created_at < @created_at
 	AND status NOT IN ('QUEUED', 'PROCESSING', 'CANCELING');
+
+-- name: CommandQueueStateGet :one
+SELECT
+	*
+FROM
+	command_queue_state
+WHERE
+	id = 1;
+
+-- name: CommandQueueStateUpdate :one
+UPDATE
+	command_queue_state
+SET
+	paused = @paused,
+	updated_at = @updated_at
+WHERE
+	id = 1 RETURNING *;
@@ -38,6 +38,33 @@ func (q *Queries) CommandCancelByStatusQueuedAndProcessingAndCreatedByCloud(ctx
 	return err
 }
 
+const commandCancelQueued = `-- name: CommandCancelQueued :execrows
+UPDATE
+	commands
+SET
+	status = 'CANCELED',
+	completed_at = ?1,
+	updated_at = ?2
+WHERE
+	id = ?3
+	AND status = 'QUEUED'
+`
+
+type CommandCancelQueuedParams struct {
+	CompletedAt *string `json:"completed_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	ID          int64   `json:"id"`
+}
+
+// It does not cancel the command if the status is not QUEUED.
+func (q *Queries) CommandCancelQueued(ctx context.Context, db DBTX, arg CommandCancelQueuedParams) (int64, error) {
+	result, err := db.ExecContext(ctx, commandCancelQueued, arg.CompletedAt, arg.UpdatedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const commandCreate = `-- name: CommandCreate :one
 INSERT INTO
 	commands (
@@ -50,7 +77,11 @@ INSERT INTO
 		created_at,
 		updated_at,
 		completed_at,
-		request_id
+		request_id,
+		retry_policy,
+		priority,
+		not_before,
+		queue_position
 	)
 VALUES
 	(
@@ -63,9 +94,19 @@ VALUES
 		?7,
 		?8,
 		?9,
-		?10
+		?10,
+		?11,
+		?12,
+		?13,
+		(
+			SELECT
+				COALESCE(MAX(queue_position), 0) + 1
+			FROM
+				commands
+		)
 	) RETURNING id,
-	outputs
+	outputs,
+	queue_position
 `
 
 type CommandCreateParams struct {
@@ -79,11 +120,15 @@ type CommandCreateParams struct {
 	UpdatedAt   string  `json:"updated_at"`
 	CompletedAt *string `json:"completed_at"`
 	RequestID   *string `json:"request_id"`
+	RetryPolicy *string `json:"retry_policy"`
+	Priority    int64   `json:"priority"`
+	NotBefore   *string `json:"not_before"`
 }
 
 type CommandCreateRow struct {
-	ID      int64  `json:"id"`
-	Outputs string `json:"outputs"`
+	ID            int64  `json:"id"`
+	Outputs       string `json:"outputs"`
+	QueuePosition int64  `json:"queue_position"`
 }
 
 func (q *Queries) CommandCreate(ctx context.Context, db DBTX, arg CommandCreateParams) (CommandCreateRow, error) {
@@ -98,9 +143,12 @@ func (q *Queries) CommandCreate(ctx context.Context, db DBTX, arg CommandCreateP
 		arg.UpdatedAt,
 		arg.CompletedAt,
 		arg.RequestID,
+		arg.RetryPolicy,
+		arg.Priority,
+		arg.NotBefore,
 	)
 	var i CommandCreateRow
-	err := row.Scan(&i.ID, &i.Outputs)
+	err := row.Scan(&i.ID, &i.Outputs, &i.QueuePosition)
 	return i, err
 }
 
@@ -140,7 +188,7 @@ func (q *Queries) CommandDeleteOldCommands(ctx context.Context, db DBTX, created
 
 const commandGetByID = `-- name: CommandGetByID :one
 SELECT
-	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
 FROM
 	commands
 WHERE
@@ -163,13 +211,52 @@ func (q *Queries) CommandGetByID(ctx context.Context, db DBTX, id int64) (Comman
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.MissionID,
+		&i.RetryPolicy,
+		&i.Priority,
+		&i.NotBefore,
+		&i.QueuePosition,
+	)
+	return i, err
+}
+
+const commandGetByRequestID = `-- name: CommandGetByRequestID :one
+SELECT
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
+FROM
+	commands
+WHERE
+	request_id = ?1
+`
+
+func (q *Queries) CommandGetByRequestID(ctx context.Context, db DBTX, requestID *string) (Command, error) {
+	row := db.QueryRowContext(ctx, commandGetByRequestID, requestID)
+	var i Command
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Source,
+		&i.Inputs,
+		&i.Error,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.Outputs,
+		&i.RequestID,
+		&i.MissionID,
+		&i.RetryPolicy,
+		&i.Priority,
+		&i.NotBefore,
+		&i.QueuePosition,
 	)
 	return i, err
 }
 
 const commandGetCurrentProcessing = `-- name: CommandGetCurrentProcessing :one
 SELECT
-	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
 FROM
 	commands
 WHERE
@@ -196,25 +283,38 @@ func (q *Queries) CommandGetCurrentProcessing(ctx context.Context, db DBTX) (Com
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.MissionID,
+		&i.RetryPolicy,
+		&i.Priority,
+		&i.NotBefore,
+		&i.QueuePosition,
 	)
 	return i, err
 }
 
 const commandGetNextExecutable = `-- name: CommandGetNextExecutable :one
 SELECT
-	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
 FROM
 	commands
 WHERE
 	status = 'QUEUED'
+	AND (
+		not_before IS NULL
+		OR not_before <= CAST(?1 AS TEXT)
+	)
 ORDER BY
-	created_at ASC
+	priority DESC,
+	queue_position ASC
 LIMIT
 	1
 `
 
-func (q *Queries) CommandGetNextExecutable(ctx context.Context, db DBTX) (Command, error) {
-	row := db.QueryRowContext(ctx, commandGetNextExecutable)
+// It returns the queued command with the highest priority,
+// commands with the same priority are returned in queue position order.
+// Commands deferred by not_before are skipped until the time is reached.
+func (q *Queries) CommandGetNextExecutable(ctx context.Context, db DBTX, now string) (Command, error) {
+	row := db.QueryRowContext(ctx, commandGetNextExecutable, now)
 	var i Command
 	err := row.Scan(
 		&i.ID,
@@ -229,10 +329,160 @@ func (q *Queries) CommandGetNextExecutable(ctx context.Context, db DBTX) (Comman
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.MissionID,
+		&i.RetryPolicy,
+		&i.Priority,
+		&i.NotBefore,
+		&i.QueuePosition,
 	)
 	return i, err
 }
 
+const commandListInterrupted = `-- name: CommandListInterrupted :many
+SELECT
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
+FROM
+	commands
+WHERE
+	status IN ('PROCESSING', 'CANCELING')
+ORDER BY
+	id ASC
+`
+
+func (q *Queries) CommandListInterrupted(ctx context.Context, db DBTX) ([]Command, error) {
+	rows, err := db.QueryContext(ctx, commandListInterrupted)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Command{}
+	for rows.Next() {
+		var i Command
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.Status,
+			&i.Source,
+			&i.Inputs,
+			&i.Error,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.Outputs,
+			&i.RequestID,
+			&i.MissionID,
+			&i.RetryPolicy,
+			&i.Priority,
+			&i.NotBefore,
+			&i.QueuePosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const commandListQueued = `-- name: CommandListQueued :many
+SELECT
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
+FROM
+	commands
+WHERE
+	status = 'QUEUED'
+ORDER BY
+	priority DESC,
+	queue_position ASC,
+	id ASC
+`
+
+// It returns the queued commands in queue order.
+func (q *Queries) CommandListQueued(ctx context.Context, db DBTX) ([]Command, error) {
+	rows, err := db.QueryContext(ctx, commandListQueued)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Command{}
+	for rows.Next() {
+		var i Command
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.Status,
+			&i.Source,
+			&i.Inputs,
+			&i.Error,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.Outputs,
+			&i.RequestID,
+			&i.MissionID,
+			&i.RetryPolicy,
+			&i.Priority,
+			&i.NotBefore,
+			&i.QueuePosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const commandQueueStateGet = `-- name: CommandQueueStateGet :one
+SELECT
+	id, paused, updated_at
+FROM
+	command_queue_state
+WHERE
+	id = 1
+`
+
+func (q *Queries) CommandQueueStateGet(ctx context.Context, db DBTX) (CommandQueueState, error) {
+	row := db.QueryRowContext(ctx, commandQueueStateGet)
+	var i CommandQueueState
+	err := row.Scan(&i.ID, &i.Paused, &i.UpdatedAt)
+	return i, err
+}
+
+const commandQueueStateUpdate = `-- name: CommandQueueStateUpdate :one
+UPDATE
+	command_queue_state
+SET
+	paused = ?1,
+	updated_at = ?2
+WHERE
+	id = 1 RETURNING id, paused, updated_at
+`
+
+type CommandQueueStateUpdateParams struct {
+	Paused    int64  `json:"paused"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func (q *Queries) CommandQueueStateUpdate(ctx context.Context, db DBTX, arg CommandQueueStateUpdateParams) (CommandQueueState, error) {
+	row := db.QueryRowContext(ctx, commandQueueStateUpdate, arg.Paused, arg.UpdatedAt)
+	var i CommandQueueState
+	err := row.Scan(&i.ID, &i.Paused, &i.UpdatedAt)
+	return i, err
+}
+
 const commandUpdate = `-- name: CommandUpdate :one
 UPDATE
 	commands
@@ -259,7 +509,7 @@ SET
 	END,
 	updated_at = ?11
 WHERE
-	id = ?12 RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id = ?12 RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
 `
 
 type CommandUpdateParams struct {
@@ -306,6 +556,73 @@ func (q *Queries) CommandUpdate(ctx context.Context, db DBTX, arg CommandUpdateP
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.MissionID,
+		&i.RetryPolicy,
+		&i.Priority,
+		&i.NotBefore,
+		&i.QueuePosition,
 	)
 	return i, err
 }
+
+const commandUpdateQueuePosition = `-- name: CommandUpdateQueuePosition :exec
+UPDATE
+	commands
+SET
+	queue_position = ?1,
+	priority = ?2,
+	updated_at = ?3
+WHERE
+	id = ?4
+`
+
+type CommandUpdateQueuePositionParams struct {
+	QueuePosition int64  `json:"queue_position"`
+	Priority      int64  `json:"priority"`
+	UpdatedAt     string `json:"updated_at"`
+	ID            int64  `json:"id"`
+}
+
+func (q *Queries) CommandUpdateQueuePosition(ctx context.Context, db DBTX, arg CommandUpdateQueuePositionParams) error {
+	_, err := db.ExecContext(ctx, commandUpdateQueuePosition,
+		arg.QueuePosition,
+		arg.Priority,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const commandUpdateQueuedInputs = `-- name: CommandUpdateQueuedInputs :execrows
+UPDATE
+	commands
+SET
+	type = ?1,
+	inputs = ?2,
+	updated_at = ?3
+WHERE
+	id = ?4
+	AND status = 'QUEUED'
+	AND mission_id IS NULL
+`
+
+type CommandUpdateQueuedInputsParams struct {
+	Type      string `json:"type"`
+	Inputs    string `json:"inputs"`
+	UpdatedAt string `json:"updated_at"`
+	ID        int64  `json:"id"`
+}
+
+// It does not update the command if the status is not QUEUED or if it is a mission step.
+func (q *Queries) CommandUpdateQueuedInputs(ctx context.Context, db DBTX, arg CommandUpdateQueuedInputsParams) (int64, error) {
+	result, err := db.ExecContext(ctx, commandUpdateQueuedInputs,
+		arg.Type,
+		arg.Inputs,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,152 @@
+-- name: MissionGetByID :one
+SELECT
+	*
+FROM
+	missions
+WHERE
+	id = @id;
+
+-- name: MissionList :many
+SELECT
+	*
+FROM
+	missions
+ORDER BY
+	created_at DESC
+LIMIT
+	@limit
+OFFSET
+	@offset;
+
+-- name: MissionCount :one
+SELECT
+	COUNT(*)
+FROM
+	missions;
+
+-- name: MissionListSteps :many
+-- It returns the commands of the missions ordered by their position in the mission.
+SELECT
+	*
+FROM
+	commands
+WHERE
+	mission_id IN (sqlc.slice('mission_ids'))
+ORDER BY
+	id ASC;
+
+-- name: MissionCreate :one
+INSERT INTO
+	missions (
+		status,
+		source,
+		on_failure,
+		max_retries,
+		error,
+		started_at,
+		completed_at,
+		created_at,
+		updated_at
+	)
+VALUES
+	(
+		@status,
+		@source,
+		@on_failure,
+		@max_retries,
+		@error,
+		@started_at,
+		@completed_at,
+		@created_at,
+		@updated_at
+	) RETURNING id;
+
+-- name: MissionCreateStep :one
+INSERT INTO
+	commands (
+		type,
+		status,
+		source,
+		inputs,
+		created_at,
+		updated_at,
+		request_id,
+		mission_id,
+		queue_position
+	)
+VALUES
+	(
+		@type,
+		@status,
+		@source,
+		@inputs,
+		@created_at,
+		@updated_at,
+		@request_id,
+		@mission_id,
+		(
+			SELECT
+				COALESCE(MAX(queue_position), 0) + 1
+			FROM
+				commands
+		)
+	) RETURNING *;
+
+-- name: MissionUpdate :one
+UPDATE
+	missions
+SET
+	status = CASE
+		WHEN @set_status = 1 THEN @status
+		ELSE status
+	END,
+	error = CASE
+		WHEN @set_error = 1 THEN @error
+		ELSE error
+	END,
+	started_at = CASE
+		WHEN @set_started_at = 1 THEN @started_at
+		ELSE started_at
+	END,
+	completed_at = CASE
+		WHEN @set_completed_at = 1 THEN @completed_at
+		ELSE completed_at
+	END,
+	updated_at = @updated_at
+WHERE
+	id = @id RETURNING *;
+
+-- name: MissionCancelQueuedSteps :exec
+UPDATE
+	commands
+SET
+	status = 'CANCELED',
+	updated_at = @updated_at
+WHERE
+	mission_id = @mission_id
+	AND status = 'QUEUED';
+
+-- name: MissionCancelByStatusQueuedAndProcessing :exec
+UPDATE
+	missions
+SET
+	status = 'CANCELED'
+WHERE
+	status IN ('QUEUED', 'PROCESSING');
+
+-- name: MissionCancelByStatusQueuedAndProcessingAndCreatedByCloud :exec
+UPDATE
+	missions
+SET
+	status = 'CANCELED'
+WHERE
+	status IN ('QUEUED', 'PROCESSING')
+	AND source = 'CLOUD';
+
+-- name: MissionDeleteOldMissions :execrows
+-- It does not delete the mission if the status is QUEUED, PROCESSING.
+DELETE FROM
+	missions
+WHERE
+	created_at < @created_at
+	AND status NOT IN ('QUEUED', 'PROCESSING');
@@ -0,0 +1,434 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: mission.sql
+
+package sqlc
+
+import (
+	"context"
+	"strings"
+)
+
+const missionCancelByStatusQueuedAndProcessing = `-- name: MissionCancelByStatusQueuedAndProcessing :exec
+UPDATE
+	missions
+SET
+	status = 'CANCELED'
+WHERE
+	status IN ('QUEUED', 'PROCESSING')
+`
+
+func (q *Queries) MissionCancelByStatusQueuedAndProcessing(ctx context.Context, db DBTX) error {
+	_, err := db.ExecContext(ctx, missionCancelByStatusQueuedAndProcessing)
+	return err
+}
+
+const missionCancelByStatusQueuedAndProcessingAndCreatedByCloud = `-- name: MissionCancelByStatusQueuedAndProcessingAndCreatedByCloud :exec
+UPDATE
+	missions
+SET
+	status = 'CANCELED'
+WHERE
+	status IN ('QUEUED', 'PROCESSING')
+	AND source = 'CLOUD'
+`
+
+func (q *Queries) MissionCancelByStatusQueuedAndProcessingAndCreatedByCloud(ctx context.Context, db DBTX) error {
+	_, err := db.ExecContext(ctx, missionCancelByStatusQueuedAndProcessingAndCreatedByCloud)
+	return err
+}
+
+const missionCancelQueuedSteps = `-- name: MissionCancelQueuedSteps :exec
+UPDATE
+	commands
+SET
+	status = 'CANCELED',
+	updated_at = ?1
+WHERE
+	mission_id = ?2
+	AND status = 'QUEUED'
+`
+
+type MissionCancelQueuedStepsParams struct {
+	UpdatedAt string `json:"updated_at"`
+	MissionID *int64 `json:"mission_id"`
+}
+
+func (q *Queries) MissionCancelQueuedSteps(ctx context.Context, db DBTX, arg MissionCancelQueuedStepsParams) error {
+	_, err := db.ExecContext(ctx, missionCancelQueuedSteps, arg.UpdatedAt, arg.MissionID)
+	return err
+}
+
+const missionCount = `-- name: MissionCount :one
+SELECT
+	COUNT(*)
+FROM
+	missions
+`
+
+func (q *Queries) MissionCount(ctx context.Context, db DBTX) (int64, error) {
+	row := db.QueryRowContext(ctx, missionCount)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const missionCreate = `-- name: MissionCreate :one
+INSERT INTO
+	missions (
+		status,
+		source,
+		on_failure,
+		max_retries,
+		error,
+		started_at,
+		completed_at,
+		created_at,
+		updated_at
+	)
+VALUES
+	(
+		?1,
+		?2,
+		?3,
+		?4,
+		?5,
+		?6,
+		?7,
+		?8,
+		?9
+	) RETURNING id
+`
+
+type MissionCreateParams struct {
+	Status      string  `json:"status"`
+	Source      string  `json:"source"`
+	OnFailure   string  `json:"on_failure"`
+	MaxRetries  int64   `json:"max_retries"`
+	Error       *string `json:"error"`
+	StartedAt   *string `json:"started_at"`
+	CompletedAt *string `json:"completed_at"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+func (q *Queries) MissionCreate(ctx context.Context, db DBTX, arg MissionCreateParams) (int64, error) {
+	row := db.QueryRowContext(ctx, missionCreate,
+		arg.Status,
+		arg.Source,
+		arg.OnFailure,
+		arg.MaxRetries,
+		arg.Error,
+		arg.StartedAt,
+		arg.CompletedAt,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const missionCreateStep = `-- name: MissionCreateStep :one
+INSERT INTO
+	commands (
+		type,
+		status,
+		source,
+		inputs,
+		created_at,
+		updated_at,
+		request_id,
+		mission_id,
+		queue_position
+	)
+VALUES
+	(
+		?1,
+		?2,
+		?3,
+		?4,
+		?5,
+		?6,
+		?7,
+		?8,
+		(
+			SELECT
+				COALESCE(MAX(queue_position), 0) + 1
+			FROM
+				commands
+		)
+	) RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
+`
+
+type MissionCreateStepParams struct {
+	Type      string  `json:"type"`
+	Status    string  `json:"status"`
+	Source    string  `json:"source"`
+	Inputs    string  `json:"inputs"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+	RequestID *string `json:"request_id"`
+	MissionID *int64  `json:"mission_id"`
+}
+
+func (q *Queries) MissionCreateStep(ctx context.Context, db DBTX, arg MissionCreateStepParams) (Command, error) {
+	row := db.QueryRowContext(ctx, missionCreateStep,
+		arg.Type,
+		arg.Status,
+		arg.Source,
+		arg.Inputs,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.RequestID,
+		arg.MissionID,
+	)
+	var i Command
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Source,
+		&i.Inputs,
+		&i.Error,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.Outputs,
+		&i.RequestID,
+		&i.MissionID,
+		&i.RetryPolicy,
+		&i.Priority,
+		&i.NotBefore,
+		&i.QueuePosition,
+	)
+	return i, err
+}
+
+const missionDeleteOldMissions = `-- name: MissionDeleteOldMissions :execrows
+DELETE FROM
+	missions
+WHERE
+	created_at < ?1
+	AND status NOT IN ('QUEUED', 'PROCESSING')
+`
+
+// It does not delete the mission if the status is QUEUED, PROCESSING.
+func (q *Queries) MissionDeleteOldMissions(ctx context.Context, db DBTX, createdAt string) (int64, error) {
+	result, err := db.ExecContext(ctx, missionDeleteOldMissions, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const missionGetByID = `-- name: MissionGetByID :one
+SELECT
+	id, status, source, on_failure, max_retries, error, started_at, completed_at, created_at, updated_at
+FROM
+	missions
+WHERE
+	id = ?1
+`
+
+func (q *Queries) MissionGetByID(ctx context.Context, db DBTX, id int64) (Mission, error) {
+	row := db.QueryRowContext(ctx, missionGetByID, id)
+	var i Mission
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Source,
+		&i.OnFailure,
+		&i.MaxRetries,
+		&i.Error,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const missionList = `-- name: MissionList :many
+SELECT
+	id, status, source, on_failure, max_retries, error, started_at, completed_at, created_at, updated_at
+FROM
+	missions
+ORDER BY
+	created_at DESC
+LIMIT
+	?2
+OFFSET
+	?1
+`
+
+type MissionListParams struct {
+	Offset int64 `json:"offset"`
+	Limit  int64 `json:"limit"`
+}
+
+func (q *Queries) MissionList(ctx context.Context, db DBTX, arg MissionListParams) ([]Mission, error) {
+	rows, err := db.QueryContext(ctx, missionList, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Mission{}
+	for rows.Next() {
+		var i Mission
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.Source,
+			&i.OnFailure,
+			&i.MaxRetries,
+			&i.Error,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const missionListSteps = `-- name: MissionListSteps :many
+SELECT
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, mission_id, retry_policy, priority, not_before, queue_position
+FROM
+	commands
+WHERE
+	mission_id IN (/*SLICE:mission_ids*/?)
+ORDER BY
+	id ASC
+`
+
+// It returns the commands of the missions ordered by their position in the mission.
+func (q *Queries) MissionListSteps(ctx context.Context, db DBTX, missionIds []*int64) ([]Command, error) {
+	query := missionListSteps
+	var queryParams []interface{}
+	if len(missionIds) > 0 {
+		for _, v := range missionIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:mission_ids*/?", strings.Repeat(",?", len(missionIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:mission_ids*/?", "NULL", 1)
+	}
+	rows, err := db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Command{}
+	for rows.Next() {
+		var i Command
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.Status,
+			&i.Source,
+			&i.Inputs,
+			&i.Error,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.Outputs,
+			&i.RequestID,
+			&i.MissionID,
+			&i.RetryPolicy,
+			&i.Priority,
+			&i.NotBefore,
+			&i.QueuePosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const missionUpdate = `-- name: MissionUpdate :one
+UPDATE
+	missions
+SET
+	status = CASE
+		WHEN ?1 = 1 THEN ?2
+		ELSE status
+	END,
+	error = CASE
+		WHEN ?3 = 1 THEN ?4
+		ELSE error
+	END,
+	started_at = CASE
+		WHEN ?5 = 1 THEN ?6
+		ELSE started_at
+	END,
+	completed_at = CASE
+		WHEN ?7 = 1 THEN ?8
+		ELSE completed_at
+	END,
+	updated_at = ?9
+WHERE
+	id = ?10 RETURNING id, status, source, on_failure, max_retries, error, started_at, completed_at, created_at, updated_at
+`
+
+type MissionUpdateParams struct {
+	SetStatus      interface{} `json:"set_status"`
+	Status         string      `json:"status"`
+	SetError       interface{} `json:"set_error"`
+	Error          *string     `json:"error"`
+	SetStartedAt   interface{} `json:"set_started_at"`
+	StartedAt      *string     `json:"started_at"`
+	SetCompletedAt interface{} `json:"set_completed_at"`
+	CompletedAt    *string     `json:"completed_at"`
+	UpdatedAt      string      `json:"updated_at"`
+	ID             int64       `json:"id"`
+}
+
+func (q *Queries) MissionUpdate(ctx context.Context, db DBTX, arg MissionUpdateParams) (Mission, error) {
+	row := db.QueryRowContext(ctx, missionUpdate,
+		arg.SetStatus,
+		arg.Status,
+		arg.SetError,
+		arg.Error,
+		arg.SetStartedAt,
+		arg.StartedAt,
+		arg.SetCompletedAt,
+		arg.CompletedAt,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	var i Mission
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Source,
+		&i.OnFailure,
+		&i.MaxRetries,
+		&i.Error,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -45,26 +45,80 @@ type CargoDoorMotor struct {
 }
 
 type Command struct {
+	ID            int64   `json:"id"`
+	Type          string  `json:"type"`
+	Status        string  `json:"status"`
+	Source        string  `json:"source"`
+	Inputs        string  `json:"inputs"`
+	Error         *string `json:"error"`
+	CompletedAt   *string `json:"completed_at"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+	StartedAt     *string `json:"started_at"`
+	Outputs       string  `json:"outputs"`
+	RequestID     *string `json:"request_id"`
+	MissionID     *int64  `json:"mission_id"`
+	RetryPolicy   *string `json:"retry_policy"`
+	Priority      int64   `json:"priority"`
+	NotBefore     *string `json:"not_before"`
+	QueuePosition int64   `json:"queue_position"`
+}
+
+type CommandQueueState struct {
+	ID        int64  `json:"id"`
+	Paused    int64  `json:"paused"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type Location struct {
+	ID              int64  `json:"id"`
+	CurrentLocation string `json:"current_location"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+type Mission struct {
 	ID          int64   `json:"id"`
-	Type        string  `json:"type"`
 	Status      string  `json:"status"`
 	Source      string  `json:"source"`
-	Inputs      string  `json:"inputs"`
+	OnFailure   string  `json:"on_failure"`
+	MaxRetries  int64   `json:"max_retries"`
 	Error       *string `json:"error"`
+	StartedAt   *string `json:"started_at"`
 	CompletedAt *string `json:"completed_at"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
-	StartedAt   *string `json:"started_at"`
-	Outputs     string  `json:"outputs"`
-	RequestID   *string `json:"request_id"`
 }
 
-type Location struct {
-	ID              int64  `json:"id"`
-	CurrentLocation string `json:"current_location"`
-	UpdatedAt       string `json:"updated_at"`
+type Railmap struct {
+	ID        int64  `json:"id"`
+	Topology  string `json:"topology"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type RailmapTag struct {
+	ID             int64   `json:"id"`
+	Location       string  `json:"location"`
+	Position       int64   `json:"position"`
+	DistanceToNext *int64  `json:"distance_to_next"`
+	Alias          *string `json:"alias"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
 }
 
 type Robot struct {
 	ID int64 `json:"id"`
 }
+
+type Schedule struct {
+	ID              int64   `json:"id"`
+	Name            string  `json:"name"`
+	Cron            string  `json:"cron"`
+	Enabled         int64   `json:"enabled"`
+	CommandTemplate *string `json:"command_template"`
+	MissionTemplate *string `json:"mission_template"`
+	LastRunAt       *string `json:"last_run_at"`
+	NextRunAt       *string `json:"next_run_at"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+	Timezone        string  `json:"timezone"`
+}
@@ -0,0 +1,79 @@
+-- name: RailMapGet :one
+SELECT
+	*
+FROM
+	railmap
+WHERE
+	id = 1;
+
+-- name: RailMapUpdate :exec
+UPDATE
+	railmap
+SET
+	topology = @topology,
+	updated_at = @updated_at
+WHERE
+	id = 1;
+
+-- name: RailMapTagList :many
+SELECT
+	*
+FROM railmap_tags
+ORDER BY position ASC;
+
+-- name: RailMapTagGet :one
+SELECT
+	*
+FROM railmap_tags
+WHERE id = @id;
+
+-- name: RailMapTagCount :one
+SELECT COUNT(*)
+FROM railmap_tags;
+
+-- name: RailMapTagCreate :one
+INSERT INTO railmap_tags (
+	location,
+	position,
+	distance_to_next,
+	alias,
+	created_at,
+	updated_at
+)
+VALUES (
+	@location,
+	@position,
+	@distance_to_next,
+	@alias,
+	@created_at,
+	@updated_at
+)
+RETURNING *;
+
+-- name: RailMapTagUpdate :one
+UPDATE
+	railmap_tags
+SET
+	distance_to_next = CASE
+		WHEN @set_distance_to_next = 1 THEN @distance_to_next
+		ELSE distance_to_next
+	END,
+	alias = CASE
+		WHEN @set_alias = 1 THEN @alias
+		ELSE alias
+	END,
+	updated_at = @updated_at
+WHERE
+	id = @id RETURNING *;
+
+-- name: RailMapTagShiftPositions :exec
+UPDATE railmap_tags
+SET position = position + @delta
+WHERE position >= @from_position;
+
+-- name: RailMapTagDelete :exec
+DELETE FROM railmap_tags
+WHERE id = @id;
+
+-- name: RailMapTagDeleteAll :exec
+DELETE FROM railmap_tags;
@@ -0,0 +1,252 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: railmap.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const railMapGet = `-- name: RailMapGet :one
+SELECT
+	id, topology, updated_at
+FROM
+	railmap
+WHERE
+	id = 1
+`
+
+func (q *Queries) RailMapGet(ctx context.Context, db DBTX) (Railmap, error) {
+	row := db.QueryRowContext(ctx, railMapGet)
+	var i Railmap
+	err := row.Scan(&i.ID, &i.Topology, &i.UpdatedAt)
+	return i, err
+}
+
+const railMapTagCount = `-- name: RailMapTagCount :one
+SELECT COUNT(*)
+FROM railmap_tags
+`
+
+func (q *Queries) RailMapTagCount(ctx context.Context, db DBTX) (int64, error) {
+	row := db.QueryRowContext(ctx, railMapTagCount)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const railMapTagCreate = `-- name: RailMapTagCreate :one
+INSERT INTO railmap_tags (
+	location,
+	position,
+	distance_to_next,
+	alias,
+	created_at,
+	updated_at
+)
+VALUES (
+	?1,
+	?2,
+	?3,
+	?4,
+	?5,
+	?6
+)
+RETURNING id, location, position, distance_to_next, alias, created_at, updated_at
+`
+
+type RailMapTagCreateParams struct {
+	Location       string  `json:"location"`
+	Position       int64   `json:"position"`
+	DistanceToNext *int64  `json:"distance_to_next"`
+	Alias          *string `json:"alias"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+func (q *Queries) RailMapTagCreate(ctx context.Context, db DBTX, arg RailMapTagCreateParams) (RailmapTag, error) {
+	row := db.QueryRowContext(ctx, railMapTagCreate,
+		arg.Location,
+		arg.Position,
+		arg.DistanceToNext,
+		arg.Alias,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i RailmapTag
+	err := row.Scan(
+		&i.ID,
+		&i.Location,
+		&i.Position,
+		&i.DistanceToNext,
+		&i.Alias,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const railMapTagDelete = `-- name: RailMapTagDelete :exec
+DELETE FROM railmap_tags
+WHERE id = ?1
+`
+
+func (q *Queries) RailMapTagDelete(ctx context.Context, db DBTX, id int64) error {
+	_, err := db.ExecContext(ctx, railMapTagDelete, id)
+	return err
+}
+
+const railMapTagDeleteAll = `-- name: RailMapTagDeleteAll :exec
+DELETE FROM railmap_tags
+`
+
+func (q *Queries) RailMapTagDeleteAll(ctx context.Context, db DBTX) error {
+	_, err := db.ExecContext(ctx, railMapTagDeleteAll)
+	return err
+}
+
+const railMapTagGet = `-- name: RailMapTagGet :one
+SELECT
+	id, location, position, distance_to_next, alias, created_at, updated_at
+FROM railmap_tags
+WHERE id = ?1
+`
+
+func (q *Queries) RailMapTagGet(ctx context.Context, db DBTX, id int64) (RailmapTag, error) {
+	row := db.QueryRowContext(ctx, railMapTagGet, id)
+	var i RailmapTag
+	err := row.Scan(
+		&i.ID,
+		&i.Location,
+		&i.Position,
+		&i.DistanceToNext,
+		&i.Alias,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const railMapTagList = `-- name: RailMapTagList :many
+SELECT
+	id, location, position, distance_to_next, alias, created_at, updated_at
+FROM railmap_tags
+ORDER BY position ASC
+`
+
+func (q *Queries) RailMapTagList(ctx context.Context, db DBTX) ([]RailmapTag, error) {
+	rows, err := db.QueryContext(ctx, railMapTagList)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RailmapTag{}
+	for rows.Next() {
+		var i RailmapTag
+		if err := rows.Scan(
+			&i.ID,
+			&i.Location,
+			&i.Position,
+			&i.DistanceToNext,
+			&i.Alias,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const railMapTagShiftPositions = `-- name: RailMapTagShiftPositions :exec
+UPDATE railmap_tags
+SET position = position + ?1
+WHERE position >= ?2
+`
+
+type RailMapTagShiftPositionsParams struct {
+	Delta        int64 `json:"delta"`
+	FromPosition int64 `json:"from_position"`
+}
+
+func (q *Queries) RailMapTagShiftPositions(ctx context.Context, db DBTX, arg RailMapTagShiftPositionsParams) error {
+	_, err := db.ExecContext(ctx, railMapTagShiftPositions, arg.Delta, arg.FromPosition)
+	return err
+}
+
+const railMapTagUpdate = `-- name: RailMapTagUpdate :one
+UPDATE
+	railmap_tags
+SET
+	distance_to_next = CASE
+		WHEN ?1 = 1 THEN ?2
+		ELSE distance_to_next
+	END,
+	alias = CASE
+		WHEN ?3 = 1 THEN ?4
+		ELSE alias
+	END,
+	updated_at = ?5
+WHERE
+	id = ?6 RETURNING id, location, position, distance_to_next, alias, created_at, updated_at
+`
+
+type RailMapTagUpdateParams struct {
+	SetDistanceToNext interface{} `json:"set_distance_to_next"`
+	DistanceToNext    *int64      `json:"distance_to_next"`
+	SetAlias          interface{} `json:"set_alias"`
+	Alias             *string     `json:"alias"`
+	UpdatedAt         string      `json:"updated_at"`
+	ID                int64       `json:"id"`
+}
+
+func (q *Queries) RailMapTagUpdate(ctx context.Context, db DBTX, arg RailMapTagUpdateParams) (RailmapTag, error) {
+	row := db.QueryRowContext(ctx, railMapTagUpdate,
+		arg.SetDistanceToNext,
+		arg.DistanceToNext,
+		arg.SetAlias,
+		arg.Alias,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	var i RailmapTag
+	err := row.Scan(
+		&i.ID,
+		&i.Location,
+		&i.Position,
+		&i.DistanceToNext,
+		&i.Alias,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const railMapUpdate = `-- name: RailMapUpdate :exec
+UPDATE
+	railmap
+SET
+	topology = ?1,
+	updated_at = ?2
+WHERE
+	id = 1
+`
+
+type RailMapUpdateParams struct {
+	Topology  string `json:"topology"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func (q *Queries) RailMapUpdate(ctx context.Context, db DBTX, arg RailMapUpdateParams) error {
+	_, err := db.ExecContext(ctx, railMapUpdate, arg.Topology, arg.UpdatedAt)
+	return err
+}
@@ -0,0 +1,87 @@
+-- name: ScheduleGetByID :one
+SELECT
+	*
+FROM
+	schedules
+WHERE
+	id = @id;
+
+-- name: ScheduleList :many
+SELECT
+	*
+FROM
+	schedules
+ORDER BY
+	id ASC
+LIMIT
+	@limit
+OFFSET
+	@offset;
+
+-- name: ScheduleCount :one
+SELECT
+	COUNT(*)
+FROM
+	schedules;
+
+-- name: ScheduleListDue :many
+-- It returns the enabled schedules with a next run at or before now.
+SELECT
+	*
+FROM
+	schedules
+WHERE
+	enabled = 1
+	AND next_run_at <= CAST(@now AS TEXT)
+ORDER BY
+	next_run_at ASC;
+
+-- name: ScheduleCreate :one
+INSERT INTO
+	schedules (
+		name,
+		cron,
+		timezone,
+		enabled,
+		command_template,
+		mission_template,
+		last_run_at,
+		next_run_at,
+		created_at,
+		updated_at
+	)
+VALUES
+	(
+		@name,
+		@cron,
+		@timezone,
+		@enabled,
+		@command_template,
+		@mission_template,
+		@last_run_at,
+		@next_run_at,
+		@created_at,
+		@updated_at
+	) RETURNING *;
+
+-- name: ScheduleUpdate :one
+UPDATE
+	schedules
+SET
+	name = @name,
+	cron = @cron,
+	timezone = @timezone,
+	enabled = @enabled,
+	command_template = @command_template,
+	mission_template = @mission_template,
+	last_run_at = @last_run_at,
+	next_run_at = @next_run_at,
+	updated_at = @updated_at
+WHERE
+	id = @id RETURNING *;
+
+-- name: ScheduleDeleteByID :execrows
+DELETE FROM
+	schedules
+WHERE
+	id = @id;
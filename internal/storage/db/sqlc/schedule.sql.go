@@ -0,0 +1,300 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: schedule.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const scheduleCount = `-- name: ScheduleCount :one
+SELECT
+	COUNT(*)
+FROM
+	schedules
+`
+
+func (q *Queries) ScheduleCount(ctx context.Context, db DBTX) (int64, error) {
+	row := db.QueryRowContext(ctx, scheduleCount)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const scheduleCreate = `-- name: ScheduleCreate :one
+INSERT INTO
+	schedules (
+		name,
+		cron,
+		timezone,
+		enabled,
+		command_template,
+		mission_template,
+		last_run_at,
+		next_run_at,
+		created_at,
+		updated_at
+	)
+VALUES
+	(
+		?1,
+		?2,
+		?3,
+		?4,
+		?5,
+		?6,
+		?7,
+		?8,
+		?9,
+		?10
+	) RETURNING id, name, cron, enabled, command_template, mission_template, last_run_at, next_run_at, created_at, updated_at, timezone
+`
+
+type ScheduleCreateParams struct {
+	Name            string  `json:"name"`
+	Cron            string  `json:"cron"`
+	Timezone        string  `json:"timezone"`
+	Enabled         int64   `json:"enabled"`
+	CommandTemplate *string `json:"command_template"`
+	MissionTemplate *string `json:"mission_template"`
+	LastRunAt       *string `json:"last_run_at"`
+	NextRunAt       *string `json:"next_run_at"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+func (q *Queries) ScheduleCreate(ctx context.Context, db DBTX, arg ScheduleCreateParams) (Schedule, error) {
+	row := db.QueryRowContext(ctx, scheduleCreate,
+		arg.Name,
+		arg.Cron,
+		arg.Timezone,
+		arg.Enabled,
+		arg.CommandTemplate,
+		arg.MissionTemplate,
+		arg.LastRunAt,
+		arg.NextRunAt,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Cron,
+		&i.Enabled,
+		&i.CommandTemplate,
+		&i.MissionTemplate,
+		&i.LastRunAt,
+		&i.NextRunAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const scheduleDeleteByID = `-- name: ScheduleDeleteByID :execrows
+DELETE FROM
+	schedules
+WHERE
+	id = ?1
+`
+
+func (q *Queries) ScheduleDeleteByID(ctx context.Context, db DBTX, id int64) (int64, error) {
+	result, err := db.ExecContext(ctx, scheduleDeleteByID, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const scheduleGetByID = `-- name: ScheduleGetByID :one
+SELECT
+	id, name, cron, enabled, command_template, mission_template, last_run_at, next_run_at, created_at, updated_at, timezone
+FROM
+	schedules
+WHERE
+	id = ?1
+`
+
+func (q *Queries) ScheduleGetByID(ctx context.Context, db DBTX, id int64) (Schedule, error) {
+	row := db.QueryRowContext(ctx, scheduleGetByID, id)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Cron,
+		&i.Enabled,
+		&i.CommandTemplate,
+		&i.MissionTemplate,
+		&i.LastRunAt,
+		&i.NextRunAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const scheduleList = `-- name: ScheduleList :many
+SELECT
+	id, name, cron, enabled, command_template, mission_template, last_run_at, next_run_at, created_at, updated_at, timezone
+FROM
+	schedules
+ORDER BY
+	id ASC
+LIMIT
+	?2
+OFFSET
+	?1
+`
+
+type ScheduleListParams struct {
+	Offset int64 `json:"offset"`
+	Limit  int64 `json:"limit"`
+}
+
+func (q *Queries) ScheduleList(ctx context.Context, db DBTX, arg ScheduleListParams) ([]Schedule, error) {
+	rows, err := db.QueryContext(ctx, scheduleList, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Schedule{}
+	for rows.Next() {
+		var i Schedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Cron,
+			&i.Enabled,
+			&i.CommandTemplate,
+			&i.MissionTemplate,
+			&i.LastRunAt,
+			&i.NextRunAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const scheduleListDue = `-- name: ScheduleListDue :many
+SELECT
+	id, name, cron, enabled, command_template, mission_template, last_run_at, next_run_at, created_at, updated_at, timezone
+FROM
+	schedules
+WHERE
+	enabled = 1
+	AND next_run_at <= CAST(?1 AS TEXT)
+ORDER BY
+	next_run_at ASC
+`
+
+// It returns the enabled schedules with a next run at or before now.
+func (q *Queries) ScheduleListDue(ctx context.Context, db DBTX, now string) ([]Schedule, error) {
+	rows, err := db.QueryContext(ctx, scheduleListDue, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Schedule{}
+	for rows.Next() {
+		var i Schedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Cron,
+			&i.Enabled,
+			&i.CommandTemplate,
+			&i.MissionTemplate,
+			&i.LastRunAt,
+			&i.NextRunAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const scheduleUpdate = `-- name: ScheduleUpdate :one
+UPDATE
+	schedules
+SET
+	name = ?1,
+	cron = ?2,
+	timezone = ?3,
+	enabled = ?4,
+	command_template = ?5,
+	mission_template = ?6,
+	last_run_at = ?7,
+	next_run_at = ?8,
+	updated_at = ?9
+WHERE
+	id = ?10 RETURNING id, name, cron, enabled, command_template, mission_template, last_run_at, next_run_at, created_at, updated_at, timezone
+`
+
+type ScheduleUpdateParams struct {
+	Name            string  `json:"name"`
+	Cron            string  `json:"cron"`
+	Timezone        string  `json:"timezone"`
+	Enabled         int64   `json:"enabled"`
+	CommandTemplate *string `json:"command_template"`
+	MissionTemplate *string `json:"mission_template"`
+	LastRunAt       *string `json:"last_run_at"`
+	NextRunAt       *string `json:"next_run_at"`
+	UpdatedAt       string  `json:"updated_at"`
+	ID              int64   `json:"id"`
+}
+
+func (q *Queries) ScheduleUpdate(ctx context.Context, db DBTX, arg ScheduleUpdateParams) (Schedule, error) {
+	row := db.QueryRowContext(ctx, scheduleUpdate,
+		arg.Name,
+		arg.Cron,
+		arg.Timezone,
+		arg.Enabled,
+		arg.CommandTemplate,
+		arg.MissionTemplate,
+		arg.LastRunAt,
+		arg.NextRunAt,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Cron,
+		&i.Enabled,
+		&i.CommandTemplate,
+		&i.MissionTemplate,
+		&i.LastRunAt,
+		&i.NextRunAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+	)
+	return i, err
+}
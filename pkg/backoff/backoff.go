@@ -0,0 +1,51 @@
+package backoff
+
+import (
+	"context"
+	"time"
+)
+
+// Exponential is a backoff that starts at Initial and doubles after each attempt.
+// The delay is capped at Max if Max is greater than zero.
+type Exponential struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+// Delay returns the delay to wait after the attempt before the next one.
+// Attempts are counted from 1.
+func (b Exponential) Delay(attempt int) time.Duration {
+	if attempt < 1 || b.Initial <= 0 {
+		return 0
+	}
+
+	delay := b.Initial
+	for range attempt - 1 {
+		delay *= 2
+		if b.Max > 0 && delay >= b.Max {
+			return b.Max
+		}
+	}
+
+	if b.Max > 0 && delay > b.Max {
+		return b.Max
+	}
+	return delay
+}
+
+// Wait blocks for the delay, it returns the context error if the context is done first.
+func Wait(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
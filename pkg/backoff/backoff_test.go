@@ -0,0 +1,66 @@
+package backoff
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponential_Delay(t *testing.T) {
+	testCases := []struct {
+		name     string
+		backoff  Exponential
+		attempt  int
+		expected time.Duration
+	}{
+		{
+			name:     "Should return the initial delay after the first attempt",
+			backoff:  Exponential{Initial: 100 * time.Millisecond},
+			attempt:  1,
+			expected: 100 * time.Millisecond,
+		},
+		{
+			name:     "Should double the delay after each attempt",
+			backoff:  Exponential{Initial: 100 * time.Millisecond},
+			attempt:  4,
+			expected: 800 * time.Millisecond,
+		},
+		{
+			name:     "Should cap the delay at the max",
+			backoff:  Exponential{Initial: 100 * time.Millisecond, Max: 300 * time.Millisecond},
+			attempt:  3,
+			expected: 300 * time.Millisecond,
+		},
+		{
+			name:     "Should return zero without an initial delay",
+			backoff:  Exponential{Max: time.Second},
+			attempt:  2,
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.backoff.Delay(tc.attempt))
+		})
+	}
+}
+
+func TestWait(t *testing.T) {
+	t.Run("Should return the context error if the context is done first", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := Wait(ctx, time.Second)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("Should wait for the delay", func(t *testing.T) {
+		start := time.Now()
+		err := Wait(context.Background(), 20*time.Millisecond)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+}
@@ -1,21 +1,63 @@
 import type { AxiosRequestConfig } from 'axios'
 import type { SortPrefix } from '@/lib/sort'
-import type { Command, CommandInputMap, CommandStatus, CommandType } from '@/types/command'
+import type { Command, CommandInputMap, CommandQueueState, CommandSource, CommandStats, CommandStatus, CommandType, RetryPolicy } from '@/types/command'
 import type { Paging } from '@/types/paging'
 import http from '@/lib/http'
 
-export const COMMAND_SORT_VALUES = ['type', 'status', 'source', 'created_at', 'completed_at'] as const
+export const COMMAND_SORT_VALUES = ['type', 'status', 'source', 'priority', 'queue_position', 'created_at', 'completed_at'] as const
 export type CommandSort = typeof COMMAND_SORT_VALUES[number]
 export interface CreateCommandParams<T extends CommandType> {
   type: T
   inputs: CommandInputMap[T]
+  retry?: RetryPolicy
+  priority?: number
+  preempt?: boolean
+  notBefore?: string
+  insertBefore?: number
+  insertAfter?: number
 }
 
-export interface ListCommandsParams {
+export interface UpdateQueuedCommandParams<T extends CommandType> {
+  id: number
+  type: T
+  inputs: CommandInputMap[T]
+}
+
+export interface MoveQueuedCommandParams {
+  id: number
+  position: number
+}
+
+export interface CommandFilterParams {
+  statuses?: CommandStatus[]
+  types?: CommandType[]
+  sources?: CommandSource[]
+  requestId?: string
+  createdFrom?: string
+  createdTo?: string
+  completedFrom?: string
+  completedTo?: string
+}
+
+export interface ListCommandsParams extends CommandFilterParams {
   page?: number
   pageSize?: number
   sorts?: SortPrefix<CommandSort>[]
-  statuses?: CommandStatus[]
+}
+
+export type CommandExportFormat = 'csv' | 'jsonl'
+
+function commandFilterQuery(params: CommandFilterParams) {
+  return {
+    statuses: params.statuses?.length !== 0 ? params.statuses?.join(',') : undefined,
+    types: params.types?.length !== 0 ? params.types?.join(',') : undefined,
+    sources: params.sources?.length !== 0 ? params.sources?.join(',') : undefined,
+    requestId: params.requestId,
+    createdFrom: params.createdFrom,
+    createdTo: params.createdTo,
+    completedFrom: params.completedFrom,
+    completedTo: params.completedTo,
+  }
 }
 
 const commandsAPI = {
@@ -25,11 +67,26 @@ const commandsAPI = {
         page: params.page,
         pageSize: params.pageSize,
         sorts: params.sorts?.length !== 0 ? params.sorts?.join(',') : undefined,
-        statuses: params.statuses?.length !== 0 ? params.statuses?.join(',') : undefined,
+        ...commandFilterQuery(params),
       },
       ...axiosOpts,
     })
   },
+  getCommandStats: (params: CommandFilterParams, axiosOpts?: AxiosRequestConfig): Promise<CommandStats> => {
+    return http.get('/commands/stats', {
+      params: commandFilterQuery(params),
+      ...axiosOpts,
+    })
+  },
+  // exportCommandsURL returns the URL of the export, so that the browser downloads the stream.
+  exportCommandsURL: (format: CommandExportFormat, params: CommandFilterParams): string => {
+    const query = new URLSearchParams({ format })
+    for (const [key, value] of Object.entries(commandFilterQuery(params))) {
+      if (value !== undefined)
+        query.set(key, value)
+    }
+    return `/api/v1/commands/export?${query.toString()}`
+  },
   getCommand: (id: number, axiosOpts?: AxiosRequestConfig): Promise<Command> => {
     return http.get(`/commands/${id}`, axiosOpts)
   },
@@ -42,8 +99,29 @@ const commandsAPI = {
   cancelProcessingCommand: (): Promise<void> => {
     return http.post('/commands/processing/cancel')
   },
+  cancelCommand: (id: number): Promise<void> => {
+    return http.post(`/commands/${id}/cancel`)
+  },
+  cancelCommandByRequestId: (requestId: string): Promise<void> => {
+    return http.post('/commands/cancel', { requestId })
+  },
   deleteCommand: (id: number): Promise<void> => {
     return http.delete(`/commands/${id}`)
   },
+  updateQueuedCommand: <T extends CommandType>({ id, ...params }: UpdateQueuedCommandParams<T>): Promise<Command> => {
+    return http.patch(`/commands/${id}`, params)
+  },
+  moveQueuedCommand: ({ id, position }: MoveQueuedCommandParams): Promise<Command> => {
+    return http.post(`/commands/${id}/move`, { position })
+  },
+  getQueueState: (axiosOpts?: AxiosRequestConfig): Promise<CommandQueueState> => {
+    return http.get('/commands/queue', axiosOpts)
+  },
+  pauseQueue: (): Promise<CommandQueueState> => {
+    return http.post('/commands/queue/pause')
+  },
+  resumeQueue: (): Promise<CommandQueueState> => {
+    return http.post('/commands/queue/resume')
+  },
 }
 export default commandsAPI
@@ -0,0 +1,37 @@
+import type { AxiosRequestConfig } from 'axios'
+import type { CreateCommandParams } from '@/api/commands'
+import type { CommandType } from '@/types/command'
+import type { Mission, MissionOnFailurePolicy } from '@/types/mission'
+import type { Paging } from '@/types/paging'
+import http from '@/lib/http'
+
+export interface CreateMissionParams {
+  steps: CreateCommandParams<CommandType>[]
+  onFailure: MissionOnFailurePolicy
+  maxRetries?: number
+}
+
+export interface ListMissionsParams {
+  page?: number
+  pageSize?: number
+}
+
+const missionsAPI = {
+  listMissions: (params: ListMissionsParams, axiosOpts?: AxiosRequestConfig): Promise<Paging<Mission>> => {
+    return http.get('/missions', {
+      params,
+      ...axiosOpts,
+    })
+  },
+  getMission: (id: number, axiosOpts?: AxiosRequestConfig): Promise<Mission> => {
+    return http.get(`/missions/${id}`, axiosOpts)
+  },
+  createMission: (params: CreateMissionParams, axiosOpts?: AxiosRequestConfig): Promise<Mission> => {
+    return http.post('/missions', params, axiosOpts)
+  },
+  cancelMission: (id: number): Promise<void> => {
+    return http.post(`/missions/${id}/cancel`)
+  },
+}
+
+export default missionsAPI
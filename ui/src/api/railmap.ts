@@ -0,0 +1,43 @@
+import type { AxiosRequestConfig } from 'axios'
+import type { RailMap, RailMapTag, RailMapTopology } from '@/types/railmap'
+import http from '@/lib/http'
+
+export interface RailMapTagInput {
+  location: string
+  distanceToNext?: number | null
+  alias?: string | null
+}
+
+export interface UpdateRailMapParams {
+  topology: RailMapTopology
+  tags: RailMapTagInput[]
+}
+
+export interface CreateRailMapTagParams extends RailMapTagInput {
+  position?: number | null
+}
+
+export interface UpdateRailMapTagParams {
+  distanceToNext: number | null
+  alias: string | null
+}
+
+const railMapAPI = {
+  getRailMap: (axiosOpts?: AxiosRequestConfig): Promise<RailMap> => {
+    return http.get('/railmap', axiosOpts)
+  },
+  updateRailMap: (params: UpdateRailMapParams, axiosOpts?: AxiosRequestConfig): Promise<RailMap> => {
+    return http.put('/railmap', params, axiosOpts)
+  },
+  createTag: (params: CreateRailMapTagParams, axiosOpts?: AxiosRequestConfig): Promise<RailMapTag> => {
+    return http.post('/railmap/tags', params, axiosOpts)
+  },
+  updateTag: (id: number, params: UpdateRailMapTagParams, axiosOpts?: AxiosRequestConfig): Promise<RailMapTag> => {
+    return http.put(`/railmap/tags/${id}`, params, axiosOpts)
+  },
+  deleteTag: (id: number): Promise<void> => {
+    return http.delete(`/railmap/tags/${id}`)
+  },
+}
+
+export default railMapAPI
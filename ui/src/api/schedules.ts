@@ -0,0 +1,38 @@
+import type { AxiosRequestConfig } from 'axios'
+import type { Paging } from '@/types/paging'
+import type { Schedule, ScheduleCommandTemplate, ScheduleMissionTemplate } from '@/types/schedule'
+import http from '@/lib/http'
+
+export interface ScheduleParams {
+  name: string
+  cron: string
+  timezone?: string
+  enabled: boolean
+  command?: ScheduleCommandTemplate
+  mission?: ScheduleMissionTemplate
+}
+
+export interface ListSchedulesParams {
+  page?: number
+  pageSize?: number
+}
+
+const schedulesAPI = {
+  listSchedules: (params: ListSchedulesParams, axiosOpts?: AxiosRequestConfig): Promise<Paging<Schedule>> => {
+    return http.get('/schedules', { params, ...axiosOpts })
+  },
+  getSchedule: (id: number, axiosOpts?: AxiosRequestConfig): Promise<Schedule> => {
+    return http.get(`/schedules/${id}`, axiosOpts)
+  },
+  createSchedule: (params: ScheduleParams, axiosOpts?: AxiosRequestConfig): Promise<Schedule> => {
+    return http.post('/schedules', params, axiosOpts)
+  },
+  updateSchedule: (id: number, params: ScheduleParams, axiosOpts?: AxiosRequestConfig): Promise<Schedule> => {
+    return http.put(`/schedules/${id}`, params, axiosOpts)
+  },
+  deleteSchedule: (id: number): Promise<void> => {
+    return http.delete(`/schedules/${id}`)
+  },
+}
+
+export default schedulesAPI
@@ -1,6 +1,7 @@
 import { z } from 'zod'
+import { ConditionTypeValues } from '@/types/command'
 
-export const createCommandSchema = z.discriminatedUnion('type', [
+const commandInputsSchema = z.discriminatedUnion('type', [
   z.object({
     type: z.literal('STOP_MOVEMENT'),
     inputs: z.object({}).default({}),
@@ -9,7 +10,7 @@ export const createCommandSchema = z.discriminatedUnion('type', [
     type: z.literal('MOVE_TO'),
     inputs: z.object({
       location: z.string(),
-      direction: z.union([z.literal('FORWARD'), z.literal('BACKWARD')]),
+      direction: z.union([z.literal('FORWARD'), z.literal('BACKWARD')]).optional(),
       motorSpeed: z.number().min(0).max(100),
     }),
   }),
@@ -67,4 +68,24 @@ export const createCommandSchema = z.discriminatedUnion('type', [
       durationMs: z.number(),
     }),
   }),
+  z.object({
+    type: z.literal('ASSERT'),
+    inputs: z.object({
+      conditions: z.array(z.object({
+        type: z.enum(ConditionTypeValues),
+        batteryPercent: z.number().int().min(0).max(100).optional(),
+        location: z.string().optional(),
+      })).min(1),
+      abortMission: z.boolean().default(false),
+    }),
+  }),
 ])
+
+export const createCommandSchema = commandInputsSchema.and(z.object({
+  priority: z.number().int().min(0).max(100).default(0),
+  preempt: z.boolean().default(false),
+  insertBefore: z.number().int().min(1).optional(),
+  insertAfter: z.number().int().min(1).optional(),
+}))
+
+export const updateQueuedCommandSchema = commandInputsSchema
@@ -120,6 +120,7 @@ export function useCommandConfig() {
     CARGO_CHECK_QR: () => {},
     SCAN_LOCATION: () => {},
     WAIT: () => {},
+    ASSERT: () => {},
   }
 
   function updateCommandConfigFromInputs(type: CommandType, inputs: CommandInputMap[CommandType]) {
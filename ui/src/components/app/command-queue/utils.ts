@@ -10,7 +10,9 @@ import {
   Package,
   QrCode,
   Scan,
+  ShieldCheck,
   StopCircle,
+  Terminal,
 } from 'lucide-vue-next'
 
 dayjs.extend(relativeTime)
@@ -27,10 +29,12 @@ const commandIcons: Record<CommandType, LucideIcon> = {
   CARGO_CHECK_QR: QrCode,
   SCAN_LOCATION: Scan,
   WAIT: Clock,
+  ASSERT: ShieldCheck,
 }
 
 export function getCommandIcon(type: CommandType) {
-  return commandIcons[type]
+  // Custom command types registered on the robot have no icon
+  return commandIcons[type] ?? Terminal
 }
 
 export function getCommandName(type: CommandType) {
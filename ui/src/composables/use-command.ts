@@ -8,6 +8,7 @@ export const COMMAND_QUEUE_QUERY_KEY = 'queuedCommand'
 export const CURRENT_PROCESSING_COMMAND_QUERY_KEY = 'currentProcessingCommand'
 export const COMMANDS_QUERY_KEY = 'commands'
 export const COMMAND_QUERY_KEY = 'command'
+export const COMMAND_QUEUE_STATE_QUERY_KEY = 'commandQueueState'
 
 export function useCurrentProcessingCommandQuery(
   opts?: { axiosOpts?: Partial<AxiosRequestConfig>, refetchInterval?: number },
@@ -29,7 +30,7 @@ export function useListQueuedCommandsQuery(
     queryFn: () => commandsAPI.listCommands({
       page: page.value,
       pageSize: pageSize.value,
-      sorts: ['created_at'],
+      sorts: ['-priority', 'queue_position'],
       statuses: ['QUEUED'],
     }, opts?.axiosOpts),
     refetchInterval: opts?.refetchInterval,
@@ -73,6 +74,15 @@ export function useCancelProcessingCommandMutation() {
     mutationFn: commandsAPI.cancelProcessingCommand,
   })
 }
+export function useCancelCommandMutation() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: commandsAPI.cancelCommand,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [COMMANDS_QUERY_KEY] })
+    },
+  })
+}
 export function useDeleteCommandMutation() {
   const queryClient = useQueryClient()
   return useMutation({
@@ -82,3 +92,54 @@ export function useDeleteCommandMutation() {
     },
   })
 }
+
+export function useUpdateQueuedCommandMutation() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: commandsAPI.updateQueuedCommand,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [COMMAND_QUEUE_QUERY_KEY] })
+      queryClient.invalidateQueries({ queryKey: [COMMAND_QUERY_KEY] })
+    },
+  })
+}
+
+export function useMoveQueuedCommandMutation() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: commandsAPI.moveQueuedCommand,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [COMMAND_QUEUE_QUERY_KEY] })
+    },
+  })
+}
+
+export function useCommandQueueStateQuery(
+  opts?: { axiosOpts?: Partial<AxiosRequestConfig>, refetchInterval?: number },
+) {
+  return useQuery({
+    queryKey: [COMMAND_QUEUE_STATE_QUERY_KEY],
+    queryFn: () => commandsAPI.getQueueState(opts?.axiosOpts),
+    refetchInterval: opts?.refetchInterval,
+  })
+}
+
+export function usePauseCommandQueueMutation() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: commandsAPI.pauseQueue,
+    onSuccess: (state) => {
+      queryClient.setQueryData([COMMAND_QUEUE_STATE_QUERY_KEY], state)
+    },
+  })
+}
+
+export function useResumeCommandQueueMutation() {
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: commandsAPI.resumeQueue,
+    onSuccess: (state) => {
+      queryClient.setQueryData([COMMAND_QUEUE_STATE_QUERY_KEY], state)
+    },
+  })
+}
@@ -9,12 +9,32 @@ export interface ESPSerialConnection {
   connected: boolean
   lastConnectedAt?: string
   error?: string
+  frameStats: SerialFrameStats
+  board?: SerialBoardInfo
 }
 
 export interface PICSerialConnection {
   connected: boolean
   lastConnectedAt?: string
   error?: string
+  frameStats: SerialFrameStats
+  board?: SerialBoardInfo
+}
+
+export interface SerialFrameStats {
+  crcErrors: number
+  resyncs: number
+  sequenceGaps: number
+}
+
+export interface SerialBoardInfo {
+  firmwareVersion: string
+  protocolVersion: number
+  commands: number[]
+  syncStates: number[]
+  legacy: boolean
+  compatible: boolean
+  updatedAt: string
 }
 
 export interface RFIDUSBConnection {
@@ -7,12 +7,45 @@ export interface ObstacleTracking {
   exitDistance: number
 }
 
+export interface MoveConfig {
+  enableObstacleTracking: boolean
+  frontObstacleTracking: ObstacleTracking
+  backObstacleTracking: ObstacleTracking
+  accelerationRampMs: number
+  decelerationRampMs: number
+  approachSpeed: number
+}
+
 export interface CargoLowerConfig {
   stableReadCount: number
   bottomObstacleTracking: ObstacleTracking
 }
 
+export interface CommandTimeoutConfig {
+  stopMovementMs: number
+  moveForwardMs: number
+  moveBackwardMs: number
+  moveToMs: number
+  cargoOpenMs: number
+  cargoCloseMs: number
+  cargoLiftMs: number
+  cargoLowerMs: number
+  cargoCheckQRMs: number
+  scanLocationMs: number
+  waitMs: number
+}
+
+export type RecoveryPolicy = 'CANCEL_ALL' | 'FAIL_INTERRUPTED' | 'RERUN_IDEMPOTENT'
+
+export interface CommandRecoveryConfig {
+  policy: RecoveryPolicy
+  safeLiftPosition: number
+}
+
 export interface CommandConfig {
+  move: MoveConfig
   cargoLift: CargoLiftConfig
   cargoLower: CargoLowerConfig
+  timeout: CommandTimeoutConfig
+  recovery: CommandRecoveryConfig
 }
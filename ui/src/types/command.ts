@@ -1,55 +1,172 @@
 export interface StopMovementInputs {
   motorSpeed: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface MoveForwardInputs {
   motorSpeed: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface MoveBackwardInputs {
   motorSpeed: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface MoveToInputs {
   location: string
-  direction: 'FORWARD' | 'BACKWARD'
+  direction?: 'FORWARD' | 'BACKWARD'
   motorSpeed: number
+  approachLocations?: string[]
+  approachSpeed?: number
+  accelerationRampMs?: number
+  decelerationRampMs?: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface CargoOpenInputs {
   motorSpeed: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface CargoCloseInputs {
   motorSpeed: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface CargoLiftInputs {
   motorSpeed: number
   position: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface CargoLowerInputs {
   motorSpeed: number
   position: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
 export interface CargoCheckQRInputs {
   qrCode: string
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
+}
+export interface ScanLocationInputs {
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
 }
-export interface ScanLocationInputs {}
 export interface WaitInputs {
   durationMs: number
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
+}
+export interface AssertInputs {
+  conditions: Condition[]
+  timeoutMs?: number
+  preconditions?: Condition[]
+  abortMission?: boolean
+}
+export interface StopMovementOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface MoveForwardOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface MoveBackwardOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface MoveToOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface CargoOpenOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface CargoCloseOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface CargoLiftOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface CargoLowerOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface CargoCheckQROutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
 }
-export interface StopMovementOutputs {}
-export interface MoveForwardOutputs {}
-export interface MoveBackwardOutputs {}
-export interface MoveToOutputs {}
-export interface CargoOpenOutputs {}
-export interface CargoCloseOutputs {}
-export interface CargoLiftOutputs {}
-export interface CargoLowerOutputs {}
-export interface CargoCheckQROutputs {}
 export interface ScanLocationOutputs {
   locations: Location[]
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export interface AssertOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
+export const ConditionTypeValues = [
+  'CARGO_HAS_ITEM',
+  'CARGO_EMPTY',
+  'CARGO_DOOR_CLOSED',
+  'CARGO_DOOR_OPEN',
+  'BATTERY_AT_LEAST',
+  'AT_LOCATION',
+] as const
+export type ConditionType = typeof ConditionTypeValues[number]
+export interface Condition {
+  type: ConditionType
+  batteryPercent?: number
+  location?: string
+}
+export interface FailedCondition {
+  condition: Condition
+  actual: string
+  precondition: boolean
+}
+export interface Attempt {
+  attempt: number
+  startedAt: string
+  completedAt: string
+  error?: string
 }
 export interface Location {
   location: string
   scannedAt: string
 }
-export interface WaitOutputs {}
+export interface WaitOutputs {
+  elapsedMs?: number
+  attempts?: Attempt[]
+  failedCondition?: FailedCondition
+}
 export interface CommandInputMap {
   STOP_MOVEMENT: StopMovementInputs
   MOVE_FORWARD: MoveForwardInputs
@@ -62,6 +179,7 @@ export interface CommandInputMap {
   CARGO_CHECK_QR: CargoCheckQRInputs
   SCAN_LOCATION: ScanLocationInputs
   WAIT: WaitInputs
+  ASSERT: AssertInputs
 }
 export interface CommandOutputMap {
   STOP_MOVEMENT: StopMovementOutputs
@@ -75,6 +193,7 @@ export interface CommandOutputMap {
   CARGO_CHECK_QR: CargoCheckQROutputs
   SCAN_LOCATION: ScanLocationOutputs
   WAIT: WaitOutputs
+  ASSERT: AssertOutputs
 }
 
 export const CommandTypeValues = [
@@ -89,6 +208,7 @@ export const CommandTypeValues = [
   'CARGO_CHECK_QR',
   'SCAN_LOCATION',
   'WAIT',
+  'ASSERT',
 ] as const
 export type CommandType = typeof CommandTypeValues[number]
 
@@ -99,9 +219,27 @@ export type CommandStatus
     | 'SUCCEEDED'
     | 'FAILED'
     | 'CANCELED'
+    | 'TIMED_OUT'
 
 export type CommandSource = 'CLOUD' | 'APP'
 
+export interface RetryPolicy {
+  maxAttempts: number
+  initialBackoffMs: number
+  maxBackoffMs: number
+}
+
+export interface CommandProgress {
+  commandId: number
+  commandType: CommandType
+  currentPosition?: number
+  targetPosition?: number
+  passedLocations?: string[]
+  waitedMs?: number
+  message?: string
+  updatedAt: string
+}
+
 export interface Command<T extends CommandType = CommandType> {
   id: number
   type: T
@@ -110,8 +248,62 @@ export interface Command<T extends CommandType = CommandType> {
   inputs: CommandInputMap[T]
   outputs: CommandOutputMap[T]
   error?: string
+  missionId?: number
+  pausedForObstacle: boolean
+  retry?: RetryPolicy
+  priority: number
+  queuePosition: number
+  notBefore?: string
+  progress?: CommandProgress
   completedAt?: string
   startedAt?: string
   createdAt: string
   updatedAt: string
 }
+
+export interface CommandQueueState {
+  paused: boolean
+  updatedAt: string
+}
+
+export interface CommandTypeStats {
+  type: CommandType
+  total: number
+  succeeded: number
+  meanDurationMs: number
+  p50DurationMs: number
+  p95DurationMs: number
+}
+
+export interface SourceStats {
+  source: CommandSource
+  total: number
+  succeeded: number
+}
+
+export interface RouteStats {
+  from: string
+  to: string
+  count: number
+  meanDurationMs: number
+  p50DurationMs: number
+  p95DurationMs: number
+}
+
+export interface FailureStats {
+  error: string
+  count: number
+}
+
+export interface CommandStats {
+  total: number
+  succeeded: number
+  failed: number
+  timedOut: number
+  canceled: number
+  successRate: number
+  types: CommandTypeStats[]
+  sources: SourceStats[]
+  routes: RouteStats[]
+  failures: FailureStats[]
+}
@@ -38,21 +38,30 @@ export interface HardwareConfig {
   leds: LEDsConfig
 }
 
+export interface CommandACKRetryConfig {
+  maxAttempts: number
+  initialBackoff: number
+  maxBackoff: number
+}
+
 export interface ESPConfig {
   serial: SerialConfig
   enableAck: boolean
   commandAckTimeout: number
+  commandAckRetry: CommandACKRetryConfig
 }
 
 export interface PICConfig {
   serial: SerialConfig
   enableAck: boolean
   commandAckTimeout: number
+  commandAckRetry: CommandACKRetryConfig
 }
 
 export type Parity = 'NONE' | 'EVEN' | 'ODD'
 export type DataBits = 5 | 6 | 7 | 8
 export type StopBits = 1 | 1.5 | 2
+export type SerialProtocol = 'JSON' | 'FRAMED'
 
 export interface SerialConfig {
   port: string
@@ -61,6 +70,7 @@ export interface SerialConfig {
   dataBits: DataBits
   stopBits: StopBits
   readTimeout: number
+  protocol: SerialProtocol
 }
 
 export interface CloudConfig {
@@ -0,0 +1,24 @@
+import type { Command, CommandSource } from '@/types/command'
+
+export type MissionStatus
+  = | 'QUEUED'
+    | 'PROCESSING'
+    | 'SUCCEEDED'
+    | 'FAILED'
+    | 'CANCELED'
+
+export type MissionOnFailurePolicy = 'ABORT' | 'SKIP' | 'RETRY'
+
+export interface Mission {
+  id: number
+  status: MissionStatus
+  source: CommandSource
+  onFailure: MissionOnFailurePolicy
+  maxRetries: number
+  steps: Command[]
+  error?: string
+  startedAt?: string
+  completedAt?: string
+  createdAt: string
+  updatedAt: string
+}
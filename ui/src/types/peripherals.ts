@@ -1,3 +1,7 @@
 export interface SerialPort {
   port: string
+  isUsb: boolean
+  vid: string
+  pid: string
+  serialNumber: string
 }
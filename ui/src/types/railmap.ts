@@ -0,0 +1,17 @@
+export type RailMapTopology = 'LOOP' | 'LINEAR'
+
+export interface RailMapTag {
+  id: number
+  location: string
+  position: number
+  distanceToNext?: number
+  alias?: string
+  createdAt: string
+  updatedAt: string
+}
+
+export interface RailMap {
+  topology: RailMapTopology
+  tags: RailMapTag[]
+  updatedAt: string
+}
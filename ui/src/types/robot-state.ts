@@ -1,5 +1,6 @@
 import type { AppConnection } from './app-connection'
 import type { Cargo, CargoDoorMotorState } from './cargo'
+import type { CommandQueueState } from './command'
 import type { Led } from './led'
 
 export interface BatteryState {
@@ -70,4 +71,5 @@ export interface RobotState {
   cargoDoorMotor: CargoDoorMotorState
   appConnection: AppConnection
   leds: Leds
+  commandQueue: CommandQueueState
 }
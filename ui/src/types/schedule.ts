@@ -0,0 +1,32 @@
+import type { CommandInputMap, CommandType, RetryPolicy } from '@/types/command'
+import type { MissionOnFailurePolicy } from '@/types/mission'
+
+export interface ScheduleStep<T extends CommandType = CommandType> {
+  type: T
+  inputs: CommandInputMap[T]
+}
+
+export interface ScheduleCommandTemplate<T extends CommandType = CommandType> extends ScheduleStep<T> {
+  retry?: RetryPolicy
+  priority?: number
+}
+
+export interface ScheduleMissionTemplate {
+  steps: ScheduleStep[]
+  onFailure: MissionOnFailurePolicy
+  maxRetries?: number
+}
+
+export interface Schedule {
+  id: number
+  name: string
+  cron: string
+  timezone: string
+  enabled: boolean
+  command?: ScheduleCommandTemplate
+  mission?: ScheduleMissionTemplate
+  lastRunAt?: string
+  nextRunAt?: string
+  createdAt: string
+  updatedAt: string
+}